@@ -37,6 +37,18 @@ const (
 
 	// ClusterKind represents the Kind of Cluster.
 	ClusterKind = "Cluster"
+
+	// PreControlPlaneDeleteHookAnnotationPrefix annotation specifies the prefix we
+	// search each annotation for during the pre-control-plane.delete hook of a Cluster
+	// using the Ordered deletion order. These hooks will prevent the deletion of the
+	// control plane until all are removed.
+	PreControlPlaneDeleteHookAnnotationPrefix = "pre-control-plane.delete.hook.cluster.cluster.x-k8s.io"
+
+	// PreInfrastructureDeleteHookAnnotationPrefix annotation specifies the prefix we
+	// search each annotation for during the pre-infrastructure.delete hook of a Cluster
+	// using the Ordered deletion order. These hooks will prevent the deletion of the
+	// infrastructure cluster until all are removed.
+	PreInfrastructureDeleteHookAnnotationPrefix = "pre-infrastructure.delete.hook.cluster.cluster.x-k8s.io"
 )
 
 // ANCHOR: ClusterSpec
@@ -71,6 +83,35 @@ type ClusterSpec struct {
 	// this feature is highly experimental, and parts of it might still be not implemented.
 	// +optional
 	Topology *Topology `json:"topology,omitempty"`
+
+	// DeletionPolicy defines how the objects of the Cluster are deleted when the Cluster is deleted.
+	// +optional
+	DeletionPolicy *ClusterDeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// ClusterDeletionOrder defines the order in which the objects of a Cluster are deleted.
+// +kubebuilder:validation:Enum=Parallel;Ordered
+type ClusterDeletionOrder string
+
+const (
+	// ParallelClusterDeletionOrder deletes all the MachineDeployments, MachineSets, MachinePools and Machines
+	// of the Cluster at the same time, then the control plane and finally the infrastructure cluster.
+	ParallelClusterDeletionOrder ClusterDeletionOrder = "Parallel"
+
+	// OrderedClusterDeletionOrder deletes the Cluster in phases, waiting for each phase to complete before starting the next one:
+	// first the workers, i.e. MachineDeployments, MachineSets, MachinePools and worker Machines, then the control plane,
+	// i.e. the control plane object or the control plane Machines, and finally the infrastructure cluster.
+	// The control plane and the infrastructure phases are not started while the Cluster has, respectively,
+	// pre-control-plane.delete or pre-infrastructure.delete hook annotations.
+	OrderedClusterDeletionOrder ClusterDeletionOrder = "Ordered"
+)
+
+// ClusterDeletionPolicy defines how the objects of a Cluster are deleted.
+type ClusterDeletionPolicy struct {
+	// Order defines the order in which the objects of the Cluster are deleted.
+	// Defaults to Parallel.
+	// +optional
+	Order ClusterDeletionOrder `json:"order,omitempty"`
 }
 
 // Topology encapsulates the information of the managed resources.
@@ -179,6 +220,14 @@ type MachineDeploymentTopology struct {
 	// +optional
 	FailureDomain *string `json:"failureDomain,omitempty"`
 
+	// FailureDomainSpread defines how the machines are placed across the failure domains
+	// stored on the cluster object. When set to "Even", machines are evenly distributed
+	// across all failure domains and FailureDomain must not be set.
+	// Valid values are "None", "Even"
+	// +kubebuilder:validation:Enum=None;Even
+	// +optional
+	FailureDomainSpread *string `json:"failureDomainSpread,omitempty"`
+
 	// Replicas is the number of worker nodes belonging to this set.
 	// If the value is nil, the MachineDeployment is created without the number of Replicas (defaulting to 1)
 	// and it's assumed that an external entity (like cluster autoscaler) is responsible for the management
@@ -208,6 +257,11 @@ type MachineDeploymentTopology struct {
 	// +optional
 	NodeDeletionTimeout *metav1.Duration `json:"nodeDeletionTimeout,omitempty"`
 
+	// NodeDrainRules defines the order in which Pods are evicted when draining the Node
+	// and which Pods are excluded from the drain.
+	// +optional
+	NodeDrainRules *NodeDrainRules `json:"nodeDrainRules,omitempty"`
+
 	// Minimum number of seconds for which a newly created machine should
 	// be ready.
 	// Defaults to 0 (machine will be considered available as soon as it
@@ -220,6 +274,19 @@ type MachineDeploymentTopology struct {
 	// +optional
 	Strategy *MachineDeploymentStrategy `json:"strategy,omitempty"`
 
+	// PreflightChecks defines the preflight checks performed before creating new Machines.
+	// +optional
+	PreflightChecks *MachineSetPreflightChecks `json:"preflightChecks,omitempty"`
+
+	// RolloutAfter is a field to indicate a rollout should be performed
+	// after the specified time even if no changes have been made to the
+	// MachineDeployment.
+	// Example: In the YAML the time can be specified in the RFC3339 format.
+	// To specify the rolloutAfter target as March 9, 2023, at 9 am UTC
+	// use "2023-03-09T09:00:00Z".
+	// +optional
+	RolloutAfter *metav1.Time `json:"rolloutAfter,omitempty"`
+
 	// Variables can be used to customize the MachineDeployment through patches.
 	// +optional
 	Variables *MachineDeploymentVariables `json:"variables,omitempty"`
@@ -239,11 +306,34 @@ type MachineHealthCheckTopology struct {
 	// +optional
 	Enable *bool `json:"enable,omitempty"`
 
+	// OverrideStrategy defines how the MachineHealthCheck defined here is combined with the
+	// MachineHealthCheckClass defined in ClusterClass.
+	// Defaults to Replace.
+	// +optional
+	OverrideStrategy MachineHealthCheckOverrideStrategy `json:"overrideStrategy,omitempty"`
+
 	// MachineHealthCheckClass defines a MachineHealthCheck for a group of machines.
-	// If specified (any field is set), it entirely overrides the MachineHealthCheckClass defined in ClusterClass.
+	// If specified (any field is set), it overrides the MachineHealthCheckClass defined in ClusterClass
+	// according to OverrideStrategy.
 	MachineHealthCheckClass `json:",inline"`
 }
 
+// MachineHealthCheckOverrideStrategy defines how a MachineHealthCheck defined in the Cluster topology
+// is combined with the MachineHealthCheckClass defined in ClusterClass.
+// +kubebuilder:validation:Enum=Replace;Merge
+type MachineHealthCheckOverrideStrategy string
+
+const (
+	// ReplaceMachineHealthCheckOverrideStrategy uses the MachineHealthCheck defined in the Cluster topology
+	// instead of the MachineHealthCheckClass defined in ClusterClass.
+	ReplaceMachineHealthCheckOverrideStrategy MachineHealthCheckOverrideStrategy = "Replace"
+
+	// MergeMachineHealthCheckOverrideStrategy uses the MachineHealthCheckClass defined in ClusterClass, overriding
+	// only the fields set in the MachineHealthCheck defined in the Cluster topology; maxUnhealthy and unhealthyRange
+	// are overridden together when any of them is set.
+	MergeMachineHealthCheckOverrideStrategy MachineHealthCheckOverrideStrategy = "Merge"
+)
+
 // MachinePoolTopology specifies the different parameters for a pool of worker nodes in the topology.
 // This pool of nodes is managed by a MachinePool object whose lifecycle is managed by the Cluster controller.
 type MachinePoolTopology struct {
@@ -74,6 +74,46 @@ type ClusterClassSpec struct {
 	// Note: Patches will be applied in the order of the array.
 	// +optional
 	Patches []ClusterClassPatch `json:"patches,omitempty"`
+
+	// FieldOwnership defines how the topology controller handles fields of the objects it manages
+	// which are owned by other field managers.
+	// +optional
+	FieldOwnership *FieldOwnershipPolicy `json:"fieldOwnership,omitempty"`
+}
+
+// FieldConflictResolution defines how the topology controller resolves server side apply conflicts.
+type FieldConflictResolution string
+
+const (
+	// ForceFieldConflictResolution makes the topology controller take ownership of all the conflicting fields.
+	ForceFieldConflictResolution FieldConflictResolution = "Force"
+
+	// YieldFieldConflictResolution makes the topology controller leave conflicting fields to the other field managers,
+	// except for the fields explicitly listed in ForceOwnershipPaths.
+	YieldFieldConflictResolution FieldConflictResolution = "Yield"
+)
+
+// FieldOwnershipPolicy defines how the topology controller handles server side apply conflicts with
+// other field managers.
+// NOTE: Conflicts are always surfaced in the TopologyFieldOwnership condition of the Cluster, no matter
+// of how they are resolved.
+type FieldOwnershipPolicy struct {
+	// ConflictResolution defines how conflicts with other field managers are resolved.
+	// If set to Force, the topology controller takes ownership of all the conflicting fields.
+	// If set to Yield, the topology controller takes ownership only of the conflicting fields matching
+	// one of the ForceOwnershipPaths, while all the other conflicting fields are left to their current managers.
+	// Defaults to Force.
+	// +optional
+	// +kubebuilder:validation:Enum=Force;Yield
+	ConflictResolution FieldConflictResolution `json:"conflictResolution,omitempty"`
+
+	// ForceOwnershipPaths is a list of field paths the topology controller always takes ownership of
+	// when ConflictResolution is set to Yield. Paths use the same format reported by server side apply
+	// conflicts, e.g. ".spec.replicas" or ".metadata.labels.environment"; a path also matches all the
+	// fields nested below it.
+	// NOTE: conflicts on fields nested in list items are always resolved by taking ownership.
+	// +optional
+	ForceOwnershipPaths []string `json:"forceOwnershipPaths,omitempty"`
 }
 
 // ControlPlaneClass defines the class for the control plane.
@@ -203,6 +243,12 @@ type MachineDeploymentClass struct {
 	// +optional
 	NodeDeletionTimeout *metav1.Duration `json:"nodeDeletionTimeout,omitempty"`
 
+	// NodeDrainRules defines the order in which Pods are evicted when draining the Node
+	// and which Pods are excluded from the drain.
+	// NOTE: This value can be overridden while defining a Cluster.Topology using this MachineDeploymentClass.
+	// +optional
+	NodeDrainRules *NodeDrainRules `json:"nodeDrainRules,omitempty"`
+
 	// Minimum number of seconds for which a newly created machine should
 	// be ready.
 	// Defaults to 0 (machine will be considered available as soon as it
@@ -214,6 +260,11 @@ type MachineDeploymentClass struct {
 	// new ones.
 	// NOTE: This value can be overridden while defining a Cluster.Topology using this MachineDeploymentClass.
 	Strategy *MachineDeploymentStrategy `json:"strategy,omitempty"`
+
+	// PreflightChecks defines the preflight checks performed before creating new Machines.
+	// NOTE: This value can be overridden while defining a Cluster.Topology using this MachineDeploymentClass.
+	// +optional
+	PreflightChecks *MachineSetPreflightChecks `json:"preflightChecks,omitempty"`
 }
 
 // MachineDeploymentClassTemplate defines how a MachineDeployment generated from a MachineDeploymentClass
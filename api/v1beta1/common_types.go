@@ -60,6 +60,12 @@ const (
 	// a classy Cluster to define the maximum concurrency while upgrading MachineDeployments.
 	ClusterTopologyUpgradeConcurrencyAnnotation = "topology.cluster.x-k8s.io/upgrade-concurrency"
 
+	// ClusterTopologyInPlaceUpdateHashAnnotation is the annotation set by the topology controller on the MachineDeployment objects
+	// to track the hash of the machine template whose Machines Runtime Extensions accepted to update in-place via the UpdateMachine hook.
+	// The MachineDeployment controller uses it to propagate the references to the rotated bootstrap and infrastructure machine
+	// templates to the existing MachineSet, instead of creating a new MachineSet and rolling out the Machines.
+	ClusterTopologyInPlaceUpdateHashAnnotation = "topology.cluster.x-k8s.io/in-place-update-hash"
+
 	// ClusterTopologyMachinePoolNameLabel is the label set on the generated  MachinePool objects
 	// to track the name of the MachinePool topology it represents.
 	ClusterTopologyMachinePoolNameLabel = "topology.cluster.x-k8s.io/pool-name"
@@ -92,6 +98,14 @@ const (
 	// LabelsFromMachineAnnotation is the annotation set on nodes to track the labels originated from machines.
 	LabelsFromMachineAnnotation = "cluster.x-k8s.io/labels-from-machine"
 
+	// AnnotationsFromMachineAnnotation is the annotation set on nodes to track the annotations originated
+	// from the nodeMetadata of machines.
+	AnnotationsFromMachineAnnotation = "cluster.x-k8s.io/annotations-from-machine"
+
+	// TaintsFromMachineAnnotation is the annotation set on nodes to track the taints originated
+	// from the nodeMetadata of machines, in the key:effect format.
+	TaintsFromMachineAnnotation = "cluster.x-k8s.io/taints-from-machine"
+
 	// OwnerNameAnnotation is the annotation set on nodes identifying the owner name.
 	OwnerNameAnnotation = "cluster.x-k8s.io/owner-name"
 
@@ -117,6 +131,13 @@ const (
 	// when KCP or a machineset scales down. This annotation is given top priority on all delete policies.
 	DeleteMachineAnnotation = "cluster.x-k8s.io/delete-machine"
 
+	// DeleteMachinePriorityAnnotation sets the priority, between 0 and 100, used to choose the worker nodes to delete
+	// when a machineset scales down; a higher value means that the Machine is deleted earlier.
+	// When set, it takes precedence over the priority computed by the delete policy; Machines with the
+	// DeleteMachineAnnotation, unhealthy Machines and Machines that are already being deleted are not affected,
+	// and they are always deleted first.
+	DeleteMachinePriorityAnnotation = "cluster.x-k8s.io/delete-machine-priority"
+
 	// TemplateClonedFromNameAnnotation is the infrastructure machine annotation that stores the name of the infrastructure template resource
 	// that was cloned for the machine. This annotation is set only during cloning a template. Older/adopted machines will not have this annotation.
 	TemplateClonedFromNameAnnotation = "cluster.x-k8s.io/cloned-from-name"
@@ -194,12 +215,39 @@ const (
 	// Note: It can be used by setting as top level annotation on MachineDeployment and MachineSets.
 	AutoscalerMaxSizeAnnotation = "cluster.x-k8s.io/cluster-api-autoscaler-node-group-max-size"
 
+	// AutoscalerCapacityCPUAnnotation defines the number of CPUs of the Nodes of a node group.
+	// The annotation is used by the autoscaler to scale a node group from zero.
+	// Note: It can be used by setting as top level annotation on MachineDeployments, MachineSets and MachinePools;
+	// for MachinePools it is set by the MachinePool controller if the InfrastructureMachinePool reports status.capacity.
+	AutoscalerCapacityCPUAnnotation = "capacity.cluster-autoscaler.kubernetes.io/cpu"
+
+	// AutoscalerCapacityMemoryAnnotation defines the amount of memory of the Nodes of a node group.
+	// The annotation is used by the autoscaler to scale a node group from zero.
+	AutoscalerCapacityMemoryAnnotation = "capacity.cluster-autoscaler.kubernetes.io/memory"
+
+	// AutoscalerCapacityEphemeralDiskAnnotation defines the amount of ephemeral storage of the Nodes of a node group.
+	// The annotation is used by the autoscaler to scale a node group from zero.
+	AutoscalerCapacityEphemeralDiskAnnotation = "capacity.cluster-autoscaler.kubernetes.io/ephemeral-disk"
+
+	// AutoscalerCapacityMaxPodsAnnotation defines the maximum number of Pods of the Nodes of a node group.
+	// The annotation is used by the autoscaler to scale a node group from zero.
+	AutoscalerCapacityMaxPodsAnnotation = "capacity.cluster-autoscaler.kubernetes.io/maxPods"
+
+	// AutoscalerCapacityGPUTypeAnnotation defines the resource name of the GPUs of the Nodes of a node group, e.g. nvidia.com/gpu.
+	// The annotation is used by the autoscaler to scale a node group from zero.
+	AutoscalerCapacityGPUTypeAnnotation = "capacity.cluster-autoscaler.kubernetes.io/gpu-type"
+
+	// AutoscalerCapacityGPUCountAnnotation defines the number of GPUs of the Nodes of a node group.
+	// The annotation is used by the autoscaler to scale a node group from zero.
+	AutoscalerCapacityGPUCountAnnotation = "capacity.cluster-autoscaler.kubernetes.io/gpu-count"
+
 	// VariableDefinitionFromInline indicates a patch or variable was defined in the `.spec` of a ClusterClass
 	// rather than from an external patch extension.
 	VariableDefinitionFromInline = "inline"
 )
 
 // MachineSetPreflightCheck defines a valid MachineSet preflight check.
+// +kubebuilder:validation:Enum=All;KubeadmVersionSkew;KubernetesVersionSkew;ControlPlaneIsStable;RuntimeExtensions
 type MachineSetPreflightCheck string
 
 const (
@@ -229,8 +277,25 @@ const (
 	// The preflight check is only run if a ControlPlane is used (controlPlaneRef must exist in the Cluster)
 	// and the ControlPlane has a version.
 	MachineSetPreflightCheckControlPlaneIsStable MachineSetPreflightCheck = "ControlPlaneIsStable"
+
+	// MachineSetPreflightCheckRuntimeExtensions is the name of the preflight check
+	// that calls the Runtime Extensions implementing the BeforeMachineCreate hook, thus allowing
+	// external components to contribute custom preflight checks, e.g. to verify that the machine image
+	// exists in the target region.
+	// The preflight check is only run if both the MachineSetPreflightChecks and the RuntimeSDK feature flags are enabled.
+	MachineSetPreflightCheckRuntimeExtensions MachineSetPreflightCheck = "RuntimeExtensions"
 )
 
+// MachineSetPreflightChecks defines the preflight checks performed by a MachineSet before creating new Machines.
+type MachineSetPreflightChecks struct {
+	// Skip is the list of preflight checks to skip, in addition to the preflight checks listed in the
+	// machineset.cluster.x-k8s.io/skip-preflight-checks annotation.
+	// "All" skips all the preflight checks, including the ones performed by Runtime Extensions.
+	// +optional
+	// +kubebuilder:validation:MaxItems=5
+	Skip []MachineSetPreflightCheck `json:"skip,omitempty"`
+}
+
 // NodeOutdatedRevisionTaint can be added to Nodes at rolling updates in general triggered by updating MachineDeployment
 // This taint is used to prevent unnecessary pod churn, i.e., as the first node is drained, pods previously running on
 // that node are scheduled onto nodes who have yet to be replaced, but will be torn down soon.
@@ -102,6 +102,61 @@ const (
 	// NOTE: Having the control plane machine available is a pre-condition for joining additional control planes
 	// or workers nodes.
 	WaitingForControlPlaneAvailableReason = "WaitingForControlPlaneAvailable"
+
+	// PreControlPlaneDeleteHookSucceededCondition reports a cluster waiting for a PreControlPlaneDeleteHook
+	// before deleting the control plane.
+	PreControlPlaneDeleteHookSucceededCondition ConditionType = "PreControlPlaneDeleteHookSucceeded"
+
+	// PreInfrastructureDeleteHookSucceededCondition reports a cluster waiting for a PreInfrastructureDeleteHook
+	// before deleting the infrastructure cluster.
+	PreInfrastructureDeleteHookSucceededCondition ConditionType = "PreInfrastructureDeleteHookSucceeded"
+
+	// ClusterAvailableCondition reports an aggregate of the status of all the resources owned by the Cluster,
+	// i.e. the InfrastructureCluster, the ControlPlane, the MachineDeployments, the MachinePools and the
+	// MachineHealthChecks. When the condition is false, the reason reports the first degraded component,
+	// while the message lists all the degraded components.
+	ClusterAvailableCondition ConditionType = "Available"
+
+	// InfrastructureNotReadyReason (Severity=Warning) documents a Cluster not available because
+	// the InfrastructureCluster is not ready.
+	InfrastructureNotReadyReason = "InfrastructureNotReady"
+
+	// ControlPlaneNotAvailableReason (Severity=Warning) documents a Cluster not available because
+	// the ControlPlane is not ready.
+	ControlPlaneNotAvailableReason = "ControlPlaneNotAvailable"
+
+	// MachineDeploymentsNotAvailableReason (Severity=Warning) documents a Cluster not available because
+	// one or more MachineDeployments are not available.
+	MachineDeploymentsNotAvailableReason = "MachineDeploymentsNotAvailable"
+
+	// MachinePoolsNotReadyReason (Severity=Warning) documents a Cluster not available because
+	// one or more MachinePools do not have all their replicas ready.
+	MachinePoolsNotReadyReason = "MachinePoolsNotReady"
+
+	// MachinesUnhealthyReason (Severity=Warning) documents a Cluster not available because
+	// one or more MachineHealthChecks are reporting unhealthy Machines.
+	MachinesUnhealthyReason = "MachinesUnhealthy"
+
+	// VersionSkewSupportedCondition documents if the Kubernetes version of the workers of a Cluster, i.e. of the
+	// Machines not belonging to the control plane and of the MachinePools, is within the version skew supported by the
+	// Kubernetes version of the control plane.
+	VersionSkewSupportedCondition ConditionType = "VersionSkewSupported"
+
+	// UnsupportedVersionSkewReason (Severity=Warning) documents a Cluster with workers newer than the control plane or
+	// older than the oldest version supported by the control plane.
+	UnsupportedVersionSkewReason = "UnsupportedVersionSkew"
+
+	// VersionSkewAtLimitReason (Severity=Info) documents a Cluster with workers at the oldest version supported by the
+	// control plane; the workers must be upgraded before upgrading the control plane to the next minor version.
+	VersionSkewAtLimitReason = "VersionSkewAtLimit"
+
+	// RefVersionsNotDeprecatedCondition documents if the objects of a Cluster, i.e. the InfrastructureCluster, the control
+	// plane and the templates of the MachineDeployments, are referenced using API versions not deprecated by their providers.
+	RefVersionsNotDeprecatedCondition ConditionType = "RefVersionsNotDeprecated"
+
+	// DeprecatedRefVersionsReason (Severity=Warning) documents a Cluster referencing at least one object using an API
+	// version marked as deprecated in the provider's CustomResourceDefinition.
+	DeprecatedRefVersionsReason = "DeprecatedRefVersions"
 )
 
 // Conditions and condition Reasons for the Machine object.
@@ -137,6 +192,10 @@ const (
 	// WaitingExternalHookReason (Severity=Info) provide evidence that we are waiting for an external hook to complete.
 	WaitingExternalHookReason = "WaitingExternalHook"
 
+	// ExternalHookTimedOutReason (Severity=Error) documents an external hook blocking the deletion of a machine
+	// for longer than its timeout.
+	ExternalHookTimedOutReason = "ExternalHookTimedOut"
+
 	// VolumeDetachSucceededCondition reports a machine waiting for volumes to be detached.
 	VolumeDetachSucceededCondition ConditionType = "VolumeDetachSucceeded"
 
@@ -216,6 +275,15 @@ const (
 	// This reason is used when the Machine controller is unable to list Nodes to find
 	// the corresponding Node for a Machine by ProviderID.
 	NodeInspectionFailedReason = "NodeInspectionFailed"
+
+	// NodeMetadataSyncedCondition reports whether the labels, annotations and taints defined in the Machine's
+	// nodeMetadata have been set on the Node.
+	NodeMetadataSyncedCondition ConditionType = "NodeMetadataSynced"
+
+	// NodeMetadataConflictReason (Severity=Warning) documents that some of the labels, annotations or taints
+	// defined in the Machine's nodeMetadata have not been set on the Node because their keys are managed by
+	// the Node itself or by Cluster API.
+	NodeMetadataConflictReason = "NodeMetadataConflict"
 )
 
 // Conditions and condition Reasons for the MachineHealthCheck object.
@@ -228,6 +296,10 @@ const (
 	// TooManyUnhealthyReason is the reason used when too many Machines are unhealthy and the MachineHealthCheck is blocked
 	// from making any further remediations.
 	TooManyUnhealthyReason = "TooManyUnhealthy"
+
+	// RemediationRateLimitedReason is the reason used when the MachineHealthCheck is blocked from making any further
+	// remediations by the cooldown period or by the maximum number of remediations per hour defined in its remediationBackoff.
+	RemediationRateLimitedReason = "RemediationRateLimited"
 )
 
 // Conditions and condition Reasons for  MachineDeployments.
@@ -337,6 +409,17 @@ const (
 	// yet completed because the ClusterClass has not reconciled yet. If this condition persists there may be an issue
 	// with the ClusterClass surfaced in the ClusterClass status or controller logs.
 	TopologyReconciledClusterClassNotReconciledReason = "ClusterClassNotReconciled"
+
+	// TopologyFieldOwnershipCondition documents if the topology controller is the only field manager of the fields
+	// it has an opinion on in the objects of a Cluster topology.
+	// Status false means that other field managers (e.g. GitOps tools or users running kubectl apply) own some of
+	// those fields, which generally leads to the topology controller and the other field managers continuously
+	// overwriting each other's changes.
+	TopologyFieldOwnershipCondition ConditionType = "TopologyFieldOwnership"
+
+	// TopologyFieldConflictReason (Severity=Warning) documents that server side apply detected conflicts between
+	// the topology controller and other field managers on at least one of the objects of a Cluster topology.
+	TopologyFieldConflictReason = "FieldConflict"
 )
 
 // Conditions and condition reasons for ClusterClass.
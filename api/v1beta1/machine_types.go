@@ -18,7 +18,11 @@ package v1beta1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	capierrors "sigs.k8s.io/cluster-api/errors"
 )
@@ -63,6 +67,20 @@ const (
 	// an instance from an infrastructure provider until all are removed.
 	PreTerminateDeleteHookAnnotationPrefix = "pre-terminate.delete.hook.machine.cluster.x-k8s.io"
 
+	// PreDrainDeleteHookTimeoutAnnotationPrefix annotation specifies the prefix we
+	// search each annotation for to get the timeout of a pre-drain.delete lifecycle hook.
+	// The annotation key must be the prefix followed by the name of the hook, e.g.
+	// "timeout.pre-drain.delete.hook.machine.cluster.x-k8s.io/my-hook", and the value a duration, e.g. "30m".
+	// When the hook blocks the deletion of the Machine for longer than the timeout, a failure condition is raised.
+	PreDrainDeleteHookTimeoutAnnotationPrefix = "timeout.pre-drain.delete.hook.machine.cluster.x-k8s.io"
+
+	// PreTerminateDeleteHookTimeoutAnnotationPrefix annotation specifies the prefix we
+	// search each annotation for to get the timeout of a pre-terminate.delete lifecycle hook.
+	// The annotation key must be the prefix followed by the name of the hook, e.g.
+	// "timeout.pre-terminate.delete.hook.machine.cluster.x-k8s.io/my-hook", and the value a duration, e.g. "30m".
+	// When the hook blocks the deletion of the Machine for longer than the timeout, a failure condition is raised.
+	PreTerminateDeleteHookTimeoutAnnotationPrefix = "timeout.pre-terminate.delete.hook.machine.cluster.x-k8s.io"
+
 	// MachineCertificatesExpiryDateAnnotation annotation specifies the expiry date of the machine certificates in RFC3339 format.
 	// This annotation can be used on control plane machines to trigger rollout before certificates expire.
 	// This annotation can be set on BootstrapConfig or Machine objects. The value set on the Machine object takes precedence.
@@ -132,10 +150,154 @@ type MachineSpec struct {
 	// Defaults to 10 seconds.
 	// +optional
 	NodeDeletionTimeout *metav1.Duration `json:"nodeDeletionTimeout,omitempty"`
+
+	// NodeDrainRules defines the order in which Pods are evicted when draining the Node
+	// and which Pods are excluded from the drain.
+	// If not set, all Pods are evicted at the same time.
+	// +optional
+	NodeDrainRules *NodeDrainRules `json:"nodeDrainRules,omitempty"`
+
+	// NodeMetadata defines labels, annotations and taints which are continuously reconciled onto the Node
+	// of the Machine; labels, annotations and taints removed from NodeMetadata are removed from the Node.
+	// NOTE: Keys managed by the Node itself (e.g. in the kubernetes.io and k8s.io domains) or by Cluster API
+	// are never set on the Node, and are reported in the NodeMetadataSynced condition instead.
+	// +optional
+	NodeMetadata *NodeMetadata `json:"nodeMetadata,omitempty"`
 }
 
 // ANCHOR_END: MachineSpec
 
+// NodeMetadata defines the labels, annotations and taints reconciled onto the Node of a Machine.
+type NodeMetadata struct {
+	// Labels to set on the Node.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations to set on the Node.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Taints to set on the Node.
+	// +optional
+	Taints []corev1.Taint `json:"taints,omitempty"`
+}
+
+// Validate validates the NodeMetadata.
+func (m *NodeMetadata) Validate(parent *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if m == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(m.Labels, parent.Child("labels"))...)
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(m.Annotations, parent.Child("annotations"))...)
+
+	taintKeys := map[string]bool{}
+	for i, taint := range m.Taints {
+		taintPath := parent.Child("taints").Index(i)
+		for _, msg := range validation.IsQualifiedName(taint.Key) {
+			allErrs = append(allErrs, field.Invalid(taintPath.Child("key"), taint.Key, msg))
+		}
+		if taint.Value != "" {
+			for _, msg := range validation.IsValidLabelValue(taint.Value) {
+				allErrs = append(allErrs, field.Invalid(taintPath.Child("value"), taint.Value, msg))
+			}
+		}
+		switch taint.Effect {
+		case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		default:
+			allErrs = append(allErrs, field.NotSupported(taintPath.Child("effect"), taint.Effect,
+				[]string{string(corev1.TaintEffectNoSchedule), string(corev1.TaintEffectPreferNoSchedule), string(corev1.TaintEffectNoExecute)}))
+		}
+		taintKey := taint.Key + ":" + string(taint.Effect)
+		if taintKeys[taintKey] {
+			allErrs = append(allErrs, field.Duplicate(taintPath, taintKey))
+		}
+		taintKeys[taintKey] = true
+	}
+	return allErrs
+}
+
+// NodeDrainOrder defines the order in which Pods are evicted when draining a Node.
+type NodeDrainOrder string
+
+const (
+	// PodPriorityNodeDrainOrder evicts Pods in ascending order of their priority,
+	// i.e. Pods with the lowest priority are evicted first.
+	PodPriorityNodeDrainOrder NodeDrainOrder = "PodPriority"
+
+	// LabelNodeDrainOrder evicts Pods in ascending order of the integer value of the label defined in OrderLabel.
+	// Pods without the label or with a value which is not an integer are evicted as if the value was 0.
+	LabelNodeDrainOrder NodeDrainOrder = "Label"
+)
+
+// NodeDrainRules defines how the Node of a Machine is drained.
+type NodeDrainRules struct {
+	// Order defines the order in which Pods are evicted; Pods with the same order are evicted
+	// at the same time, and only once all the Pods of the previous group are gone.
+	// If not set, all Pods are evicted at the same time.
+	// +kubebuilder:validation:Enum=PodPriority;Label
+	// +optional
+	Order NodeDrainOrder `json:"order,omitempty"`
+
+	// OrderLabel is the key of the label defining the order in which Pods are evicted.
+	// It must be set if and only if Order is Label.
+	// +optional
+	OrderLabel string `json:"orderLabel,omitempty"`
+
+	// Exclusions defines the Pods which are never evicted when draining the Node and
+	// thus never block the drain.
+	// +optional
+	Exclusions []NodeDrainExclusion `json:"exclusions,omitempty"`
+}
+
+// NodeDrainExclusion defines a set of Pods which are excluded from the drain.
+// A Pod is excluded if it matches all the criteria set in the exclusion.
+type NodeDrainExclusion struct {
+	// Namespace of the excluded Pods.
+	// If not set, Pods from all the namespaces are excluded.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// PodSelector is a label selector for the excluded Pods.
+	// If not set, all the Pods in Namespace are excluded.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// Validate validates the NodeDrainRules.
+func (r *NodeDrainRules) Validate(parent *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if r == nil {
+		return allErrs
+	}
+
+	switch {
+	case r.Order == LabelNodeDrainOrder && r.OrderLabel == "":
+		allErrs = append(allErrs, field.Required(parent.Child("orderLabel"), "must be set if order is Label"))
+	case r.Order != LabelNodeDrainOrder && r.OrderLabel != "":
+		allErrs = append(allErrs, field.Forbidden(parent.Child("orderLabel"), "can only be set if order is Label"))
+	case r.OrderLabel != "":
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(r.OrderLabel, parent.Child("orderLabel"))...)
+	}
+
+	for i, exclusion := range r.Exclusions {
+		exclusionPath := parent.Child("exclusions").Index(i)
+		if exclusion.Namespace == "" && exclusion.PodSelector == nil {
+			allErrs = append(allErrs, field.Required(exclusionPath, "at least one of namespace or podSelector must be set"))
+		}
+		if exclusion.Namespace != "" {
+			for _, msg := range validation.IsDNS1123Label(exclusion.Namespace) {
+				allErrs = append(allErrs, field.Invalid(exclusionPath.Child("namespace"), exclusion.Namespace, msg))
+			}
+		}
+		if exclusion.PodSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(exclusion.PodSelector, metav1validation.LabelSelectorValidationOptions{}, exclusionPath.Child("podSelector"))...)
+		}
+	}
+	return allErrs
+}
+
 // ANCHOR: MachineStatus
 
 // MachineStatus defines the observed state of Machine.
@@ -206,6 +368,11 @@ type MachineStatus struct {
 	// +optional
 	CertificatesExpiryDate *metav1.Time `json:"certificatesExpiryDate,omitempty"`
 
+	// PendingDeletionHooks is the list of pre-drain and pre-terminate deletion hooks
+	// which are currently blocking the deletion of the Machine.
+	// +optional
+	PendingDeletionHooks []MachineDeletionHook `json:"pendingDeletionHooks,omitempty"`
+
 	// BootstrapReady is the state of the bootstrap provider.
 	// +optional
 	BootstrapReady bool `json:"bootstrapReady"`
@@ -225,6 +392,19 @@ type MachineStatus struct {
 
 // ANCHOR_END: MachineStatus
 
+// MachineDeletionHook describes a deletion hook which is blocking the deletion of a Machine.
+type MachineDeletionHook struct {
+	// Name is the name of the hook annotation, e.g. "pre-drain.delete.hook.machine.cluster.x-k8s.io/my-hook".
+	Name string `json:"name"`
+
+	// Owner is the value of the hook annotation, which by convention identifies the owner of the hook.
+	// +optional
+	Owner string `json:"owner,omitempty"`
+
+	// WaitingSince is the time when the hook was first observed blocking the deletion of the Machine.
+	WaitingSince metav1.Time `json:"waitingSince"`
+}
+
 // SetTypedPhase sets the Phase field to the string representation of MachinePhase.
 func (m *MachineStatus) SetTypedPhase(p MachinePhase) {
 	m.Phase = string(p)
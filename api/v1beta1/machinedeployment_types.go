@@ -126,6 +126,19 @@ type MachineDeploymentSpec struct {
 	// +optional
 	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
 
+	// FailureDomainSpread defines how new Machines are placed across the failure domains
+	// reported by the Cluster when the Machine template does not define a failure domain.
+	// Valid values are "None", "Even"
+	// When no value is supplied, the default FailureDomainSpread of MachineSet is used
+	// +kubebuilder:validation:Enum=None;Even
+	// +optional
+	FailureDomainSpread *string `json:"failureDomainSpread,omitempty"`
+
+	// PreflightChecks defines the preflight checks performed by the MachineSets of this MachineDeployment
+	// before creating new Machines.
+	// +optional
+	PreflightChecks *MachineSetPreflightChecks `json:"preflightChecks,omitempty"`
+
 	// The number of old MachineSets to retain to allow rollback.
 	// This is a pointer to distinguish between explicit zero and not specified.
 	// Defaults to 1.
@@ -202,9 +215,9 @@ type MachineRollingUpdateDeployment struct {
 	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
 
 	// DeletePolicy defines the policy used by the MachineDeployment to identify nodes to delete when downscaling.
-	// Valid values are "Random, "Newest", "Oldest"
+	// Valid values are "Random, "Newest", "Oldest", "BalancedOldest"
 	// When no value is supplied, the default DeletePolicy of MachineSet is used
-	// +kubebuilder:validation:Enum=Random;Newest;Oldest
+	// +kubebuilder:validation:Enum=Random;Newest;Oldest;BalancedOldest
 	// +optional
 	DeletePolicy *string `json:"deletePolicy,omitempty"`
 }
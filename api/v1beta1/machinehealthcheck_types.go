@@ -30,6 +30,10 @@ var (
 	// 10 minutes should allow the instance to start and the node to join the
 	// cluster on most providers.
 	DefaultNodeStartupTimeout = metav1.Duration{Duration: 10 * time.Minute}
+
+	// DefaultRemediationMaxBackoff is the maximum time to wait before remediating again the same Machine
+	// if a remediation backoff is configured without MaxBackoff.
+	DefaultRemediationMaxBackoff = metav1.Duration{Duration: time.Hour}
 )
 
 // ANCHOR: MachineHealthCheckSpec
@@ -79,10 +83,90 @@ type MachineHealthCheckSpec struct {
 	// a controller that lives outside of Cluster API.
 	// +optional
 	RemediationTemplate *corev1.ObjectReference `json:"remediationTemplate,omitempty"`
+
+	// RemediationBackoff configures the backoff and the rate limiting of the remediations triggered by this
+	// MachineHealthCheck, so that e.g. a flapping Node condition or a bad image don't cause endless remediation loops.
+	// If not set, remediations are triggered as soon as Machines are detected as unhealthy.
+	// +optional
+	RemediationBackoff *MachineHealthCheckRemediationBackoff `json:"remediationBackoff,omitempty"`
+
+	// RemediationStrategies is an ordered list of remediation strategies for unhealthy Machines, e.g. an external
+	// reboot first and then the deletion of the Machine; a strategy is applied to a Machine only after all the
+	// previous strategies failed to bring the Machine back to healthy.
+	// This field is mutually exclusive with RemediationTemplate.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	RemediationStrategies []MachineHealthCheckRemediationStrategy `json:"remediationStrategies,omitempty"`
 }
 
 // ANCHOR_END: MachineHealthCHeckSpec
 
+// ANCHOR: MachineHealthCheckRemediationStrategy
+
+// MachineHealthCheckRemediationStrategy defines a step of the remediation escalation of a MachineHealthCheck.
+type MachineHealthCheckRemediationStrategy struct {
+	// Name of the remediation strategy; it must be unique in the MachineHealthCheck.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// RemediationTemplate is a reference to a remediation template provided by an infrastructure provider;
+	// the MachineHealthCheck controller creates a new object from the template referenced and hands off
+	// remediation of the machine to a controller that lives outside of Cluster API.
+	// If not set, remediation is handed off to the owner of the Machine, e.g. the MachineSet or the control plane,
+	// which usually deletes the Machine; this is allowed only for the last remediation strategy.
+	// +optional
+	RemediationTemplate *corev1.ObjectReference `json:"remediationTemplate,omitempty"`
+
+	// MaxAttempts is the number of remediation requests to create with this strategy before escalating
+	// to the next one.
+	// If not set, this value is defaulted to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+
+	// Timeout is the time to wait for a remediation request created with this strategy to bring the Machine
+	// back to healthy; after the timeout the remediation request is deleted and the attempt is considered failed.
+	// If set, it must be greater than 0.
+	// If not set, an attempt is considered failed only when the remediation request is deleted while the Machine
+	// is still unhealthy.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ANCHOR_END: MachineHealthCheckRemediationStrategy
+
+// ANCHOR: MachineHealthCheckRemediationBackoff
+
+// MachineHealthCheckRemediationBackoff configures the backoff and the rate limiting of the remediations
+// triggered by a MachineHealthCheck.
+type MachineHealthCheckRemediationBackoff struct {
+	// InitialBackoff is the time to wait before triggering again a remediation of Machines with the same
+	// owner, e.g. the same MachineSet or control plane, after this MachineHealthCheck remediated one of them;
+	// the time to wait doubles at every further remediation of Machines with the same owner, up to MaxBackoff.
+	// Machines without an owner are tracked individually.
+	// If not set, the remediations of Machines with the same owner are not delayed.
+	// +optional
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+
+	// MaxBackoff is the maximum time to wait before triggering again a remediation of Machines with the same owner.
+	// If not set, this value is defaulted to 1 hour.
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+
+	// MaxRemediationsPerHour is the maximum number of remediations this MachineHealthCheck can trigger
+	// in a rolling window of one hour.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRemediationsPerHour *int32 `json:"maxRemediationsPerHour,omitempty"`
+
+	// CooldownPeriod is the minimum time to wait after a remediation before this MachineHealthCheck
+	// can trigger another one.
+	// +optional
+	CooldownPeriod *metav1.Duration `json:"cooldownPeriod,omitempty"`
+}
+
+// ANCHOR_END: MachineHealthCheckRemediationBackoff
+
 // ANCHOR: UnhealthyCondition
 
 // UnhealthyCondition represents a Node condition type and value with a timeout
@@ -130,6 +214,16 @@ type MachineHealthCheckStatus struct {
 	// +optional
 	Targets []string `json:"targets,omitempty"`
 
+	// RemediationHistory records the remediations recently triggered by this MachineHealthCheck; it is used to
+	// enforce the remediationBackoff, and it is only reported when remediationBackoff is set.
+	// +optional
+	RemediationHistory []MachineHealthCheckRemediation `json:"remediationHistory,omitempty"`
+
+	// RemediationProgress reports the remediation strategy currently applied to each unhealthy Machine; it is only
+	// reported when remediationStrategies is set.
+	// +optional
+	RemediationProgress []MachineHealthCheckRemediationProgress `json:"remediationProgress,omitempty"`
+
 	// Conditions defines current service state of the MachineHealthCheck.
 	// +optional
 	Conditions Conditions `json:"conditions,omitempty"`
@@ -137,6 +231,38 @@ type MachineHealthCheckStatus struct {
 
 // ANCHOR_END: MachineHealthCheckStatus
 
+// MachineHealthCheckRemediation records a remediation triggered by a MachineHealthCheck.
+type MachineHealthCheckRemediation struct {
+	// MachineName is the name of the remediated Machine.
+	MachineName string `json:"machineName"`
+
+	// Target identifies the remediated Machine across replacements, i.e. Kind/Name of the controller owner
+	// of the Machine, e.g. MachineSet/md-0-abcde, or the name of the Machine if it has no controller owner.
+	// +optional
+	Target string `json:"target,omitempty"`
+
+	// Time is the time the remediation has been triggered.
+	Time metav1.Time `json:"time"`
+}
+
+// MachineHealthCheckRemediationProgress records the progress of the remediation of a Machine
+// through the remediation strategies of a MachineHealthCheck.
+type MachineHealthCheckRemediationProgress struct {
+	// MachineName is the name of the Machine being remediated.
+	MachineName string `json:"machineName"`
+
+	// Strategy is the name of the remediation strategy currently applied to the Machine.
+	Strategy string `json:"strategy"`
+
+	// Attempts is the number of remediation requests created for the Machine with the current strategy.
+	// +optional
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// LastAttemptTime is the time the last remediation request has been created for the Machine with the current strategy.
+	// +optional
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=machinehealthchecks,shortName=mhc;mhcs,scope=Namespaced,categories=cluster-api
 // +kubebuilder:storageversion
@@ -65,11 +65,22 @@ type MachineSetSpec struct {
 	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
 
 	// DeletePolicy defines the policy used to identify nodes to delete when downscaling.
-	// Defaults to "Random".  Valid values are "Random, "Newest", "Oldest"
-	// +kubebuilder:validation:Enum=Random;Newest;Oldest
+	// Defaults to "Random".  Valid values are "Random, "Newest", "Oldest", "BalancedOldest"
+	// +kubebuilder:validation:Enum=Random;Newest;Oldest;BalancedOldest
 	// +optional
 	DeletePolicy string `json:"deletePolicy,omitempty"`
 
+	// FailureDomainSpread defines how new Machines are placed across the failure domains
+	// reported by the Cluster when the Machine template does not define a failure domain.
+	// Defaults to "None".  Valid values are "None", "Even"
+	// +kubebuilder:validation:Enum=None;Even
+	// +optional
+	FailureDomainSpread string `json:"failureDomainSpread,omitempty"`
+
+	// PreflightChecks defines the preflight checks performed before creating new Machines.
+	// +optional
+	PreflightChecks *MachineSetPreflightChecks `json:"preflightChecks,omitempty"`
+
 	// Selector is a label query over machines that should match the replica count.
 	// Label keys and values that must match in order to be controlled by this MachineSet.
 	// It must match the machine template's labels.
@@ -127,6 +138,30 @@ const (
 	// or NodeHealthy type of Status.Conditions is not true).
 	// It then prioritizes the oldest Machines for deletion based on the Machine's CreationTimestamp.
 	OldestMachineSetDeletePolicy MachineSetDeletePolicy = "Oldest"
+
+	// BalancedOldestMachineSetDeletePolicy prioritizes both Machines that have the annotation
+	// "cluster.x-k8s.io/delete-machine=yes" and Machines that are unhealthy
+	// (Status.FailureReason or Status.FailureMessage are set to a non-empty value
+	// or NodeHealthy type of Status.Conditions is not true).
+	// It then deletes the oldest Machines based on the Machine's CreationTimestamp, picking them
+	// from the failure domain with the most Machines so that failure domains are kept balanced.
+	BalancedOldestMachineSetDeletePolicy MachineSetDeletePolicy = "BalancedOldest"
+)
+
+// FailureDomainSpreadPolicy defines how Machines are placed across the failure domains
+// of a Cluster. Defaults to "None".
+type FailureDomainSpreadPolicy string
+
+const (
+	// NoneFailureDomainSpreadPolicy creates Machines in the failure domain defined in the
+	// Machine template, if any.
+	NoneFailureDomainSpreadPolicy FailureDomainSpreadPolicy = "None"
+
+	// EvenFailureDomainSpreadPolicy places each new Machine in the failure domain with the fewest
+	// Machines of the MachineSet, so that replicas are evenly distributed across the failure
+	// domains reported in the Cluster's status.
+	// It only applies when the Machine template does not define a failure domain.
+	EvenFailureDomainSpreadPolicy FailureDomainSpreadPolicy = "Even"
 )
 
 // ANCHOR: MachineSetStatus
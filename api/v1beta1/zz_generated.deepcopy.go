@@ -207,6 +207,11 @@ func (in *ClusterClassSpec) DeepCopyInto(out *ClusterClassSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FieldOwnership != nil {
+		in, out := &in.FieldOwnership, &out.FieldOwnership
+		*out = new(FieldOwnershipPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassSpec.
@@ -333,6 +338,21 @@ func (in *ClusterClassVariableMetadata) DeepCopy() *ClusterClassVariableMetadata
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDeletionPolicy) DeepCopyInto(out *ClusterDeletionPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDeletionPolicy.
+func (in *ClusterDeletionPolicy) DeepCopy() *ClusterDeletionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDeletionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterList) DeepCopyInto(out *ClusterList) {
 	*out = *in
@@ -419,6 +439,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(Topology)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(ClusterDeletionPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
@@ -711,6 +736,26 @@ func (in FailureDomains) DeepCopy() FailureDomains {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldOwnershipPolicy) DeepCopyInto(out *FieldOwnershipPolicy) {
+	*out = *in
+	if in.ForceOwnershipPaths != nil {
+		in, out := &in.ForceOwnershipPaths, &out.ForceOwnershipPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldOwnershipPolicy.
+func (in *FieldOwnershipPolicy) DeepCopy() *FieldOwnershipPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldOwnershipPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JSONPatch) DeepCopyInto(out *JSONPatch) {
 	*out = *in
@@ -926,6 +971,22 @@ func (in MachineAddresses) DeepCopy() MachineAddresses {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeletionHook) DeepCopyInto(out *MachineDeletionHook) {
+	*out = *in
+	in.WaitingSince.DeepCopyInto(&out.WaitingSince)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeletionHook.
+func (in *MachineDeletionHook) DeepCopy() *MachineDeletionHook {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeletionHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeployment) DeepCopyInto(out *MachineDeployment) {
 	*out = *in
@@ -987,6 +1048,11 @@ func (in *MachineDeploymentClass) DeepCopyInto(out *MachineDeploymentClass) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.NodeDrainRules != nil {
+		in, out := &in.NodeDrainRules, &out.NodeDrainRules
+		*out = new(NodeDrainRules)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MinReadySeconds != nil {
 		in, out := &in.MinReadySeconds, &out.MinReadySeconds
 		*out = new(int32)
@@ -997,6 +1063,11 @@ func (in *MachineDeploymentClass) DeepCopyInto(out *MachineDeploymentClass) {
 		*out = new(MachineDeploymentStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PreflightChecks != nil {
+		in, out := &in.PreflightChecks, &out.PreflightChecks
+		*out = new(MachineSetPreflightChecks)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentClass.
@@ -1103,6 +1174,16 @@ func (in *MachineDeploymentSpec) DeepCopyInto(out *MachineDeploymentSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.FailureDomainSpread != nil {
+		in, out := &in.FailureDomainSpread, &out.FailureDomainSpread
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreflightChecks != nil {
+		in, out := &in.PreflightChecks, &out.PreflightChecks
+		*out = new(MachineSetPreflightChecks)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RevisionHistoryLimit != nil {
 		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
 		*out = new(int32)
@@ -1176,6 +1257,11 @@ func (in *MachineDeploymentTopology) DeepCopyInto(out *MachineDeploymentTopology
 		*out = new(string)
 		**out = **in
 	}
+	if in.FailureDomainSpread != nil {
+		in, out := &in.FailureDomainSpread, &out.FailureDomainSpread
+		*out = new(string)
+		**out = **in
+	}
 	if in.Replicas != nil {
 		in, out := &in.Replicas, &out.Replicas
 		*out = new(int32)
@@ -1201,6 +1287,11 @@ func (in *MachineDeploymentTopology) DeepCopyInto(out *MachineDeploymentTopology
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.NodeDrainRules != nil {
+		in, out := &in.NodeDrainRules, &out.NodeDrainRules
+		*out = new(NodeDrainRules)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MinReadySeconds != nil {
 		in, out := &in.MinReadySeconds, &out.MinReadySeconds
 		*out = new(int32)
@@ -1211,6 +1302,15 @@ func (in *MachineDeploymentTopology) DeepCopyInto(out *MachineDeploymentTopology
 		*out = new(MachineDeploymentStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PreflightChecks != nil {
+		in, out := &in.PreflightChecks, &out.PreflightChecks
+		*out = new(MachineSetPreflightChecks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RolloutAfter != nil {
+		in, out := &in.RolloutAfter, &out.RolloutAfter
+		*out = (*in).DeepCopy()
+	}
 	if in.Variables != nil {
 		in, out := &in.Variables, &out.Variables
 		*out = new(MachineDeploymentVariables)
@@ -1349,6 +1449,106 @@ func (in *MachineHealthCheckList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckRemediation) DeepCopyInto(out *MachineHealthCheckRemediation) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckRemediation.
+func (in *MachineHealthCheckRemediation) DeepCopy() *MachineHealthCheckRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckRemediationBackoff) DeepCopyInto(out *MachineHealthCheckRemediationBackoff) {
+	*out = *in
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRemediationsPerHour != nil {
+		in, out := &in.MaxRemediationsPerHour, &out.MaxRemediationsPerHour
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CooldownPeriod != nil {
+		in, out := &in.CooldownPeriod, &out.CooldownPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckRemediationBackoff.
+func (in *MachineHealthCheckRemediationBackoff) DeepCopy() *MachineHealthCheckRemediationBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckRemediationBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckRemediationProgress) DeepCopyInto(out *MachineHealthCheckRemediationProgress) {
+	*out = *in
+	if in.LastAttemptTime != nil {
+		in, out := &in.LastAttemptTime, &out.LastAttemptTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckRemediationProgress.
+func (in *MachineHealthCheckRemediationProgress) DeepCopy() *MachineHealthCheckRemediationProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckRemediationProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckRemediationStrategy) DeepCopyInto(out *MachineHealthCheckRemediationStrategy) {
+	*out = *in
+	if in.RemediationTemplate != nil {
+		in, out := &in.RemediationTemplate, &out.RemediationTemplate
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckRemediationStrategy.
+func (in *MachineHealthCheckRemediationStrategy) DeepCopy() *MachineHealthCheckRemediationStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckRemediationStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineHealthCheckSpec) DeepCopyInto(out *MachineHealthCheckSpec) {
 	*out = *in
@@ -1378,6 +1578,18 @@ func (in *MachineHealthCheckSpec) DeepCopyInto(out *MachineHealthCheckSpec) {
 		*out = new(v1.ObjectReference)
 		**out = **in
 	}
+	if in.RemediationBackoff != nil {
+		in, out := &in.RemediationBackoff, &out.RemediationBackoff
+		*out = new(MachineHealthCheckRemediationBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemediationStrategies != nil {
+		in, out := &in.RemediationStrategies, &out.RemediationStrategies
+		*out = make([]MachineHealthCheckRemediationStrategy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckSpec.
@@ -1398,6 +1610,20 @@ func (in *MachineHealthCheckStatus) DeepCopyInto(out *MachineHealthCheckStatus)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RemediationHistory != nil {
+		in, out := &in.RemediationHistory, &out.RemediationHistory
+		*out = make([]MachineHealthCheckRemediation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RemediationProgress != nil {
+		in, out := &in.RemediationProgress, &out.RemediationProgress
+		*out = make([]MachineHealthCheckRemediationProgress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(Conditions, len(*in))
@@ -1716,6 +1942,26 @@ func (in *MachineSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSetPreflightChecks) DeepCopyInto(out *MachineSetPreflightChecks) {
+	*out = *in
+	if in.Skip != nil {
+		in, out := &in.Skip, &out.Skip
+		*out = make([]MachineSetPreflightCheck, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetPreflightChecks.
+func (in *MachineSetPreflightChecks) DeepCopy() *MachineSetPreflightChecks {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSetPreflightChecks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineSetSpec) DeepCopyInto(out *MachineSetSpec) {
 	*out = *in
@@ -1724,6 +1970,11 @@ func (in *MachineSetSpec) DeepCopyInto(out *MachineSetSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PreflightChecks != nil {
+		in, out := &in.PreflightChecks, &out.PreflightChecks
+		*out = new(MachineSetPreflightChecks)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.Template.DeepCopyInto(&out.Template)
 }
@@ -1805,6 +2056,16 @@ func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.NodeDrainRules != nil {
+		in, out := &in.NodeDrainRules, &out.NodeDrainRules
+		*out = new(NodeDrainRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeMetadata != nil {
+		in, out := &in.NodeMetadata, &out.NodeMetadata
+		*out = new(NodeMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSpec.
@@ -1853,6 +2114,13 @@ func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
 		in, out := &in.CertificatesExpiryDate, &out.CertificatesExpiryDate
 		*out = (*in).DeepCopy()
 	}
+	if in.PendingDeletionHooks != nil {
+		in, out := &in.PendingDeletionHooks, &out.PendingDeletionHooks
+		*out = make([]MachineDeletionHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(Conditions, len(*in))
@@ -1909,6 +2177,84 @@ func (in *NetworkRanges) DeepCopy() *NetworkRanges {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeDrainExclusion) DeepCopyInto(out *NodeDrainExclusion) {
+	*out = *in
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeDrainExclusion.
+func (in *NodeDrainExclusion) DeepCopy() *NodeDrainExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDrainExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeDrainRules) DeepCopyInto(out *NodeDrainRules) {
+	*out = *in
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make([]NodeDrainExclusion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeDrainRules.
+func (in *NodeDrainRules) DeepCopy() *NodeDrainRules {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeDrainRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMetadata) DeepCopyInto(out *NodeMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMetadata.
+func (in *NodeMetadata) DeepCopy() *NodeMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectMeta) DeepCopyInto(out *ObjectMeta) {
 	*out = *in
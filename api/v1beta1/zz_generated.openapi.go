@@ -40,6 +40,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/v1beta1.ClusterClassStatusVariableDefinition":     schema_sigsk8sio_cluster_api_api_v1beta1_ClusterClassStatusVariableDefinition(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.ClusterClassVariable":                     schema_sigsk8sio_cluster_api_api_v1beta1_ClusterClassVariable(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.ClusterClassVariableMetadata":             schema_sigsk8sio_cluster_api_api_v1beta1_ClusterClassVariableMetadata(ref),
+		"sigs.k8s.io/cluster-api/api/v1beta1.ClusterDeletionPolicy":                    schema_sigsk8sio_cluster_api_api_v1beta1_ClusterDeletionPolicy(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.ClusterList":                              schema_sigsk8sio_cluster_api_api_v1beta1_ClusterList(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.ClusterNetwork":                           schema_sigsk8sio_cluster_api_api_v1beta1_ClusterNetwork(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.ClusterSpec":                              schema_sigsk8sio_cluster_api_api_v1beta1_ClusterSpec(ref),
@@ -51,12 +52,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/v1beta1.ControlPlaneTopology":                     schema_sigsk8sio_cluster_api_api_v1beta1_ControlPlaneTopology(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.ExternalPatchDefinition":                  schema_sigsk8sio_cluster_api_api_v1beta1_ExternalPatchDefinition(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.FailureDomainSpec":                        schema_sigsk8sio_cluster_api_api_v1beta1_FailureDomainSpec(ref),
+		"sigs.k8s.io/cluster-api/api/v1beta1.FieldOwnershipPolicy":                     schema_sigsk8sio_cluster_api_api_v1beta1_FieldOwnershipPolicy(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.JSONPatch":                                schema_sigsk8sio_cluster_api_api_v1beta1_JSONPatch(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.JSONPatchValue":                           schema_sigsk8sio_cluster_api_api_v1beta1_JSONPatchValue(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.JSONSchemaProps":                          schema_sigsk8sio_cluster_api_api_v1beta1_JSONSchemaProps(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.LocalObjectTemplate":                      schema_sigsk8sio_cluster_api_api_v1beta1_LocalObjectTemplate(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.Machine":                                  schema_sigsk8sio_cluster_api_api_v1beta1_Machine(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineAddress":                           schema_sigsk8sio_cluster_api_api_v1beta1_MachineAddress(ref),
+		"sigs.k8s.io/cluster-api/api/v1beta1.MachineDeletionHook":                      schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeletionHook(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineDeployment":                        schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeployment(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentClass":                   schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeploymentClass(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentClassNamingStrategy":     schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeploymentClassNamingStrategy(ref),
@@ -70,6 +73,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheck":                       schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheck(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckClass":                  schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckClass(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckList":                   schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckList(ref),
+		"sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediation":            schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckRemediation(ref),
+		"sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediationBackoff":     schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckRemediationBackoff(ref),
+		"sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediationProgress":    schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckRemediationProgress(ref),
+		"sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediationStrategy":    schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckRemediationStrategy(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckSpec":                   schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckSpec(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckStatus":                 schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckStatus(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckTopology":               schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckTopology(ref),
@@ -82,12 +89,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineRollingUpdateDeployment":           schema_sigsk8sio_cluster_api_api_v1beta1_MachineRollingUpdateDeployment(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineSet":                               schema_sigsk8sio_cluster_api_api_v1beta1_MachineSet(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineSetList":                           schema_sigsk8sio_cluster_api_api_v1beta1_MachineSetList(ref),
+		"sigs.k8s.io/cluster-api/api/v1beta1.MachineSetPreflightChecks":                schema_sigsk8sio_cluster_api_api_v1beta1_MachineSetPreflightChecks(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineSetSpec":                           schema_sigsk8sio_cluster_api_api_v1beta1_MachineSetSpec(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineSetStatus":                         schema_sigsk8sio_cluster_api_api_v1beta1_MachineSetStatus(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineSpec":                              schema_sigsk8sio_cluster_api_api_v1beta1_MachineSpec(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineStatus":                            schema_sigsk8sio_cluster_api_api_v1beta1_MachineStatus(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.MachineTemplateSpec":                      schema_sigsk8sio_cluster_api_api_v1beta1_MachineTemplateSpec(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.NetworkRanges":                            schema_sigsk8sio_cluster_api_api_v1beta1_NetworkRanges(ref),
+		"sigs.k8s.io/cluster-api/api/v1beta1.NodeDrainExclusion":                       schema_sigsk8sio_cluster_api_api_v1beta1_NodeDrainExclusion(ref),
+		"sigs.k8s.io/cluster-api/api/v1beta1.NodeDrainRules":                           schema_sigsk8sio_cluster_api_api_v1beta1_NodeDrainRules(ref),
+		"sigs.k8s.io/cluster-api/api/v1beta1.NodeMetadata":                             schema_sigsk8sio_cluster_api_api_v1beta1_NodeMetadata(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.ObjectMeta":                               schema_sigsk8sio_cluster_api_api_v1beta1_ObjectMeta(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.PatchDefinition":                          schema_sigsk8sio_cluster_api_api_v1beta1_PatchDefinition(ref),
 		"sigs.k8s.io/cluster-api/api/v1beta1.PatchSelector":                            schema_sigsk8sio_cluster_api_api_v1beta1_PatchSelector(ref),
@@ -417,11 +428,17 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_ClusterClassSpec(ref common.Refere
 							},
 						},
 					},
+					"fieldOwnership": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FieldOwnership defines how the topology controller handles fields of the objects it manages which are owned by other field managers.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.FieldOwnershipPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/v1beta1.ClusterClassPatch", "sigs.k8s.io/cluster-api/api/v1beta1.ClusterClassVariable", "sigs.k8s.io/cluster-api/api/v1beta1.ControlPlaneClass", "sigs.k8s.io/cluster-api/api/v1beta1.LocalObjectTemplate", "sigs.k8s.io/cluster-api/api/v1beta1.WorkersClass"},
+			"sigs.k8s.io/cluster-api/api/v1beta1.ClusterClassPatch", "sigs.k8s.io/cluster-api/api/v1beta1.ClusterClassVariable", "sigs.k8s.io/cluster-api/api/v1beta1.ControlPlaneClass", "sigs.k8s.io/cluster-api/api/v1beta1.FieldOwnershipPolicy", "sigs.k8s.io/cluster-api/api/v1beta1.LocalObjectTemplate", "sigs.k8s.io/cluster-api/api/v1beta1.WorkersClass"},
 	}
 }
 
@@ -658,6 +675,26 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_ClusterClassVariableMetadata(ref c
 	}
 }
 
+func schema_sigsk8sio_cluster_api_api_v1beta1_ClusterDeletionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterDeletionPolicy defines how the objects of a Cluster are deleted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"order": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Order defines the order in which the objects of the Cluster are deleted. Defaults to Parallel.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_api_v1beta1_ClusterList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -793,11 +830,17 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_ClusterSpec(ref common.ReferenceCa
 							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.Topology"),
 						},
 					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy defines how the objects of the Cluster are deleted when the Cluster is deleted.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.ClusterDeletionPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "sigs.k8s.io/cluster-api/api/v1beta1.APIEndpoint", "sigs.k8s.io/cluster-api/api/v1beta1.ClusterNetwork", "sigs.k8s.io/cluster-api/api/v1beta1.Topology"},
+			"k8s.io/api/core/v1.ObjectReference", "sigs.k8s.io/cluster-api/api/v1beta1.APIEndpoint", "sigs.k8s.io/cluster-api/api/v1beta1.ClusterDeletionPolicy", "sigs.k8s.io/cluster-api/api/v1beta1.ClusterNetwork", "sigs.k8s.io/cluster-api/api/v1beta1.Topology"},
 	}
 }
 
@@ -1209,6 +1252,41 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_FailureDomainSpec(ref common.Refer
 	}
 }
 
+func schema_sigsk8sio_cluster_api_api_v1beta1_FieldOwnershipPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FieldOwnershipPolicy defines how the topology controller handles server side apply conflicts with other field managers. NOTE: Conflicts are always surfaced in the TopologyFieldOwnership condition of the Cluster, no matter of how they are resolved.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conflictResolution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConflictResolution defines how conflicts with other field managers are resolved. If set to Force, the topology controller takes ownership of all the conflicting fields. If set to Yield, the topology controller takes ownership only of the conflicting fields matching one of the ForceOwnershipPaths, while all the other conflicting fields are left to their current managers. Defaults to Force.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forceOwnershipPaths": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForceOwnershipPaths is a list of field paths the topology controller always takes ownership of when ConflictResolution is set to Yield. Paths use the same format reported by server side apply conflicts, e.g. \".spec.replicas\" or \".metadata.labels.environment\"; a path also matches all the fields nested below it. NOTE: conflicts on fields nested in list items are always resolved by taking ownership.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_api_v1beta1_JSONPatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1561,6 +1639,43 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineAddress(ref common.Referenc
 	}
 }
 
+func schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeletionHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeletionHook describes a deletion hook which is blocking the deletion of a Machine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the hook annotation, e.g. \"pre-drain.delete.hook.machine.cluster.x-k8s.io/my-hook\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Owner is the value of the hook annotation, which by convention identifies the owner of the hook.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"waitingSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitingSince is the time when the hook was first observed blocking the deletion of the Machine.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "waitingSince"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1667,6 +1782,12 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeploymentClass(ref common.
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"nodeDrainRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeDrainRules defines the order in which Pods are evicted when draining the Node and which Pods are excluded from the drain. NOTE: This value can be overridden while defining a Cluster.Topology using this MachineDeploymentClass.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.NodeDrainRules"),
+						},
+					},
 					"minReadySeconds": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Minimum number of seconds for which a newly created machine should be ready. Defaults to 0 (machine will be considered available as soon as it is ready) NOTE: This value can be overridden while defining a Cluster.Topology using this MachineDeploymentClass.",
@@ -1680,12 +1801,18 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeploymentClass(ref common.
 							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentStrategy"),
 						},
 					},
+					"preflightChecks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreflightChecks defines the preflight checks performed before creating new Machines. NOTE: This value can be overridden while defining a Cluster.Topology using this MachineDeploymentClass.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.MachineSetPreflightChecks"),
+						},
+					},
 				},
 				Required: []string{"class", "template"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentClassNamingStrategy", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentClassTemplate", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentStrategy", "sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckClass"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentClassNamingStrategy", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentClassTemplate", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentStrategy", "sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckClass", "sigs.k8s.io/cluster-api/api/v1beta1.MachineSetPreflightChecks", "sigs.k8s.io/cluster-api/api/v1beta1.NodeDrainRules"},
 	}
 }
 
@@ -1850,6 +1977,19 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeploymentSpec(ref common.R
 							Format:      "int32",
 						},
 					},
+					"failureDomainSpread": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureDomainSpread defines how new Machines are placed across the failure domains reported by the Cluster when the Machine template does not define a failure domain. Valid values are \"None\", \"Even\" When no value is supplied, the default FailureDomainSpread of MachineSet is used",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"preflightChecks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreflightChecks defines the preflight checks performed by the MachineSets of this MachineDeployment before creating new Machines.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.MachineSetPreflightChecks"),
+						},
+					},
 					"revisionHistoryLimit": {
 						SchemaProps: spec.SchemaProps{
 							Description: "The number of old MachineSets to retain to allow rollback. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.",
@@ -1876,7 +2016,7 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeploymentSpec(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentStrategy", "sigs.k8s.io/cluster-api/api/v1beta1.MachineTemplateSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentStrategy", "sigs.k8s.io/cluster-api/api/v1beta1.MachineSetPreflightChecks", "sigs.k8s.io/cluster-api/api/v1beta1.MachineTemplateSpec"},
 	}
 }
 
@@ -2035,6 +2175,13 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeploymentTopology(ref comm
 							Format:      "",
 						},
 					},
+					"failureDomainSpread": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureDomainSpread defines how the machines are placed across the failure domains stored on the cluster object. When set to \"Even\", machines are evenly distributed across all failure domains and FailureDomain must not be set. Valid values are \"None\", \"Even\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"replicas": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Replicas is the number of worker nodes belonging to this set. If the value is nil, the MachineDeployment is created without the number of Replicas (defaulting to 1) and it's assumed that an external entity (like cluster autoscaler) is responsible for the management of this value.",
@@ -2066,6 +2213,12 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeploymentTopology(ref comm
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"nodeDrainRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeDrainRules defines the order in which Pods are evicted when draining the Node and which Pods are excluded from the drain.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.NodeDrainRules"),
+						},
+					},
 					"minReadySeconds": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Minimum number of seconds for which a newly created machine should be ready. Defaults to 0 (machine will be considered available as soon as it is ready)",
@@ -2079,6 +2232,18 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeploymentTopology(ref comm
 							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentStrategy"),
 						},
 					},
+					"preflightChecks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreflightChecks defines the preflight checks performed before creating new Machines.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.MachineSetPreflightChecks"),
+						},
+					},
+					"rolloutAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutAfter is a field to indicate a rollout should be performed after the specified time even if no changes have been made to the MachineDeployment. Example: In the YAML the time can be specified in the RFC3339 format. To specify the rolloutAfter target as March 9, 2023, at 9 am UTC use \"2023-03-09T09:00:00Z\".",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 					"variables": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Variables can be used to customize the MachineDeployment through patches.",
@@ -2090,7 +2255,7 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineDeploymentTopology(ref comm
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentStrategy", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentVariables", "sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckTopology", "sigs.k8s.io/cluster-api/api/v1beta1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentStrategy", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeploymentVariables", "sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckTopology", "sigs.k8s.io/cluster-api/api/v1beta1.MachineSetPreflightChecks", "sigs.k8s.io/cluster-api/api/v1beta1.NodeDrainRules", "sigs.k8s.io/cluster-api/api/v1beta1.ObjectMeta"},
 	}
 }
 
@@ -2275,6 +2440,171 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckList(ref common.
 	}
 }
 
+func schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckRemediation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHealthCheckRemediation records a remediation triggered by a MachineHealthCheck.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineName is the name of the remediated Machine.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target identifies the remediated Machine across replacements, i.e. Kind/Name of the controller owner of the Machine, e.g. MachineSet/md-0-abcde, or the name of the Machine if it has no controller owner.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time the remediation has been triggered.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"machineName", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckRemediationBackoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHealthCheckRemediationBackoff configures the backoff and the rate limiting of the remediations triggered by a MachineHealthCheck.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"initialBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialBackoff is the time to wait before triggering again a remediation of Machines with the same owner, e.g. the same MachineSet or control plane, after this MachineHealthCheck remediated one of them; the time to wait doubles at every further remediation of Machines with the same owner, up to MaxBackoff. Machines without an owner are tracked individually. If not set, the remediations of Machines with the same owner are not delayed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackoff is the maximum time to wait before triggering again a remediation of Machines with the same owner. If not set, this value is defaulted to 1 hour.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxRemediationsPerHour": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRemediationsPerHour is the maximum number of remediations this MachineHealthCheck can trigger in a rolling window of one hour.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"cooldownPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CooldownPeriod is the minimum time to wait after a remediation before this MachineHealthCheck can trigger another one.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckRemediationProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHealthCheckRemediationProgress records the progress of the remediation of a Machine through the remediation strategies of a MachineHealthCheck.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineName is the name of the Machine being remediated.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Strategy is the name of the remediation strategy currently applied to the Machine.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts is the number of remediation requests created for the Machine with the current strategy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastAttemptTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastAttemptTime is the time the last remediation request has been created for the Machine with the current strategy.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"machineName", "strategy"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckRemediationStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHealthCheckRemediationStrategy defines a step of the remediation escalation of a MachineHealthCheck.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the remediation strategy; it must be unique in the MachineHealthCheck.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"remediationTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemediationTemplate is a reference to a remediation template provided by an infrastructure provider; the MachineHealthCheck controller creates a new object from the template referenced and hands off remediation of the machine to a controller that lives outside of Cluster API. If not set, remediation is handed off to the owner of the Machine, e.g. the MachineSet or the control plane, which usually deletes the Machine; this is allowed only for the last remediation strategy.",
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+						},
+					},
+					"maxAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAttempts is the number of remediation requests to create with this strategy before escalating to the next one. If not set, this value is defaulted to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the time to wait for a remediation request created with this strategy to bring the Machine back to healthy; after the timeout the remediation request is deleted and the attempt is considered failed. If set, it must be greater than 0. If not set, an attempt is considered failed only when the remediation request is deleted while the Machine is still unhealthy.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2336,12 +2666,32 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckSpec(ref common.
 							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
+					"remediationBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemediationBackoff configures the backoff and the rate limiting of the remediations triggered by this MachineHealthCheck, so that e.g. a flapping Node condition or a bad image don't cause endless remediation loops. If not set, remediations are triggered as soon as Machines are detected as unhealthy.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediationBackoff"),
+						},
+					},
+					"remediationStrategies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemediationStrategies is an ordered list of remediation strategies for unhealthy Machines, e.g. an external reboot first and then the deletion of the Machine; a strategy is applied to a Machine only after all the previous strategies failed to bring the Machine back to healthy. This field is mutually exclusive with RemediationTemplate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediationStrategy"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"clusterName", "selector"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "sigs.k8s.io/cluster-api/api/v1beta1.UnhealthyCondition"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediationBackoff", "sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediationStrategy", "sigs.k8s.io/cluster-api/api/v1beta1.UnhealthyCondition"},
 	}
 }
 
@@ -2398,6 +2748,34 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckStatus(ref commo
 							},
 						},
 					},
+					"remediationHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemediationHistory records the remediations recently triggered by this MachineHealthCheck; it is used to enforce the remediationBackoff, and it is only reported when remediationBackoff is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediation"),
+									},
+								},
+							},
+						},
+					},
+					"remediationProgress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemediationProgress reports the remediation strategy currently applied to each unhealthy Machine; it is only reported when remediationStrategies is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediationProgress"),
+									},
+								},
+							},
+						},
+					},
 					"conditions": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Conditions defines current service state of the MachineHealthCheck.",
@@ -2416,7 +2794,7 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckStatus(ref commo
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/v1beta1.Condition"},
+			"sigs.k8s.io/cluster-api/api/v1beta1.Condition", "sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediation", "sigs.k8s.io/cluster-api/api/v1beta1.MachineHealthCheckRemediationProgress"},
 	}
 }
 
@@ -2434,6 +2812,13 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineHealthCheckTopology(ref com
 							Format:      "",
 						},
 					},
+					"overrideStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OverrideStrategy defines how the MachineHealthCheck defined here is combined with the MachineHealthCheckClass defined in ClusterClass. Defaults to Replace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"unhealthyConditions": {
 						SchemaProps: spec.SchemaProps{
 							Description: "UnhealthyConditions contains a list of the conditions that determine whether a node is considered unhealthy. The conditions are combined in a logical OR, i.e. if any of the conditions is met, the node is unhealthy.",
@@ -2806,7 +3191,7 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineRollingUpdateDeployment(ref
 					},
 					"deletePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletePolicy defines the policy used by the MachineDeployment to identify nodes to delete when downscaling. Valid values are \"Random, \"Newest\", \"Oldest\" When no value is supplied, the default DeletePolicy of MachineSet is used",
+							Description: "DeletePolicy defines the policy used by the MachineDeployment to identify nodes to delete when downscaling. Valid values are \"Random, \"Newest\", \"Oldest\", \"BalancedOldest\" When no value is supplied, the default DeletePolicy of MachineSet is used",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2915,6 +3300,34 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineSetList(ref common.Referenc
 	}
 }
 
+func schema_sigsk8sio_cluster_api_api_v1beta1_MachineSetPreflightChecks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineSetPreflightChecks defines the preflight checks performed by a MachineSet before creating new Machines.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"skip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Skip is the list of preflight checks to skip, in addition to the preflight checks listed in the machineset.cluster.x-k8s.io/skip-preflight-checks annotation. \"All\" skips all the preflight checks, including the ones performed by Runtime Extensions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_api_v1beta1_MachineSetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2946,11 +3359,24 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineSetSpec(ref common.Referenc
 					},
 					"deletePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeletePolicy defines the policy used to identify nodes to delete when downscaling. Defaults to \"Random\".  Valid values are \"Random, \"Newest\", \"Oldest\"",
+							Description: "DeletePolicy defines the policy used to identify nodes to delete when downscaling. Defaults to \"Random\".  Valid values are \"Random, \"Newest\", \"Oldest\", \"BalancedOldest\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureDomainSpread": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureDomainSpread defines how new Machines are placed across the failure domains reported by the Cluster when the Machine template does not define a failure domain. Defaults to \"None\".  Valid values are \"None\", \"Even\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"preflightChecks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreflightChecks defines the preflight checks performed before creating new Machines.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.MachineSetPreflightChecks"),
+						},
+					},
 					"selector": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Selector is a label query over machines that should match the replica count. Label keys and values that must match in order to be controlled by this MachineSet. It must match the machine template's labels. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors",
@@ -2970,7 +3396,7 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineSetSpec(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "sigs.k8s.io/cluster-api/api/v1beta1.MachineTemplateSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "sigs.k8s.io/cluster-api/api/v1beta1.MachineSetPreflightChecks", "sigs.k8s.io/cluster-api/api/v1beta1.MachineTemplateSpec"},
 	}
 }
 
@@ -3130,12 +3556,24 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineSpec(ref common.ReferenceCa
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"nodeDrainRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeDrainRules defines the order in which Pods are evicted when draining the Node and which Pods are excluded from the drain. If not set, all Pods are evicted at the same time.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.NodeDrainRules"),
+						},
+					},
+					"nodeMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeMetadata defines labels, annotations and taints which are continuously reconciled onto the Node of the Machine; labels, annotations and taints removed from NodeMetadata are removed from the Node. NOTE: Keys managed by the Node itself (e.g. in the kubernetes.io and k8s.io domains) or by Cluster API are never set on the Node, and are reported in the NodeMetadataSynced condition instead.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/v1beta1.NodeMetadata"),
+						},
+					},
 				},
 				Required: []string{"clusterName", "bootstrap", "infrastructureRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "sigs.k8s.io/cluster-api/api/v1beta1.Bootstrap"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "sigs.k8s.io/cluster-api/api/v1beta1.Bootstrap", "sigs.k8s.io/cluster-api/api/v1beta1.NodeDrainRules", "sigs.k8s.io/cluster-api/api/v1beta1.NodeMetadata"},
 	}
 }
 
@@ -3205,6 +3643,20 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineStatus(ref common.Reference
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"pendingDeletionHooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingDeletionHooks is the list of pre-drain and pre-terminate deletion hooks which are currently blocking the deletion of the Machine.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/v1beta1.MachineDeletionHook"),
+									},
+								},
+							},
+						},
+					},
 					"bootstrapReady": {
 						SchemaProps: spec.SchemaProps{
 							Description: "BootstrapReady is the state of the bootstrap provider.",
@@ -3246,7 +3698,7 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_MachineStatus(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.NodeSystemInfo", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api/api/v1beta1.Condition", "sigs.k8s.io/cluster-api/api/v1beta1.MachineAddress"},
+			"k8s.io/api/core/v1.NodeSystemInfo", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api/api/v1beta1.Condition", "sigs.k8s.io/cluster-api/api/v1beta1.MachineAddress", "sigs.k8s.io/cluster-api/api/v1beta1.MachineDeletionHook"},
 	}
 }
 
@@ -3307,6 +3759,138 @@ func schema_sigsk8sio_cluster_api_api_v1beta1_NetworkRanges(ref common.Reference
 	}
 }
 
+func schema_sigsk8sio_cluster_api_api_v1beta1_NodeDrainExclusion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeDrainExclusion defines a set of Pods which are excluded from the drain. A Pod is excluded if it matches all the criteria set in the exclusion.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the excluded Pods. If not set, Pods from all the namespaces are excluded.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodSelector is a label selector for the excluded Pods. If not set, all the Pods in Namespace are excluded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_api_v1beta1_NodeDrainRules(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeDrainRules defines how the Node of a Machine is drained.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"order": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Order defines the order in which Pods are evicted; Pods with the same order are evicted at the same time, and only once all the Pods of the previous group are gone. If not set, all Pods are evicted at the same time.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"orderLabel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OrderLabel is the key of the label defining the order in which Pods are evicted. It must be set if and only if Order is Label.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"exclusions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exclusions defines the Pods which are never evicted when draining the Node and thus never block the drain.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/v1beta1.NodeDrainExclusion"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api/api/v1beta1.NodeDrainExclusion"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_api_v1beta1_NodeMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeMetadata defines the labels, annotations and taints reconciled onto the Node of a Machine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels to set on the Node.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations to set on the Node.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints to set on the Node.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.Taint"},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_api_v1beta1_ObjectMeta(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
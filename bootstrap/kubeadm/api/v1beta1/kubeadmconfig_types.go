@@ -21,6 +21,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/feature"
@@ -46,6 +47,8 @@ var (
 	missingSecretNameMsg                             = "secret file source must specify non-empty secret name"
 	missingSecretKeyMsg                              = "secret file source must specify non-empty secret key"
 	pathConflictMsg                                  = "path property must be unique among all files"
+	fragmentNameConflictMsg                          = "name property must be unique among all fragments"
+	invalidFragmentContentMsg                        = "content must be a YAML mapping"
 )
 
 // KubeadmConfigSpec defines the desired state of KubeadmConfig.
@@ -119,6 +122,12 @@ type KubeadmConfigSpec struct {
 	// Ignition contains Ignition specific configuration.
 	// +optional
 	Ignition *IgnitionSpec `json:"ignition,omitempty"`
+
+	// Fragments specifies additional pieces of bootstrap data to be merged with the bootstrap data
+	// generated by the kubeadm bootstrap provider; fragments are merged in the order they are defined,
+	// after the generated bootstrap data.
+	// +optional
+	Fragments []BootstrapDataFragment `json:"fragments,omitempty"`
 }
 
 // Default defaults a KubeadmConfigSpec.
@@ -141,6 +150,7 @@ func (c *KubeadmConfigSpec) Validate(pathPrefix *field.Path) field.ErrorList {
 	allErrs = append(allErrs, c.validateFiles(pathPrefix)...)
 	allErrs = append(allErrs, c.validateUsers(pathPrefix)...)
 	allErrs = append(allErrs, c.validateIgnition(pathPrefix)...)
+	allErrs = append(allErrs, c.validateFragments(pathPrefix)...)
 
 	return allErrs
 }
@@ -245,6 +255,43 @@ func (c *KubeadmConfigSpec) validateUsers(pathPrefix *field.Path) field.ErrorLis
 	return allErrs
 }
 
+func (c *KubeadmConfigSpec) validateFragments(pathPrefix *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	knownNames := map[string]struct{}{}
+
+	for i := range c.Fragments {
+		fragment := c.Fragments[i]
+		if _, conflict := knownNames[fragment.Name]; conflict {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					pathPrefix.Child("fragments").Index(i).Child("name"),
+					fragment.Name,
+					fragmentNameConflictMsg,
+				),
+			)
+		}
+		knownNames[fragment.Name] = struct{}{}
+
+		// Both cloud-config and Container Linux Config fragments are merged as YAML mappings with the generated
+		// bootstrap data, so fragments which cannot be parsed are rejected here instead of failing at bootstrap time.
+		content := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(fragment.Content), &content); err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					pathPrefix.Child("fragments").Index(i).Child("content"),
+					fragment.Content,
+					fmt.Sprintf("%s: %v", invalidFragmentContentMsg, err),
+				),
+			)
+		}
+	}
+
+	return allErrs
+}
+
 func (c *KubeadmConfigSpec) validateIgnition(pathPrefix *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -380,6 +427,25 @@ type ContainerLinuxConfig struct {
 	Strict bool `json:"strict,omitempty"`
 }
 
+// BootstrapDataFragment is a piece of user provided bootstrap data to be merged with the bootstrap data
+// generated by the kubeadm bootstrap provider.
+type BootstrapDataFragment struct {
+	// Name of the fragment; it must be unique among all the fragments.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// Content of the fragment.
+	//
+	// If format is cloud-config, content must be a cloud-config document; maps are merged with
+	// the generated cloud-config, lists are appended and other values replace the generated ones.
+	//
+	// If format is ignition, content must be a Container Linux Config, and it is merged with the generated
+	// Ignition configuration as described in https://coreos.github.io/ignition/operator-notes/#config-merging.
+	// +kubebuilder:validation:MinLength=1
+	Content string `json:"content"`
+}
+
 // KubeadmConfigStatus defines the observed state of KubeadmConfig.
 type KubeadmConfigStatus struct {
 	// Ready indicates the BootstrapData field is ready to be consumed
@@ -68,6 +68,21 @@ func (in *APIServer) DeepCopy() *APIServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapDataFragment) DeepCopyInto(out *BootstrapDataFragment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapDataFragment.
+func (in *BootstrapDataFragment) DeepCopy() *BootstrapDataFragment {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapDataFragment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BootstrapToken) DeepCopyInto(out *BootstrapToken) {
 	*out = *in
@@ -740,6 +755,11 @@ func (in *KubeadmConfigSpec) DeepCopyInto(out *KubeadmConfigSpec) {
 		*out = new(IgnitionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Fragments != nil {
+		in, out := &in.Fragments, &out.Fragments
+		*out = make([]BootstrapDataFragment, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmConfigSpec.
@@ -26,6 +26,7 @@ import (
 
 	kubeadmbootstrapcontrollers "sigs.k8s.io/cluster-api/bootstrap/kubeadm/internal/controllers"
 	"sigs.k8s.io/cluster-api/controllers/remote"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 // Following types provides access to reconcilers implemented in internal/controllers, thus
@@ -46,6 +47,9 @@ type KubeadmConfigReconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
+
 	// TokenTTL is the amount of time a bootstrap token (and therefore a KubeadmConfig) will be valid.
 	TokenTTL time.Duration
 }
@@ -57,6 +61,7 @@ func (r *KubeadmConfigReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 		SecretCachingClient: r.SecretCachingClient,
 		Tracker:             r.Tracker,
 		WatchFilterValue:    r.WatchFilterValue,
+		Shard:               r.Shard,
 		TokenTTL:            r.TokenTTL,
 	}).SetupWithManager(ctx, mgr, options)
 }
@@ -56,6 +56,7 @@ type BaseUserData struct {
 	KubeadmCommand       string
 	KubeadmVerbosity     string
 	SentinelFileCommand  string
+	Fragments            []bootstrapv1.BootstrapDataFragment
 }
 
 func (input *BaseUserData) prepare() error {
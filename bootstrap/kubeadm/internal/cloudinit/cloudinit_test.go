@@ -21,6 +21,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/certs"
@@ -278,3 +279,55 @@ func TestNewJoinControlPlaneExperimentalRetry(t *testing.T) {
 		g.Expect(out).To(ContainSubstring(f))
 	}
 }
+
+func TestMergeFragments(t *testing.T) {
+	g := NewWithT(t)
+
+	userData := []byte(cloudConfigHeader + `write_files:
+-   path: /run/kubeadm/kubeadm-join-config.yaml
+    content: |
+      nodeRegistration:
+        name: '{{ ds.meta_data.local_hostname }}'
+runcmd:
+  - "kubeadm join"
+ntp:
+  enabled: true
+  servers:
+    - a.pool.ntp.org
+`)
+	fragments := []bootstrapv1.BootstrapDataFragment{
+		{
+			Name: "foo",
+			Content: `runcmd:
+- "echo foo"
+ntp:
+  enabled: false
+`,
+		},
+		{
+			Name: "bar",
+			Content: `runcmd:
+- "echo bar"
+ntp:
+  servers:
+  - b.pool.ntp.org
+package_update: true
+`,
+		},
+	}
+
+	out, err := mergeFragments(userData, fragments)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(out)).To(HavePrefix(cloudConfigHeader))
+
+	merged := map[string]interface{}{}
+	g.Expect(yaml.Unmarshal(out, &merged)).To(Succeed())
+	g.Expect(merged["runcmd"]).To(Equal([]interface{}{"kubeadm join", "echo foo", "echo bar"}))
+	g.Expect(merged["ntp"]).To(Equal(map[string]interface{}{
+		"enabled": false,
+		"servers": []interface{}{"a.pool.ntp.org", "b.pool.ntp.org"},
+	}))
+	g.Expect(merged["package_update"]).To(BeTrue())
+	g.Expect(merged["write_files"]).To(HaveLen(1))
+	g.Expect(string(out)).To(ContainSubstring("name: '{{ ds.meta_data.local_hostname }}'"))
+}
@@ -67,5 +67,5 @@ func NewInitControlPlane(input *ControlPlaneInput) ([]byte, error) {
 		return nil, err
 	}
 
-	return userData, nil
+	return mergeFragments(userData, input.Fragments)
 }
@@ -67,5 +67,5 @@ func NewJoinControlPlane(input *ControlPlaneJoinInput) ([]byte, error) {
 		return nil, errors.Wrapf(err, "failed to generate user data for machine joining control plane")
 	}
 
-	return userData, err
+	return mergeFragments(userData, input.Fragments)
 }
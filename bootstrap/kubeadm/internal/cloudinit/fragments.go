@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
+)
+
+// mergeFragments merges the given fragments into the cloud-config user data, in order.
+// Maps are merged recursively, lists are appended and any other value in a fragment replaces
+// the value in the user data.
+func mergeFragments(userData []byte, fragments []bootstrapv1.BootstrapDataFragment) ([]byte, error) {
+	if len(fragments) == 0 {
+		return userData, nil
+	}
+
+	merged := map[string]interface{}{}
+	if err := yaml.Unmarshal(bytes.TrimPrefix(userData, []byte(cloudConfigHeader)), &merged); err != nil {
+		return nil, errors.Wrap(err, "failed to parse generated cloud-config")
+	}
+
+	for _, fragment := range fragments {
+		data := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(fragment.Content), &data); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse cloud-config of fragment %q", fragment.Name)
+		}
+		mergeMaps(merged, data)
+	}
+
+	out, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal cloud-config")
+	}
+	return append([]byte(cloudConfigHeader), out...), nil
+}
+
+// mergeMaps merges src into dst.
+func mergeMaps(dst, src map[string]interface{}) {
+	for k, srcValue := range src {
+		dstValue, ok := dst[k]
+		if !ok {
+			dst[k] = srcValue
+			continue
+		}
+		switch srcTyped := srcValue.(type) {
+		case map[string]interface{}:
+			if dstTyped, ok := dstValue.(map[string]interface{}); ok {
+				mergeMaps(dstTyped, srcTyped)
+				continue
+			}
+		case []interface{}:
+			if dstTyped, ok := dstValue.([]interface{}); ok {
+				dst[k] = append(dstTyped, srcTyped...)
+				continue
+			}
+		}
+		dst[k] = srcValue
+	}
+}
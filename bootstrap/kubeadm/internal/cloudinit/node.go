@@ -53,5 +53,10 @@ func NewNode(input *NodeInput) ([]byte, error) {
 		return nil, err
 	}
 	input.Header = cloudConfigHeader
-	return generate("Node", nodeCloudInit, input)
+	userData, err := generate("Node", nodeCloudInit, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeFragments(userData, input.Fragments)
 }
@@ -55,6 +55,40 @@ func TestNewNode(t *testing.T) {
 			checkWriteFiles("/run/kubeadm/kubeadm-join-config.yaml", "/run/cluster-api/placeholder"),
 			false,
 		},
+		{
+			"check fragments are merged",
+			&NodeInput{
+				BaseUserData: BaseUserData{
+					Fragments: []bootstrapv1.BootstrapDataFragment{
+						{
+							Name:    "foo",
+							Content: "write_files:\n- path: /etc/foo.conf\n  content: foo\n",
+						},
+						{
+							Name:    "bar",
+							Content: "write_files:\n- path: /etc/bar.conf\n  content: bar\n",
+						},
+					},
+				},
+			},
+			checkWriteFiles("/run/kubeadm/kubeadm-join-config.yaml", "/run/cluster-api/placeholder", "/etc/foo.conf", "/etc/bar.conf"),
+			false,
+		},
+		{
+			"check fragments must be valid cloud-config",
+			&NodeInput{
+				BaseUserData: BaseUserData{
+					Fragments: []bootstrapv1.BootstrapDataFragment{
+						{
+							Name:    "foo",
+							Content: "write_files: [",
+						},
+					},
+				},
+			},
+			nil,
+			true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -63,6 +97,9 @@ func TestNewNode(t *testing.T) {
 				t.Errorf("NewNode() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.wantErr {
+				return
+			}
 			if err := tt.check(got); err != nil {
 				t.Errorf("%v: got = %s", err, got)
 			}
@@ -1441,6 +1441,108 @@ func TestBootstrapTokenRotationMachinePool(t *testing.T) {
 	g.Expect(foundNew).To(BeTrue())
 }
 
+func TestBootstrapTokenRecreationIfExpired(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := builder.Cluster(metav1.NamespaceDefault, "cluster").Build()
+	cluster.Status.InfrastructureReady = true
+	conditions.MarkTrue(cluster, clusterv1.ControlPlaneInitializedCondition)
+	cluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{Host: "100.105.150.1", Port: 6443}
+
+	controlPlaneInitMachine := newControlPlaneMachine(cluster, "control-plane-init-machine")
+	initConfig := newControlPlaneInitKubeadmConfig(controlPlaneInitMachine.Namespace, "control-plane-init-config")
+	addKubeadmConfigToMachine(initConfig, controlPlaneInitMachine)
+
+	workerMachine := newWorkerMachineForCluster(cluster)
+	workerJoinConfig := newWorkerJoinKubeadmConfig(metav1.NamespaceDefault, "worker-join-cfg")
+	addKubeadmConfigToMachine(workerJoinConfig, workerMachine)
+
+	controlPlaneJoinMachine := newControlPlaneMachine(cluster, "control-plane-join-machine")
+	controlPlaneJoinConfig := newControlPlaneJoinKubeadmConfig(controlPlaneJoinMachine.Namespace, "control-plane-join-cfg")
+	addKubeadmConfigToMachine(controlPlaneJoinConfig, controlPlaneJoinMachine)
+	objects := []client.Object{
+		cluster,
+		workerMachine,
+		workerJoinConfig,
+		controlPlaneJoinMachine,
+		controlPlaneJoinConfig,
+	}
+
+	objects = append(objects, createSecrets(t, cluster, initConfig)...)
+	myclient := fake.NewClientBuilder().WithObjects(objects...).WithStatusSubresource(&bootstrapv1.KubeadmConfig{}, &clusterv1.Machine{}).Build()
+	remoteClient := fake.NewClientBuilder().Build()
+	k := &KubeadmConfigReconciler{
+		Client:              myclient,
+		SecretCachingClient: myclient,
+		KubeadmInitLock:     &myInitLocker{},
+		TokenTTL:            DefaultTokenTTL,
+		Tracker:             remote.NewTestClusterCacheTracker(logr.New(log.NullLogSink{}), myclient, remoteClient, remoteClient.Scheme(), client.ObjectKey{Name: cluster.Name, Namespace: cluster.Namespace}),
+	}
+	requests := []ctrl.Request{
+		{
+			NamespacedName: client.ObjectKey{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "worker-join-cfg",
+			},
+		},
+		{
+			NamespacedName: client.ObjectKey{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "control-plane-join-cfg",
+			},
+		},
+	}
+
+	oldTokens := map[string]string{}
+	for _, req := range requests {
+		result, err := k.Reconcile(ctx, req)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(k.TokenTTL / 3))
+
+		cfg, err := getKubeadmConfig(myclient, req.Name, req.Namespace)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(cfg.Status.Ready).To(BeTrue())
+		g.Expect(cfg.Status.DataSecretName).NotTo(BeNil())
+		oldTokens[req.Name] = cfg.Spec.JoinConfiguration.Discovery.BootstrapToken.Token
+	}
+
+	t.Log("Simulate the token secrets expired and were deleted by the token cleaner before the nodes joined")
+
+	l := &corev1.SecretList{}
+	g.Expect(remoteClient.List(ctx, l, client.ListOption(client.InNamespace(metav1.NamespaceSystem)))).To(Succeed())
+	g.Expect(l.Items).To(HaveLen(2))
+	for i := range l.Items {
+		g.Expect(remoteClient.Delete(ctx, &l.Items[i])).To(Succeed())
+	}
+
+	for _, req := range requests {
+		result, err := k.Reconcile(ctx, req)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(k.TokenTTL / 3))
+
+		cfg, err := getKubeadmConfig(myclient, req.Name, req.Namespace)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(cfg.Status.Ready).To(BeTrue())
+		newToken := cfg.Spec.JoinConfiguration.Discovery.BootstrapToken.Token
+		g.Expect(newToken).ToNot(Equal(oldTokens[req.Name]))
+
+		// The bootstrap data in the existing secret must be regenerated with the new token.
+		dataSecret := &corev1.Secret{}
+		g.Expect(myclient.Get(ctx, client.ObjectKey{Namespace: req.Namespace, Name: *cfg.Status.DataSecretName}, dataSecret)).To(Succeed())
+		g.Expect(string(dataSecret.Data["value"])).To(ContainSubstring(newToken))
+		g.Expect(string(dataSecret.Data["value"])).ToNot(ContainSubstring(oldTokens[req.Name]))
+	}
+
+	l = &corev1.SecretList{}
+	g.Expect(remoteClient.List(ctx, l, client.ListOption(client.InNamespace(metav1.NamespaceSystem)))).To(Succeed())
+	g.Expect(l.Items).To(HaveLen(2))
+	for _, item := range l.Items {
+		expirationTime, err := time.Parse(time.RFC3339, string(item.Data[bootstrapapi.BootstrapTokenExpirationKey]))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(expirationTime).Should(BeTemporally("~", time.Now().UTC().Add(k.TokenTTL), 10*time.Second))
+	}
+}
+
 // Ensure the discovery portion of the JoinConfiguration gets generated correctly.
 func TestKubeadmConfigReconciler_Reconcile_DiscoveryReconcileBehaviors(t *testing.T) {
 	caHash := []string{"...."}
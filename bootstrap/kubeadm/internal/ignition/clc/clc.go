@@ -360,7 +360,7 @@ func Render(input *cloudinit.BaseUserData, clc *bootstrapv1.ContainerLinuxConfig
 		return nil, "", errors.Wrapf(err, "rendering CLC configuration")
 	}
 
-	userData, warnings, err := buildIgnitionConfig(clcBytes, clc)
+	userData, warnings, err := buildIgnitionConfig(clcBytes, clc, input.Fragments)
 	if err != nil {
 		return nil, "", errors.Wrapf(err, "building Ignition config")
 	}
@@ -368,7 +368,7 @@ func Render(input *cloudinit.BaseUserData, clc *bootstrapv1.ContainerLinuxConfig
 	return userData, warnings, nil
 }
 
-func buildIgnitionConfig(baseCLC []byte, clc *bootstrapv1.ContainerLinuxConfig) ([]byte, string, error) {
+func buildIgnitionConfig(baseCLC []byte, clc *bootstrapv1.ContainerLinuxConfig, fragments []bootstrapv1.BootstrapDataFragment) ([]byte, string, error) {
 	// We control baseCLC config, so treat it as strict.
 	ign, _, err := clcToIgnition(baseCLC, true)
 	if err != nil {
@@ -388,6 +388,19 @@ func buildIgnitionConfig(baseCLC []byte, clc *bootstrapv1.ContainerLinuxConfig)
 		ign = ignition.Append(ign, additionalIgn)
 	}
 
+	for _, fragment := range fragments {
+		fragmentIgn, warnings, err := clcToIgnition([]byte(fragment.Content), false)
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "converting CLC of fragment %q to Ignition", fragment.Name)
+		}
+
+		if warnings != "" {
+			clcWarnings = strings.TrimSpace(strings.Join([]string{clcWarnings, warnings}, "\n"))
+		}
+
+		ign = ignition.Append(ign, fragmentIgn)
+	}
+
 	userData, err := json.Marshal(&ign)
 	if err != nil {
 		return nil, "", errors.Wrapf(err, "marshaling generated Ignition config into JSON")
@@ -18,6 +18,7 @@ limitations under the License.
 package clc_test
 
 import (
+	"strings"
 	"testing"
 
 	ignition "github.com/flatcar/ignition/config/v2_3"
@@ -632,6 +633,57 @@ func TestRender(t *testing.T) {
 		}
 	})
 
+	t.Run("merges fragments", func(t *testing.T) {
+		input := &cloudinit.BaseUserData{
+			Fragments: []bootstrapv1.BootstrapDataFragment{
+				{
+					Name: "foo",
+					Content: `storage:
+  files:
+  - path: /etc/foo
+    filesystem: root
+    contents:
+      inline: foo
+`,
+				},
+				{
+					Name: "bar",
+					Content: `systemd:
+  units:
+  - name: bar.service
+    enabled: true
+`,
+				},
+			},
+		}
+
+		data, _, err := clc.Render(input, nil, "foo")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, expected := range []string{`"path":"/etc/foo"`, `"name":"bar.service"`, `"name":"kubeadm.service"`} {
+			if !strings.Contains(string(data), expected) {
+				t.Errorf("expected rendered Ignition config to contain %s", expected)
+			}
+		}
+	})
+
+	t.Run("returns error on invalid fragments", func(t *testing.T) {
+		input := &cloudinit.BaseUserData{
+			Fragments: []bootstrapv1.BootstrapDataFragment{
+				{
+					Name:    "foo",
+					Content: "storage: [",
+				},
+			},
+		}
+
+		if _, _, err := clc.Render(input, nil, "foo"); err == nil {
+			t.Fatalf("expected error")
+		}
+	})
+
 	t.Run("returns Ignition warnings", func(t *testing.T) {
 		config := &bootstrapv1.ContainerLinuxConfig{
 			AdditionalConfig: configWithIgnitionWarning,
@@ -183,6 +183,64 @@ func TestKubeadmConfigValidate(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		"valid fragments": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Fragments: []bootstrapv1.BootstrapDataFragment{
+						{
+							Name:    "foo",
+							Content: "runcmd:\n- foo",
+						},
+						{
+							Name:    "bar",
+							Content: "bootcmd:\n- bar",
+						},
+					},
+				},
+			},
+		},
+		"invalid with fragment content which is not a YAML mapping": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Fragments: []bootstrapv1.BootstrapDataFragment{
+						{
+							Name:    "foo",
+							Content: "- foo",
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"invalid with duplicate fragment name": {
+			in: &bootstrapv1.KubeadmConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "baz",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: bootstrapv1.KubeadmConfigSpec{
+					Fragments: []bootstrapv1.BootstrapDataFragment{
+						{
+							Name:    "foo",
+							Content: "runcmd:\n- foo",
+						},
+						{
+							Name:    "foo",
+							Content: "runcmd:\n- bar",
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		"valid passwd": {
 			in: &bootstrapv1.KubeadmConfig{
 				ObjectMeta: metav1.ObjectMeta{
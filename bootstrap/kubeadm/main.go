@@ -53,6 +53,7 @@ import (
 	bootstrapv1alpha3 "sigs.k8s.io/cluster-api/internal/apis/bootstrap/kubeadm/v1alpha3"
 	bootstrapv1alpha4 "sigs.k8s.io/cluster-api/internal/apis/bootstrap/kubeadm/v1alpha4"
 	"sigs.k8s.io/cluster-api/util/flags"
+	"sigs.k8s.io/cluster-api/util/shard"
 	"sigs.k8s.io/cluster-api/version"
 )
 
@@ -77,13 +78,19 @@ var (
 	webhookCertDir              string
 	healthAddr                  string
 	tlsOptions                  = flags.TLSOptions{}
+	shardOptions                = flags.ShardOptions{}
 	diagnosticsOptions          = flags.DiagnosticsOptions{}
 	logOptions                  = logs.NewOptions()
 	// CABPK specific flags.
-	clusterConcurrency             int
-	clusterCacheTrackerConcurrency int
-	kubeadmConfigConcurrency       int
-	tokenTTL                       time.Duration
+	clusterConcurrency                          int
+	clusterCacheTrackerConcurrency              int
+	clusterCacheTrackerClientQPS                float32
+	clusterCacheTrackerClientBurst              int
+	clusterCacheTrackerHealthCheckInterval      time.Duration
+	clusterCacheTrackerHealthCheckTimeout       time.Duration
+	clusterCacheTrackerMaxConcurrentConnections int
+	kubeadmConfigConcurrency                    int
+	tokenTTL                                    time.Duration
 )
 
 func init() {
@@ -130,6 +137,21 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&clusterCacheTrackerConcurrency, "clustercachetracker-concurrency", 10,
 		"Number of clusters to process simultaneously")
 
+	fs.Float32Var(&clusterCacheTrackerClientQPS, "clustercachetracker-client-qps", 0,
+		"Maximum queries per second from the controller client to the Kubernetes API server of each workload cluster. If not set, the client-go default is used.")
+
+	fs.IntVar(&clusterCacheTrackerClientBurst, "clustercachetracker-client-burst", 0,
+		"Maximum number of queries that should be allowed in one burst from the controller client to the Kubernetes API server of each workload cluster. If not set, the client-go default is used.")
+
+	fs.DurationVar(&clusterCacheTrackerHealthCheckInterval, "clustercachetracker-health-check-interval", 10*time.Second,
+		"Interval between the health checks of the connection to each workload cluster (e.g. 10s)")
+
+	fs.DurationVar(&clusterCacheTrackerHealthCheckTimeout, "clustercachetracker-health-check-timeout", 5*time.Second,
+		"Timeout of each health check request to a workload cluster (e.g. 5s)")
+
+	fs.IntVar(&clusterCacheTrackerMaxConcurrentConnections, "clustercachetracker-max-concurrent-connections", 0,
+		"Maximum number of connections to workload clusters created simultaneously. If 0, the number of connections created simultaneously is not limited.")
+
 	fs.IntVar(&kubeadmConfigConcurrency, "kubeadmconfig-concurrency", 10,
 		"Number of kubeadm configs to process simultaneously")
 
@@ -156,6 +178,7 @@ func InitFlags(fs *pflag.FlagSet) {
 
 	flags.AddDiagnosticsOptions(fs, &diagnosticsOptions)
 	flags.AddTLSOptions(fs, &tlsOptions)
+	flags.AddShardOptions(fs, &shardOptions)
 
 	feature.MutableGates.AddFlag(fs)
 }
@@ -194,6 +217,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	managerShard, err := flags.GetShard(shardOptions)
+	if err != nil {
+		setupLog.Error(err, "unable to configure sharding")
+		os.Exit(1)
+	}
+
 	diagnosticsOpts := flags.GetDiagnosticsOptions(diagnosticsOptions)
 
 	var watchNamespaces map[string]cache.Config
@@ -213,7 +242,7 @@ func main() {
 	ctrlOptions := ctrl.Options{
 		Scheme:                     scheme,
 		LeaderElection:             enableLeaderElection,
-		LeaderElectionID:           "kubeadm-bootstrap-manager-leader-election-capi",
+		LeaderElectionID:           managerShard.LeaderElectionID("kubeadm-bootstrap-manager-leader-election-capi"),
 		LeaseDuration:              &leaderElectionLeaseDuration,
 		RenewDeadline:              &leaderElectionRenewDeadline,
 		RetryPeriod:                &leaderElectionRetryPeriod,
@@ -261,7 +290,7 @@ func main() {
 
 	setupChecks(mgr)
 	setupWebhooks(mgr)
-	setupReconcilers(ctx, mgr)
+	setupReconcilers(ctx, mgr, managerShard)
 
 	setupLog.Info("starting manager", "version", version.Get().String())
 	if err := mgr.Start(ctx); err != nil {
@@ -282,7 +311,7 @@ func setupChecks(mgr ctrl.Manager) {
 	}
 }
 
-func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
+func setupReconcilers(ctx context.Context, mgr ctrl.Manager, managerShard shard.Shard) {
 	secretCachingClient, err := client.New(mgr.GetConfig(), client.Options{
 		HTTPClient: mgr.GetHTTPClient(),
 		Cache: &client.CacheOptions{
@@ -299,9 +328,14 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 	tracker, err := remote.NewClusterCacheTracker(
 		mgr,
 		remote.ClusterCacheTrackerOptions{
-			SecretCachingClient: secretCachingClient,
-			ControllerName:      controllerName,
-			Log:                 &ctrl.Log,
+			SecretCachingClient:            secretCachingClient,
+			ControllerName:                 controllerName,
+			Log:                            &ctrl.Log,
+			ClientQPS:                      clusterCacheTrackerClientQPS,
+			ClientBurst:                    clusterCacheTrackerClientBurst,
+			HealthCheckInterval:            clusterCacheTrackerHealthCheckInterval,
+			HealthCheckTimeout:             clusterCacheTrackerHealthCheckTimeout,
+			MaxConcurrentAccessorCreations: clusterCacheTrackerMaxConcurrentConnections,
 		},
 	)
 	if err != nil {
@@ -312,6 +346,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		Client:           mgr.GetClient(),
 		Tracker:          tracker,
 		WatchFilterValue: watchFilterValue,
+		Shard:            managerShard,
 	}).SetupWithManager(ctx, mgr, concurrency(clusterCacheTrackerConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ClusterCacheReconciler")
 		os.Exit(1)
@@ -322,6 +357,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		SecretCachingClient: secretCachingClient,
 		Tracker:             tracker,
 		WatchFilterValue:    watchFilterValue,
+		Shard:               managerShard,
 		TokenTTL:            tokenTTL,
 	}).SetupWithManager(ctx, mgr, concurrency(kubeadmConfigConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KubeadmConfig")
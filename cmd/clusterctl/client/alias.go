@@ -17,6 +17,7 @@ limitations under the License.
 package client
 
 import (
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/backup"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/repository"
@@ -51,3 +52,6 @@ type Kubeconfig cluster.Kubeconfig
 // Processor defines the methods necessary for creating a specific yaml
 // processor.
 type Processor yaml.Processor
+
+// Backup describes a backup of a management cluster stored in an object storage.
+type Backup backup.Manifest
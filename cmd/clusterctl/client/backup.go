@@ -0,0 +1,172 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/backup"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+// CreateBackupOptions carries the options supported by CreateBackup.
+type CreateBackupOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Namespace where the objects describing the workload clusters exist. If unspecified, the current
+	// namespace will be used.
+	Namespace string
+
+	// AllNamespaces backs up the objects in all the namespaces; it can't be used with Namespace.
+	AllNamespaces bool
+
+	// Location is the URL of the object storage where backups are stored,
+	// e.g. s3://bucket/prefix, gs://bucket/prefix, azblob://account/container/prefix or a local directory.
+	Location string
+
+	// Keep is the number of backups to keep in the location, deleting older ones; zero means all the backups are kept.
+	Keep int
+}
+
+// ListBackupsOptions carries the options supported by ListBackups.
+type ListBackupsOptions struct {
+	// Location is the URL of the object storage where backups are stored.
+	Location string
+}
+
+// RestoreBackupOptions carries the options supported by RestoreBackup.
+type RestoreBackupOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster where the backup is restored. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Location is the URL of the object storage where backups are stored.
+	Location string
+
+	// Name of the backup to be restored; if empty, the latest backup is restored.
+	Name string
+}
+
+func (c *clusterctlClient) CreateBackup(ctx context.Context, options CreateBackupOptions) (string, error) {
+	if options.Location == "" {
+		return "", errors.New("Location must be set")
+	}
+	if options.AllNamespaces && options.Namespace != "" {
+		return "", errors.New("can't set both Namespace and AllNamespaces")
+	}
+	if options.Keep < 0 {
+		return "", errors.New("Keep can't be negative")
+	}
+
+	storage, err := backup.NewStorage(options.Location, c.configClient.Variables())
+	if err != nil {
+		return "", err
+	}
+
+	fromCluster, err := c.getClusterClient(ctx, options.Kubeconfig)
+	if err != nil {
+		return "", err
+	}
+
+	// If the option specifying the Namespace is empty, try to detect it.
+	namespace := options.Namespace
+	if namespace == "" && !options.AllNamespaces {
+		if namespace, err = fromCluster.Proxy().CurrentNamespace(); err != nil {
+			return "", err
+		}
+	}
+
+	directory, err := os.MkdirTemp("", "clusterctl-backup")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create temporary directory")
+	}
+	defer os.RemoveAll(directory)
+
+	if err := fromCluster.ObjectMover().ToDirectory(ctx, namespace, cluster.ClusterFilter{}, directory); err != nil {
+		return "", err
+	}
+
+	manifest, err := backup.Upload(ctx, storage, directory, namespace, time.Now())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to upload backup")
+	}
+
+	if options.Keep > 0 {
+		if err := backup.Prune(ctx, storage, options.Keep); err != nil {
+			return "", errors.Wrap(err, "failed to delete old backups")
+		}
+	}
+	return manifest.Name, nil
+}
+
+func (c *clusterctlClient) ListBackups(ctx context.Context, options ListBackupsOptions) ([]Backup, error) {
+	if options.Location == "" {
+		return nil, errors.New("Location must be set")
+	}
+
+	storage, err := backup.NewStorage(options.Location, c.configClient.Variables())
+	if err != nil {
+		return nil, err
+	}
+
+	manifests, err := backup.List(ctx, storage)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list backups")
+	}
+
+	backups := make([]Backup, 0, len(manifests))
+	for _, m := range manifests {
+		backups = append(backups, Backup(m))
+	}
+	return backups, nil
+}
+
+func (c *clusterctlClient) RestoreBackup(ctx context.Context, options RestoreBackupOptions) error {
+	if options.Location == "" {
+		return errors.New("Location must be set")
+	}
+
+	storage, err := backup.NewStorage(options.Location, c.configClient.Variables())
+	if err != nil {
+		return err
+	}
+
+	toCluster, err := c.getClusterClient(ctx, options.Kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	directory, err := os.MkdirTemp("", "clusterctl-restore")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary directory")
+	}
+	defer os.RemoveAll(directory)
+
+	if _, err := backup.Download(ctx, storage, options.Name, directory); err != nil {
+		return errors.Wrap(err, "failed to download backup")
+	}
+
+	// Nb. restoring from directory rebuilds the owner graph, so objects are created after their owners
+	// and owner references are updated with the UIDs of the restored objects.
+	return toCluster.ObjectMover().FromDirectory(ctx, toCluster, directory)
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+)
+
+const (
+	// backupsPrefix is the prefix of the keys hosting the manifest of each backup.
+	backupsPrefix = "backups/"
+
+	// objectsPrefix is the prefix of the keys hosting the content of the backed up files,
+	// addressed by their SHA256 digest so files which did not change are shared across backups.
+	objectsPrefix = "objects/"
+
+	// nameFormat is the format of the timestamp used to name backups.
+	nameFormat = "20060102-150405"
+)
+
+// Manifest describes a backup.
+type Manifest struct {
+	// Name of the backup.
+	Name string `json:"name"`
+
+	// CreatedAt is the time the backup was created.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Namespace is the namespace backed up; empty means all the namespaces.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Files maps the name of each backed up file to the SHA256 digest of its content.
+	Files map[string]string `json:"files"`
+}
+
+// Upload stores the files in a directory as a new backup.
+// Only the files which are not already stored by a previous backup are uploaded.
+func Upload(ctx context.Context, storage Storage, directory, namespace string, now time.Time) (*Manifest, error) {
+	log := logf.Log
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read directory %q", directory)
+	}
+
+	existing, err := storage.List(ctx, objectsPrefix)
+	if err != nil {
+		return nil, err
+	}
+	existingDigests := sets.Set[string]{}
+	for _, key := range existing {
+		existingDigests.Insert(strings.TrimPrefix(key, objectsPrefix))
+	}
+
+	manifest := &Manifest{
+		Name:      now.UTC().Format(nameFormat),
+		CreatedAt: now.UTC(),
+		Namespace: namespace,
+		Files:     map[string]string{},
+	}
+	uploaded := 0
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(directory, entry.Name())) //nolint:gosec
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read file %q", entry.Name())
+		}
+		digest := sha256Hex(data)
+		manifest.Files[entry.Name()] = digest
+
+		if existingDigests.Has(digest) {
+			continue
+		}
+		if err := storage.Put(ctx, objectsPrefix+digest, data); err != nil {
+			return nil, err
+		}
+		existingDigests.Insert(digest)
+		uploaded++
+	}
+	log.V(1).Info("Uploaded backup files", "Backup", manifest.Name, "Files", len(manifest.Files), "Uploaded", uploaded)
+
+	// The manifest is stored last, so a backup is listed only once all its files are stored.
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize backup manifest")
+	}
+	if err := storage.Put(ctx, backupsPrefix+manifest.Name+".json", data); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// List returns the backups in a storage, sorted from the oldest to the newest.
+func List(ctx context.Context, storage Storage) ([]Manifest, error) {
+	keys, err := storage.List(ctx, backupsPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := []Manifest{}
+	for _, key := range keys {
+		if !strings.HasSuffix(key, ".json") {
+			continue
+		}
+		manifest, err := getManifest(ctx, storage, key)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, *manifest)
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].Name < manifests[j].Name
+	})
+	return manifests, nil
+}
+
+// Download writes the files of a backup to a directory; if name is empty, the latest backup is downloaded.
+func Download(ctx context.Context, storage Storage, name, directory string) (*Manifest, error) {
+	var manifest *Manifest
+	if name == "" {
+		manifests, err := List(ctx, storage)
+		if err != nil {
+			return nil, err
+		}
+		if len(manifests) == 0 {
+			return nil, errors.New("no backups found")
+		}
+		manifest = &manifests[len(manifests)-1]
+	} else {
+		var err error
+		manifest, err = getManifest(ctx, storage, backupsPrefix+name+".json")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get backup %q", name)
+		}
+	}
+
+	if err := os.MkdirAll(directory, 0750); err != nil {
+		return nil, errors.Wrapf(err, "failed to create directory %q", directory)
+	}
+	for file, digest := range manifest.Files {
+		data, err := storage.Get(ctx, objectsPrefix+digest)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get file %q of backup %q", file, manifest.Name)
+		}
+		if sha256Hex(data) != digest {
+			return nil, errors.Errorf("file %q of backup %q is corrupted", file, manifest.Name)
+		}
+		if err := os.WriteFile(filepath.Join(directory, filepath.Base(file)), data, 0600); err != nil {
+			return nil, errors.Wrapf(err, "failed to write file %q", file)
+		}
+	}
+	return manifest, nil
+}
+
+// Prune deletes all the backups except the newest keep ones, and then deletes the files
+// no longer used by any backup.
+func Prune(ctx context.Context, storage Storage, keep int) error {
+	log := logf.Log
+
+	manifests, err := List(ctx, storage)
+	if err != nil {
+		return err
+	}
+	if len(manifests) <= keep {
+		return nil
+	}
+
+	used := sets.Set[string]{}
+	for _, manifest := range manifests[len(manifests)-keep:] {
+		for _, digest := range manifest.Files {
+			used.Insert(digest)
+		}
+	}
+
+	for _, manifest := range manifests[:len(manifests)-keep] {
+		log.V(1).Info("Deleting backup", "Backup", manifest.Name)
+		if err := storage.Delete(ctx, backupsPrefix+manifest.Name+".json"); err != nil {
+			return err
+		}
+	}
+
+	objects, err := storage.List(ctx, objectsPrefix)
+	if err != nil {
+		return err
+	}
+	for _, key := range objects {
+		if used.Has(strings.TrimPrefix(key, objectsPrefix)) {
+			continue
+		}
+		if err := storage.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getManifest(ctx context.Context, storage Storage, key string) (*Manifest, error) {
+	data, err := storage.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse backup manifest %s", key)
+	}
+	return manifest, nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestUploadDownload(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+	storage := newLocalStorage(t.TempDir())
+
+	// First backup.
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "Cluster_ns1_foo.yaml"), []byte("cluster"), 0600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(dir, "Secret_ns1_foo-kubeconfig.yaml"), []byte("secret"), 0600)).To(Succeed())
+
+	first, err := Upload(ctx, storage, dir, "ns1", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(first.Name).To(Equal("20240102-030405"))
+	g.Expect(first.Files).To(HaveLen(2))
+
+	objects, err := storage.List(ctx, objectsPrefix)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objects).To(HaveLen(2))
+
+	// Second backup, only the changed file is uploaded.
+	g.Expect(os.WriteFile(filepath.Join(dir, "Cluster_ns1_foo.yaml"), []byte("cluster-changed"), 0600)).To(Succeed())
+
+	second, err := Upload(ctx, storage, dir, "ns1", time.Date(2024, 1, 3, 3, 4, 5, 0, time.UTC))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(second.Files["Secret_ns1_foo-kubeconfig.yaml"]).To(Equal(first.Files["Secret_ns1_foo-kubeconfig.yaml"]))
+
+	objects, err = storage.List(ctx, objectsPrefix)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objects).To(HaveLen(3))
+
+	manifests, err := List(ctx, storage)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(manifests).To(HaveLen(2))
+	g.Expect(manifests[0].Name).To(Equal(first.Name))
+	g.Expect(manifests[1].Name).To(Equal(second.Name))
+
+	// Download the first backup.
+	out := t.TempDir()
+	_, err = Download(ctx, storage, first.Name, out)
+	g.Expect(err).ToNot(HaveOccurred())
+	data, err := os.ReadFile(filepath.Join(out, "Cluster_ns1_foo.yaml")) //nolint:gosec
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(Equal("cluster"))
+
+	// Download the latest backup.
+	out = t.TempDir()
+	manifest, err := Download(ctx, storage, "", out)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(manifest.Name).To(Equal(second.Name))
+	data, err = os.ReadFile(filepath.Join(out, "Cluster_ns1_foo.yaml")) //nolint:gosec
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(data)).To(Equal("cluster-changed"))
+
+	// Download a backup which does not exist.
+	_, err = Download(ctx, storage, "does-not-exist", t.TempDir())
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestDownload_corrupted(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+	storage := newLocalStorage(t.TempDir())
+
+	dir := t.TempDir()
+	g.Expect(os.WriteFile(filepath.Join(dir, "Cluster_ns1_foo.yaml"), []byte("cluster"), 0600)).To(Succeed())
+	manifest, err := Upload(ctx, storage, dir, "ns1", time.Now())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(storage.Put(ctx, objectsPrefix+manifest.Files["Cluster_ns1_foo.yaml"], []byte("tampered"))).To(Succeed())
+
+	_, err = Download(ctx, storage, manifest.Name, t.TempDir())
+	g.Expect(err).To(MatchError(ContainSubstring("is corrupted")))
+}
+
+func TestPrune(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+	storage := newLocalStorage(t.TempDir())
+
+	dir := t.TempDir()
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i, content := range []string{"a", "b", "c"} {
+		g.Expect(os.WriteFile(filepath.Join(dir, "Cluster_ns1_foo.yaml"), []byte(content), 0600)).To(Succeed())
+		_, err := Upload(ctx, storage, dir, "ns1", now.Add(time.Duration(i)*time.Hour))
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	g.Expect(Prune(ctx, storage, 2)).To(Succeed())
+
+	manifests, err := List(ctx, storage)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(manifests).To(HaveLen(2))
+	g.Expect(manifests[0].Name).To(Equal("20240102-040405"))
+
+	// Files used only by the deleted backup are deleted.
+	objects, err := storage.List(ctx, objectsPrefix)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objects).To(ConsistOf(objectsPrefix+sha256Hex([]byte("b")), objectsPrefix+sha256Hex([]byte("c"))))
+
+	// Pruning with less backups than keep is a no-op.
+	g.Expect(Prune(ctx, storage, 5)).To(Succeed())
+	manifests, err = List(ctx, storage)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(manifests).To(HaveLen(2))
+}
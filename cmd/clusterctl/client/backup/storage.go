@@ -0,0 +1,175 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+)
+
+const (
+	// AWSAccessKeyIDVariable defines the variable hosting the access key ID used for S3 locations.
+	AWSAccessKeyIDVariable = "AWS_ACCESS_KEY_ID"
+
+	// AWSSecretAccessKeyVariable defines the variable hosting the secret access key used for S3 locations.
+	AWSSecretAccessKeyVariable = "AWS_SECRET_ACCESS_KEY"
+
+	// AWSSessionTokenVariable defines the variable hosting the optional session token used for S3 locations.
+	AWSSessionTokenVariable = "AWS_SESSION_TOKEN"
+
+	// AWSRegionVariable defines the variable hosting the region of S3 locations; it defaults to us-east-1.
+	AWSRegionVariable = "AWS_REGION"
+
+	// AWSEndpointURLVariable defines the variable hosting the endpoint of an S3 compatible object storage, e.g. MinIO.
+	AWSEndpointURLVariable = "AWS_ENDPOINT_URL"
+
+	// GCSHMACAccessKeyIDVariable defines the variable hosting the HMAC access key ID used for GCS locations.
+	GCSHMACAccessKeyIDVariable = "GCS_HMAC_ACCESS_KEY_ID"
+
+	// GCSHMACSecretVariable defines the variable hosting the HMAC secret used for GCS locations.
+	GCSHMACSecretVariable = "GCS_HMAC_SECRET"
+
+	// AzureStorageSASTokenVariable defines the variable hosting the SAS token used for Azure Blob Storage locations.
+	AzureStorageSASTokenVariable = "AZURE_STORAGE_SAS_TOKEN"
+
+	// AzureStorageBlobEndpointVariable defines the variable hosting the endpoint of an Azure Blob Storage compatible
+	// object storage, e.g. Azurite.
+	AzureStorageBlobEndpointVariable = "AZURE_STORAGE_BLOB_ENDPOINT"
+
+	gcsEndpoint = "https://storage.googleapis.com"
+
+	storageRequestTimeout = 60 * time.Second
+)
+
+// Storage defines the methods for storing backups in an object storage.
+// Keys are relative to the location of the storage, and use / as separator.
+type Storage interface {
+	// Put stores data under the given key.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get returns the data stored under the given key.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// List returns all the keys starting with the given prefix.
+	List(ctx context.Context, prefix string) ([]string, error)
+
+	// Delete deletes the data stored under the given key; deleting a key which does not exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// NewStorage returns the Storage for a location, which can be:
+//   - s3://bucket/prefix for Amazon S3, or any S3 compatible object storage if the AWS_ENDPOINT_URL variable is set.
+//   - gs://bucket/prefix for Google Cloud Storage, using HMAC keys.
+//   - azblob://account/container/prefix for Azure Blob Storage, using a SAS token.
+//   - file:///path or a local path, for a local directory.
+//
+// Credentials are read from the variables, i.e. from environment variables or from the clusterctl configuration file.
+func NewStorage(location string, variables config.VariablesClient) (Storage, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid backup location %q", location)
+	}
+	prefix := strings.Trim(u.Path, "/")
+
+	httpClient := &http.Client{Timeout: storageRequestTimeout}
+	switch u.Scheme {
+	case "", "file":
+		dir := u.Path
+		if u.Scheme == "" {
+			dir = location
+		}
+		return newLocalStorage(dir), nil
+	case "s3":
+		if u.Host == "" {
+			return nil, errors.Errorf("invalid backup location %q: bucket is missing", location)
+		}
+		region := getOptionalVariable(variables, AWSRegionVariable)
+		if region == "" {
+			region = "us-east-1"
+		}
+		endpoint := getOptionalVariable(variables, AWSEndpointURLVariable)
+		baseURL := ""
+		if endpoint == "" {
+			baseURL = "https://" + u.Host + ".s3." + region + ".amazonaws.com"
+		} else {
+			baseURL = strings.TrimSuffix(endpoint, "/") + "/" + u.Host
+		}
+		accessKeyID, err := variables.Get(AWSAccessKeyIDVariable)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get credentials for backup location %q", location)
+		}
+		secretAccessKey, err := variables.Get(AWSSecretAccessKeyVariable)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get credentials for backup location %q", location)
+		}
+		return newS3Storage(httpClient, baseURL, prefix, region, accessKeyID, secretAccessKey, getOptionalVariable(variables, AWSSessionTokenVariable))
+	case "gs":
+		if u.Host == "" {
+			return nil, errors.Errorf("invalid backup location %q: bucket is missing", location)
+		}
+		// GCS supports the S3 API with HMAC keys, see https://cloud.google.com/storage/docs/interoperability.
+		accessKeyID, err := variables.Get(GCSHMACAccessKeyIDVariable)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get credentials for backup location %q", location)
+		}
+		secret, err := variables.Get(GCSHMACSecretVariable)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get credentials for backup location %q", location)
+		}
+		return newS3Storage(httpClient, gcsEndpoint+"/"+u.Host, prefix, "auto", accessKeyID, secret, "")
+	case "azblob":
+		container, prefix, _ := strings.Cut(prefix, "/")
+		if u.Host == "" || container == "" {
+			return nil, errors.Errorf("invalid backup location %q: account or container is missing", location)
+		}
+		endpoint := getOptionalVariable(variables, AzureStorageBlobEndpointVariable)
+		if endpoint == "" {
+			endpoint = "https://" + u.Host + ".blob.core.windows.net"
+		}
+		sasToken, err := variables.Get(AzureStorageSASTokenVariable)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get credentials for backup location %q", location)
+		}
+		return newAzureStorage(httpClient, strings.TrimSuffix(endpoint, "/")+"/"+container, prefix, sasToken)
+	default:
+		return nil, errors.Errorf("invalid backup location %q: scheme %q is not supported", location, u.Scheme)
+	}
+}
+
+func getOptionalVariable(variables config.VariablesClient, key string) string {
+	value, err := variables.Get(key)
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
+// joinKey joins the prefix of a storage with a key.
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return path.Join(prefix, key)
+}
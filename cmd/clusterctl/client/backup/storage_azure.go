@@ -0,0 +1,183 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// azureStorageVersion is the version of the Azure Blob Storage REST API.
+const azureStorageVersion = "2021-08-06"
+
+// azureStorage implements Storage for Azure Blob Storage, using the Blob service REST API
+// authorized with a SAS token.
+type azureStorage struct {
+	httpClient   *http.Client
+	containerURL *url.URL
+	prefix       string
+	sasToken     url.Values
+}
+
+var _ Storage = &azureStorage{}
+
+func newAzureStorage(httpClient *http.Client, containerURL, prefix, sasToken string) (Storage, error) {
+	u, err := url.Parse(containerURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid Azure Blob Storage endpoint %q", containerURL)
+	}
+	token, err := url.ParseQuery(strings.TrimPrefix(sasToken, "?"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid Azure Blob Storage SAS token")
+	}
+	return &azureStorage{
+		httpClient:   httpClient,
+		containerURL: u,
+		prefix:       prefix,
+		sasToken:     token,
+	}, nil
+}
+
+func (s *azureStorage) Put(ctx context.Context, key string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, joinKey(s.prefix, key), nil, data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write %s", key)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return errors.Wrapf(responseError(resp), "failed to write %s", key)
+	}
+	return nil
+}
+
+func (s *azureStorage) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, joinKey(s.prefix, key), nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", key)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(responseError(resp), "failed to read %s", key)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", key)
+	}
+	return data, nil
+}
+
+// azureEnumerationResults is the response of the List Blobs API.
+type azureEnumerationResults struct {
+	Blobs struct {
+		Blob []struct {
+			Name string `xml:"Name"`
+		} `xml:"Blob"`
+	} `xml:"Blobs"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+func (s *azureStorage) List(ctx context.Context, prefix string) ([]string, error) {
+	keyPrefix := ""
+	if s.prefix != "" {
+		keyPrefix = s.prefix + "/"
+	}
+
+	keys := []string{}
+	marker := ""
+	for {
+		query := url.Values{}
+		query.Set("restype", "container")
+		query.Set("comp", "list")
+		query.Set("prefix", keyPrefix+prefix)
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+
+		result, err := s.list(ctx, query)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list %s", prefix)
+		}
+		for _, b := range result.Blobs.Blob {
+			keys = append(keys, strings.TrimPrefix(b.Name, keyPrefix))
+		}
+		if result.NextMarker == "" {
+			return keys, nil
+		}
+		marker = result.NextMarker
+	}
+}
+
+func (s *azureStorage) list(ctx context.Context, query url.Values) (*azureEnumerationResults, error) {
+	resp, err := s.do(ctx, http.MethodGet, "", query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp)
+	}
+	result := &azureEnumerationResults{}
+	if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+	return result, nil
+}
+
+func (s *azureStorage) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, joinKey(s.prefix, key), nil, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete %s", key)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusNotFound {
+		return errors.Wrapf(responseError(resp), "failed to delete %s", key)
+	}
+	return nil
+}
+
+// do sends a request for a blob, or for the container if key is empty.
+func (s *azureStorage) do(ctx context.Context, method, key string, query url.Values, body []byte) (*http.Response, error) {
+	u := *s.containerURL
+	if key != "" {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + key
+	}
+	q := url.Values{}
+	for k, v := range s.sasToken {
+		q[k] = v
+	}
+	for k, v := range query {
+		q[k] = v
+	}
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-ms-version", azureStorageVersion)
+	if method == http.MethodPut {
+		req.Header.Set("x-ms-blob-type", "BlockBlob")
+	}
+	return s.httpClient.Do(req)
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	s3Service          = "s3"
+	s3SigningAlgorithm = "AWS4-HMAC-SHA256"
+	s3DateTimeFormat   = "20060102T150405Z"
+	s3DateFormat       = "20060102"
+)
+
+// s3Storage implements Storage for Amazon S3 and S3 compatible object storages, using the S3 REST API
+// signed with AWS Signature Version 4.
+type s3Storage struct {
+	httpClient      *http.Client
+	baseURL         *url.URL
+	prefix          string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+
+	// now is used to sign requests; it is replaced in tests.
+	now func() time.Time
+}
+
+var _ Storage = &s3Storage{}
+
+func newS3Storage(httpClient *http.Client, baseURL, prefix, region, accessKeyID, secretAccessKey, sessionToken string) (Storage, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid S3 endpoint %q", baseURL)
+	}
+	return &s3Storage{
+		httpClient:      httpClient,
+		baseURL:         u,
+		prefix:          prefix,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		sessionToken:    sessionToken,
+		now:             time.Now,
+	}, nil
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, joinKey(s.prefix, key), nil, data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write %s", key)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Wrapf(responseError(resp), "failed to write %s", key)
+	}
+	return nil
+}
+
+func (s *s3Storage) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, joinKey(s.prefix, key), nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", key)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(responseError(resp), "failed to read %s", key)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", key)
+	}
+	return data, nil
+}
+
+// s3ListBucketResult is the response of the ListObjectsV2 API.
+type s3ListBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (s *s3Storage) List(ctx context.Context, prefix string) ([]string, error) {
+	keyPrefix := ""
+	if s.prefix != "" {
+		keyPrefix = s.prefix + "/"
+	}
+
+	keys := []string{}
+	continuationToken := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", keyPrefix+prefix)
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		result, err := s.list(ctx, query)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list %s", prefix)
+		}
+		for _, c := range result.Contents {
+			keys = append(keys, strings.TrimPrefix(c.Key, keyPrefix))
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+func (s *s3Storage) list(ctx context.Context, query url.Values) (*s3ListBucketResult, error) {
+	resp, err := s.do(ctx, http.MethodGet, "", query, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp)
+	}
+	result := &s3ListBucketResult{}
+	if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, errors.Wrap(err, "failed to parse response")
+	}
+	return result, nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, joinKey(s.prefix, key), nil, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete %s", key)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return errors.Wrapf(responseError(resp), "failed to delete %s", key)
+	}
+	return nil
+}
+
+// do sends a signed request for an object key, or for the bucket if key is empty.
+func (s *s3Storage) do(ctx context.Context, method, key string, query url.Values, body []byte) (*http.Response, error) {
+	u := *s.baseURL
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + key
+	u.RawQuery = encodeQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	s.sign(req, body)
+	return s.httpClient.Do(req)
+}
+
+// sign adds the AWS Signature Version 4 authorization header to a request,
+// see https://docs.aws.amazon.com/IAM/latest/UserGuide/create-signed-request.html.
+func (s *s3Storage) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", now.Format(s3DateTimeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.sessionToken)
+	}
+
+	signedHeaderNames := []string{}
+	for name := range req.Header {
+		signedHeaderNames = append(signedHeaderNames, strings.ToLower(name))
+	}
+	sort.Strings(signedHeaderNames)
+
+	canonicalHeaders := &strings.Builder{}
+	for _, name := range signedHeaderNames {
+		fmt.Fprintf(canonicalHeaders, "%s:%s\n", name, strings.TrimSpace(req.Header.Get(name)))
+	}
+	signedHeaders := strings.Join(signedHeaderNames, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{now.Format(s3DateFormat), s.region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		s3SigningAlgorithm,
+		now.Format(s3DateTimeFormat),
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.secretAccessKey), now.Format(s3DateFormat))
+	signingKey = hmacSHA256(signingKey, s.region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgorithm, s.accessKeyID, scope, signedHeaders, signature))
+}
+
+// encodeQuery encodes query parameters as required by AWS Signature Version 4, i.e. sorted by key
+// and with spaces encoded as %20.
+func encodeQuery(query url.Values) string {
+	return strings.ReplaceAll(query.Encode(), "+", "%20")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// responseError returns an error for an unexpected response, including the beginning of the response body.
+func responseError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return errors.Errorf("unexpected response %s: %s", resp.Status, strings.TrimSpace(string(body)))
+}
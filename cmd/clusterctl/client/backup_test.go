@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_clusterctlClient_Backup(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+	client := fakeClientForMove()
+	location := t.TempDir()
+
+	// Options are validated.
+	_, err := client.CreateBackup(ctx, CreateBackupOptions{
+		Kubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+	})
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = client.CreateBackup(ctx, CreateBackupOptions{
+		Kubeconfig:    Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+		Namespace:     "ns1",
+		AllNamespaces: true,
+		Location:      location,
+	})
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = client.CreateBackup(ctx, CreateBackupOptions{
+		Kubeconfig: Kubeconfig{Path: "kubeconfig", Context: "does-not-exist"},
+		Location:   location,
+	})
+	g.Expect(err).To(HaveOccurred())
+
+	// Create a backup.
+	name, err := client.CreateBackup(ctx, CreateBackupOptions{
+		Kubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+		Namespace:  "ns1",
+		Location:   location,
+		Keep:       1,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	backups, err := client.ListBackups(ctx, ListBackupsOptions{Location: location})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(backups).To(HaveLen(1))
+	g.Expect(backups[0].Name).To(Equal(name))
+	g.Expect(backups[0].Namespace).To(Equal("ns1"))
+
+	// Restore the backup.
+	g.Expect(client.RestoreBackup(ctx, RestoreBackupOptions{
+		Kubeconfig: Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+		Location:   location,
+		Name:       name,
+	})).To(Succeed())
+
+	g.Expect(client.RestoreBackup(ctx, RestoreBackupOptions{
+		Kubeconfig: Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+		Location:   location,
+		Name:       "does-not-exist",
+	})).ToNot(Succeed())
+}
@@ -38,6 +38,10 @@ type Client interface {
 	// GenerateProvider returns the provider components for a given provider with options including targetNamespace.
 	GenerateProvider(ctx context.Context, provider string, providerType clusterctlv1.ProviderType, options ComponentsOptions) (Components, error)
 
+	// GenerateExtension writes the scaffolding of a new Runtime Extension project implementing the selected Runtime Hooks,
+	// and returns the paths of the generated files.
+	GenerateExtension(ctx context.Context, options GenerateExtensionOptions) ([]string, error)
+
 	// Init initializes a management cluster by adding the requested list of providers.
 	Init(ctx context.Context, options InitOptions) ([]Components, error)
 
@@ -56,6 +60,16 @@ type Client interface {
 	// Move moves all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target management cluster.
 	Move(ctx context.Context, options MoveOptions) error
 
+	// CreateBackup stores all the Cluster API objects existing in a namespace (or in all the namespaces) in an object storage,
+	// and returns the name of the backup.
+	CreateBackup(ctx context.Context, options CreateBackupOptions) (string, error)
+
+	// ListBackups returns the backups stored in an object storage.
+	ListBackups(ctx context.Context, options ListBackupsOptions) ([]Backup, error)
+
+	// RestoreBackup restores a backup stored in an object storage to a management cluster.
+	RestoreBackup(ctx context.Context, options RestoreBackupOptions) error
+
 	// PlanUpgrade returns a set of suggested Upgrade plans for the cluster.
 	PlanUpgrade(ctx context.Context, options PlanUpgradeOptions) ([]UpgradePlan, error)
 
@@ -90,6 +104,8 @@ type AlphaClient interface {
 	//
 	// Deprecated: TopologyPlan is deprecated and will be removed in one of the upcoming releases.
 	TopologyPlan(ctx context.Context, options TopologyPlanOptions) (*TopologyPlanOutput, error)
+	// TopologyDiff dry runs the topology reconciler against a Cluster in the management cluster.
+	TopologyDiff(ctx context.Context, options TopologyDiffOptions) (*TopologyDiffOutput, error)
 }
 
 // YamlPrinter exposes methods that prints the processed template and
@@ -87,6 +87,10 @@ func (f fakeClient) GenerateProvider(ctx context.Context, provider string, provi
 	return f.internalClient.GenerateProvider(ctx, provider, providerType, options)
 }
 
+func (f fakeClient) GenerateExtension(ctx context.Context, options GenerateExtensionOptions) ([]string, error) {
+	return f.internalClient.GenerateExtension(ctx, options)
+}
+
 func (f fakeClient) GetClusterTemplate(ctx context.Context, options GetClusterTemplateOptions) (Template, error) {
 	return f.internalClient.GetClusterTemplate(ctx, options)
 }
@@ -111,6 +115,18 @@ func (f fakeClient) Move(ctx context.Context, options MoveOptions) error {
 	return f.internalClient.Move(ctx, options)
 }
 
+func (f fakeClient) CreateBackup(ctx context.Context, options CreateBackupOptions) (string, error) {
+	return f.internalClient.CreateBackup(ctx, options)
+}
+
+func (f fakeClient) ListBackups(ctx context.Context, options ListBackupsOptions) ([]Backup, error) {
+	return f.internalClient.ListBackups(ctx, options)
+}
+
+func (f fakeClient) RestoreBackup(ctx context.Context, options RestoreBackupOptions) error {
+	return f.internalClient.RestoreBackup(ctx, options)
+}
+
 func (f fakeClient) PlanUpgrade(ctx context.Context, options PlanUpgradeOptions) ([]UpgradePlan, error) {
 	return f.internalClient.PlanUpgrade(ctx, options)
 }
@@ -151,6 +167,10 @@ func (f fakeClient) TopologyPlan(ctx context.Context, options TopologyPlanOption
 	return f.internalClient.TopologyPlan(ctx, options)
 }
 
+func (f fakeClient) TopologyDiff(ctx context.Context, options TopologyDiffOptions) (*cluster.TopologyDiffOutput, error) {
+	return f.internalClient.TopologyDiff(ctx, options)
+}
+
 // newFakeClient returns a clusterctl client that allows to execute tests on a set of fake config, fake repositories and fake clusters.
 // you can use WithCluster and WithRepository to prepare for the test case.
 func newFakeClient(ctx context.Context, configClient config.Client) *fakeClient {
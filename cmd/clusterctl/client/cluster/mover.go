@@ -28,6 +28,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -51,26 +52,73 @@ type ResourceMutatorFunc func(u *unstructured.Unstructured) error
 // ObjectMover defines methods for moving Cluster API objects to another management cluster.
 type ObjectMover interface {
 	// Move moves all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target management cluster.
-	Move(ctx context.Context, namespace string, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error
+	// If the filter is not empty, only the Clusters selected by the filter and the objects they require are moved.
+	Move(ctx context.Context, namespace string, filter ClusterFilter, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error
+
+	// Resume completes an interrupted move of the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target management cluster.
+	Resume(ctx context.Context, namespace string, toCluster Client, mutators ...ResourceMutatorFunc) error
+
+	// Rollback reverts an interrupted move of the Cluster API objects existing in a namespace (or from all the namespaces if empty), deleting
+	// the objects already created in the target management cluster and resuming the objects in the source management cluster.
+	Rollback(ctx context.Context, namespace string, toCluster Client, mutators ...ResourceMutatorFunc) error
 
 	// ToDirectory writes all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target directory.
-	ToDirectory(ctx context.Context, namespace string, directory string) error
+	// If the filter is not empty, only the Clusters selected by the filter and the objects they require are written.
+	ToDirectory(ctx context.Context, namespace string, filter ClusterFilter, directory string) error
 
 	// FromDirectory reads all the Cluster API objects existing in a configured directory to a target management cluster.
 	FromDirectory(ctx context.Context, toCluster Client, directory string) error
 }
 
+// ClusterFilter selects the Clusters to be moved; a Cluster is selected if it matches all the criteria which are set.
+type ClusterFilter struct {
+	// Selector selects the Clusters with matching labels.
+	Selector labels.Selector
+
+	// Names selects the Clusters with the given names.
+	Names []string
+}
+
+// IsEmpty returns true if the filter does not define any criteria, i.e. all the Clusters are selected.
+func (f ClusterFilter) IsEmpty() bool {
+	return (f.Selector == nil || f.Selector.Empty()) && len(f.Names) == 0
+}
+
+// selectClusters returns the Clusters selected by the filter.
+func (f ClusterFilter) selectClusters(clusters []*node) ([]*node, error) {
+	selected := []*node{}
+	for _, cluster := range clusters {
+		if len(f.Names) > 0 && !sets.New(f.Names...).Has(cluster.identity.Name) {
+			continue
+		}
+		if f.Selector != nil {
+			clusterLabels, _ := cluster.additionalInfo[clusterLabelsKey].(map[string]string)
+			if !f.Selector.Matches(labels.Set(clusterLabels)) {
+				continue
+			}
+		}
+		selected = append(selected, cluster)
+	}
+	if len(selected) == 0 {
+		return nil, errors.New("no Clusters match the filter")
+	}
+	return selected, nil
+}
+
 // objectMover implements the ObjectMover interface.
 type objectMover struct {
 	fromProxy             Proxy
 	fromProviderInventory InventoryClient
 	dryRun                bool
+
+	// progress is the progress of the current move operation, persisted in the source management cluster.
+	progress *moveProgress
 }
 
 // ensure objectMover implements the ObjectMover interface.
 var _ ObjectMover = &objectMover{}
 
-func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error {
+func (o *objectMover) Move(ctx context.Context, namespace string, filter ClusterFilter, toCluster Client, dryRun bool, mutators ...ResourceMutatorFunc) error {
 	log := logf.Log
 	log.Info("Performing move...")
 	o.dryRun = dryRun
@@ -85,9 +133,24 @@ func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Clie
 		if err := o.checkTargetProviders(ctx, toCluster.ProviderInventory()); err != nil {
 			return errors.Wrap(err, "failed to check providers in target cluster")
 		}
+
+		// Refuse to start a new move if a previous one was interrupted, so objects are not left split across
+		// management clusters.
+		progress, err := getMoveProgress(ctx, o.fromProxy, namespace)
+		if err != nil {
+			return err
+		}
+		if progress != nil {
+			return errors.Errorf("a previous move is not completed (phase %s), resume or roll back it before starting a new move", progress.Phase)
+		}
+		o.progress = &moveProgress{Namespace: namespace, Selective: !filter.IsEmpty()}
 	}
 
-	objectGraph, err := o.getObjectGraph(ctx, namespace)
+	var selectClusters func([]*node) ([]*node, error)
+	if !filter.IsEmpty() {
+		selectClusters = filter.selectClusters
+	}
+	objectGraph, err := o.getObjectGraph(ctx, namespace, selectClusters)
 	if err != nil {
 		return errors.Wrap(err, "failed to get object graph")
 	}
@@ -101,11 +164,66 @@ func (o *objectMover) Move(ctx context.Context, namespace string, toCluster Clie
 	return o.move(ctx, objectGraph, proxy, mutators...)
 }
 
-func (o *objectMover) ToDirectory(ctx context.Context, namespace string, directory string) error {
+func (o *objectMover) Resume(ctx context.Context, namespace string, toCluster Client, mutators ...ResourceMutatorFunc) error {
+	log := logf.Log
+	log.Info("Resuming move...")
+
+	progress, err := getMoveProgress(ctx, o.fromProxy, namespace)
+	if err != nil {
+		return err
+	}
+	if progress == nil {
+		return errors.New("failed to find an interrupted move to resume")
+	}
+	o.progress = progress
+
+	if err := o.checkTargetProviders(ctx, toCluster.ProviderInventory()); err != nil {
+		return errors.Wrap(err, "failed to check providers in target cluster")
+	}
+
+	// Nb. Objects already deleted from the source cluster are not part of the object graph anymore; this is
+	// fine because they have been created in the target cluster before being deleted.
+	objectGraph, err := o.getObjectGraph(ctx, namespace, progress.selectClusters())
+	if err != nil {
+		return errors.Wrap(err, "failed to get object graph")
+	}
+
+	return o.move(ctx, objectGraph, toCluster.Proxy(), mutators...)
+}
+
+func (o *objectMover) Rollback(ctx context.Context, namespace string, toCluster Client, mutators ...ResourceMutatorFunc) error {
+	log := logf.Log
+	log.Info("Rolling back move...")
+
+	progress, err := getMoveProgress(ctx, o.fromProxy, namespace)
+	if err != nil {
+		return err
+	}
+	if progress == nil {
+		return errors.New("failed to find an interrupted move to roll back")
+	}
+	if progress.Phase != moveProgressPhaseCreating {
+		return errors.Errorf("cannot roll back a move in phase %s because objects are already being deleted from the source cluster, resume it instead", progress.Phase)
+	}
+	o.progress = progress
+
+	objectGraph, err := o.getObjectGraph(ctx, namespace, progress.selectClusters())
+	if err != nil {
+		return errors.Wrap(err, "failed to get object graph")
+	}
+
+	return o.rollback(ctx, objectGraph, toCluster.Proxy(), mutators...)
+}
+
+func (o *objectMover) ToDirectory(ctx context.Context, namespace string, filter ClusterFilter, directory string) error {
 	log := logf.Log
 	log.Info("Moving to directory...")
 
-	objectGraph, err := o.getObjectGraph(ctx, namespace)
+	var selectClusters func([]*node) ([]*node, error)
+	if !filter.IsEmpty() {
+		selectClusters = filter.selectClusters
+	}
+	objectGraph, err := o.getObjectGraph(ctx, namespace, selectClusters)
 	if err != nil {
 		return errors.Wrap(err, "failed to get object graph")
 	}
@@ -184,7 +302,9 @@ func (o *objectMover) filesToObjs(dir string) ([]unstructured.Unstructured, erro
 	return objs, nil
 }
 
-func (o *objectMover) getObjectGraph(ctx context.Context, namespace string) (*objectGraph, error) {
+// getObjectGraph returns the graph of the objects to be moved; if selectClusters is set, the graph includes
+// only the Clusters it returns and the objects they require.
+func (o *objectMover) getObjectGraph(ctx context.Context, namespace string, selectClusters func([]*node) ([]*node, error)) (*objectGraph, error) {
 	objectGraph := newObjectGraph(o.fromProxy, o.fromProviderInventory)
 
 	// Gets all the types defined by the CRDs installed by clusterctl plus the ConfigMap/Secret core types.
@@ -200,6 +320,14 @@ func (o *objectMover) getObjectGraph(ctx context.Context, namespace string) (*ob
 		return nil, errors.Wrap(err, "failed to discover the object graph")
 	}
 
+	if selectClusters != nil {
+		clusters, err := selectClusters(objectGraph.getClusters())
+		if err != nil {
+			return nil, err
+		}
+		objectGraph.filterClusters(clusters)
+	}
+
 	// Checks if Cluster API has already completed the provisioning of the infrastructure for the objects involved in the move/toDirectory operation.
 	// This is required because if the infrastructure is provisioned, then we can reasonably assume that the objects we are moving/backing up are
 	// not currently waiting for long-running reconciliation loops, and so we can safely rely on the pause field on the Cluster object
@@ -324,6 +452,20 @@ func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Prox
 	clusterClasses := graph.getClusterClasses()
 	log.Info("Moving Cluster API objects", "ClusterClasses", len(clusterClasses))
 
+	// Persists the progress before pausing, so an interrupted move can be resumed or rolled back.
+	targetClusters, targetClusterClasses := clusters, clusterClasses
+	if o.progress != nil {
+		o.progress.addNodes(clusters, clusterClasses)
+		targetClusters = refsToNodes(o.progress.Clusters, clusters)
+		targetClusterClasses = refsToNodes(o.progress.ClusterClasses, clusterClasses)
+		if o.progress.Phase == "" {
+			o.progress.Phase = moveProgressPhaseCreating
+		}
+		if err := o.saveMoveProgress(ctx, o.progress.Phase); err != nil {
+			return err
+		}
+	}
+
 	// Sets the pause field on the Cluster object in the source management cluster, so the controllers stop reconciling it.
 	log.V(1).Info("Pausing the source cluster")
 	if err := setClusterPause(ctx, o.fromProxy, clusters, true, o.dryRun); err != nil {
@@ -359,6 +501,14 @@ func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Prox
 	// - then all the MachineSets, then all the Machines, etc.
 	moveSequence := getMoveSequence(graph)
 
+	// Print the objects that would be moved, group by group, with their owners.
+	if o.dryRun {
+		log.Info("Objects to be moved, in order")
+		for _, line := range describeMoveSequence(moveSequence) {
+			log.Info(line)
+		}
+	}
+
 	// Create all objects group by group, ensuring all the ownerReferences are re-created.
 	log.Info("Creating objects in the target cluster")
 	for groupIndex := 0; groupIndex < len(moveSequence.groups); groupIndex++ {
@@ -367,27 +517,79 @@ func (o *objectMover) move(ctx context.Context, graph *objectGraph, toProxy Prox
 		}
 	}
 
+	// From this point on objects are deleted from the source cluster, so the move can't be rolled back anymore.
+	if err := o.saveMoveProgress(ctx, moveProgressPhaseDeleting); err != nil {
+		return err
+	}
+
 	// Nb. mutators used after this point (after creating the resources on target clusters) are mainly intended for
 	// using the right namespace to fetch the resource from the target cluster.
 	// mutators affecting non metadata fields are no-op after this point.
 
 	// Delete all objects group by group in reverse order.
+	// Nb. Shared objects are kept in the source cluster because they are still required by objects not being moved.
 	log.Info("Deleting objects from the source cluster")
 	for groupIndex := len(moveSequence.groups) - 1; groupIndex >= 0; groupIndex-- {
-		if err := o.deleteGroup(ctx, moveSequence.getGroup(groupIndex)); err != nil {
+		if err := o.deleteGroup(ctx, moveSequence.getGroup(groupIndex).withoutShared(), o.fromProxy); err != nil {
 			return err
 		}
 	}
 
+	// Resume the shared ClusterClasses in the source management cluster, because they are still used by Clusters not being moved.
+	sharedClusterClasses := []*node{}
+	for _, clusterClass := range clusterClasses {
+		if clusterClass.isShared {
+			sharedClusterClasses = append(sharedClusterClasses, clusterClass)
+		}
+	}
+	if len(sharedClusterClasses) > 0 {
+		log.V(1).Info("Resuming the shared source ClusterClasses")
+		if err := setClusterClassPause(ctx, o.fromProxy, sharedClusterClasses, false, o.dryRun); err != nil {
+			return errors.Wrap(err, "error resuming ClusterClasses")
+		}
+	}
+
 	// Resume the ClusterClasses in the target management cluster, so the controllers start reconciling it.
 	log.V(1).Info("Resuming the target ClusterClasses")
-	if err := setClusterClassPause(ctx, toProxy, clusterClasses, false, o.dryRun, mutators...); err != nil {
+	if err := setClusterClassPause(ctx, toProxy, targetClusterClasses, false, o.dryRun, mutators...); err != nil {
 		return errors.Wrap(err, "error resuming ClusterClasses")
 	}
 
 	// Reset the pause field on the Cluster object in the target management cluster, so the controllers start reconciling it.
 	log.V(1).Info("Resuming the target cluster")
-	return setClusterPause(ctx, toProxy, clusters, false, o.dryRun, mutators...)
+	if err := setClusterPause(ctx, toProxy, targetClusters, false, o.dryRun, mutators...); err != nil {
+		return err
+	}
+
+	// The move is completed.
+	return o.deleteMoveProgress(ctx)
+}
+
+// rollback reverts an interrupted move by deleting the objects already created in the target management cluster
+// and resuming the objects in the source management cluster.
+func (o *objectMover) rollback(ctx context.Context, graph *objectGraph, toProxy Proxy, mutators ...ResourceMutatorFunc) error {
+	log := logf.Log
+
+	// Objects are deleted from the target cluster group by group in reverse order, like for the source cluster during move.
+	moveSequence := getMoveSequence(graph)
+	log.Info("Deleting objects from the target cluster")
+	for groupIndex := len(moveSequence.groups) - 1; groupIndex >= 0; groupIndex-- {
+		if err := o.deleteGroup(ctx, moveSequence.getGroup(groupIndex), toProxy, mutators...); err != nil {
+			return err
+		}
+	}
+
+	log.V(1).Info("Resuming the source ClusterClasses")
+	if err := setClusterClassPause(ctx, o.fromProxy, refsToNodes(o.progress.ClusterClasses, graph.getClusterClasses()), false, o.dryRun); err != nil {
+		return errors.Wrap(err, "error resuming ClusterClasses")
+	}
+
+	log.V(1).Info("Resuming the source cluster")
+	if err := setClusterPause(ctx, o.fromProxy, refsToNodes(o.progress.Clusters, graph.getClusters()), false, o.dryRun); err != nil {
+		return err
+	}
+
+	return o.deleteMoveProgress(ctx)
 }
 
 func (o *objectMover) toDirectory(ctx context.Context, graph *objectGraph, directory string) error {
@@ -505,6 +707,17 @@ func (s *moveSequence) getGroup(i int) moveGroup {
 	return s.groups[i]
 }
 
+// withoutShared returns the nodes in the group which are not shared with objects not being moved.
+func (g moveGroup) withoutShared() moveGroup {
+	nodes := moveGroup{}
+	for _, n := range g {
+		if !n.isShared {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
 // Define the move sequence by processing the ownerReference chain.
 func getMoveSequence(graph *objectGraph) *moveSequence {
 	moveSequence := &moveSequence{
@@ -1162,8 +1375,8 @@ func (o *objectMover) buildOwnerChain(obj *unstructured.Unstructured, n *node) {
 	}
 }
 
-// deleteGroup deletes all the Kubernetes objects from the source management cluster corresponding to the object graph nodes in a moveGroup.
-func (o *objectMover) deleteGroup(ctx context.Context, group moveGroup) error {
+// deleteGroup deletes all the Kubernetes objects from a management cluster corresponding to the object graph nodes in a moveGroup.
+func (o *objectMover) deleteGroup(ctx context.Context, group moveGroup, proxy Proxy, mutators ...ResourceMutatorFunc) error {
 	deleteSourceObjectBackoff := newWriteBackoff()
 	errList := []error{}
 	for i := range group {
@@ -1172,7 +1385,7 @@ func (o *objectMover) deleteGroup(ctx context.Context, group moveGroup) error {
 		// Delete the Kubernetes object corresponding to the current node.
 		// Nb. The operation is wrapped in a retry loop to make move more resilient to unexpected conditions.
 		err := retryWithExponentialBackoff(ctx, deleteSourceObjectBackoff, func(ctx context.Context) error {
-			return o.deleteSourceObject(ctx, nodeToDelete)
+			return o.deleteObject(ctx, proxy, nodeToDelete, mutators...)
 		})
 
 		if err != nil {
@@ -1191,6 +1404,13 @@ var (
 // deleteSourceObject deletes the Kubernetes object corresponding to the node from the source management cluster, taking care of removing all the finalizers so
 // the objects gets immediately deleted (force delete).
 func (o *objectMover) deleteSourceObject(ctx context.Context, nodeToDelete *node) error {
+	return o.deleteObject(ctx, o.fromProxy, nodeToDelete)
+}
+
+// deleteObject deletes the Kubernetes object corresponding to the node from a management cluster, taking care of removing all the finalizers so
+// the objects gets immediately deleted (force delete).
+// Nb. mutators are used when rolling back a move for identifying the object in the target management cluster.
+func (o *objectMover) deleteObject(ctx context.Context, proxy Proxy, nodeToDelete *node, mutators ...ResourceMutatorFunc) error {
 	// Don't delete cluster-wide nodes or nodes that are below a hierarchy that starts with a global object (e.g. a secrets owned by a global identity object).
 	if nodeToDelete.isGlobal || nodeToDelete.isGlobalHierarchy {
 		return nil
@@ -1203,7 +1423,7 @@ func (o *objectMover) deleteSourceObject(ctx context.Context, nodeToDelete *node
 		return nil
 	}
 
-	cFrom, err := o.fromProxy.NewClient(ctx)
+	cFrom, err := proxy.NewClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -1212,10 +1432,13 @@ func (o *objectMover) deleteSourceObject(ctx context.Context, nodeToDelete *node
 	sourceObj := &unstructured.Unstructured{}
 	sourceObj.SetAPIVersion(nodeToDelete.identity.APIVersion)
 	sourceObj.SetKind(nodeToDelete.identity.Kind)
-	sourceObjKey := client.ObjectKey{
-		Namespace: nodeToDelete.identity.Namespace,
-		Name:      nodeToDelete.identity.Name,
+	sourceObj.SetNamespace(nodeToDelete.identity.Namespace)
+	sourceObj.SetName(nodeToDelete.identity.Name)
+	sourceObj, err = applyMutators(sourceObj, mutators...)
+	if err != nil {
+		return err
 	}
+	sourceObjKey := client.ObjectKeyFromObject(sourceObj)
 
 	if err := cFrom.Get(ctx, sourceObjKey, sourceObj); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -0,0 +1,286 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+)
+
+const (
+	// moveProgressName is the name of the ConfigMap used to persist the progress of a move operation
+	// in the source management cluster.
+	moveProgressName = "clusterctl-move-progress"
+
+	// moveProgressDataKey is the key of the ConfigMap data holding the move progress.
+	moveProgressDataKey = "progress"
+)
+
+// moveProgressPhase defines the phase of a move operation.
+type moveProgressPhase string
+
+const (
+	// moveProgressPhaseCreating is the phase in which objects are paused in the source management cluster
+	// and created in the target management cluster; a move in this phase can be resumed or rolled back.
+	moveProgressPhaseCreating = moveProgressPhase("CreatingObjects")
+
+	// moveProgressPhaseDeleting is the phase in which objects are deleted from the source management cluster;
+	// a move in this phase can only be resumed.
+	moveProgressPhaseDeleting = moveProgressPhase("DeletingObjects")
+)
+
+// moveProgress is the progress of a move operation, persisted in the source management cluster
+// so an interrupted move can be resumed or rolled back.
+type moveProgress struct {
+	// Namespace is the namespace being moved; empty means all the namespaces.
+	Namespace string `json:"namespace"`
+
+	// Phase is the current phase of the move.
+	Phase moveProgressPhase `json:"phase"`
+
+	// Selective is true if only the recorded Clusters, and the objects they require, are being moved.
+	Selective bool `json:"selective,omitempty"`
+
+	// Clusters are the Clusters being moved.
+	// NOTE: Clusters are recorded because they must be unpaused in the target management cluster even if they
+	// have already been deleted from the source management cluster when the move is resumed.
+	Clusters []corev1.ObjectReference `json:"clusters,omitempty"`
+
+	// ClusterClasses are the ClusterClasses being moved.
+	ClusterClasses []corev1.ObjectReference `json:"clusterClasses,omitempty"`
+}
+
+// addNodes records the Clusters and the ClusterClasses being moved.
+func (p *moveProgress) addNodes(clusters, clusterClasses []*node) {
+	p.Clusters = appendMissingRefs(p.Clusters, clusters)
+	p.ClusterClasses = appendMissingRefs(p.ClusterClasses, clusterClasses)
+}
+
+func appendMissingRefs(refs []corev1.ObjectReference, nodes []*node) []corev1.ObjectReference {
+	for _, n := range nodes {
+		found := false
+		for _, ref := range refs {
+			if ref.Kind == n.identity.Kind && ref.Namespace == n.identity.Namespace && ref.Name == n.identity.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			refs = append(refs, corev1.ObjectReference{
+				APIVersion: n.identity.APIVersion,
+				Kind:       n.identity.Kind,
+				Namespace:  n.identity.Namespace,
+				Name:       n.identity.Name,
+			})
+		}
+	}
+	return refs
+}
+
+// selectClusters returns a function selecting the recorded Clusters if the move is selective, nil otherwise.
+// Nb. Recorded Clusters already deleted from the source cluster can't be selected; it is fine if none is left.
+func (p *moveProgress) selectClusters() func([]*node) ([]*node, error) {
+	if !p.Selective {
+		return nil
+	}
+	return func(clusters []*node) ([]*node, error) {
+		selected := []*node{}
+		for _, cluster := range clusters {
+			for _, ref := range p.Clusters {
+				if ref.Namespace == cluster.identity.Namespace && ref.Name == cluster.identity.Name {
+					selected = append(selected, cluster)
+					break
+				}
+			}
+		}
+		return selected, nil
+	}
+}
+
+// refsToNodes returns the given nodes plus a node for each of the recorded references not included in nodes.
+func refsToNodes(refs []corev1.ObjectReference, nodes []*node) []*node {
+	ret := append([]*node{}, nodes...)
+	for _, ref := range refs {
+		found := false
+		for _, n := range nodes {
+			if ref.Kind == n.identity.Kind && ref.Namespace == n.identity.Namespace && ref.Name == n.identity.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			ret = append(ret, &node{identity: ref})
+		}
+	}
+	return ret
+}
+
+// moveProgressKey returns the key of the ConfigMap persisting the progress of a move of the given namespace.
+func moveProgressKey(namespace string) client.ObjectKey {
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	return client.ObjectKey{Namespace: namespace, Name: moveProgressName}
+}
+
+// getMoveProgress returns the progress of an interrupted move of the given namespace, if any.
+func getMoveProgress(ctx context.Context, proxy Proxy, namespace string) (*moveProgress, error) {
+	c, err := proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key := moveProgressKey(namespace)
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, key, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "error reading move progress %s", key)
+	}
+
+	progress := &moveProgress{}
+	if err := json.Unmarshal([]byte(configMap.Data[moveProgressDataKey]), progress); err != nil {
+		return nil, errors.Wrapf(err, "error parsing move progress %s", key)
+	}
+	if progress.Namespace != namespace {
+		return nil, errors.Errorf("move progress %s belongs to a move of namespace %q", key, progress.Namespace)
+	}
+	return progress, nil
+}
+
+// saveMoveProgress persists the progress of the current move operation in the source management cluster.
+func (o *objectMover) saveMoveProgress(ctx context.Context, phase moveProgressPhase) error {
+	if o.dryRun || o.progress == nil {
+		return nil
+	}
+
+	log := logf.Log
+	log.V(5).Info("Saving move progress", "phase", phase)
+
+	o.progress.Phase = phase
+	data, err := json.Marshal(o.progress)
+	if err != nil {
+		return errors.Wrap(err, "error serializing move progress")
+	}
+
+	key := moveProgressKey(o.progress.Namespace)
+	return retryWithExponentialBackoff(ctx, newWriteBackoff(), func(ctx context.Context) error {
+		c, err := o.fromProxy.NewClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		configMap := &corev1.ConfigMap{}
+		if err := c.Get(ctx, key, configMap); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "error reading move progress %s", key)
+			}
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+					Labels:    map[string]string{clusterctlv1.ClusterctlLabel: ""},
+				},
+				Data: map[string]string{moveProgressDataKey: string(data)},
+			}
+			if err := c.Create(ctx, configMap); err != nil {
+				return errors.Wrapf(err, "error creating move progress %s", key)
+			}
+			return nil
+		}
+
+		configMap.Data = map[string]string{moveProgressDataKey: string(data)}
+		if err := c.Update(ctx, configMap); err != nil {
+			return errors.Wrapf(err, "error updating move progress %s", key)
+		}
+		return nil
+	})
+}
+
+// deleteMoveProgress deletes the progress of the current move operation from the source management cluster.
+func (o *objectMover) deleteMoveProgress(ctx context.Context) error {
+	if o.dryRun || o.progress == nil {
+		return nil
+	}
+
+	key := moveProgressKey(o.progress.Namespace)
+	return retryWithExponentialBackoff(ctx, newWriteBackoff(), func(ctx context.Context) error {
+		c, err := o.fromProxy.NewClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace}}
+		if err := c.Delete(ctx, configMap); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "error deleting move progress %s", key)
+		}
+		return nil
+	})
+}
+
+// describeMoveSequence returns a human readable description of the move sequence, listing the objects
+// of each move group together with their owners.
+func describeMoveSequence(moveSequence *moveSequence) []string {
+	lines := []string{}
+	for i, group := range moveSequence.groups {
+		lines = append(lines, fmt.Sprintf("Group %d:", i+1))
+
+		objects := make([]string, 0, len(group))
+		for _, n := range group {
+			line := nodeRefString(n)
+
+			owners := []string{}
+			for owner := range n.owners {
+				owners = append(owners, nodeRefString(owner))
+			}
+			for owner := range n.softOwners {
+				owners = append(owners, nodeRefString(owner)+" (soft)")
+			}
+			if len(owners) > 0 {
+				sort.Strings(owners)
+				line += ", owned by " + strings.Join(owners, ", ")
+			}
+			if n.isGlobal || n.isGlobalHierarchy {
+				line += ", not deleted from the source cluster"
+			}
+			objects = append(objects, "  "+line)
+		}
+		sort.Strings(objects)
+		lines = append(lines, objects...)
+	}
+	return lines
+}
+
+func nodeRefString(n *node) string {
+	if n.identity.Namespace == "" {
+		return fmt.Sprintf("%s %s", n.identity.Kind, n.identity.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", n.identity.Kind, n.identity.Namespace, n.identity.Name)
+}
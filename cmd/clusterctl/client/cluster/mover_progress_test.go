@@ -0,0 +1,178 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+)
+
+func Test_describeMoveSequence(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+
+	graph := getObjectGraphWithObjs(test.NewFakeCluster("ns1", "foo").Objs())
+	g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+	g.Expect(graph.Discovery(ctx, "")).To(Succeed())
+
+	g.Expect(describeMoveSequence(getMoveSequence(graph))).To(Equal([]string{
+		"Group 1:",
+		"  Cluster ns1/foo",
+		"Group 2:",
+		"  GenericInfrastructureCluster ns1/foo, owned by Cluster ns1/foo",
+		"  Secret ns1/foo-ca, owned by Cluster ns1/foo (soft)",
+		"  Secret ns1/foo-kubeconfig, owned by Cluster ns1/foo",
+	}))
+}
+
+func Test_moveProgress(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+
+	proxy := test.NewFakeProxy()
+	mover := objectMover{
+		fromProxy: proxy,
+		progress:  &moveProgress{Namespace: "ns1"},
+	}
+
+	// No move in progress.
+	progress, err := getMoveProgress(ctx, proxy, "ns1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(progress).To(BeNil())
+
+	// Save the progress of a move.
+	mover.progress.addNodes([]*node{{identity: corev1.ObjectReference{Kind: "Cluster", Namespace: "ns1", Name: "foo"}}}, nil)
+	g.Expect(mover.saveMoveProgress(ctx, moveProgressPhaseCreating)).To(Succeed())
+	g.Expect(mover.saveMoveProgress(ctx, moveProgressPhaseDeleting)).To(Succeed())
+
+	progress, err = getMoveProgress(ctx, proxy, "ns1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(progress).To(Equal(&moveProgress{
+		Namespace: "ns1",
+		Phase:     moveProgressPhaseDeleting,
+		Clusters:  []corev1.ObjectReference{{Kind: "Cluster", Namespace: "ns1", Name: "foo"}},
+	}))
+
+	// The progress of a move of all the namespaces is not confused with the progress of a move of the default namespace.
+	allNamespacesMover := objectMover{
+		fromProxy: proxy,
+		progress:  &moveProgress{},
+	}
+	g.Expect(allNamespacesMover.saveMoveProgress(ctx, moveProgressPhaseCreating)).To(Succeed())
+	_, err = getMoveProgress(ctx, proxy, "default")
+	g.Expect(err).To(HaveOccurred())
+
+	// Delete the progress of the move.
+	g.Expect(mover.deleteMoveProgress(ctx)).To(Succeed())
+	progress, err = getMoveProgress(ctx, proxy, "ns1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(progress).To(BeNil())
+}
+
+func Test_objectMover_move_deletesProgress(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+
+	graph := getObjectGraphWithObjs(test.NewFakeCluster("ns1", "foo").Objs())
+	g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+	g.Expect(graph.Discovery(ctx, "ns1")).To(Succeed())
+
+	toProxy := getFakeProxyWithCRDs()
+
+	mover := objectMover{
+		fromProxy: graph.proxy,
+		progress:  &moveProgress{Namespace: "ns1"},
+	}
+	g.Expect(mover.move(ctx, graph, toProxy)).To(Succeed())
+
+	// The progress is deleted once the move is completed.
+	progress, err := getMoveProgress(ctx, graph.proxy, "ns1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(progress).To(BeNil())
+}
+
+func Test_objectMover_rollback(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+
+	graph := getObjectGraphWithObjs(test.NewFakeCluster("ns1", "foo").Objs())
+	g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+	g.Expect(graph.Discovery(ctx, "ns1")).To(Succeed())
+
+	toProxy := getFakeProxyWithCRDs()
+
+	mover := objectMover{
+		fromProxy: graph.proxy,
+		progress:  &moveProgress{Namespace: "ns1"},
+	}
+
+	// Simulate a move interrupted after creating all the objects in the target cluster.
+	mover.progress.addNodes(graph.getClusters(), graph.getClusterClasses())
+	g.Expect(mover.saveMoveProgress(ctx, moveProgressPhaseCreating)).To(Succeed())
+	g.Expect(setClusterPause(ctx, graph.proxy, graph.getClusters(), true, false)).To(Succeed())
+	moveSequence := getMoveSequence(graph)
+	for i := range moveSequence.groups {
+		g.Expect(mover.createGroup(ctx, moveSequence.getGroup(i), toProxy)).To(Succeed())
+	}
+
+	g.Expect(mover.rollback(ctx, graph, toProxy)).To(Succeed())
+
+	csFrom, err := graph.proxy.NewClient(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	csTo, err := toProxy.NewClient(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	for _, node := range graph.getMoveNodes() {
+		key := client.ObjectKey{Namespace: node.identity.Namespace, Name: node.identity.Name}
+
+		// objects are kept in the source cluster.
+		oFrom := &unstructured.Unstructured{}
+		oFrom.SetAPIVersion(node.identity.APIVersion)
+		oFrom.SetKind(node.identity.Kind)
+		g.Expect(csFrom.Get(ctx, key, oFrom)).To(Succeed())
+
+		// objects are deleted from the target cluster.
+		oTo := &unstructured.Unstructured{}
+		oTo.SetAPIVersion(node.identity.APIVersion)
+		oTo.SetKind(node.identity.Kind)
+		err := csTo.Get(ctx, key, oTo)
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue(), "%s %s not deleted from the target cluster", node.identity.Kind, key)
+	}
+
+	// Clusters are resumed in the source cluster.
+	cluster := &clusterv1.Cluster{}
+	g.Expect(csFrom.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "foo"}, cluster)).To(Succeed())
+	g.Expect(cluster.Spec.Paused).To(BeFalse())
+
+	// The progress is deleted once the rollback is completed.
+	progress, err := getMoveProgress(ctx, graph.proxy, "ns1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(progress).To(BeNil())
+}
@@ -1451,6 +1451,53 @@ func Test_objectMover_move_with_Mutator(t *testing.T) {
 	}
 }
 
+func Test_objectMover_move_selective(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+
+	objs := test.NewFakeClusterClass("ns1", "class1").Objs()
+	objs = append(objs, test.NewFakeCluster("ns1", "foo").WithTopologyClass("class1").Objs()...)
+	objs = append(objs, test.NewFakeCluster("ns1", "bar").WithTopologyClass("class1").Objs()...)
+	objs = deduplicateObjects(objs)
+
+	// Create an objectGraph bound a source cluster with all the CRDs for the types involved in the test.
+	graph := getObjectGraphWithObjs(objs)
+	g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+	g.Expect(graph.Discovery(ctx, "")).To(Succeed())
+
+	// Select only the foo Cluster.
+	clusters, err := ClusterFilter{Names: []string{"foo"}}.selectClusters(graph.getClusters())
+	g.Expect(err).ToNot(HaveOccurred())
+	graph.filterClusters(clusters)
+
+	// gets a fakeProxy to an empty cluster with all the required CRDs
+	toProxy := getFakeProxyWithCRDs()
+
+	mover := objectMover{
+		fromProxy: graph.proxy,
+	}
+	g.Expect(mover.move(ctx, graph, toProxy)).To(Succeed())
+
+	csFrom, err := graph.proxy.NewClient(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	csTo, err := toProxy.NewClient(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// foo is moved, bar is kept in the source cluster.
+	g.Expect(apierrors.IsNotFound(csFrom.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "foo"}, &clusterv1.Cluster{}))).To(BeTrue())
+	g.Expect(csTo.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "foo"}, &clusterv1.Cluster{})).To(Succeed())
+	g.Expect(csFrom.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "bar"}, &clusterv1.Cluster{})).To(Succeed())
+	g.Expect(apierrors.IsNotFound(csTo.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "bar"}, &clusterv1.Cluster{}))).To(BeTrue())
+
+	// The ClusterClass is copied to the target cluster and kept, not paused, in the source cluster.
+	for _, c := range []client.Client{csFrom, csTo} {
+		clusterClass := &clusterv1.ClusterClass{}
+		g.Expect(c.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "class1"}, clusterClass)).To(Succeed())
+		g.Expect(clusterClass.GetAnnotations()).ToNot(HaveKey(clusterv1.PausedAnnotation))
+	}
+}
+
 func Test_objectMover_checkProvisioningCompleted(t *testing.T) {
 	type fields struct {
 		objs []client.Object
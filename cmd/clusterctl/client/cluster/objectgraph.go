@@ -39,6 +39,7 @@ import (
 
 const clusterTopologyNameKey = "cluster.spec.topology.class"
 const clusterResourceSetBindingClusterNameKey = "clusterresourcesetbinding.spec.clustername"
+const clusterLabelsKey = "cluster.metadata.labels"
 
 type empty struct{}
 
@@ -96,6 +97,11 @@ type node struct {
 	// blockingMove is true when the object should prevent a move operation from proceeding as indicated by
 	// the presence of the block-move annotation.
 	blockingMove bool
+
+	// isShared is set to true if this object is required both by the Clusters being moved and by objects which
+	// are not moved, e.g. a ClusterClass used also by Clusters not selected for a move.
+	// When this flag is true the object should not be deleted from the source cluster.
+	isShared bool
 }
 
 type discoveryTypeInfo struct {
@@ -144,12 +150,13 @@ func (n *node) captureAdditionalInformation(obj *unstructured.Unstructured) erro
 		if err := localScheme.Convert(obj, cluster, nil); err != nil {
 			return errors.Wrapf(err, "failed to convert object %s to Cluster", n.identityStr())
 		}
+		if n.additionalInfo == nil {
+			n.additionalInfo = map[string]interface{}{}
+		}
 		if cluster.Spec.Topology != nil {
-			if n.additionalInfo == nil {
-				n.additionalInfo = map[string]interface{}{}
-			}
 			n.additionalInfo[clusterTopologyNameKey] = cluster.Spec.Topology.Class
 		}
+		n.additionalInfo[clusterLabelsKey] = cluster.GetLabels()
 	}
 
 	// If the node is a ClusterResourceSetBinding capture the name of the cluster it is referencing to.
@@ -665,6 +672,136 @@ func (o *objectGraph) setTenant(node, tenant *node, isGlobalHierarchy bool) {
 	}
 }
 
+// filterClusters removes from the graph all the objects which are not required by the given Clusters.
+// Required objects are the objects belonging to the Clusters, their owners, e.g. ClusterClasses and ClusterResourceSets,
+// the objects belonging to those owners but not to other Clusters, e.g. ClusterClass templates, and global objects.
+// Objects which are required also by objects removed from the graph are marked as shared.
+func (o *objectGraph) filterClusters(clusters []*node) {
+	selected := map[*node]empty{}
+	for _, cluster := range clusters {
+		selected[cluster] = empty{}
+	}
+
+	included := map[*node]empty{}
+	var include func(n *node)
+	include = func(n *node) {
+		if _, ok := included[n]; ok {
+			return
+		}
+		included[n] = empty{}
+		for owner := range n.owners {
+			include(owner)
+		}
+		for owner := range n.softOwners {
+			include(owner)
+		}
+	}
+
+	isCluster := func(n *node) bool {
+		return n.identity.GroupVersionKind().GroupKind() == clusterv1.GroupVersion.WithKind("Cluster").GroupKind()
+	}
+
+	// Include the objects belonging to the selected Clusters and the global objects.
+	for _, n := range o.uidToNode {
+		if n.isGlobal || n.isGlobalHierarchy {
+			include(n)
+			continue
+		}
+		for tenant := range n.tenant {
+			if _, ok := selected[tenant]; ok {
+				include(n)
+				break
+			}
+		}
+	}
+
+	// Include the objects belonging to the included tenants which are not Clusters, e.g. ClusterClass templates,
+	// unless they belong to other Clusters too, e.g. ClusterResourceSetBindings for other Clusters.
+	for changed := true; changed; {
+		changed = false
+		for _, n := range o.uidToNode {
+			if _, ok := included[n]; ok {
+				continue
+			}
+			belongsToIncludedTenant := false
+			belongsToOtherClusters := false
+			for tenant := range n.tenant {
+				if isCluster(tenant) {
+					belongsToOtherClusters = true
+					break
+				}
+				if _, ok := included[tenant]; ok {
+					belongsToIncludedTenant = true
+				}
+			}
+			if belongsToIncludedTenant && !belongsToOtherClusters {
+				include(n)
+				changed = true
+			}
+		}
+	}
+
+	// Mark as shared the included objects required by objects which are not included, and the objects they own
+	// unless they belong to the selected Clusters, e.g. the resources of a ClusterResourceSet but not its bindings.
+	belongsToSelectedClusters := func(n *node) bool {
+		for tenant := range n.tenant {
+			if _, ok := selected[tenant]; ok {
+				return true
+			}
+		}
+		return false
+	}
+	for _, n := range o.uidToNode {
+		if _, ok := included[n]; ok {
+			continue
+		}
+		for owner := range n.owners {
+			owner.isShared = true
+		}
+		for owner := range n.softOwners {
+			owner.isShared = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for n := range included {
+			if n.isShared || belongsToSelectedClusters(n) {
+				continue
+			}
+			for owner := range n.owners {
+				if owner.isShared {
+					n.isShared = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	// Remove all the objects which are not included, as well as the references to them.
+	for uid, n := range o.uidToNode {
+		if _, ok := included[n]; !ok {
+			delete(o.uidToNode, uid)
+			continue
+		}
+		for owner := range n.owners {
+			if _, ok := included[owner]; !ok {
+				delete(n.owners, owner)
+			}
+		}
+		for owner := range n.softOwners {
+			if _, ok := included[owner]; !ok {
+				delete(n.softOwners, owner)
+			}
+		}
+		for tenant := range n.tenant {
+			if _, ok := included[tenant]; !ok {
+				delete(n.tenant, tenant)
+			}
+		}
+	}
+}
+
 // checkVirtualNode logs if nodes are still virtual.
 func (o *objectGraph) checkVirtualNode() {
 	log := logf.Log
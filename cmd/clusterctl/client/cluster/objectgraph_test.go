@@ -2527,3 +2527,126 @@ func deduplicateObjects(objs []client.Object) []client.Object {
 	}
 	return res
 }
+
+func Test_objectGraph_filterClusters(t *testing.T) {
+	type fields struct {
+		objs []client.Object
+	}
+	tests := []struct {
+		name         string
+		fields       fields
+		clusters     []string
+		wantMove     []string
+		wantShared   []string
+		wantNotFound []string
+	}{
+		{
+			name: "Selecting a Cluster excludes the objects of other Clusters",
+			fields: fields{
+				objs: func() []client.Object {
+					objs := test.NewFakeCluster("ns1", "foo").Objs()
+					objs = append(objs, test.NewFakeCluster("ns1", "bar").Objs()...)
+					return objs
+				}(),
+			},
+			clusters: []string{"foo"},
+			wantMove: []string{
+				"cluster.x-k8s.io/v1beta1, Kind=Cluster, ns1/foo",
+				"infrastructure.cluster.x-k8s.io/v1beta1, Kind=GenericInfrastructureCluster, ns1/foo",
+				"/v1, Kind=Secret, ns1/foo-ca",
+				"/v1, Kind=Secret, ns1/foo-kubeconfig",
+			},
+			wantShared: []string{},
+		},
+		{
+			name: "Selecting a Cluster includes its ClusterClass, shared with other Clusters",
+			fields: fields{
+				objs: func() []client.Object {
+					objs := test.NewFakeClusterClass("ns1", "class1").Objs()
+					objs = append(objs, test.NewFakeCluster("ns1", "foo").WithTopologyClass("class1").Objs()...)
+					objs = append(objs, test.NewFakeCluster("ns1", "bar").WithTopologyClass("class1").Objs()...)
+					return deduplicateObjects(objs)
+				}(),
+			},
+			clusters: []string{"foo"},
+			wantMove: []string{
+				"cluster.x-k8s.io/v1beta1, Kind=ClusterClass, ns1/class1",
+				"infrastructure.cluster.x-k8s.io/v1beta1, Kind=GenericInfrastructureClusterTemplate, ns1/class1",
+				"controlplane.cluster.x-k8s.io/v1beta1, Kind=GenericControlPlaneTemplate, ns1/class1",
+				"cluster.x-k8s.io/v1beta1, Kind=Cluster, ns1/foo",
+				"infrastructure.cluster.x-k8s.io/v1beta1, Kind=GenericInfrastructureCluster, ns1/foo",
+				"/v1, Kind=Secret, ns1/foo-ca",
+				"/v1, Kind=Secret, ns1/foo-kubeconfig",
+			},
+			wantShared: []string{
+				"cluster.x-k8s.io/v1beta1, Kind=ClusterClass, ns1/class1",
+				"infrastructure.cluster.x-k8s.io/v1beta1, Kind=GenericInfrastructureClusterTemplate, ns1/class1",
+				"controlplane.cluster.x-k8s.io/v1beta1, Kind=GenericControlPlaneTemplate, ns1/class1",
+			},
+		},
+		{
+			name: "Selecting a Cluster includes the ClusterResourceSets applied to it, shared with other Clusters",
+			fields: fields{
+				objs: func() []client.Object {
+					objs := test.NewFakeCluster("ns1", "foo").Objs()
+					objs = append(objs, test.NewFakeCluster("ns1", "bar").Objs()...)
+					objs = append(objs, test.NewFakeClusterResourceSet("ns1", "crs1").
+						WithSecret("resource-s1").
+						WithConfigMap("resource-c1").
+						ApplyToCluster(test.SelectClusterObj(objs, "ns1", "foo")).
+						ApplyToCluster(test.SelectClusterObj(objs, "ns1", "bar")).
+						Objs()...)
+					return objs
+				}(),
+			},
+			clusters: []string{"foo"},
+			wantMove: []string{
+				"cluster.x-k8s.io/v1beta1, Kind=Cluster, ns1/foo",
+				"infrastructure.cluster.x-k8s.io/v1beta1, Kind=GenericInfrastructureCluster, ns1/foo",
+				"/v1, Kind=Secret, ns1/foo-ca",
+				"/v1, Kind=Secret, ns1/foo-kubeconfig",
+				"addons.cluster.x-k8s.io/v1beta1, Kind=ClusterResourceSet, ns1/crs1",
+				"addons.cluster.x-k8s.io/v1beta1, Kind=ClusterResourceSetBinding, ns1/foo",
+				"/v1, Kind=Secret, ns1/resource-s1",
+				"/v1, Kind=ConfigMap, ns1/resource-c1",
+			},
+			wantShared: []string{
+				"addons.cluster.x-k8s.io/v1beta1, Kind=ClusterResourceSet, ns1/crs1",
+				"/v1, Kind=Secret, ns1/resource-s1",
+				"/v1, Kind=ConfigMap, ns1/resource-c1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			graph := getObjectGraphWithObjs(tt.fields.objs)
+			g.Expect(graph.getDiscoveryTypes(ctx)).To(Succeed())
+			g.Expect(graph.Discovery(ctx, "")).To(Succeed())
+
+			clusters, err := ClusterFilter{Names: tt.clusters}.selectClusters(graph.getClusters())
+			g.Expect(err).ToNot(HaveOccurred())
+			graph.filterClusters(clusters)
+
+			gotMove := []string{}
+			gotShared := []string{}
+			for _, n := range graph.getMoveNodes() {
+				gotMove = append(gotMove, string(n.identity.UID))
+				if n.isShared {
+					gotShared = append(gotShared, string(n.identity.UID))
+				}
+				for owner := range n.owners {
+					g.Expect(graph.uidToNode).To(ContainElement(owner))
+				}
+				for owner := range n.softOwners {
+					g.Expect(graph.uidToNode).To(ContainElement(owner))
+				}
+			}
+			g.Expect(gotMove).To(ConsistOf(tt.wantMove))
+			g.Expect(gotShared).To(ConsistOf(tt.wantShared))
+		})
+	}
+}
@@ -41,6 +41,7 @@ import (
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster/internal/dryrun"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/scheme"
 	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
 	"sigs.k8s.io/cluster-api/feature"
 	clusterclasscontroller "sigs.k8s.io/cluster-api/internal/controllers/clusterclass"
 	clustertopologycontroller "sigs.k8s.io/cluster-api/internal/controllers/topology/cluster"
@@ -56,6 +57,7 @@ const (
 // TopologyClient has methods to work with ClusterClass and ManagedTopologies.
 type TopologyClient interface {
 	Plan(ctx context.Context, in *TopologyPlanInput) (*TopologyPlanOutput, error)
+	Diff(ctx context.Context, in *TopologyDiffInput) (*TopologyDiffOutput, error)
 }
 
 // topologyClient implements TopologyClient.
@@ -220,6 +222,94 @@ func (t *topologyClient) Plan(ctx context.Context, in *TopologyPlanInput) (*Topo
 	return res, nil
 }
 
+// TopologyDiffInput defines the input for the Diff function.
+type TopologyDiffInput struct {
+	ClusterName     string
+	TargetNamespace string
+}
+
+// PatchContribution defines the fields of a template changed by a ClusterClass patch.
+type PatchContribution = scope.PatchContribution
+
+// TopologyDiffOutput defines the output of the Diff function.
+type TopologyDiffOutput struct {
+	// Cluster is the cluster on which the topology reconciler loop is executed.
+	Cluster client.ObjectKey
+	// ChangeSummary is the full list of changes (objects created, modified and deleted) the topology
+	// reconciler would apply to the Cluster on the next reconcile.
+	*ChangeSummary
+	// PatchContributions lists which ClusterClass patches changed which fields of the templates
+	// used to compute the desired state of the Cluster.
+	PatchContributions []PatchContribution
+}
+
+// Diff performs a dry run execution of the topology reconciler for a Cluster in the management cluster.
+// It returns a summary of the changes the topology reconciler would apply on the next reconcile, together
+// with the fields contributed by each ClusterClass patch.
+func (t *topologyClient) Diff(ctx context.Context, in *TopologyDiffInput) (*TopologyDiffOutput, error) {
+	if in.ClusterName == "" {
+		return nil, errors.New("cluster name must be set")
+	}
+
+	if err := t.proxy.CheckClusterAvailable(ctx); err != nil {
+		return nil, errors.Wrap(err, "management cluster not available")
+	}
+	initialized, err := t.inventoryClient.CheckCAPIInstalled(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !initialized {
+		return nil, errors.New("management cluster not initialized, Cluster API is not installed")
+	}
+	c, err := t.proxy.NewClient(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a client to the cluster")
+	}
+
+	namespace := in.TargetNamespace
+	if namespace == "" {
+		namespace, err = t.proxy.CurrentNamespace()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get current namespace")
+		}
+	}
+	targetCluster := client.ObjectKey{Namespace: namespace, Name: in.ClusterName}
+
+	cluster := &clusterv1.Cluster{}
+	if err := c.Get(ctx, targetCluster, cluster); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Cluster %s", targetCluster)
+	}
+	if cluster.Spec.Topology == nil {
+		return nil, fmt.Errorf("cluster %s does not use a managed topology", targetCluster)
+	}
+
+	// Use a dry run client without any additional object, so the topology reconciler
+	// computes the changes against the objects as they currently are in the management cluster.
+	dryRunClient := dryrun.NewClient(c, nil)
+	reconciler := &clustertopologycontroller.Reconciler{
+		Client:                    dryRunClient,
+		APIReader:                 dryRunClient,
+		UnstructuredCachingClient: dryRunClient,
+	}
+	reconciler.SetupForDryRun(&noOpRecorder{})
+	request := reconcile.Request{NamespacedName: targetCluster}
+	// Run the topology reconciler.
+	if _, err := reconciler.Reconcile(ctx, request); err != nil {
+		return nil, errors.Wrap(err, "failed to dry run the topology controller")
+	}
+	// Calculate changes observed by dry run client.
+	changes, err := dryRunClient.Changes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get changes made by the topology controller")
+	}
+
+	return &TopologyDiffOutput{
+		Cluster:            targetCluster,
+		ChangeSummary:      changes,
+		PatchContributions: reconciler.PatchContributions(),
+	}, nil
+}
+
 // validateInput checks that the topology plan input does not violate any of the below expectations:
 // - no more than 1 cluster in the input.
 // - no more than 1 clusterclass in the input.
@@ -396,6 +396,94 @@ func Test_topologyClient_Plan(t *testing.T) {
 	}
 }
 
+func Test_topologyClient_Diff(t *testing.T) {
+	type item struct {
+		kind       string
+		namespace  string
+		namePrefix string
+	}
+	tests := []struct {
+		name            string
+		existingObjects []*unstructured.Unstructured
+		in              *TopologyDiffInput
+		wantModified    []item
+		wantErr         bool
+	}{
+		{
+			name:    "Input without a Cluster name should return error",
+			in:      &TopologyDiffInput{TargetNamespace: "default"},
+			wantErr: true,
+		},
+		{
+			name: "Input with a Cluster which does not exist should return error",
+			existingObjects: mustToUnstructured(
+				mockCRDsYAML,
+				existingMyClusterClassYAML,
+			),
+			in:      &TopologyDiffInput{ClusterName: "my-cluster", TargetNamespace: "default"},
+			wantErr: true,
+		},
+		{
+			name: "Cluster with pending changes",
+			existingObjects: func() []*unstructured.Unstructured {
+				objs := mustToUnstructured(
+					mockCRDsYAML,
+					existingMyClusterClassYAML,
+					existingMyClusterYAML,
+				)
+				// Change the control plane replicas from 1 to 3 without reconciling the topology.
+				for _, o := range objs {
+					if o.GetKind() == "Cluster" {
+						if err := unstructured.SetNestedField(o.Object, int64(3), "spec", "topology", "controlPlane", "replicas"); err != nil {
+							panic(err)
+						}
+					}
+				}
+				return objs
+			}(),
+			in: &TopologyDiffInput{ClusterName: "my-cluster", TargetNamespace: "default"},
+			wantModified: []item{
+				{kind: "KubeadmControlPlane", namespace: "default", namePrefix: "my-cluster-"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			existingObjects := []client.Object{}
+			for _, o := range tt.existingObjects {
+				existingObjects = append(existingObjects, o)
+			}
+			proxy := test.NewFakeProxy().WithClusterAvailable(true).WithFakeCAPISetup().WithObjs(existingObjects...)
+			inventoryClient := newInventoryClient(proxy, nil)
+			tc := newTopologyClient(
+				proxy,
+				inventoryClient,
+			)
+
+			res, err := tc.Diff(ctx, tt.in)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			g.Expect(res.Cluster).To(Equal(client.ObjectKey{Namespace: tt.in.TargetNamespace, Name: tt.in.ClusterName}))
+
+			actualModifiedObjs := []*unstructured.Unstructured{}
+			for _, m := range res.Modified {
+				actualModifiedObjs = append(actualModifiedObjs, m.After)
+			}
+			for _, modified := range tt.wantModified {
+				g.Expect(actualModifiedObjs).To(ContainElement(MatchTopologyPlanOutputItem(modified.kind, modified.namespace, modified.namePrefix)))
+			}
+		})
+	}
+}
+
 func MatchTopologyPlanOutputItem(kind, namespace, namePrefix string) types.GomegaMatcher {
 	return &topologyPlanOutputItemMatcher{kind, namespace, namePrefix}
 }
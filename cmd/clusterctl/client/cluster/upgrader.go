@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -46,12 +47,19 @@ type ProviderUpgrader interface {
 
 	// ApplyCustomPlan plan executes an upgrade using the UpgradeItems provided by the user.
 	ApplyCustomPlan(ctx context.Context, opts UpgradeOptions, providersToUpgrade ...UpgradeItem) error
+
+	// CheckCompatibility checks if an upgrade plan is compatible with the runtime extensions registered in the management cluster
+	// and with the objects stored in the management cluster.
+	CheckCompatibility(ctx context.Context, upgradePlan UpgradePlan) ([]CompatibilityIssue, error)
 }
 
 // UpgradePlan defines a list of possible upgrade targets for a management cluster.
 type UpgradePlan struct {
 	Contract  string
 	Providers []UpgradeItem
+
+	// CompatibilityIssues are the issues detected when checking if the upgrade plan is compatible with the management cluster.
+	CompatibilityIssues []CompatibilityIssue
 }
 
 // UpgradeOptions defines the options used to upgrade installation.
@@ -356,6 +364,25 @@ func (u *providerUpgrader) doUpgrade(ctx context.Context, upgradePlan *UpgradePl
 		}
 	}
 
+	// Check the upgrade does not break runtime extensions or objects stored in the management cluster.
+	// Note: This must be done before migrating CRs and replacing the providers, so nothing is changed if the upgrade can't be applied.
+	issues, err := u.CheckCompatibility(ctx, *upgradePlan)
+	if err != nil {
+		return err
+	}
+	log := logf.Log
+	messages := []string{}
+	for _, issue := range issues {
+		if !issue.Blocking {
+			log.Info(issue.String())
+			continue
+		}
+		messages = append(messages, issue.Message)
+	}
+	if len(messages) > 0 {
+		return errors.Errorf("unable to complete that upgrade: %s", strings.Join(messages, "; "))
+	}
+
 	// Ensure Providers are updated in the following order: Core, Bootstrap, ControlPlane, Infrastructure.
 	providers := upgradePlan.Providers
 	sort.Slice(providers, func(a, b int) bool {
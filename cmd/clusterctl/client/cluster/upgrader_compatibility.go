@@ -0,0 +1,211 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/scheme"
+	runtimev1 "sigs.k8s.io/cluster-api/exp/runtime/api/v1alpha1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// CompatibilityIssue is an issue detected when checking if an upgrade plan is compatible with the management cluster.
+type CompatibilityIssue struct {
+	// Blocking is true if the upgrade can't be applied, false if the issue is a warning.
+	Blocking bool
+
+	// Message describes the issue.
+	Message string
+}
+
+// String returns a human readable description of the issue.
+func (i CompatibilityIssue) String() string {
+	if i.Blocking {
+		return "Error: " + i.Message
+	}
+	return "Warning: " + i.Message
+}
+
+// runtimeHooksCatalog contains the runtime hooks supported by this version of Cluster API.
+var runtimeHooksCatalog = runtimecatalog.New()
+
+func init() {
+	_ = runtimehooksv1.AddToCatalog(runtimeHooksCatalog)
+}
+
+// CheckCompatibility checks if an upgrade plan is compatible with the management cluster, i.e. that
+// the runtime extensions registered in the management cluster are supported by the target version of the core provider,
+// and that the objects stored in the management cluster can be migrated to the CRDs of the target provider versions.
+func (u *providerUpgrader) CheckCompatibility(ctx context.Context, upgradePlan UpgradePlan) ([]CompatibilityIssue, error) {
+	c, err := u.proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := []CompatibilityIssue{}
+	for _, upgradeItem := range upgradePlan.Providers {
+		// If there is not a specified next version, skip it (we are already up-to-date).
+		if upgradeItem.NextVersion == "" {
+			continue
+		}
+
+		if upgradeItem.GetProviderType() == clusterctlv1.CoreProviderType {
+			extensionIssues, err := checkExtensionConfigs(ctx, c, upgradeItem)
+			if err != nil {
+				return nil, err
+			}
+			issues = append(issues, extensionIssues...)
+		}
+
+		components, err := u.getUpgradeComponents(ctx, upgradeItem)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range components.Objs() {
+			if obj.GetKind() != "CustomResourceDefinition" {
+				continue
+			}
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			if err := scheme.Scheme.Convert(&obj, crd, nil); err != nil {
+				return nil, errors.Wrapf(err, "failed to convert CRD %q", obj.GetName())
+			}
+			crdIssues, err := checkCRDStoredVersions(ctx, c, upgradeItem, crd)
+			if err != nil {
+				return nil, err
+			}
+			issues = append(issues, crdIssues...)
+		}
+	}
+	return issues, nil
+}
+
+// checkExtensionConfigs checks that all the handlers of the runtime extensions registered in the management cluster
+// use runtime hooks supported by the target version of the core provider.
+// NOTE: The runtime hooks supported by the target version of the core provider are assumed to be the
+// ones known by this version of clusterctl.
+func checkExtensionConfigs(ctx context.Context, c client.Client, upgradeItem UpgradeItem) ([]CompatibilityIssue, error) {
+	extensionConfigs := &runtimev1.ExtensionConfigList{}
+	if err := retryWithExponentialBackoff(ctx, newReadBackoff(), func(ctx context.Context) error {
+		if err := c.List(ctx, extensionConfigs); err != nil {
+			// Management clusters not using runtime extensions might not have the ExtensionConfig CRD.
+			if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to list ExtensionConfigs")
+	}
+
+	issues := []CompatibilityIssue{}
+	for _, extensionConfig := range extensionConfigs.Items {
+		if !conditions.IsTrue(&extensionConfig, runtimev1.RuntimeExtensionDiscoveredCondition) {
+			issues = append(issues, CompatibilityIssue{
+				Message: fmt.Sprintf("ExtensionConfig %s is not discovered, unable to check if its handlers are supported by %s %s",
+					extensionConfig.Name, upgradeItem.InstanceName(), upgradeItem.NextVersion),
+			})
+			continue
+		}
+
+		for _, handler := range extensionConfig.Status.Handlers {
+			gv, err := schema.ParseGroupVersion(handler.RequestHook.APIVersion)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse the API version of handler %s of ExtensionConfig %s", handler.Name, extensionConfig.Name)
+			}
+			gvh := runtimecatalog.GroupVersionHook{Group: gv.Group, Version: gv.Version, Hook: handler.RequestHook.Hook}
+			if !runtimeHooksCatalog.IsHookRegistered(gvh) {
+				issues = append(issues, CompatibilityIssue{
+					Blocking: true,
+					Message: fmt.Sprintf("handler %s of ExtensionConfig %s implements the %s hook, which is not supported by %s %s",
+						handler.Name, extensionConfig.Name, gvh, upgradeItem.InstanceName(), upgradeItem.NextVersion),
+				})
+			}
+		}
+	}
+	return issues, nil
+}
+
+// checkCRDStoredVersions checks that the objects stored in the management cluster for a CRD can be migrated to the new CRD.
+func checkCRDStoredVersions(ctx context.Context, c client.Client, upgradeItem UpgradeItem, newCRD *apiextensionsv1.CustomResourceDefinition) ([]CompatibilityIssue, error) {
+	currentCRD := &apiextensionsv1.CustomResourceDefinition{}
+	exists := true
+	if err := retryWithExponentialBackoff(ctx, newReadBackoff(), func(ctx context.Context) error {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(newCRD), currentCRD); err != nil {
+			if apierrors.IsNotFound(err) {
+				exists = false
+				return nil
+			}
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to get CRD %q", newCRD.Name)
+	}
+
+	// Nothing to check if the CRD doesn't exist yet.
+	if !exists {
+		return nil, nil
+	}
+
+	newVersions := sets.Set[string]{}
+	servedVersions := sets.Set[string]{}
+	for _, version := range newCRD.Spec.Versions {
+		newVersions.Insert(version.Name)
+		if version.Served {
+			servedVersions.Insert(version.Name)
+		}
+	}
+
+	currentStorageVersion, err := storageVersionForCRD(currentCRD)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the current storage version has been dropped in the new CRD, objects can't be migrated.
+	if !newVersions.Has(currentStorageVersion) {
+		return []CompatibilityIssue{{
+			Blocking: true,
+			Message: fmt.Sprintf("CRD %s of %s %s does not contain the current storage version %s, thus not allowing migration of the stored objects",
+				newCRD.Name, upgradeItem.InstanceName(), upgradeItem.NextVersion, currentStorageVersion),
+		}}, nil
+	}
+
+	// If the new CRD drops stored versions, objects are migrated during upgrade; this can take a while.
+	unservedStoredVersions := sets.New[string](currentCRD.Status.StoredVersions...).Difference(servedVersions)
+	if unservedStoredVersions.Len() > 0 {
+		return []CompatibilityIssue{{
+			Message: fmt.Sprintf("CRD %s of %s %s does not serve the stored versions %s, stored objects will be migrated to %s during upgrade",
+				newCRD.Name, upgradeItem.InstanceName(), upgradeItem.NextVersion, strings.Join(sets.List(unservedStoredVersions), ","), currentStorageVersion),
+		}}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	runtimev1 "sigs.k8s.io/cluster-api/exp/runtime/api/v1alpha1"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+)
+
+func Test_checkExtensionConfigs(t *testing.T) {
+	upgradeItem := UpgradeItem{
+		Provider:    fakeProvider("cluster-api", clusterctlv1.CoreProviderType, "v1.0.0", "cluster-api-system"),
+		NextVersion: "v1.1.0",
+	}
+
+	extensionConfig := func(name string, discovered bool, hooks ...runtimev1.GroupVersionHook) *runtimev1.ExtensionConfig {
+		ext := &runtimev1.ExtensionConfig{
+			TypeMeta:   metav1.TypeMeta{APIVersion: runtimev1.GroupVersion.String(), Kind: "ExtensionConfig"},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		}
+		status := corev1.ConditionFalse
+		if discovered {
+			status = corev1.ConditionTrue
+		}
+		ext.Status.Conditions = clusterv1.Conditions{{Type: runtimev1.RuntimeExtensionDiscoveredCondition, Status: status}}
+		for _, hook := range hooks {
+			ext.Status.Handlers = append(ext.Status.Handlers, runtimev1.ExtensionHandler{Name: hook.Hook + "." + name, RequestHook: hook})
+		}
+		return ext
+	}
+
+	tests := []struct {
+		name         string
+		objs         []client.Object
+		wantBlocking []bool
+	}{
+		{
+			name:         "No ExtensionConfigs",
+			wantBlocking: []bool{},
+		},
+		{
+			name: "Handlers for supported hooks",
+			objs: []client.Object{
+				extensionConfig("foo", true,
+					runtimev1.GroupVersionHook{APIVersion: runtimehooksv1.GroupVersion.String(), Hook: "BeforeClusterUpgrade"},
+					runtimev1.GroupVersionHook{APIVersion: runtimehooksv1.GroupVersion.String(), Hook: "GeneratePatches"},
+				),
+			},
+			wantBlocking: []bool{},
+		},
+		{
+			name: "Handler for an unsupported hook version",
+			objs: []client.Object{
+				extensionConfig("foo", true,
+					runtimev1.GroupVersionHook{APIVersion: runtimehooksv1.GroupVersion.Group + "/v1alpha0", Hook: "BeforeClusterUpgrade"},
+				),
+			},
+			wantBlocking: []bool{true},
+		},
+		{
+			name: "Handler for an unsupported hook",
+			objs: []client.Object{
+				extensionConfig("foo", true,
+					runtimev1.GroupVersionHook{APIVersion: runtimehooksv1.GroupVersion.String(), Hook: "DoesNotExist"},
+				),
+			},
+			wantBlocking: []bool{true},
+		},
+		{
+			name: "ExtensionConfig not discovered",
+			objs: []client.Object{
+				extensionConfig("foo", false),
+			},
+			wantBlocking: []bool{false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			c, err := test.NewFakeProxy().WithObjs(tt.objs...).NewClient(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			issues, err := checkExtensionConfigs(ctx, c, upgradeItem)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			gotBlocking := []bool{}
+			for _, issue := range issues {
+				gotBlocking = append(gotBlocking, issue.Blocking)
+			}
+			g.Expect(gotBlocking).To(Equal(tt.wantBlocking))
+		})
+	}
+}
+
+func Test_checkCRDStoredVersions(t *testing.T) {
+	upgradeItem := UpgradeItem{
+		Provider:    fakeProvider("infra", clusterctlv1.InfrastructureProviderType, "v2.0.0", "infra-system"),
+		NextVersion: "v2.1.0",
+	}
+
+	currentCRD := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true},
+				{Name: "v1beta1", Storage: true, Served: true},
+			},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: []string{"v1alpha1", "v1beta1"}},
+	}
+
+	tests := []struct {
+		name         string
+		currentCRD   *apiextensionsv1.CustomResourceDefinition
+		newCRD       *apiextensionsv1.CustomResourceDefinition
+		wantBlocking []bool
+	}{
+		{
+			name:       "No issues if current CRD does not exist",
+			currentCRD: &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "something else"}},
+			newCRD: &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{Name: "v1beta2", Storage: true, Served: true},
+					},
+				},
+			},
+			wantBlocking: []bool{},
+		},
+		{
+			name:       "No issues if new CRD serves all the stored versions",
+			currentCRD: currentCRD,
+			newCRD: &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{Name: "v1alpha1", Served: true},
+						{Name: "v1beta1", Served: true},
+						{Name: "v1beta2", Storage: true, Served: true},
+					},
+				},
+			},
+			wantBlocking: []bool{},
+		},
+		{
+			name:       "Warning if new CRD does not serve a stored version",
+			currentCRD: currentCRD,
+			newCRD: &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{Name: "v1beta1", Storage: true, Served: true},
+					},
+				},
+			},
+			wantBlocking: []bool{false},
+		},
+		{
+			name:       "Error if new CRD drops the current storage version",
+			currentCRD: currentCRD,
+			newCRD: &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+						{Name: "v1beta2", Storage: true, Served: true},
+					},
+				},
+			},
+			wantBlocking: []bool{true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ctx := context.Background()
+
+			c, err := test.NewFakeProxy().WithObjs(tt.currentCRD).NewClient(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			issues, err := checkCRDStoredVersions(ctx, c, upgradeItem, tt.newCRD)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			gotBlocking := []bool{}
+			for _, issue := range issues {
+				gotBlocking = append(gotBlocking, issue.Blocking)
+			}
+			g.Expect(gotBlocking).To(Equal(tt.wantBlocking))
+		})
+	}
+}
@@ -18,8 +18,11 @@ package cluster
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	utilkubeconfig "sigs.k8s.io/cluster-api/util/kubeconfig"
@@ -29,6 +32,11 @@ import (
 type WorkloadCluster interface {
 	// GetKubeconfig returns the kubeconfig of the workload cluster.
 	GetKubeconfig(ctx context.Context, workloadClusterName string, namespace string) (string, error)
+
+	// GetKubeconfigWithAuth returns a kubeconfig of the workload cluster where users authenticate with the given
+	// AuthInfo, e.g. an exec plugin or an auth provider, instead of the admin client certificate.
+	// The server and the certificate authority are the ones of the kubeconfig generated by Cluster API.
+	GetKubeconfigWithAuth(ctx context.Context, workloadClusterName string, namespace string, authInfo *clientcmdapi.AuthInfo) (string, error)
 }
 
 // workloadCluster implements WorkloadCluster.
@@ -59,3 +67,52 @@ func (p *workloadCluster) GetKubeconfig(ctx context.Context, workloadClusterName
 	}
 	return string(dataBytes), nil
 }
+
+func (p *workloadCluster) GetKubeconfigWithAuth(ctx context.Context, workloadClusterName string, namespace string, authInfo *clientcmdapi.AuthInfo) (string, error) {
+	data, err := p.GetKubeconfig(ctx, workloadClusterName, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	adminConfig, err := clientcmd.Load([]byte(data))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse the kubeconfig of cluster %s/%s", namespace, workloadClusterName)
+	}
+	adminContext, ok := adminConfig.Contexts[adminConfig.CurrentContext]
+	if !ok {
+		return "", errors.Errorf("failed to parse the kubeconfig of cluster %s/%s: current context %q not found", namespace, workloadClusterName, adminConfig.CurrentContext)
+	}
+	cluster, ok := adminConfig.Clusters[adminContext.Cluster]
+	if !ok {
+		return "", errors.Errorf("failed to parse the kubeconfig of cluster %s/%s: cluster %q not found", namespace, workloadClusterName, adminContext.Cluster)
+	}
+
+	// Build a new kubeconfig, so the admin client certificate is never part of the output.
+	userName := fmt.Sprintf("%s-user", workloadClusterName)
+	contextName := fmt.Sprintf("%s@%s", userName, workloadClusterName)
+	config := &clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			workloadClusterName: {
+				Server:                   cluster.Server,
+				CertificateAuthorityData: cluster.CertificateAuthorityData,
+				TLSServerName:            cluster.TLSServerName,
+			},
+		},
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			userName: authInfo,
+		},
+		Contexts: map[string]*clientcmdapi.Context{
+			contextName: {
+				Cluster:  workloadClusterName,
+				AuthInfo: userName,
+			},
+		},
+		CurrentContext: contextName,
+	}
+
+	out, err := clientcmd.Write(*config)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to serialize the kubeconfig of cluster %s/%s", namespace, workloadClusterName)
+	}
+	return string(out), nil
+}
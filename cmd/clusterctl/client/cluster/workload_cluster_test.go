@@ -23,6 +23,8 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
@@ -100,3 +102,64 @@ users:
 		})
 	}
 }
+
+func Test_WorkloadCluster_GetKubeconfigWithAuth(t *testing.T) {
+	g := NewWithT(t)
+
+	validKubeConfig := `
+clusters:
+- cluster:
+    certificate-authority-data: c3R1ZmY=
+    server: https://test-cluster-api:6443
+  name: test1
+contexts:
+- context:
+    cluster: test1
+    user: test1-admin
+  name: test1-admin@test1
+current-context: test1-admin@test1
+kind: Config
+preferences: {}
+users:
+- name: test1-admin
+  user:
+    client-certificate-data: c3R1ZmYtY2VydC1kYXRh
+    client-key-data: c3R1ZmYta2V5LWRhdGE=
+`
+	validSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test1-kubeconfig",
+			Namespace: "test",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: "test1"},
+		},
+		Data: map[string][]byte{
+			secret.KubeconfigDataName: []byte(validKubeConfig),
+		},
+	}
+
+	authInfo := &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			Command:         "kubectl",
+			Args:            []string{"oidc-login", "get-token"},
+			APIVersion:      "client.authentication.k8s.io/v1",
+			InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+		},
+	}
+
+	wc := newWorkloadCluster(test.NewFakeProxy().WithObjs(validSecret))
+	data, err := wc.GetKubeconfigWithAuth(context.Background(), "test1", "test", authInfo)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	config, err := clientcmd.Load([]byte(data))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(config.CurrentContext).To(Equal("test1-user@test1"))
+	g.Expect(config.Clusters).To(HaveKey("test1"))
+	g.Expect(config.Clusters["test1"].Server).To(Equal("https://test-cluster-api:6443"))
+	g.Expect(config.Clusters["test1"].CertificateAuthorityData).To(Equal([]byte("stuff")))
+	g.Expect(config.AuthInfos).To(HaveLen(1))
+	g.Expect(config.AuthInfos).To(HaveKey("test1-user"))
+	g.Expect(config.AuthInfos["test1-user"].ClientCertificateData).To(BeEmpty())
+	g.Expect(config.AuthInfos["test1-user"].ClientKeyData).To(BeEmpty())
+	g.Expect(config.AuthInfos["test1-user"].Exec.Command).To(Equal("kubectl"))
+	g.Expect(config.AuthInfos["test1-user"].Exec.Args).To(Equal([]string{"oidc-login", "get-token"}))
+}
@@ -19,6 +19,16 @@ package config
 const (
 	// GitHubTokenVariable defines a variable hosting the GitHub access token.
 	GitHubTokenVariable = "github-token"
+
+	// OCIUsernameVariable defines a variable hosting the username used to authenticate to OCI registries.
+	OCIUsernameVariable = "OCI_USERNAME"
+
+	// OCIPasswordVariable defines a variable hosting the password or token used to authenticate to OCI registries.
+	OCIPasswordVariable = "OCI_PASSWORD"
+
+	// OCIPublicKeyVariable defines a variable hosting the path of the public key used to verify the cosign
+	// signatures of provider artifacts pulled from OCI registries.
+	OCIPublicKeyVariable = "OCI_PUBLIC_KEY"
 )
 
 // VariablesClient has methods to work with environment variables and with variables defined in the clusterctl configuration file.
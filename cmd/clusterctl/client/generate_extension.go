@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/scaffold"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+	clusterctlversion "sigs.k8s.io/cluster-api/version"
+)
+
+// releasePreReleaseRegex matches the pre-release part of the versions of Cluster API releases, e.g. rc.0.
+var releasePreReleaseRegex = regexp.MustCompile(`^(alpha|beta|rc)\.\d+$`)
+
+// GenerateExtensionOptions carries the options supported by GenerateExtension.
+type GenerateExtensionOptions struct {
+	// Name of the Runtime Extension.
+	Name string
+
+	// Module is the Go module of the Runtime Extension project; it defaults to example.com/<name>.
+	Module string
+
+	// Namespace where the Runtime Extension is deployed; it defaults to <name>-system.
+	Namespace string
+
+	// Image is the container image of the Runtime Extension; it defaults to <name>:dev.
+	Image string
+
+	// Hooks is the list of the Runtime Hooks implemented by the Runtime Extension, e.g. BeforeClusterCreate.
+	Hooks []string
+
+	// OutputDir is the directory where the project is generated; it defaults to ./<name>.
+	// Existing files are never overwritten.
+	OutputDir string
+}
+
+func (c *clusterctlClient) GenerateExtension(_ context.Context, options GenerateExtensionOptions) ([]string, error) {
+	log := logf.Log
+
+	if options.Module == "" {
+		options.Module = fmt.Sprintf("example.com/%s", options.Name)
+	}
+	if options.Namespace == "" {
+		options.Namespace = fmt.Sprintf("%s-system", options.Name)
+	}
+	if options.Image == "" {
+		options.Image = fmt.Sprintf("%s:dev", options.Name)
+	}
+	if options.OutputDir == "" {
+		options.OutputDir = options.Name
+	}
+
+	files, err := scaffold.GenerateExtension(scaffold.ExtensionOptions{
+		Name:              options.Name,
+		Module:            options.Module,
+		Namespace:         options.Namespace,
+		Image:             options.Image,
+		Hooks:             options.Hooks,
+		ClusterAPIVersion: clusterAPIModuleVersion(clusterctlversion.Get().GitVersion),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		path := filepath.Join(options.OutputDir, filepath.FromSlash(p))
+		if _, err := os.Stat(path); err == nil {
+			return nil, errors.Errorf("failed to generate the Runtime Extension: file %s already exists", path)
+		} else if !os.IsNotExist(err) {
+			return nil, errors.Wrapf(err, "failed to check if file %s exists", path)
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	log.Info("Generating Runtime Extension", "name", options.Name, "module", options.Module, "outputDir", options.OutputDir)
+	for p, content := range files {
+		path := filepath.Join(options.OutputDir, filepath.FromSlash(p))
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return nil, errors.Wrapf(err, "failed to create directory %s", filepath.Dir(path))
+		}
+		if err := os.WriteFile(path, content, 0600); err != nil {
+			return nil, errors.Wrapf(err, "failed to write file %s", path)
+		}
+	}
+	return paths, nil
+}
+
+// clusterAPIModuleVersion returns the version of the sigs.k8s.io/cluster-api module to be required by a
+// scaffolded project, or an empty string if clusterctl is not built from a Cluster API release (e.g. a dev build).
+func clusterAPIModuleVersion(gitVersion string) string {
+	v, err := version.ParseSemantic(gitVersion)
+	if err != nil || v.Major() == 0 || v.BuildMetadata() != "" {
+		return ""
+	}
+	if v.PreRelease() != "" && !releasePreReleaseRegex.MatchString(v.PreRelease()) {
+		return ""
+	}
+	return gitVersion
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_clusterctlClient_GenerateExtension(t *testing.T) {
+	t.Run("generates the Runtime Extension project", func(t *testing.T) {
+		g := NewWithT(t)
+
+		outputDir := filepath.Join(t.TempDir(), "my-extension")
+		c := &clusterctlClient{}
+		paths, err := c.GenerateExtension(context.Background(), GenerateExtensionOptions{
+			Name:      "my-extension",
+			Hooks:     []string{"BeforeClusterCreate"},
+			OutputDir: outputDir,
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(paths).To(ContainElements(
+			filepath.Join(outputDir, "main.go"),
+			filepath.Join(outputDir, "go.mod"),
+			filepath.Join(outputDir, "handlers", "lifecycle", "handlers.go"),
+			filepath.Join(outputDir, "config", "extensionconfig.yaml"),
+		))
+		g.Expect(paths).ToNot(ContainElement(filepath.Join(outputDir, "handlers", "topologymutation", "handlers.go")))
+
+		goMod, err := os.ReadFile(filepath.Join(outputDir, "go.mod")) //nolint:gosec
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(goMod)).To(ContainSubstring("module example.com/my-extension"))
+
+		extensionConfig, err := os.ReadFile(filepath.Join(outputDir, "config", "extensionconfig.yaml")) //nolint:gosec
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(string(extensionConfig)).To(ContainSubstring("namespace: my-extension-system"))
+	})
+	t.Run("fails without overwriting existing files", func(t *testing.T) {
+		g := NewWithT(t)
+
+		outputDir := t.TempDir()
+		g.Expect(os.WriteFile(filepath.Join(outputDir, "main.go"), []byte("package main"), 0600)).To(Succeed())
+
+		c := &clusterctlClient{}
+		_, err := c.GenerateExtension(context.Background(), GenerateExtensionOptions{
+			Name:      "my-extension",
+			Hooks:     []string{"GeneratePatches"},
+			OutputDir: outputDir,
+		})
+		g.Expect(err).To(HaveOccurred())
+
+		_, err = os.Stat(filepath.Join(outputDir, "go.mod"))
+		g.Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+	t.Run("fails for unknown hooks", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := &clusterctlClient{}
+		_, err := c.GenerateExtension(context.Background(), GenerateExtensionOptions{
+			Name:      "my-extension",
+			Hooks:     []string{"NotAHook"},
+			OutputDir: t.TempDir(),
+		})
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func Test_clusterAPIModuleVersion(t *testing.T) {
+	tests := []struct {
+		gitVersion string
+		want       string
+	}{
+		{gitVersion: "v1.7.0", want: "v1.7.0"},
+		{gitVersion: "v1.7.0-rc.1", want: "v1.7.0-rc.1"},
+		{gitVersion: "v1.7.0-rc.1-10-gabcdef0", want: ""},
+		{gitVersion: "v1.7.0-dirty", want: ""},
+		{gitVersion: "v0.0.0-master+$Format:%H$", want: ""},
+		{gitVersion: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.gitVersion, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(clusterAPIModuleVersion(tt.gitVersion)).To(Equal(tt.want))
+		})
+	}
+}
@@ -18,8 +18,16 @@ package client
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// defaultExecAPIVersion is the default API version of the ExecCredential used by exec plugins.
+	defaultExecAPIVersion = "client.authentication.k8s.io/v1"
 )
 
 // GetKubeconfigOptions carries all the options supported by GetKubeconfig.
@@ -33,9 +41,52 @@ type GetKubeconfigOptions struct {
 
 	// WorkloadClusterName is the name of the workload cluster.
 	WorkloadClusterName string
+
+	// Exec, if set, returns a kubeconfig where users authenticate using an exec credential plugin
+	// instead of the admin client certificate. It can't be used with OIDC.
+	Exec *KubeconfigExecOptions
+
+	// OIDC, if set, returns a kubeconfig where users authenticate using the oidc auth provider
+	// instead of the admin client certificate. It can't be used with Exec.
+	OIDC *KubeconfigOIDCOptions
+}
+
+// KubeconfigExecOptions defines the exec credential plugin to be used in a kubeconfig.
+type KubeconfigExecOptions struct {
+	// Command to execute.
+	Command string
+
+	// Args are the arguments passed to the command.
+	Args []string
+
+	// Env defines additional environment variables to expose to the command.
+	Env map[string]string
+
+	// APIVersion is the preferred input version of the ExecCredential; it defaults to client.authentication.k8s.io/v1.
+	APIVersion string
+}
+
+// KubeconfigOIDCOptions defines the OIDC provider to be used in a kubeconfig.
+type KubeconfigOIDCOptions struct {
+	// IssuerURL is the URL of the OIDC issuer.
+	IssuerURL string
+
+	// ClientID is the OIDC client ID.
+	ClientID string
+
+	// ClientSecret is the OIDC client secret; it is optional for public clients.
+	ClientSecret string
+
+	// ExtraScopes are additional scopes to request.
+	ExtraScopes []string
 }
 
 func (c *clusterctlClient) GetKubeconfig(ctx context.Context, options GetKubeconfigOptions) (string, error) {
+	authInfo, err := kubeconfigAuthInfo(options)
+	if err != nil {
+		return "", err
+	}
+
 	// gets access to the management cluster
 	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
 	if err != nil {
@@ -58,5 +109,53 @@ func (c *clusterctlClient) GetKubeconfig(ctx context.Context, options GetKubecon
 		options.Namespace = currentNamespace
 	}
 
+	if authInfo != nil {
+		return clusterClient.WorkloadCluster().GetKubeconfigWithAuth(ctx, options.WorkloadClusterName, options.Namespace, authInfo)
+	}
 	return clusterClient.WorkloadCluster().GetKubeconfig(ctx, options.WorkloadClusterName, options.Namespace)
 }
+
+// kubeconfigAuthInfo returns the AuthInfo for the exec plugin or the OIDC provider defined in options, if any.
+func kubeconfigAuthInfo(options GetKubeconfigOptions) (*clientcmdapi.AuthInfo, error) {
+	switch {
+	case options.Exec != nil && options.OIDC != nil:
+		return nil, errors.New("can't set both Exec and OIDC")
+	case options.Exec != nil:
+		if options.Exec.Command == "" {
+			return nil, errors.New("Exec.Command must be set")
+		}
+		exec := &clientcmdapi.ExecConfig{
+			Command:         options.Exec.Command,
+			Args:            options.Exec.Args,
+			APIVersion:      options.Exec.APIVersion,
+			InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+		}
+		if exec.APIVersion == "" {
+			exec.APIVersion = defaultExecAPIVersion
+		}
+		for name, value := range options.Exec.Env {
+			exec.Env = append(exec.Env, clientcmdapi.ExecEnvVar{Name: name, Value: value})
+		}
+		sort.Slice(exec.Env, func(i, j int) bool {
+			return exec.Env[i].Name < exec.Env[j].Name
+		})
+		return &clientcmdapi.AuthInfo{Exec: exec}, nil
+	case options.OIDC != nil:
+		if options.OIDC.IssuerURL == "" || options.OIDC.ClientID == "" {
+			return nil, errors.New("OIDC.IssuerURL and OIDC.ClientID must be set")
+		}
+		config := map[string]string{
+			"idp-issuer-url": options.OIDC.IssuerURL,
+			"client-id":      options.OIDC.ClientID,
+		}
+		if options.OIDC.ClientSecret != "" {
+			config["client-secret"] = options.OIDC.ClientSecret
+		}
+		if len(options.OIDC.ExtraScopes) > 0 {
+			config["extra-scopes"] = strings.Join(options.OIDC.ExtraScopes, ",")
+		}
+		return &clientcmdapi.AuthInfo{AuthProvider: &clientcmdapi.AuthProviderConfig{Name: "oidc", Config: config}}, nil
+	default:
+		return nil, nil
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
@@ -70,3 +71,93 @@ func Test_clusterctlClient_GetKubeconfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_kubeconfigAuthInfo(t *testing.T) {
+	tests := []struct {
+		name      string
+		options   GetKubeconfigOptions
+		want      *clientcmdapi.AuthInfo
+		expectErr bool
+	}{
+		{
+			name:    "no auth options",
+			options: GetKubeconfigOptions{},
+			want:    nil,
+		},
+		{
+			name: "exec plugin",
+			options: GetKubeconfigOptions{
+				Exec: &KubeconfigExecOptions{
+					Command: "kubectl",
+					Args:    []string{"oidc-login", "get-token"},
+					Env:     map[string]string{"B": "2", "A": "1"},
+				},
+			},
+			want: &clientcmdapi.AuthInfo{
+				Exec: &clientcmdapi.ExecConfig{
+					Command:         "kubectl",
+					Args:            []string{"oidc-login", "get-token"},
+					Env:             []clientcmdapi.ExecEnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}},
+					APIVersion:      "client.authentication.k8s.io/v1",
+					InteractiveMode: clientcmdapi.IfAvailableExecInteractiveMode,
+				},
+			},
+		},
+		{
+			name: "oidc auth provider",
+			options: GetKubeconfigOptions{
+				OIDC: &KubeconfigOIDCOptions{
+					IssuerURL:   "https://issuer.example.com",
+					ClientID:    "my-client",
+					ExtraScopes: []string{"groups", "email"},
+				},
+			},
+			want: &clientcmdapi.AuthInfo{
+				AuthProvider: &clientcmdapi.AuthProviderConfig{
+					Name: "oidc",
+					Config: map[string]string{
+						"idp-issuer-url": "https://issuer.example.com",
+						"client-id":      "my-client",
+						"extra-scopes":   "groups,email",
+					},
+				},
+			},
+		},
+		{
+			name: "exec plugin without command",
+			options: GetKubeconfigOptions{
+				Exec: &KubeconfigExecOptions{},
+			},
+			expectErr: true,
+		},
+		{
+			name: "oidc without client id",
+			options: GetKubeconfigOptions{
+				OIDC: &KubeconfigOIDCOptions{IssuerURL: "https://issuer.example.com"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "both exec plugin and oidc",
+			options: GetKubeconfigOptions{
+				Exec: &KubeconfigExecOptions{Command: "kubectl"},
+				OIDC: &KubeconfigOIDCOptions{IssuerURL: "https://issuer.example.com", ClientID: "my-client"},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := kubeconfigAuthInfo(tt.options)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"os"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
 )
@@ -39,6 +40,13 @@ type MoveOptions struct {
 	// namespace will be used.
 	Namespace string
 
+	// Selector is a label selector; if set, only the Clusters with matching labels are moved, together with the objects they require.
+	Selector string
+
+	// Clusters are the names of the Clusters to be moved; if set, only the Clusters with the given names are moved, together with
+	// the objects they require.
+	Clusters []string
+
 	// ExperimentalResourceMutatorFn accepts any number of resource mutator functions that are applied on all resources being moved.
 	// This is an experimental feature and is exposed only from the library and not (yet) through the CLI.
 	ExperimentalResourceMutators []cluster.ResourceMutatorFunc
@@ -51,6 +59,13 @@ type MoveOptions struct {
 
 	// DryRun means the move action is a dry run, no real action will be performed.
 	DryRun bool
+
+	// Resume completes a previously interrupted move to the target management cluster.
+	Resume bool
+
+	// Rollback reverts a previously interrupted move, deleting the objects already created in the target management cluster
+	// and resuming the objects in the source management cluster.
+	Rollback bool
 }
 
 func (c *clusterctlClient) Move(ctx context.Context, options MoveOptions) error {
@@ -59,6 +74,20 @@ func (c *clusterctlClient) Move(ctx context.Context, options MoveOptions) error
 		return errors.Errorf("can't set both FromDirectory and ToDirectory")
 	}
 
+	if options.Resume && options.Rollback {
+		return errors.Errorf("can't set both Resume and Rollback")
+	}
+
+	if (options.Resume || options.Rollback) &&
+		(options.DryRun || options.FromDirectory != "" || options.ToDirectory != "") {
+		return errors.Errorf("Resume and Rollback can't be used with DryRun, FromDirectory or ToDirectory")
+	}
+
+	// Nb. Resume and Rollback always apply to the Clusters selected by the interrupted move.
+	if (options.Resume || options.Rollback) && (options.Selector != "" || len(options.Clusters) > 0) {
+		return errors.Errorf("Resume and Rollback can't be used with Selector or Clusters")
+	}
+
 	if !options.DryRun &&
 		options.FromDirectory == "" &&
 		options.ToDirectory == "" &&
@@ -66,6 +95,10 @@ func (c *clusterctlClient) Move(ctx context.Context, options MoveOptions) error
 		return errors.Errorf("at least one of FromDirectory, ToDirectory and ToKubeconfig must be set")
 	}
 
+	if options.FromDirectory != "" && (options.Selector != "" || len(options.Clusters) > 0) {
+		return errors.Errorf("Selector and Clusters can't be used with FromDirectory")
+	}
+
 	if options.ToDirectory != "" {
 		return c.toDirectory(ctx, options)
 	} else if options.FromDirectory != "" {
@@ -99,7 +132,19 @@ func (c *clusterctlClient) move(ctx context.Context, options MoveOptions) error
 		}
 	}
 
-	return fromCluster.ObjectMover().Move(ctx, options.Namespace, toCluster, options.DryRun, options.ExperimentalResourceMutators...)
+	if options.Resume {
+		return fromCluster.ObjectMover().Resume(ctx, options.Namespace, toCluster, options.ExperimentalResourceMutators...)
+	}
+	if options.Rollback {
+		return fromCluster.ObjectMover().Rollback(ctx, options.Namespace, toCluster, options.ExperimentalResourceMutators...)
+	}
+
+	filter, err := options.clusterFilter()
+	if err != nil {
+		return err
+	}
+
+	return fromCluster.ObjectMover().Move(ctx, options.Namespace, filter, toCluster, options.DryRun, options.ExperimentalResourceMutators...)
 }
 
 func (c *clusterctlClient) fromDirectory(ctx context.Context, options MoveOptions) error {
@@ -134,7 +179,25 @@ func (c *clusterctlClient) toDirectory(ctx context.Context, options MoveOptions)
 		return err
 	}
 
-	return fromCluster.ObjectMover().ToDirectory(ctx, options.Namespace, options.ToDirectory)
+	filter, err := options.clusterFilter()
+	if err != nil {
+		return err
+	}
+
+	return fromCluster.ObjectMover().ToDirectory(ctx, options.Namespace, filter, options.ToDirectory)
+}
+
+// clusterFilter returns the filter selecting the Clusters to be moved.
+func (o MoveOptions) clusterFilter() (cluster.ClusterFilter, error) {
+	filter := cluster.ClusterFilter{Names: o.Clusters}
+	if o.Selector != "" {
+		selector, err := labels.Parse(o.Selector)
+		if err != nil {
+			return filter, errors.Wrapf(err, "invalid selector %q", o.Selector)
+		}
+		filter.Selector = selector
+	}
+	return filter, nil
 }
 
 func (c *clusterctlClient) getClusterClient(ctx context.Context, kubeconfig Kubeconfig) (cluster.Client, error) {
@@ -124,6 +124,64 @@ func Test_clusterctlClient_Move(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "does not return an error when resuming a move",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:   Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					Resume:         true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "returns an error if the selector is invalid",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:   Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					Selector:       "environment in (",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if both Resume and Rollback are set",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:   Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					Resume:         true,
+					Rollback:       true,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "returns an error if Rollback is set with DryRun",
+			fields: fields{
+				client: fakeClientForMove(),
+			},
+			args: args{
+				options: MoveOptions{
+					FromKubeconfig: Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"},
+					ToKubeconfig:   Kubeconfig{Path: "kubeconfig", Context: "worker-context"},
+					Rollback:       true,
+					DryRun:         true,
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -307,11 +365,19 @@ type fakeObjectMover struct {
 	fromDirectoryErr error
 }
 
-func (f *fakeObjectMover) Move(_ context.Context, _ string, _ cluster.Client, _ bool, _ ...cluster.ResourceMutatorFunc) error {
+func (f *fakeObjectMover) Move(_ context.Context, _ string, _ cluster.ClusterFilter, _ cluster.Client, _ bool, _ ...cluster.ResourceMutatorFunc) error {
+	return f.moveErr
+}
+
+func (f *fakeObjectMover) Resume(_ context.Context, _ string, _ cluster.Client, _ ...cluster.ResourceMutatorFunc) error {
+	return f.moveErr
+}
+
+func (f *fakeObjectMover) Rollback(_ context.Context, _ string, _ cluster.Client, _ ...cluster.ResourceMutatorFunc) error {
 	return f.moveErr
 }
 
-func (f *fakeObjectMover) ToDirectory(_ context.Context, _ string, _ string) error {
+func (f *fakeObjectMover) ToDirectory(_ context.Context, _ string, _ cluster.ClusterFilter, _ string) error {
 	return f.toDirectoryErr
 }
 
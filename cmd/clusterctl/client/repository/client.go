@@ -197,6 +197,15 @@ func repositoryFactory(ctx context.Context, providerConfig config.Provider, conf
 		return nil, errors.Errorf("invalid provider url. Only GitHub and GitLab are supported for %q schema", rURL.Scheme)
 	}
 
+	// if the url is an OCI registry repository
+	if rURL.Scheme == ociScheme {
+		repo, err := NewOCIRepository(ctx, providerConfig, configVariablesClient)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating the OCI repository client")
+		}
+		return repo, err
+	}
+
 	// if the url is a local filesystem repository
 	if rURL.Scheme == "file" || rURL.Scheme == "" {
 		repo, err := newLocalRepository(ctx, providerConfig, configVariablesClient)
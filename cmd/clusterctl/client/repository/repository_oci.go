@@ -0,0 +1,224 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+	"sigs.k8s.io/cluster-api/internal/oci"
+)
+
+const (
+	ociScheme             = "oci"
+	ociLatestReleaseLabel = "latest"
+)
+
+// ociRepository provides support for providers published as artifacts in OCI registries.
+//
+// Each version of a provider is an artifact tagged with the version, and each file is a layer of the artifact
+// named with the org.opencontainers.image.title annotation, as done e.g. by `oras push`.
+// The repository can be pinned to an artifact digest, and cosign signatures of the artifacts
+// are verified if a public key is configured.
+type ociRepository struct {
+	providerConfig config.Provider
+	client         oci.Client
+	credentials    *oci.Credentials
+	publicKey      crypto.PublicKey
+	reference      *oci.Reference
+	defaultVersion string
+	rootPath       string
+	componentsPath string
+	injectClient   oci.Client
+}
+
+var _ Repository = &ociRepository{}
+
+type ociRepositoryOption func(*ociRepository)
+
+func injectOCIClient(c oci.Client) ociRepositoryOption {
+	return func(o *ociRepository) {
+		o.injectClient = c
+	}
+}
+
+// NewOCIRepository returns an ociRepository implementation.
+func NewOCIRepository(ctx context.Context, providerConfig config.Provider, configVariablesClient config.VariablesClient, opts ...ociRepositoryOption) (Repository, error) {
+	if configVariablesClient == nil {
+		return nil, errors.New("invalid arguments: configVariablesClient can't be nil")
+	}
+
+	invalidURLErr := errors.Errorf(
+		"invalid url: an OCI repository url should be in the form oci://{registry}/{repository}[:{latest|version-tag}][@{digest}]/{componentsClient.yaml}, got %q",
+		providerConfig.URL(),
+	)
+
+	// Split the url into the artifact reference and the components file name.
+	artifactURL, componentsPath, ok := cutLast(strings.TrimPrefix(providerConfig.URL(), ociScheme+"://"), "/")
+	if !ok || !strings.HasSuffix(componentsPath, ".yaml") {
+		return nil, invalidURLErr
+	}
+	reference, err := oci.ParseReference(ociScheme + "://" + artifactURL)
+	if err != nil {
+		return nil, errors.Wrap(invalidURLErr, err.Error())
+	}
+
+	// A version is required when pinning a digest, given that versions are used to track installed providers.
+	if reference.IsDigest() && (reference.Tag == "" || reference.Tag == ociLatestReleaseLabel) {
+		return nil, errors.Errorf("invalid url %q: an OCI repository url pinned to a digest must include the version tag, e.g. oci://{registry}/{repository}:v1.0.0@{digest}/{componentsClient.yaml}", providerConfig.URL())
+	}
+
+	defaultVersion := reference.Tag
+	if defaultVersion == "" {
+		defaultVersion = ociLatestReleaseLabel
+	}
+
+	repo := &ociRepository{
+		providerConfig: providerConfig,
+		reference:      reference,
+		defaultVersion: defaultVersion,
+		rootPath:       ".",
+		componentsPath: componentsPath,
+	}
+
+	// Process ociRepositoryOptions.
+	for _, o := range opts {
+		o(repo)
+	}
+
+	repo.client = repo.injectClient
+	if repo.client == nil {
+		repo.client = oci.NewClient(nil)
+	}
+
+	if username, err := configVariablesClient.Get(config.OCIUsernameVariable); err == nil {
+		password, _ := configVariablesClient.Get(config.OCIPasswordVariable)
+		repo.credentials = &oci.Credentials{Username: username, Password: password}
+	}
+
+	if publicKeyPath, err := configVariablesClient.Get(config.OCIPublicKeyVariable); err == nil && publicKeyPath != "" {
+		data, err := os.ReadFile(publicKeyPath) //nolint:gosec
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the public key defined by %s", config.OCIPublicKeyVariable)
+		}
+		if repo.publicKey, err = oci.ParsePublicKey(data); err != nil {
+			return nil, errors.Wrapf(err, "invalid public key defined by %s", config.OCIPublicKeyVariable)
+		}
+	}
+
+	if defaultVersion == ociLatestReleaseLabel {
+		repo.defaultVersion, err = latestContractRelease(ctx, repo, clusterv1.GroupVersion.Version)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get latest release")
+		}
+	}
+
+	return repo, nil
+}
+
+// DefaultVersion returns defaultVersion field of ociRepository struct.
+func (o *ociRepository) DefaultVersion() string {
+	return o.defaultVersion
+}
+
+// RootPath returns rootPath field of ociRepository struct.
+func (o *ociRepository) RootPath() string {
+	return o.rootPath
+}
+
+// ComponentsPath returns componentsPath field of ociRepository struct.
+func (o *ociRepository) ComponentsPath() string {
+	return o.componentsPath
+}
+
+// GetVersions returns the list of versions that are available in a provider repository, i.e. the tags of the
+// repository, or only the pinned version if the repository is pinned to a digest.
+func (o *ociRepository) GetVersions(ctx context.Context) ([]string, error) {
+	if o.reference.IsDigest() {
+		return []string{o.reference.Tag}, nil
+	}
+
+	tags, err := o.client.Tags(ctx, o.repositoryURL(), o.credentials)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the list of versions from %q", o.repositoryURL())
+	}
+	return tags, nil
+}
+
+// GetFile returns a file for a given provider version.
+func (o *ociRepository) GetFile(ctx context.Context, version, path string) ([]byte, error) {
+	log := logf.Log
+
+	artifactURL := fmt.Sprintf("%s:%s", o.repositoryURL(), version)
+	if o.reference.IsDigest() {
+		if version != o.reference.Tag {
+			return nil, errors.Errorf("failed to get file %q with version %q from %q: the repository is pinned to version %s", path, version, o.repositoryURL(), o.reference.Tag)
+		}
+		artifactURL = fmt.Sprintf("%s@%s", artifactURL, o.reference.Reference)
+	}
+
+	cacheID := fmt.Sprintf("%s/%s", artifactURL, path)
+	if content, ok := cacheFiles[cacheID]; ok {
+		return content, nil
+	}
+
+	artifact, err := o.client.Pull(ctx, artifactURL, o.credentials)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get file %q with version %q from %q", path, version, o.repositoryURL())
+	}
+
+	if o.publicKey != nil {
+		if err := oci.VerifySignature(ctx, o.client, o.reference, artifact.Digest, o.credentials, o.publicKey); err != nil {
+			return nil, errors.Wrapf(err, "failed to verify the signature of version %q from %q", version, o.repositoryURL())
+		}
+		log.V(5).Info("Verified artifact signature", "Repository", o.repositoryURL(), "Version", version, "Digest", artifact.Digest)
+	}
+
+	// Cache all the files in the artifact, so the artifact is pulled only once.
+	for _, l := range artifact.Layers {
+		if title := l.Annotations[oci.TitleAnnotation]; title != "" {
+			cacheFiles[fmt.Sprintf("%s/%s", artifactURL, title)] = l.Data
+		}
+	}
+
+	content, ok := cacheFiles[cacheID]
+	if !ok {
+		return nil, errors.Errorf("failed to get file %q with version %q from %q: file not found in artifact %s", path, version, o.repositoryURL(), artifact.Digest)
+	}
+	return content, nil
+}
+
+// repositoryURL returns the oci:// URL of the repository, without tag or digest.
+func (o *ociRepository) repositoryURL() string {
+	return fmt.Sprintf("%s://%s/%s", ociScheme, o.reference.Registry, o.reference.Repository)
+}
+
+// cutLast slices s around the last instance of sep.
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
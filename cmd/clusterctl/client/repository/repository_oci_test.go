@@ -0,0 +1,361 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	"sigs.k8s.io/cluster-api/internal/oci"
+)
+
+// fakeOCIRegistry is a minimal OCI registry serving artifacts with files as layers, as pushed by `oras push`.
+type fakeOCIRegistry struct {
+	manifests map[string][]byte
+	blobs     map[string][]byte
+	tags      []string
+}
+
+func newFakeOCIRegistry() *fakeOCIRegistry {
+	return &fakeOCIRegistry{
+		manifests: map[string][]byte{},
+		blobs:     map[string][]byte{},
+	}
+}
+
+// withArtifact adds an artifact with the given tag and layers, and returns the digest of the artifact.
+func (f *fakeOCIRegistry) withArtifact(tag string, layers []oci.Layer) string {
+	descriptors := []map[string]interface{}{}
+	for _, l := range layers {
+		digest := fmt.Sprintf("sha256:%x", sha256.Sum256(l.Data))
+		f.blobs[digest] = l.Data
+		descriptors = append(descriptors, map[string]interface{}{
+			"mediaType":   "application/yaml",
+			"digest":      digest,
+			"size":        len(l.Data),
+			"annotations": l.Annotations,
+		})
+	}
+	manifest, _ := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+		"layers":        descriptors,
+	})
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256(manifest))
+	f.manifests[tag] = manifest
+	f.manifests[digest] = manifest
+	f.tags = append(f.tags, tag)
+	return digest
+}
+
+// withFiles adds an artifact with the given tag and files, and returns the digest of the artifact.
+func (f *fakeOCIRegistry) withFiles(tag string, files map[string]string) string {
+	layers := []oci.Layer{}
+	for name, content := range files {
+		layers = append(layers, oci.Layer{Annotations: map[string]string{oci.TitleAnnotation: name}, Data: []byte(content)})
+	}
+	return f.withArtifact(tag, layers)
+}
+
+func (f *fakeOCIRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/v2/providers/core/")
+	switch {
+	case path == "tags/list":
+		data, _ := json.Marshal(map[string]interface{}{"name": "providers/core", "tags": f.tags})
+		_, _ = w.Write(data)
+	case strings.HasPrefix(path, "manifests/"):
+		manifest, ok := f.manifests[strings.TrimPrefix(path, "manifests/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(manifest)
+	case strings.HasPrefix(path, "blobs/"):
+		blob, ok := f.blobs[strings.TrimPrefix(path, "blobs/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(blob)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+const ociTestMetadata = `apiVersion: clusterctl.cluster.x-k8s.io/v1alpha3
+kind: Metadata
+releaseSeries:
+- major: 1
+  minor: 0
+  contract: v1beta1
+- major: 1
+  minor: 1
+  contract: v1beta1
+`
+
+func Test_ociRepository_newOCIRepository(t *testing.T) {
+	registry := newFakeOCIRegistry()
+	registry.withFiles("v1.0.0", map[string]string{"core-components.yaml": "v1.0.0", "metadata.yaml": ociTestMetadata})
+	v110Digest := registry.withFiles("v1.1.0", map[string]string{"core-components.yaml": "v1.1.0", "metadata.yaml": ociTestMetadata})
+	srv := httptest.NewTLSServer(registry)
+	defer srv.Close()
+	host := srv.Listener.Addr().String()
+
+	tests := []struct {
+		name               string
+		url                string
+		wantDefaultVersion string
+		wantComponentsPath string
+		wantErr            bool
+	}{
+		{
+			name:               "version tag",
+			url:                fmt.Sprintf("oci://%s/providers/core:v1.0.0/core-components.yaml", host),
+			wantDefaultVersion: "v1.0.0",
+			wantComponentsPath: "core-components.yaml",
+		},
+		{
+			name:               "latest tag is resolved to the latest version",
+			url:                fmt.Sprintf("oci://%s/providers/core:latest/core-components.yaml", host),
+			wantDefaultVersion: "v1.1.0",
+			wantComponentsPath: "core-components.yaml",
+		},
+		{
+			name:               "no tag is resolved to the latest version",
+			url:                fmt.Sprintf("oci://%s/providers/core/core-components.yaml", host),
+			wantDefaultVersion: "v1.1.0",
+			wantComponentsPath: "core-components.yaml",
+		},
+		{
+			name:               "version tag pinned to a digest",
+			url:                fmt.Sprintf("oci://%s/providers/core:v1.1.0@%s/core-components.yaml", host, v110Digest),
+			wantDefaultVersion: "v1.1.0",
+			wantComponentsPath: "core-components.yaml",
+		},
+		{
+			name:    "digest without a version tag",
+			url:     fmt.Sprintf("oci://%s/providers/core@%s/core-components.yaml", host, v110Digest),
+			wantErr: true,
+		},
+		{
+			name:    "no components file",
+			url:     fmt.Sprintf("oci://%s/providers/core:v1.0.0", host),
+			wantErr: true,
+		},
+		{
+			name:    "no repository",
+			url:     fmt.Sprintf("oci://%s/core-components.yaml", host),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			resetCaches()
+
+			providerConfig := config.NewProvider("test", tt.url, clusterctlv1.CoreProviderType)
+			got, err := NewOCIRepository(context.Background(), providerConfig, test.NewFakeVariableClient(), injectOCIClient(oci.NewClient(srv.Client())))
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got.DefaultVersion()).To(Equal(tt.wantDefaultVersion))
+			g.Expect(got.ComponentsPath()).To(Equal(tt.wantComponentsPath))
+			g.Expect(got.RootPath()).To(Equal("."))
+		})
+	}
+}
+
+func Test_ociRepository_GetVersions(t *testing.T) {
+	registry := newFakeOCIRegistry()
+	registry.withFiles("v1.0.0", map[string]string{"core-components.yaml": "v1.0.0"})
+	v110Digest := registry.withFiles("v1.1.0", map[string]string{"core-components.yaml": "v1.1.0"})
+	srv := httptest.NewTLSServer(registry)
+	defer srv.Close()
+	host := srv.Listener.Addr().String()
+
+	t.Run("returns the repository tags", func(t *testing.T) {
+		g := NewWithT(t)
+
+		providerConfig := config.NewProvider("test", fmt.Sprintf("oci://%s/providers/core:v1.0.0/core-components.yaml", host), clusterctlv1.CoreProviderType)
+		repo, err := NewOCIRepository(context.Background(), providerConfig, test.NewFakeVariableClient(), injectOCIClient(oci.NewClient(srv.Client())))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got, err := repo.GetVersions(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(ConsistOf("v1.0.0", "v1.1.0"))
+	})
+
+	t.Run("returns only the pinned version", func(t *testing.T) {
+		g := NewWithT(t)
+
+		providerConfig := config.NewProvider("test", fmt.Sprintf("oci://%s/providers/core:v1.1.0@%s/core-components.yaml", host, v110Digest), clusterctlv1.CoreProviderType)
+		repo, err := NewOCIRepository(context.Background(), providerConfig, test.NewFakeVariableClient(), injectOCIClient(oci.NewClient(srv.Client())))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		got, err := repo.GetVersions(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(got).To(ConsistOf("v1.1.0"))
+	})
+}
+
+func Test_ociRepository_GetFile(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyPath := filepath.Join(t.TempDir(), "cosign.pub")
+	if err := os.WriteFile(publicKeyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := newFakeOCIRegistry()
+	v100Digest := registry.withFiles("v1.0.0", map[string]string{"core-components.yaml": "v1.0.0", "cluster-template.yaml": "template"})
+	v110Digest := registry.withFiles("v1.1.0", map[string]string{"core-components.yaml": "v1.1.0"})
+
+	// Sign only v1.1.0, as done by `cosign sign --key cosign.key`.
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"providers/core"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, v110Digest))
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry.withArtifact(strings.Replace(v110Digest, ":", "-", 1)+".sig", []oci.Layer{{
+		Annotations: map[string]string{"dev.cosignproject.cosign/signature": base64.StdEncoding.EncodeToString(signature)},
+		Data:        payload,
+	}})
+
+	srv := httptest.NewTLSServer(registry)
+	defer srv.Close()
+	host := srv.Listener.Addr().String()
+
+	tests := []struct {
+		name      string
+		url       string
+		variables map[string]string
+		version   string
+		path      string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:    "get a file",
+			url:     fmt.Sprintf("oci://%s/providers/core:v1.0.0/core-components.yaml", host),
+			version: "v1.0.0",
+			path:    "cluster-template.yaml",
+			want:    "template",
+		},
+		{
+			name:    "get a file from another version",
+			url:     fmt.Sprintf("oci://%s/providers/core:v1.0.0/core-components.yaml", host),
+			version: "v1.1.0",
+			path:    "core-components.yaml",
+			want:    "v1.1.0",
+		},
+		{
+			name:    "file not in the artifact",
+			url:     fmt.Sprintf("oci://%s/providers/core:v1.0.0/core-components.yaml", host),
+			version: "v1.1.0",
+			path:    "cluster-template.yaml",
+			wantErr: true,
+		},
+		{
+			name:    "version does not exist",
+			url:     fmt.Sprintf("oci://%s/providers/core:v1.0.0/core-components.yaml", host),
+			version: "v2.0.0",
+			path:    "core-components.yaml",
+			wantErr: true,
+		},
+		{
+			name:    "get a file from the pinned digest",
+			url:     fmt.Sprintf("oci://%s/providers/core:v1.0.0@%s/core-components.yaml", host, v100Digest),
+			version: "v1.0.0",
+			path:    "core-components.yaml",
+			want:    "v1.0.0",
+		},
+		{
+			name:    "fails for a version other than the pinned one",
+			url:     fmt.Sprintf("oci://%s/providers/core:v1.0.0@%s/core-components.yaml", host, v100Digest),
+			version: "v1.1.0",
+			path:    "core-components.yaml",
+			wantErr: true,
+		},
+		{
+			name:      "get a file from a signed artifact",
+			url:       fmt.Sprintf("oci://%s/providers/core:v1.1.0/core-components.yaml", host),
+			variables: map[string]string{config.OCIPublicKeyVariable: publicKeyPath},
+			version:   "v1.1.0",
+			path:      "core-components.yaml",
+			want:      "v1.1.0",
+		},
+		{
+			name:      "fails for an artifact which is not signed",
+			url:       fmt.Sprintf("oci://%s/providers/core:v1.0.0/core-components.yaml", host),
+			variables: map[string]string{config.OCIPublicKeyVariable: publicKeyPath},
+			version:   "v1.0.0",
+			path:      "core-components.yaml",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			resetCaches()
+
+			variableClient := test.NewFakeVariableClient()
+			for k, v := range tt.variables {
+				variableClient.WithVar(k, v)
+			}
+
+			providerConfig := config.NewProvider("test", tt.url, clusterctlv1.CoreProviderType)
+			repo, err := NewOCIRepository(context.Background(), providerConfig, variableClient, injectOCIClient(oci.NewClient(srv.Client())))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			got, err := repo.GetFile(context.Background(), tt.version, tt.path)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(got)).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scaffold implements the generation of the source code of new projects, e.g. Runtime Extensions.
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"go/format"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	extensionTemplatesDir = "templates/extension"
+	templateSuffix        = ".tmpl"
+
+	// DefaultGoVersion is the Go version used by the generated projects.
+	DefaultGoVersion = "1.21"
+)
+
+//go:embed templates
+var templates embed.FS
+
+// HookCategory is the category of a Runtime Hook.
+type HookCategory string
+
+const (
+	// TopologyMutationHookCategory is the category of the hooks used to patch the templates of a Cluster's topology.
+	TopologyMutationHookCategory HookCategory = "topologymutation"
+
+	// LifecycleHookCategory is the category of the hooks called at specific stages of the lifecycle of a Cluster.
+	LifecycleHookCategory HookCategory = "lifecycle"
+)
+
+// Hook describes a Runtime Hook which can be implemented by a scaffolded Runtime Extension.
+type Hook struct {
+	// Name is the name of the hook, e.g. BeforeClusterCreate.
+	Name string
+
+	// Category is the category of the hook.
+	Category HookCategory
+
+	// Blocking is true if the hook can block the operation it is called for.
+	Blocking bool
+
+	// Accept is true if the response of the hook can accept or reject the request.
+	Accept bool
+}
+
+// HandlerName returns the name of the handler registered for the hook, e.g. before-cluster-create.
+func (h Hook) HandlerName() string {
+	var b strings.Builder
+	for i, r := range h.Name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteRune('-')
+		}
+		b.WriteString(strings.ToLower(string(r)))
+	}
+	return b.String()
+}
+
+// hooks is the list of the Runtime Hooks which can be implemented by a scaffolded Runtime Extension.
+// NOTE: this list must be kept in sync with the hooks defined in sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1.
+var hooks = []Hook{
+	{Name: "GeneratePatches", Category: TopologyMutationHookCategory},
+	{Name: "ValidateTopology", Category: TopologyMutationHookCategory},
+	{Name: "DiscoverVariables", Category: TopologyMutationHookCategory},
+	{Name: "BeforeClusterCreate", Category: LifecycleHookCategory, Blocking: true},
+	{Name: "AfterControlPlaneInitialized", Category: LifecycleHookCategory},
+	{Name: "BeforeClusterUpgrade", Category: LifecycleHookCategory, Blocking: true},
+	{Name: "AfterControlPlaneUpgrade", Category: LifecycleHookCategory, Blocking: true},
+	{Name: "BeforeWorkersUpgrade", Category: LifecycleHookCategory, Blocking: true},
+	{Name: "AfterWorkersUpgrade", Category: LifecycleHookCategory, Blocking: true},
+	{Name: "AfterClusterUpgrade", Category: LifecycleHookCategory},
+	{Name: "BeforeClusterDelete", Category: LifecycleHookCategory, Blocking: true},
+	{Name: "UpdateMachine", Category: LifecycleHookCategory, Accept: true},
+	{Name: "BeforeMachineCreate", Category: LifecycleHookCategory, Blocking: true},
+}
+
+// Hooks returns the Runtime Hooks which can be implemented by a scaffolded Runtime Extension.
+func Hooks() []Hook {
+	return append([]Hook{}, hooks...)
+}
+
+// ExtensionOptions carries the options supported by GenerateExtension.
+type ExtensionOptions struct {
+	// Name of the Runtime Extension; it is used for naming the objects deployed in the management cluster.
+	Name string
+
+	// Module is the Go module of the Runtime Extension project.
+	Module string
+
+	// Namespace where the Runtime Extension is deployed.
+	Namespace string
+
+	// Image is the container image of the Runtime Extension.
+	Image string
+
+	// Hooks is the list of the names of the Runtime Hooks implemented by the Runtime Extension.
+	Hooks []string
+
+	// ClusterAPIVersion is the version of the sigs.k8s.io/cluster-api module required by the project.
+	// If empty, the dependency is left to be resolved by go mod tidy.
+	ClusterAPIVersion string
+
+	// GoVersion is the Go version used by the project; it defaults to DefaultGoVersion.
+	GoVersion string
+}
+
+// extensionData is the data used to render the templates of a Runtime Extension project.
+type extensionData struct {
+	ExtensionOptions
+
+	LifecycleHooks        []Hook
+	TopologyMutationHooks []Hook
+}
+
+// HasHook returns true if the Runtime Extension implements the given hook.
+func (d extensionData) HasHook(name string) bool {
+	for _, h := range append(d.LifecycleHooks, d.TopologyMutationHooks...) {
+		if h.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateExtension returns the files of a new Runtime Extension project, indexed by their path relative
+// to the root of the project.
+func GenerateExtension(options ExtensionOptions) (map[string][]byte, error) {
+	if errs := validation.IsDNS1123Label(options.Name); len(errs) > 0 {
+		return nil, errors.Errorf("invalid Runtime Extension name %q: %s", options.Name, strings.Join(errs, ", "))
+	}
+	if options.Module == "" {
+		return nil, errors.New("module must be set")
+	}
+	if options.Namespace == "" {
+		return nil, errors.New("namespace must be set")
+	}
+	if options.Image == "" {
+		return nil, errors.New("image must be set")
+	}
+	if options.GoVersion == "" {
+		options.GoVersion = DefaultGoVersion
+	}
+
+	data := extensionData{ExtensionOptions: options}
+	requested := sets.New[string](options.Hooks...)
+	if requested.Len() == 0 {
+		return nil, errors.New("at least one hook must be selected")
+	}
+	// NOTE: hooks are added in the order of the hooks list, so the generated code doesn't depend on the order of the options.
+	for _, h := range hooks {
+		if !requested.Has(h.Name) {
+			continue
+		}
+		requested.Delete(h.Name)
+		switch h.Category {
+		case LifecycleHookCategory:
+			data.LifecycleHooks = append(data.LifecycleHooks, h)
+		case TopologyMutationHookCategory:
+			data.TopologyMutationHooks = append(data.TopologyMutationHooks, h)
+		}
+	}
+	if requested.Len() > 0 {
+		return nil, errors.Errorf("unknown hooks %s, supported hooks are %s", strings.Join(sets.List(requested), ", "), strings.Join(hookNames(), ", "))
+	}
+
+	files := map[string][]byte{}
+	err := fs.WalkDir(templates, extensionTemplatesDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(p, templateSuffix) {
+			return nil
+		}
+
+		target := strings.TrimSuffix(strings.TrimPrefix(p, extensionTemplatesDir+"/"), templateSuffix)
+		// Skip the handlers of the hook categories not implemented by the Runtime Extension.
+		if (strings.HasPrefix(target, path.Join("handlers", string(LifecycleHookCategory))+"/") && len(data.LifecycleHooks) == 0) ||
+			(strings.HasPrefix(target, path.Join("handlers", string(TopologyMutationHookCategory))+"/") && len(data.TopologyMutationHooks) == 0) {
+			return nil
+		}
+
+		content, err := render(p, data)
+		if err != nil {
+			return err
+		}
+		if path.Ext(target) == ".go" {
+			if content, err = format.Source(content); err != nil {
+				return errors.Wrapf(err, "failed to format %s", target)
+			}
+		}
+		files[target] = content
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func render(name string, data interface{}) ([]byte, error) {
+	raw, err := templates.ReadFile(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read template %s", name)
+	}
+	tpl, err := template.New(path.Base(name)).Option("missingkey=error").Parse(string(raw))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse template %s", name)
+	}
+	var out bytes.Buffer
+	if err := tpl.Execute(&out, data); err != nil {
+		return nil, errors.Wrapf(err, "failed to render template %s", name)
+	}
+	return out.Bytes(), nil
+}
+
+func hookNames() []string {
+	names := make([]string, 0, len(hooks))
+	for _, h := range hooks {
+		names = append(names, h.Name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffold
+
+import (
+	"go/parser"
+	"go/token"
+	"path"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+)
+
+func TestHooks(t *testing.T) {
+	g := NewWithT(t)
+
+	catalog := runtimecatalog.New()
+	g.Expect(runtimehooksv1.AddToCatalog(catalog)).To(Succeed())
+
+	// Ensure all the hooks which can be scaffolded exist in the catalog.
+	for _, h := range Hooks() {
+		gvh := runtimecatalog.GroupVersionHook{
+			Group:   runtimehooksv1.GroupVersion.Group,
+			Version: runtimehooksv1.GroupVersion.Version,
+			Hook:    h.Name,
+		}
+		g.Expect(catalog.IsHookRegistered(gvh)).To(BeTrue(), "hook %s is not registered", h.Name)
+	}
+}
+
+func TestHook_HandlerName(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(Hook{Name: "BeforeClusterCreate"}.HandlerName()).To(Equal("before-cluster-create"))
+	g.Expect(Hook{Name: "GeneratePatches"}.HandlerName()).To(Equal("generate-patches"))
+}
+
+func TestGenerateExtension(t *testing.T) {
+	defaultOptions := func() ExtensionOptions {
+		return ExtensionOptions{
+			Name:      "my-extension",
+			Module:    "example.com/my-extension",
+			Namespace: "my-extension-system",
+			Image:     "my-extension:dev",
+		}
+	}
+
+	tests := []struct {
+		name      string
+		hooks     []string
+		wantFiles []string
+		wantErr   bool
+	}{
+		{
+			name:  "topology mutation hooks only",
+			hooks: []string{"GeneratePatches", "DiscoverVariables"},
+			wantFiles: []string{
+				"Dockerfile",
+				"Makefile",
+				"README.md",
+				"config/extension.yaml",
+				"config/extensionconfig.yaml",
+				"go.mod",
+				"handlers/topologymutation/handlers.go",
+				"handlers/topologymutation/handlers_test.go",
+				"main.go",
+			},
+		},
+		{
+			name:  "lifecycle hooks only",
+			hooks: []string{"BeforeClusterCreate", "UpdateMachine"},
+			wantFiles: []string{
+				"Dockerfile",
+				"Makefile",
+				"README.md",
+				"config/extension.yaml",
+				"config/extensionconfig.yaml",
+				"go.mod",
+				"handlers/lifecycle/handlers.go",
+				"handlers/lifecycle/handlers_test.go",
+				"main.go",
+			},
+		},
+		{
+			name:  "all hooks",
+			hooks: hookNames(),
+			wantFiles: []string{
+				"Dockerfile",
+				"Makefile",
+				"README.md",
+				"config/extension.yaml",
+				"config/extensionconfig.yaml",
+				"go.mod",
+				"handlers/lifecycle/handlers.go",
+				"handlers/lifecycle/handlers_test.go",
+				"handlers/topologymutation/handlers.go",
+				"handlers/topologymutation/handlers_test.go",
+				"main.go",
+			},
+		},
+		{
+			name:    "fails without hooks",
+			wantErr: true,
+		},
+		{
+			name:    "fails with unknown hooks",
+			hooks:   []string{"GeneratePatches", "NotAHook"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			options := defaultOptions()
+			options.Hooks = tt.hooks
+			files, err := GenerateExtension(options)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(sets.List(sets.KeySet(files))).To(Equal(tt.wantFiles))
+
+			// Ensure the generated Go code is valid.
+			for name, content := range files {
+				if path.Ext(name) != ".go" {
+					continue
+				}
+				_, err := parser.ParseFile(token.NewFileSet(), name, content, parser.AllErrors)
+				g.Expect(err).ToNot(HaveOccurred(), "file %s is not valid Go code", name)
+			}
+		})
+	}
+}
+
+func TestGenerateExtension_Validation(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := GenerateExtension(ExtensionOptions{
+		Name:      "My_Extension",
+		Module:    "example.com/my-extension",
+		Namespace: "default",
+		Image:     "my-extension:dev",
+		Hooks:     []string{"GeneratePatches"},
+	})
+	g.Expect(err).To(HaveOccurred())
+}
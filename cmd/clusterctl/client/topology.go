@@ -62,3 +62,34 @@ func (c *clusterctlClient) TopologyPlan(ctx context.Context, options TopologyPla
 
 	return out, err
 }
+
+// TopologyDiffOptions define options for TopologyDiff.
+type TopologyDiffOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Cluster is the name of the cluster to dryrun reconcile.
+	Cluster string
+
+	// Namespace is the namespace of the cluster.
+	// If unspecified, the current namespace will be used.
+	Namespace string
+}
+
+// TopologyDiffOutput defines the output of the topology diff operation.
+type TopologyDiffOutput = cluster.TopologyDiffOutput
+
+// TopologyDiff performs a dry run execution of the topology reconciler for a Cluster in the management cluster.
+// It returns a summary of the changes the topology reconciler would apply on the next reconcile.
+func (c *clusterctlClient) TopologyDiff(ctx context.Context, options TopologyDiffOptions) (*TopologyDiffOutput, error) {
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterClient.Topology().Diff(ctx, &cluster.TopologyDiffInput{
+		ClusterName:     options.Cluster,
+		TargetNamespace: options.Namespace,
+	})
+}
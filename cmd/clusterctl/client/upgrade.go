@@ -78,6 +78,16 @@ func (c *clusterctlClient) PlanUpgrade(ctx context.Context, options PlanUpgradeO
 			Contract:  plan.Contract,
 			Providers: plan.Providers,
 		}
+
+		// Checks if the upgrade plan is compatible with the management cluster; this is possible only for plans
+		// this version of clusterctl can apply.
+		if plan.Contract == clusterv1.GroupVersion.Version {
+			issues, err := clusterClient.ProviderUpgrader().CheckCompatibility(ctx, plan)
+			if err != nil {
+				return nil, err
+			}
+			aliasUpgradePlan[i].CompatibilityIssues = issues
+		}
 	}
 
 	return aliasUpgradePlan, nil
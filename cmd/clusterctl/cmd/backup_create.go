@@ -0,0 +1,101 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+)
+
+type backupCreateOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	namespace         string
+	allNamespaces     bool
+	location          string
+	keep              int
+}
+
+var bco = &backupCreateOptions{}
+
+var backupCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Back up Cluster API objects and all dependencies to an object storage",
+	Long: LongDesc(`
+		Back up Cluster API objects and all dependencies to an object storage.
+
+		Backups are incremental: objects which did not change since a previous backup in the same
+		location are not uploaded again.
+
+		Note: Clusters are paused while objects are read, like during a move.`),
+
+	Example: Examples(`
+		Back up the Cluster API objects in the current namespace to an S3 bucket.
+		clusterctl backup create --location=s3://my-bucket/management-cluster
+
+		Back up the Cluster API objects in all the namespaces, keeping only the last 7 backups.
+		clusterctl backup create --location=s3://my-bucket/management-cluster --all-namespaces --keep=7`),
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return runBackupCreate()
+	},
+}
+
+func init() {
+	backupCreateCmd.Flags().StringVar(&bco.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file for the management cluster. If unspecified, default discovery rules apply.")
+	backupCreateCmd.Flags().StringVar(&bco.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	backupCreateCmd.Flags().StringVarP(&bco.namespace, "namespace", "n", "",
+		"The namespace where the workload clusters are hosted. If unspecified, the current context's namespace is used.")
+	backupCreateCmd.Flags().BoolVarP(&bco.allNamespaces, "all-namespaces", "A", false,
+		"Back up the Cluster API objects in all the namespaces.")
+	backupCreateCmd.Flags().StringVar(&bco.location, "location", "",
+		"Location of the backups, e.g. s3://bucket/prefix, gs://bucket/prefix, azblob://account/container/prefix or a local directory.")
+	backupCreateCmd.Flags().IntVar(&bco.keep, "keep", 0,
+		"Number of backups to keep in the location, deleting older ones. If 0, all the backups are kept.")
+
+	backupCreateCmd.MarkFlagsMutuallyExclusive("namespace", "all-namespaces")
+	_ = backupCreateCmd.MarkFlagRequired("location")
+}
+
+func runBackupCreate() error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	name, err := c.CreateBackup(ctx, client.CreateBackupOptions{
+		Kubeconfig:    client.Kubeconfig{Path: bco.kubeconfig, Context: bco.kubeconfigContext},
+		Namespace:     bco.namespace,
+		AllNamespaces: bco.allNamespaces,
+		Location:      bco.location,
+		Keep:          bco.keep,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Backup %s created\n", name)
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+)
+
+type backupListOptions struct {
+	location string
+}
+
+var blo = &backupListOptions{}
+
+var backupListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the backups stored in an object storage",
+
+	Example: Examples(`
+		List the backups stored in an S3 bucket.
+		clusterctl backup list --location=s3://my-bucket/management-cluster`),
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return runBackupList()
+	},
+}
+
+func init() {
+	backupListCmd.Flags().StringVar(&blo.location, "location", "",
+		"Location of the backups, e.g. s3://bucket/prefix, gs://bucket/prefix, azblob://account/container/prefix or a local directory.")
+
+	_ = backupListCmd.MarkFlagRequired("location")
+}
+
+func runBackupList() error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	backups, err := c.ListBackups(ctx, client.ListBackupsOptions{
+		Location: blo.location,
+	})
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCREATED\tNAMESPACE\tOBJECTS")
+	for _, b := range backups {
+		namespace := b.Namespace
+		if namespace == "" {
+			namespace = "(all)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", b.Name, b.CreatedAt.Format(time.RFC3339), namespace, len(b.Files))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+)
+
+type backupRestoreOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	location          string
+	name              string
+}
+
+var bro = &backupRestoreOptions{}
+
+var backupRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore Cluster API objects and all dependencies from an object storage",
+	Long: LongDesc(`
+		Restore Cluster API objects and all dependencies from a backup stored in an object storage.
+
+		Objects are created after their owners, and owner references are updated accordingly.
+
+		Note: The management cluster MUST have the required provider components installed.`),
+
+	Example: Examples(`
+		Restore the latest backup stored in an S3 bucket.
+		clusterctl backup restore --location=s3://my-bucket/management-cluster
+
+		Restore a specific backup.
+		clusterctl backup restore --location=s3://my-bucket/management-cluster --name=20240102-030405`),
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return runBackupRestore()
+	},
+}
+
+func init() {
+	backupRestoreCmd.Flags().StringVar(&bro.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file for the management cluster. If unspecified, default discovery rules apply.")
+	backupRestoreCmd.Flags().StringVar(&bro.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	backupRestoreCmd.Flags().StringVar(&bro.location, "location", "",
+		"Location of the backups, e.g. s3://bucket/prefix, gs://bucket/prefix, azblob://account/container/prefix or a local directory.")
+	backupRestoreCmd.Flags().StringVar(&bro.name, "name", "",
+		"Name of the backup to be restored. If unspecified, the latest backup is restored.")
+
+	_ = backupRestoreCmd.MarkFlagRequired("location")
+}
+
+func runBackupRestore() error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	return c.RestoreBackup(ctx, client.RestoreBackupOptions{
+		Kubeconfig: client.Kubeconfig{Path: bro.kubeconfig, Context: bro.kubeconfigContext},
+		Location:   bro.location,
+		Name:       bro.name,
+	})
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/scaffold"
+)
+
+type generateExtensionOptions struct {
+	hooks     []string
+	module    string
+	namespace string
+	image     string
+	outputDir string
+}
+
+var geo = &generateExtensionOptions{}
+
+var generateExtensionCmd = &cobra.Command{
+	Use:   "extension NAME",
+	Args:  cobra.ExactArgs(1),
+	Short: "Generate the scaffolding of a Runtime Extension",
+	Long: LongDesc(`
+		Generate the scaffolding of a Runtime Extension.
+
+		clusterctl generates a Go project implementing the selected Runtime Hooks, including the hook handlers
+		with their unit tests, the wiring of the Runtime Extension server, a Dockerfile, a Makefile and the
+		manifests for deploying the Runtime Extension and registering it with an ExtensionConfig.
+
+		Existing files are never overwritten.`),
+
+	Example: Examples(`
+		# Generates a Runtime Extension implementing the topology mutation hooks.
+		clusterctl generate extension my-extension --hooks GeneratePatches,ValidateTopology,DiscoverVariables
+
+		# Generates a Runtime Extension implementing lifecycle hooks in a specific Go module and directory.
+		clusterctl generate extension my-extension --hooks BeforeClusterCreate,BeforeClusterDelete \
+			--module github.com/my-org/my-extension --output-dir ./my-extension`),
+
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runGenerateExtension(args[0])
+	},
+}
+
+func init() {
+	hookNames := []string{}
+	for _, h := range scaffold.Hooks() {
+		hookNames = append(hookNames, h.Name)
+	}
+
+	generateExtensionCmd.Flags().StringSliceVar(&geo.hooks, "hooks", nil,
+		fmt.Sprintf("Comma separated list of the Runtime Hooks implemented by the Runtime Extension. Supported hooks are %s.", strings.Join(hookNames, ", ")))
+	generateExtensionCmd.Flags().StringVar(&geo.module, "module", "",
+		"The Go module of the Runtime Extension project. If unspecified, example.com/NAME is used.")
+	generateExtensionCmd.Flags().StringVarP(&geo.namespace, "namespace", "n", "",
+		"The namespace where the Runtime Extension is deployed. If unspecified, NAME-system is used.")
+	generateExtensionCmd.Flags().StringVar(&geo.image, "image", "",
+		"The container image of the Runtime Extension. If unspecified, NAME:dev is used.")
+	generateExtensionCmd.Flags().StringVar(&geo.outputDir, "output-dir", "",
+		"The directory where the Runtime Extension project is generated. If unspecified, ./NAME is used.")
+
+	_ = generateExtensionCmd.MarkFlagRequired("hooks")
+
+	generateCmd.AddCommand(generateExtensionCmd)
+}
+
+func runGenerateExtension(name string) error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	paths, err := c.GenerateExtension(ctx, client.GenerateExtensionOptions{
+		Name:      name,
+		Module:    geo.module,
+		Namespace: geo.namespace,
+		Image:     geo.image,
+		Hooks:     geo.hooks,
+		OutputDir: geo.outputDir,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range paths {
+		fmt.Println(p)
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -31,6 +32,16 @@ type getKubeconfigOptions struct {
 	kubeconfig        string
 	kubeconfigContext string
 	namespace         string
+
+	execCommand    string
+	execArgs       []string
+	execEnv        []string
+	execAPIVersion string
+
+	oidcIssuerURL    string
+	oidcClientID     string
+	oidcClientSecret string
+	oidcExtraScopes  []string
 }
 
 var gk = &getKubeconfigOptions{}
@@ -46,7 +57,14 @@ var getKubeconfigCmd = &cobra.Command{
 		clusterctl get kubeconfig <name of workload cluster>
 
 		# Get the workload cluster's kubeconfig in a particular namespace.
-		clusterctl get kubeconfig <name of workload cluster> --namespace foo`),
+		clusterctl get kubeconfig <name of workload cluster> --namespace foo
+
+		# Get a kubeconfig for the workload cluster using an exec credential plugin instead of the admin client certificate.
+		clusterctl get kubeconfig <name of workload cluster> --exec-command kubectl \
+			--exec-arg oidc-login --exec-arg get-token --exec-arg=--oidc-issuer-url=https://issuer.example.com --exec-arg=--oidc-client-id=my-client
+
+		# Get a kubeconfig for the workload cluster using the OIDC auth provider instead of the admin client certificate.
+		clusterctl get kubeconfig <name of workload cluster> --oidc-issuer-url https://issuer.example.com --oidc-client-id my-client`),
 
 	Args: func(_ *cobra.Command, args []string) error {
 		if len(args) != 1 {
@@ -67,6 +85,27 @@ func init() {
 	getKubeconfigCmd.Flags().StringVar(&gk.kubeconfigContext, "kubeconfig-context", "",
 		"Context to be used within the kubeconfig file. If empty, current context will be used.")
 
+	getKubeconfigCmd.Flags().StringVar(&gk.execCommand, "exec-command", "",
+		"Command of the exec credential plugin to be used for authenticating to the workload cluster instead of the admin client certificate.")
+	getKubeconfigCmd.Flags().StringArrayVar(&gk.execArgs, "exec-arg", nil,
+		"Argument passed to the exec credential plugin. Can be repeated.")
+	getKubeconfigCmd.Flags().StringArrayVar(&gk.execEnv, "exec-env", nil,
+		"Environment variable in the form NAME=VALUE exposed to the exec credential plugin. Can be repeated.")
+	getKubeconfigCmd.Flags().StringVar(&gk.execAPIVersion, "exec-api-version", "",
+		"API version of the ExecCredential used by the exec credential plugin. If empty, client.authentication.k8s.io/v1 will be used.")
+
+	getKubeconfigCmd.Flags().StringVar(&gk.oidcIssuerURL, "oidc-issuer-url", "",
+		"URL of the OIDC issuer to be used for authenticating to the workload cluster instead of the admin client certificate.")
+	getKubeconfigCmd.Flags().StringVar(&gk.oidcClientID, "oidc-client-id", "",
+		"OIDC client ID.")
+	getKubeconfigCmd.Flags().StringVar(&gk.oidcClientSecret, "oidc-client-secret", "",
+		"OIDC client secret.")
+	getKubeconfigCmd.Flags().StringSliceVar(&gk.oidcExtraScopes, "oidc-extra-scopes", nil,
+		"Additional scopes to request to the OIDC issuer.")
+
+	getKubeconfigCmd.MarkFlagsMutuallyExclusive("exec-command", "oidc-issuer-url")
+	getKubeconfigCmd.MarkFlagsRequiredTogether("oidc-issuer-url", "oidc-client-id")
+
 	// completions
 	getKubeconfigCmd.ValidArgsFunction = resourceNameCompletionFunc(
 		getKubeconfigCmd.Flags().Lookup("kubeconfig"),
@@ -93,6 +132,32 @@ func runGetKubeconfig(workloadClusterName string) error {
 		Namespace:           gk.namespace,
 	}
 
+	if gk.execCommand != "" {
+		env := map[string]string{}
+		for _, e := range gk.execEnv {
+			name, value, ok := strings.Cut(e, "=")
+			if !ok || name == "" {
+				return errors.Errorf("invalid --exec-env %q: must be in the form NAME=VALUE", e)
+			}
+			env[name] = value
+		}
+		options.Exec = &client.KubeconfigExecOptions{
+			Command:    gk.execCommand,
+			Args:       gk.execArgs,
+			Env:        env,
+			APIVersion: gk.execAPIVersion,
+		}
+	}
+
+	if gk.oidcIssuerURL != "" {
+		options.OIDC = &client.KubeconfigOIDCOptions{
+			IssuerURL:    gk.oidcIssuerURL,
+			ClientID:     gk.oidcClientID,
+			ClientSecret: gk.oidcClientSecret,
+			ExtraScopes:  gk.oidcExtraScopes,
+		}
+	}
+
 	out, err := c.GetKubeconfig(ctx, options)
 	if err != nil {
 		return err
@@ -31,9 +31,13 @@ type moveOptions struct {
 	toKubeconfig          string
 	toKubeconfigContext   string
 	namespace             string
+	selector              string
+	clusters              []string
 	fromDirectory         string
 	toDirectory           string
 	dryRun                bool
+	resume                bool
+	rollback              bool
 }
 
 var mo = &moveOptions{}
@@ -51,11 +55,23 @@ var moveCmd = &cobra.Command{
 		Move Cluster API objects and all dependencies between management clusters.
 		clusterctl move --to-kubeconfig=target-kubeconfig.yaml
 
+		Move only the Clusters with the given label, together with all the objects they require.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --selector=environment=staging
+
+		Move only the given Clusters, together with all the objects they require.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --cluster=cluster1 --cluster=cluster2
+
 		Write Cluster API objects and all dependencies from a management cluster to directory.
 		clusterctl move --to-directory /tmp/backup-directory
 
 		Read Cluster API objects and all dependencies from a directory into a management cluster.
 		clusterctl move --from-directory /tmp/backup-directory
+
+		Complete a move that was interrupted.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --resume
+
+		Revert a move that was interrupted while creating objects in the target management cluster.
+		clusterctl move --to-kubeconfig=target-kubeconfig.yaml --rollback
 	`),
 	Args: cobra.NoArgs,
 	RunE: func(*cobra.Command, []string) error {
@@ -74,16 +90,29 @@ func init() {
 		"Context to be used within the kubeconfig file for the destination management cluster. If empty, current context will be used.")
 	moveCmd.Flags().StringVarP(&mo.namespace, "namespace", "n", "",
 		"The namespace where the workload cluster is hosted. If unspecified, the current context's namespace is used.")
+	moveCmd.Flags().StringVarP(&mo.selector, "selector", "l", "",
+		"Label selector for the Clusters to be moved, together with all the objects they require. If unspecified, all the Clusters are moved.")
+	moveCmd.Flags().StringSliceVar(&mo.clusters, "cluster", nil,
+		"Name of a Cluster to be moved, together with all the objects it requires. Can be repeated. If unspecified, all the Clusters are moved.")
 	moveCmd.Flags().BoolVar(&mo.dryRun, "dry-run", false,
-		"Enable dry run, don't really perform the move actions")
+		"Enable dry run, don't really perform the move actions but print the objects that would be moved")
 	moveCmd.Flags().StringVar(&mo.toDirectory, "to-directory", "",
 		"Write Cluster API objects and all dependencies from a management cluster to directory.")
 	moveCmd.Flags().StringVar(&mo.fromDirectory, "from-directory", "",
 		"Read Cluster API objects and all dependencies from a directory into a management cluster.")
+	moveCmd.Flags().BoolVar(&mo.resume, "resume", false,
+		"Complete a previously interrupted move to the destination management cluster.")
+	moveCmd.Flags().BoolVar(&mo.rollback, "rollback", false,
+		"Revert a previously interrupted move, deleting the objects created in the destination management cluster and resuming the objects in the source management cluster.")
 
 	moveCmd.MarkFlagsMutuallyExclusive("to-directory", "to-kubeconfig")
 	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "to-directory")
 	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "kubeconfig")
+	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "selector")
+	moveCmd.MarkFlagsMutuallyExclusive("from-directory", "cluster")
+	moveCmd.MarkFlagsMutuallyExclusive("resume", "rollback", "selector")
+	moveCmd.MarkFlagsMutuallyExclusive("resume", "rollback", "cluster")
+	moveCmd.MarkFlagsMutuallyExclusive("resume", "rollback", "dry-run", "to-directory", "from-directory")
 
 	RootCmd.AddCommand(moveCmd)
 }
@@ -98,6 +127,10 @@ func runMove() error {
 		return errors.New("please specify a target cluster using the --to-kubeconfig flag when not using --dry-run, --to-directory or --from-directory")
 	}
 
+	if (mo.resume || mo.rollback) && mo.toKubeconfig == "" {
+		return errors.New("please specify the target cluster of the interrupted move using the --to-kubeconfig flag")
+	}
+
 	c, err := client.New(ctx, cfgFile)
 	if err != nil {
 		return err
@@ -109,6 +142,10 @@ func runMove() error {
 		FromDirectory:  mo.fromDirectory,
 		ToDirectory:    mo.toDirectory,
 		Namespace:      mo.namespace,
+		Selector:       mo.selector,
+		Clusters:       mo.clusters,
 		DryRun:         mo.dryRun,
+		Resume:         mo.resume,
+		Rollback:       mo.rollback,
 	})
 }
@@ -0,0 +1,143 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+type topologyDiffOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	cluster           string
+	namespace         string
+	outDir            string
+}
+
+var td = &topologyDiffOptions{}
+
+var topologyDiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "List the pending changes of a cluster that uses a managed topology",
+	Long: LongDesc(`
+		Provide the list of objects that the topology controller would create, modify and delete on the next reconcile
+		of a Cluster in the management cluster, together with the fields of the templates contributed by each ClusterClass patch.
+		Details about the objects that will be created and modified can be stored in a path passed using --output-directory.
+
+		Note: Clusters using a ClusterClass with external patches are not supported, because this command cannot call Runtime Extensions.
+	`),
+	Example: Examples(`
+		# List the pending changes of the cluster "cluster1" in the current namespace.
+		clusterctl alpha topology diff --cluster cluster1
+
+		# List the pending changes of the cluster "cluster1" in the "ns1" namespace and write details to the output directory.
+		clusterctl alpha topology diff --cluster cluster1 -n ns1 -o output/
+	`),
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return runTopologyDiff()
+	},
+}
+
+func init() {
+	topologyDiffCmd.Flags().StringVar(&td.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig for the management cluster. If unspecified, default discovery rules apply.")
+	topologyDiffCmd.Flags().StringVar(&td.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+
+	topologyDiffCmd.Flags().StringVarP(&td.cluster, "cluster", "c", "", "name of the target cluster")
+	topologyDiffCmd.Flags().StringVarP(&td.namespace, "namespace", "n", "", "namespace of the target cluster. If unspecified, the current namespace will be used")
+	topologyDiffCmd.Flags().StringVarP(&td.outDir, "output-directory", "o", "", "output directory to write details about created/modified objects")
+
+	if err := topologyDiffCmd.MarkFlagRequired("cluster"); err != nil {
+		panic(err)
+	}
+
+	topologyCmd.AddCommand(topologyDiffCmd)
+}
+
+func runTopologyDiff() error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	out, err := c.TopologyDiff(ctx, client.TopologyDiffOptions{
+		Kubeconfig: client.Kubeconfig{Path: td.kubeconfig, Context: td.kubeconfigContext},
+		Cluster:    td.cluster,
+		Namespace:  td.namespace,
+	})
+	if err != nil {
+		return err
+	}
+	return printTopologyDiffOutput(out, td.outDir)
+}
+
+func printTopologyDiffOutput(out *cluster.TopologyDiffOutput, outDir string) error {
+	printChangeSummary(out.Cluster, out.ChangeSummary)
+	printPatchContributions(out.PatchContributions)
+	if outDir != "" {
+		if err := writeOutputFiles(out.ChangeSummary, outDir); err != nil {
+			return pkgerrors.Wrap(err, "failed to write output files of target cluster changes")
+		}
+	}
+	fmt.Printf("\n")
+	return nil
+}
+
+func printPatchContributions(contributions []cluster.PatchContribution) {
+	if len(contributions) == 0 {
+		fmt.Printf("No fields contributed by ClusterClass patches.\n")
+		return
+	}
+
+	fmt.Printf("Fields contributed by ClusterClass patches: \n")
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Patch", "Holder", "Template", "Fields"})
+	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetAutoWrapText(false)
+	table.SetCenterSeparator("")
+	table.SetColumnSeparator("")
+	table.SetRowSeparator("")
+	table.SetHeaderLine(false)
+	table.SetBorder(false)
+
+	for _, c := range contributions {
+		table.Append([]string{
+			c.Patch,
+			fmt.Sprintf("%s/%s", c.HolderKind, c.HolderName),
+			c.HolderFieldPath,
+			strings.Join(c.Paths, ", "),
+		})
+	}
+	fmt.Printf("\n")
+	table.Render()
+	fmt.Printf("\n")
+}
@@ -155,8 +155,8 @@ func printTopologyPlanOutput(out *cluster.TopologyPlanOutput, outdir string) err
 	if out.ReconciledCluster == nil {
 		fmt.Printf("No target cluster identified. Use --cluster to specify a target cluster to get detailed changes.")
 	} else {
-		printChangeSummary(out)
-		if err := writeOutputFiles(out, outdir); err != nil {
+		printChangeSummary(*out.ReconciledCluster, out.ChangeSummary)
+		if err := writeOutputFiles(out.ChangeSummary, outdir); err != nil {
 			return pkgerrors.Wrap(err, "failed to write output files of target cluster changes")
 		}
 	}
@@ -190,13 +190,13 @@ func printAffectedClusters(out *cluster.TopologyPlanOutput) {
 	fmt.Printf("\n")
 }
 
-func printChangeSummary(out *cluster.TopologyPlanOutput) {
+func printChangeSummary(clusterKey crclient.ObjectKey, out *cluster.ChangeSummary) {
 	if len(out.Created) == 0 && len(out.Modified) == 0 && len(out.Deleted) == 0 {
-		fmt.Printf("No changes detected for Cluster %q.\n", fmt.Sprintf("%s/%s", out.ReconciledCluster.Namespace, out.ReconciledCluster.Name))
+		fmt.Printf("No changes detected for Cluster %q.\n", fmt.Sprintf("%s/%s", clusterKey.Namespace, clusterKey.Name))
 		return
 	}
 
-	fmt.Printf("Changes for Cluster %q: \n", fmt.Sprintf("%s/%s", out.ReconciledCluster.Namespace, out.ReconciledCluster.Name))
+	fmt.Printf("Changes for Cluster %q: \n", fmt.Sprintf("%s/%s", clusterKey.Namespace, clusterKey.Name))
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader([]string{"Namespace", "Kind", "Name", "Action"})
 	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
@@ -228,7 +228,7 @@ func printChangeSummary(out *cluster.TopologyPlanOutput) {
 	fmt.Printf("\n")
 }
 
-func writeOutputFiles(out *cluster.TopologyPlanOutput, outDir string) error {
+func writeOutputFiles(out *cluster.ChangeSummary, outDir string) error {
 	if _, err := os.Stat(outDir); os.IsNotExist(err) {
 		return fmt.Errorf("output directory %q does not exist", outDir)
 	}
@@ -125,8 +125,21 @@ func runUpgradePlan() error {
 		}
 		fmt.Println("")
 
+		blocked := false
+		if len(plan.CompatibilityIssues) > 0 {
+			fmt.Println("The following compatibility issues were detected:")
+			fmt.Println("")
+			for _, issue := range plan.CompatibilityIssues {
+				fmt.Printf("- %s\n", issue)
+				blocked = blocked || issue.Blocking
+			}
+			fmt.Println("")
+		}
+
 		if upgradeAvailable {
-			if plan.Contract == clusterv1.GroupVersion.Version {
+			if blocked {
+				fmt.Println("The upgrade can't be applied until the errors above are fixed.")
+			} else if plan.Contract == clusterv1.GroupVersion.Version {
 				fmt.Println("You can now apply the upgrade by executing the following command:")
 				fmt.Println("")
 				fmt.Printf("clusterctl upgrade apply --contract %s\n", plan.Contract)
@@ -35,6 +35,7 @@ import (
 	machinedeploymenttopologycontroller "sigs.k8s.io/cluster-api/internal/controllers/topology/machinedeployment"
 	machinesettopologycontroller "sigs.k8s.io/cluster-api/internal/controllers/topology/machineset"
 	runtimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 // Following types provides access to reconcilers implemented in internal/controllers, thus
@@ -48,6 +49,9 @@ type ClusterReconciler struct {
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
 }
 
 func (r *ClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -56,6 +60,7 @@ func (r *ClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manag
 		UnstructuredCachingClient: r.UnstructuredCachingClient,
 		APIReader:                 r.APIReader,
 		WatchFilterValue:          r.WatchFilterValue,
+		Shard:                     r.Shard,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -69,6 +74,9 @@ type MachineReconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
+
 	// NodeDrainClientTimeout timeout of the client used for draining nodes.
 	NodeDrainClientTimeout time.Duration
 }
@@ -80,6 +88,7 @@ func (r *MachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manag
 		APIReader:                 r.APIReader,
 		Tracker:                   r.Tracker,
 		WatchFilterValue:          r.WatchFilterValue,
+		Shard:                     r.Shard,
 		NodeDrainClientTimeout:    r.NodeDrainClientTimeout,
 	}).SetupWithManager(ctx, mgr, options)
 }
@@ -91,8 +100,13 @@ type MachineSetReconciler struct {
 	APIReader                 client.Reader
 	Tracker                   *remote.ClusterCacheTracker
 
+	RuntimeClient runtimeclient.Client
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
 }
 
 func (r *MachineSetReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -101,7 +115,9 @@ func (r *MachineSetReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 		UnstructuredCachingClient: r.UnstructuredCachingClient,
 		APIReader:                 r.APIReader,
 		Tracker:                   r.Tracker,
+		RuntimeClient:             r.RuntimeClient,
 		WatchFilterValue:          r.WatchFilterValue,
+		Shard:                     r.Shard,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -113,6 +129,9 @@ type MachineDeploymentReconciler struct {
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
 }
 
 func (r *MachineDeploymentReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -121,6 +140,7 @@ func (r *MachineDeploymentReconciler) SetupWithManager(ctx context.Context, mgr
 		UnstructuredCachingClient: r.UnstructuredCachingClient,
 		APIReader:                 r.APIReader,
 		WatchFilterValue:          r.WatchFilterValue,
+		Shard:                     r.Shard,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -131,6 +151,9 @@ type MachineHealthCheckReconciler struct {
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
 }
 
 func (r *MachineHealthCheckReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -138,6 +161,7 @@ func (r *MachineHealthCheckReconciler) SetupWithManager(ctx context.Context, mgr
 		Client:           r.Client,
 		Tracker:          r.Tracker,
 		WatchFilterValue: r.WatchFilterValue,
+		Shard:            r.Shard,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -154,6 +178,9 @@ type ClusterTopologyReconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
+
 	// UnstructuredCachingClient provides a client that forces caching of unstructured objects,
 	// thus allowing to optimize reads for templates or provider specific objects in a managed topology.
 	UnstructuredCachingClient client.Client
@@ -167,6 +194,7 @@ func (r *ClusterTopologyReconciler) SetupWithManager(ctx context.Context, mgr ct
 		RuntimeClient:             r.RuntimeClient,
 		UnstructuredCachingClient: r.UnstructuredCachingClient,
 		WatchFilterValue:          r.WatchFilterValue,
+		Shard:                     r.Shard,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -180,6 +208,9 @@ type MachineDeploymentTopologyReconciler struct {
 	// race conditions caused by an outdated cache.
 	APIReader        client.Reader
 	WatchFilterValue string
+
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
 }
 
 func (r *MachineDeploymentTopologyReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -187,6 +218,7 @@ func (r *MachineDeploymentTopologyReconciler) SetupWithManager(ctx context.Conte
 		Client:           r.Client,
 		APIReader:        r.APIReader,
 		WatchFilterValue: r.WatchFilterValue,
+		Shard:            r.Shard,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -200,6 +232,9 @@ type MachineSetTopologyReconciler struct {
 	// race conditions caused by an outdated cache.
 	APIReader        client.Reader
 	WatchFilterValue string
+
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
 }
 
 func (r *MachineSetTopologyReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -207,6 +242,7 @@ func (r *MachineSetTopologyReconciler) SetupWithManager(ctx context.Context, mgr
 		Client:           r.Client,
 		APIReader:        r.APIReader,
 		WatchFilterValue: r.WatchFilterValue,
+		Shard:            r.Shard,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
@@ -98,6 +98,18 @@ type ClusterCacheTracker struct {
 	// This information will be used to detected if the controller is running on a workload cluster, so
 	// that we can then access the apiserver directly.
 	controllerPodMetadata *metav1.ObjectMeta
+
+	// clientQPS and clientBurst are the rate limits of the clients for the workload clusters.
+	clientQPS   float32
+	clientBurst int
+
+	// healthCheckInterval and healthCheckTimeout configure the health checks of the workload clusters.
+	healthCheckInterval time.Duration
+	healthCheckTimeout  time.Duration
+
+	// accessorCreationSlots limits the number of clusterAccessors created at the same time.
+	// It is nil if the number of clusterAccessors created at the same time is not limited.
+	accessorCreationSlots chan struct{}
 }
 
 // ClusterCacheTrackerOptions defines options to configure
@@ -122,6 +134,29 @@ type ClusterCacheTrackerOptions struct {
 	// This is used to calculate the user agent string.
 	// If not set, it defaults to "cluster-cache-tracker".
 	ControllerName string
+
+	// ClientQPS is the maximum queries per second from the clients of the ClusterCacheTracker
+	// to the API server of each workload cluster.
+	// If not set, the client-go default is used.
+	ClientQPS float32
+
+	// ClientBurst is the maximum number of queries that should be allowed in one burst from the clients
+	// of the ClusterCacheTracker to the API server of each workload cluster.
+	// If not set, the client-go default is used.
+	ClientBurst int
+
+	// HealthCheckInterval is the interval between the health checks of each workload cluster.
+	// Defaults to 10s if not set.
+	HealthCheckInterval time.Duration
+
+	// HealthCheckTimeout is the timeout of each health check request to a workload cluster.
+	// Defaults to 5s if not set.
+	HealthCheckTimeout time.Duration
+
+	// MaxConcurrentAccessorCreations is the maximum number of connections to workload clusters
+	// created at the same time; creating a connection includes waiting for the initial sync of its cache.
+	// If not set, the number of connections created at the same time is not limited.
+	MaxConcurrentAccessorCreations int
 }
 
 func setDefaultOptions(opts *ClusterCacheTrackerOptions) {
@@ -165,6 +200,11 @@ func NewClusterCacheTracker(manager ctrl.Manager, options ClusterCacheTrackerOpt
 		options.Log.Info("Couldn't find controller pod metadata, the ClusterCacheTracker will always access clusters using the regular apiserver endpoint")
 	}
 
+	var accessorCreationSlots chan struct{}
+	if options.MaxConcurrentAccessorCreations > 0 {
+		accessorCreationSlots = make(chan struct{}, options.MaxConcurrentAccessorCreations)
+	}
+
 	return &ClusterCacheTracker{
 		controllerName:        controllerName,
 		controllerPodMetadata: controllerPodMetadata,
@@ -176,6 +216,11 @@ func NewClusterCacheTracker(manager ctrl.Manager, options ClusterCacheTrackerOpt
 		clusterAccessors:      make(map[client.ObjectKey]*clusterAccessor),
 		clusterLock:           newKeyedMutex(),
 		indexes:               options.Indexes,
+		clientQPS:             options.ClientQPS,
+		clientBurst:           options.ClientBurst,
+		healthCheckInterval:   options.HealthCheckInterval,
+		healthCheckTimeout:    options.HealthCheckTimeout,
+		accessorCreationSlots: accessorCreationSlots,
 	}, nil
 }
 
@@ -277,6 +322,16 @@ func (t *ClusterCacheTracker) getClusterAccessor(ctx context.Context, cluster cl
 		return accessor, nil
 	}
 
+	// If the number of clusterAccessors created at the same time is limited, wait for a free slot.
+	if t.accessorCreationSlots != nil {
+		select {
+		case t.accessorCreationSlots <- struct{}{}:
+			defer func() { <-t.accessorCreationSlots }()
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "failed to create cluster accessor: failed waiting for other cluster accessors to be created")
+		}
+	}
+
 	// We are the go routine who has to initialize the clusterAccessor.
 	log.V(4).Info("Creating new cluster accessor")
 	accessor, err := t.newClusterAccessor(ctx, cluster, indexes...)
@@ -303,6 +358,12 @@ func (t *ClusterCacheTracker) newClusterAccessor(ctx context.Context, cluster cl
 	if err != nil {
 		return nil, errors.Wrapf(err, "error fetching REST client config for remote cluster %q", cluster.String())
 	}
+	if t.clientQPS > 0 {
+		config.QPS = t.clientQPS
+	}
+	if t.clientBurst > 0 {
+		config.Burst = t.clientBurst
+	}
 
 	// Create a http client and a mapper for the cluster.
 	httpClient, mapper, err := t.createHTTPClientAndMapper(config, cluster)
@@ -503,9 +564,11 @@ func (t *ClusterCacheTracker) createCachedClient(ctx context.Context, config *re
 
 	// Start cluster healthcheck!!!
 	go t.healthCheckCluster(cacheCtx, &healthCheckInput{
-		cluster:    cluster,
-		cfg:        config,
-		httpClient: httpClient,
+		cluster:        cluster,
+		cfg:            config,
+		httpClient:     httpClient,
+		interval:       t.healthCheckInterval,
+		requestTimeout: t.healthCheckTimeout,
 	})
 
 	return &cachedClientOutput{
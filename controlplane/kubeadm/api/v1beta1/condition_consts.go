@@ -36,6 +36,26 @@ const (
 	CertificatesGenerationFailedReason = "CertificatesGenerationFailed"
 )
 
+const (
+	// CertificatesExpiringSoonCondition documents that the certificates of one or more machines controlled by the
+	// KubeadmControlPlane expire within the threshold defined by rolloutBefore.certificatesExpiryDays, or within
+	// DefaultCertificatesExpiringSoonDays if automatic rollout before certificates expiry is not configured.
+	// NOTE: Differently from other conditions, this condition is true when action may be required; it is not
+	// considered when computing the Ready condition.
+	CertificatesExpiringSoonCondition clusterv1.ConditionType = "CertificatesExpiringSoon"
+
+	// CertificatesRotationInProgressReason documents that the certificates of one or more machines expire soon
+	// and KCP is rotating them by rolling out the machines, as configured in rolloutBefore.certificatesExpiryDays.
+	CertificatesRotationInProgressReason = "CertificatesRotationInProgress"
+
+	// CertificatesExpiringSoonReason documents that the certificates of one or more machines expire soon
+	// and automatic rollout before certificates expiry is not configured; manual action is required.
+	CertificatesExpiringSoonReason = "CertificatesExpiringSoon"
+
+	// CertificatesNotExpiringSoonReason (Severity=Info) documents that no machine has certificates expiring soon.
+	CertificatesNotExpiringSoonReason = "CertificatesNotExpiringSoon"
+)
+
 const (
 	// AvailableCondition documents that the first control plane instance has completed the kubeadm init operation
 	// and so the control plane is available and an API server instance is ready for processing requests.
@@ -54,6 +74,10 @@ const (
 	// RollingUpdateInProgressReason (Severity=Warning) documents a KubeadmControlPlane object executing a
 	// rolling upgrade for aligning the machines spec to the desired state.
 	RollingUpdateInProgressReason = "RollingUpdateInProgress"
+
+	// WaitingForMachineDeletionReason (Severity=Info) documents a KubeadmControlPlane using the OnDelete rollout
+	// strategy waiting for an operator to delete the machines with an outdated spec.
+	WaitingForMachineDeletionReason = "WaitingForMachineDeletion"
 )
 
 const (
@@ -132,6 +156,15 @@ const (
 	// EtcdMemberUnhealthyReason (Severity=Error) documents a Machine's etcd member is unhealthy.
 	EtcdMemberUnhealthyReason = "EtcdMemberUnhealthy"
 
+	// EtcdMaintenanceSucceededCondition documents the result of the last etcd maintenance performed by KCP;
+	// when this condition is true, the message reports the size of the database of the etcd members.
+	// NOTE: This conditions exists only if a stacked etcd cluster is used and etcd maintenance is configured.
+	EtcdMaintenanceSucceededCondition clusterv1.ConditionType = "EtcdMaintenanceSucceeded"
+
+	// EtcdMaintenanceFailedReason (Severity=Warning) documents a failure while performing etcd maintenance
+	// operations, e.g. defragmentation; the maintenance is retried at the next reconcile.
+	EtcdMaintenanceFailedReason = "EtcdMaintenanceFailed"
+
 	// MachinesCreatedCondition documents that the machines controlled by the KubeadmControlPlane are created.
 	// When this condition is false, it indicates that there was an error when cloning the infrastructure/bootstrap template or
 	// when generating the machine object.
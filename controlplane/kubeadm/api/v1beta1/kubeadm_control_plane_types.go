@@ -35,6 +35,10 @@ const (
 	// RollingUpdateStrategyType replaces the old control planes by new one using rolling update
 	// i.e. gradually scale up or down the old control planes and scale up or down the new one.
 	RollingUpdateStrategyType RolloutStrategyType = "RollingUpdate"
+
+	// OnDeleteStrategyType replaces the old control planes only when they are deleted by an operator
+	// i.e. a new control plane with the current spec is created only after an old one has been deleted.
+	OnDeleteStrategyType RolloutStrategyType = "OnDelete"
 )
 
 const (
@@ -58,6 +62,11 @@ const (
 	// failures in updating remediation retry or remediation count (both counters restart from zero).
 	RemediationInProgressAnnotation = "controlplane.cluster.x-k8s.io/remediation-in-progress"
 
+	// PreTerminateHookCleanupAnnotation is the pre-terminate hook set by KCP on its machines when using the OnDelete
+	// rollout strategy; the hook allows KCP to remove the etcd member and the kubeadm ClusterStatus entry of a machine
+	// deleted by an operator before its infrastructure is deleted.
+	PreTerminateHookCleanupAnnotation = clusterv1.PreTerminateDeleteHookAnnotationPrefix + "/kcp-cleanup"
+
 	// RemediationForAnnotation is used to link a new machine to the unhealthy machine it is replacing;
 	// please note that in case of retry, when also the remediating machine fails, the system keeps track of
 	// the first machine of the sequence only.
@@ -68,6 +77,10 @@ const (
 	// DefaultMinHealthyPeriod defines the default minimum period before we consider a remediation on a
 	// machine unrelated from the previous remediation.
 	DefaultMinHealthyPeriod = 1 * time.Hour
+
+	// DefaultCertificatesExpiringSoonDays defines the default number of days before the certificates of a machine
+	// expire from which the CertificatesExpiringSoon condition is reported, if rolloutBefore.certificatesExpiryDays is not set.
+	DefaultCertificatesExpiringSoonDays = 30
 )
 
 // KubeadmControlPlaneSpec defines the desired state of KubeadmControlPlane.
@@ -117,6 +130,12 @@ type KubeadmControlPlaneSpec struct {
 	// The RemediationStrategy that controls how control plane machine remediation happens.
 	// +optional
 	RemediationStrategy *RemediationStrategy `json:"remediationStrategy,omitempty"`
+
+	// EtcdMaintenance defines the maintenance operations performed periodically by KCP on the etcd members it manages,
+	// e.g. defragmentation; if not set, no maintenance operations are performed.
+	// NOTE: This field is considered only when KCP manages etcd (stacked etcd).
+	// +optional
+	EtcdMaintenance *EtcdMaintenance `json:"etcdMaintenance,omitempty"`
 }
 
 // KubeadmControlPlaneMachineTemplate defines the template for Machines
@@ -153,6 +172,7 @@ type KubeadmControlPlaneMachineTemplate struct {
 type RolloutBefore struct {
 	// CertificatesExpiryDays indicates a rollout needs to be performed if the
 	// certificates of the machine will expire within the specified days.
+	// The same threshold is used to report the CertificatesExpiringSoon condition.
 	// +optional
 	CertificatesExpiryDays *int32 `json:"certificatesExpiryDays,omitempty"`
 }
@@ -160,8 +180,8 @@ type RolloutBefore struct {
 // RolloutStrategy describes how to replace existing machines
 // with new ones.
 type RolloutStrategy struct {
-	// Type of rollout. Currently the only supported strategy is
-	// "RollingUpdate".
+	// Type of rollout. Supported strategies are "RollingUpdate" and "OnDelete".
+	// With "OnDelete", machines with an outdated spec are replaced only after they are deleted by an operator.
 	// Default is RollingUpdate.
 	// +optional
 	Type RolloutStrategyType `json:"type,omitempty"`
@@ -228,6 +248,37 @@ type RemediationStrategy struct {
 	MinHealthyPeriod *metav1.Duration `json:"minHealthyPeriod,omitempty"`
 }
 
+// EtcdMaintenance defines the maintenance operations performed periodically by KCP on the etcd members it manages.
+// Maintenance operations are performed only when the control plane is stable, i.e. when KCP is not scaling,
+// rolling out or remediating machines, and within the maintenance window, if defined.
+type EtcdMaintenance struct {
+	// DefragmentationInterval is the interval between two defragmentations of the etcd members.
+	// Members are defragmented one at a time, the leader last, given that a member cannot serve
+	// requests while it is being defragmented.
+	// +required
+	DefragmentationInterval metav1.Duration `json:"defragmentationInterval"`
+
+	// MaintenanceWindow defines the daily window during which maintenance operations can be performed;
+	// if not set, maintenance operations can be performed at any time.
+	// +optional
+	MaintenanceWindow *EtcdMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// ClearAlarms defines if KCP should disarm the NOSPACE alarms of the etcd members after defragmentation.
+	// NOTE: CORRUPT alarms are never disarmed, given that they require manual intervention.
+	// +optional
+	ClearAlarms bool `json:"clearAlarms,omitempty"`
+}
+
+// EtcdMaintenanceWindow defines a daily window during which etcd maintenance operations can be performed.
+type EtcdMaintenanceWindow struct {
+	// StartTime is the time of the day the maintenance window starts, in UTC, in the "HH:MM" format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	StartTime string `json:"startTime"`
+
+	// Duration is the duration of the maintenance window.
+	Duration metav1.Duration `json:"duration"`
+}
+
 // KubeadmControlPlaneStatus defines the observed state of KubeadmControlPlane.
 type KubeadmControlPlaneStatus struct {
 	// Selector is the label selector in string format to avoid introspection
@@ -297,6 +348,10 @@ type KubeadmControlPlaneStatus struct {
 	// LastRemediation stores info about last remediation performed.
 	// +optional
 	LastRemediation *LastRemediationStatus `json:"lastRemediation,omitempty"`
+
+	// EtcdMaintenance stores info about the last etcd maintenance performed.
+	// +optional
+	EtcdMaintenance *EtcdMaintenanceStatus `json:"etcdMaintenance,omitempty"`
 }
 
 // LastRemediationStatus  stores info about last remediation performed.
@@ -314,6 +369,29 @@ type LastRemediationStatus struct {
 	RetryCount int32 `json:"retryCount"`
 }
 
+// EtcdMaintenanceStatus stores info about the last etcd maintenance performed.
+type EtcdMaintenanceStatus struct {
+	// LastDefragmentationTime is when the last defragmentation of all the etcd members completed.
+	// +optional
+	LastDefragmentationTime *metav1.Time `json:"lastDefragmentationTime,omitempty"`
+
+	// Members stores the size of the database of the etcd members as of the last defragmentation.
+	// +optional
+	Members []EtcdMemberMaintenanceStatus `json:"members,omitempty"`
+}
+
+// EtcdMemberMaintenanceStatus stores the size of the database of an etcd member.
+type EtcdMemberMaintenanceStatus struct {
+	// Name is the name of the etcd member.
+	Name string `json:"name"`
+
+	// DBSize is the physically allocated size of the database of the member, in bytes.
+	DBSize int64 `json:"dbSize"`
+
+	// DBSizeInUse is the logical size of the database actually in use by the member, in bytes.
+	DBSizeInUse int64 `json:"dbSizeInUse"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=kubeadmcontrolplanes,shortName=kcp,scope=Namespaced,categories=cluster-api
 // +kubebuilder:storageversion
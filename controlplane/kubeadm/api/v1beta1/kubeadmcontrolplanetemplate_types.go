@@ -101,6 +101,12 @@ type KubeadmControlPlaneTemplateResourceSpec struct {
 	// The RemediationStrategy that controls how control plane machine remediation happens.
 	// +optional
 	RemediationStrategy *RemediationStrategy `json:"remediationStrategy,omitempty"`
+
+	// EtcdMaintenance defines the maintenance operations performed periodically by KCP on the etcd members it manages,
+	// e.g. defragmentation; if not set, no maintenance operations are performed.
+	// NOTE: This field is considered only when KCP manages etcd (stacked etcd).
+	// +optional
+	EtcdMaintenance *EtcdMaintenance `json:"etcdMaintenance,omitempty"`
 }
 
 // KubeadmControlPlaneTemplateMachineTemplate defines the template for Machines
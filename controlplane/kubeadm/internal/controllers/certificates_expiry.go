@@ -0,0 +1,106 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func init() {
+	// Register the metrics at the controller-runtime metrics registry.
+	ctrlmetrics.Registry.MustRegister(machineCertificatesExpiry)
+}
+
+// machineCertificatesExpiry reports the expiry date of the certificates of the machines controlled by a KubeadmControlPlane.
+var machineCertificatesExpiry = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Subsystem: "capi_kubeadm_control_plane",
+	Name:      "machine_certificates_expiry_timestamp_seconds",
+	Help:      "Expiry date of the certificates of the control plane machines, as a Unix timestamp in seconds.",
+}, []string{"namespace", "cluster", "kubeadmcontrolplane", "machine"})
+
+// reconcileCertificatesExpiringSoon reports the expiry date of the certificates of the control plane machines
+// as metrics, and sets the CertificatesExpiringSoon condition if the certificates of one or more machines expire
+// within the threshold defined by rolloutBefore.certificatesExpiryDays or DefaultCertificatesExpiringSoonDays.
+func reconcileCertificatesExpiringSoon(controlPlane *internal.ControlPlane, now time.Time) {
+	kcp := controlPlane.KCP
+
+	// Drop the metrics of the machines which are now gone.
+	machineCertificatesExpiry.DeletePartialMatch(prometheus.Labels{"namespace": kcp.Namespace, "kubeadmcontrolplane": kcp.Name})
+	if !kcp.DeletionTimestamp.IsZero() {
+		return
+	}
+
+	threshold := time.Duration(controlplanev1.DefaultCertificatesExpiringSoonDays) * 24 * time.Hour
+	rotationEnabled := kcp.Spec.RolloutBefore != nil && kcp.Spec.RolloutBefore.CertificatesExpiryDays != nil
+	if rotationEnabled {
+		threshold = time.Duration(*kcp.Spec.RolloutBefore.CertificatesExpiryDays) * 24 * time.Hour
+	}
+
+	var (
+		earliestExpiry time.Time
+		expiringSoon   []string
+	)
+	machines := controlPlane.Machines.Filter(collections.Not(collections.HasDeletionTimestamp))
+	for _, m := range machines.UnsortedList() {
+		if m.Status.CertificatesExpiryDate == nil {
+			continue
+		}
+		expiry := m.Status.CertificatesExpiryDate.Time
+		machineCertificatesExpiry.WithLabelValues(kcp.Namespace, controlPlane.Cluster.Name, kcp.Name, m.Name).Set(float64(expiry.Unix()))
+
+		if earliestExpiry.IsZero() || expiry.Before(earliestExpiry) {
+			earliestExpiry = expiry
+		}
+		if now.Add(threshold).After(expiry) {
+			expiringSoon = append(expiringSoon, fmt.Sprintf("%s (expiring at %s)", m.Name, expiry.UTC().Format(time.RFC3339)))
+		}
+	}
+
+	// If the expiry date of the certificates is not known yet for any machine, there is nothing to report.
+	if earliestExpiry.IsZero() {
+		conditions.Delete(kcp, controlplanev1.CertificatesExpiringSoonCondition)
+		return
+	}
+
+	if len(expiringSoon) == 0 {
+		conditions.MarkFalse(kcp, controlplanev1.CertificatesExpiringSoonCondition, controlplanev1.CertificatesNotExpiringSoonReason, clusterv1.ConditionSeverityInfo,
+			"Certificates of control plane machines expire not before %s", earliestExpiry.UTC().Format(time.RFC3339))
+		return
+	}
+
+	sort.Strings(expiringSoon)
+	condition := conditions.TrueCondition(controlplanev1.CertificatesExpiringSoonCondition)
+	condition.Reason = controlplanev1.CertificatesExpiringSoonReason
+	condition.Message = fmt.Sprintf("Certificates of control plane machines expiring soon: %s; rollout the machines to rotate certificates", strings.Join(expiringSoon, ", "))
+	if rotationEnabled {
+		condition.Reason = controlplanev1.CertificatesRotationInProgressReason
+		condition.Message = fmt.Sprintf("Rolling out control plane machines with certificates expiring soon: %s", strings.Join(expiringSoon, ", "))
+	}
+	conditions.Set(kcp, condition)
+}
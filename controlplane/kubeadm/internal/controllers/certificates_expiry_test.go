@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileCertificatesExpiringSoon(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	machineWithCertificatesExpiry := func(name string, expiry *time.Time) *clusterv1.Machine {
+		m := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault}}
+		if expiry != nil {
+			m.Status.CertificatesExpiryDate = &metav1.Time{Time: *expiry}
+		}
+		return m
+	}
+	inDays := func(days int) *time.Time {
+		t := now.Add(time.Duration(days) * 24 * time.Hour)
+		return &t
+	}
+
+	tests := []struct {
+		name             string
+		rolloutBefore    *controlplanev1.RolloutBefore
+		deleting         bool
+		machines         []*clusterv1.Machine
+		wantCondition    bool
+		wantStatus       corev1.ConditionStatus
+		wantReason       string
+		wantMetricsCount int
+	}{
+		{
+			name:             "no condition if the certificates expiry is not known yet",
+			machines:         []*clusterv1.Machine{machineWithCertificatesExpiry("m1", nil)},
+			wantCondition:    false,
+			wantMetricsCount: 0,
+		},
+		{
+			name: "condition false if no certificates expire within the default threshold",
+			machines: []*clusterv1.Machine{
+				machineWithCertificatesExpiry("m1", inDays(60)),
+				machineWithCertificatesExpiry("m2", nil),
+			},
+			wantCondition:    true,
+			wantStatus:       corev1.ConditionFalse,
+			wantReason:       controlplanev1.CertificatesNotExpiringSoonReason,
+			wantMetricsCount: 1,
+		},
+		{
+			name: "condition true if certificates expire within the default threshold",
+			machines: []*clusterv1.Machine{
+				machineWithCertificatesExpiry("m1", inDays(60)),
+				machineWithCertificatesExpiry("m2", inDays(10)),
+			},
+			wantCondition:    true,
+			wantStatus:       corev1.ConditionTrue,
+			wantReason:       controlplanev1.CertificatesExpiringSoonReason,
+			wantMetricsCount: 2,
+		},
+		{
+			name:          "condition false if no certificates expire within rolloutBefore.certificatesExpiryDays",
+			rolloutBefore: &controlplanev1.RolloutBefore{CertificatesExpiryDays: ptr.To[int32](7)},
+			machines: []*clusterv1.Machine{
+				machineWithCertificatesExpiry("m1", inDays(10)),
+			},
+			wantCondition:    true,
+			wantStatus:       corev1.ConditionFalse,
+			wantReason:       controlplanev1.CertificatesNotExpiringSoonReason,
+			wantMetricsCount: 1,
+		},
+		{
+			name:          "condition true with rotation in progress if certificates expire within rolloutBefore.certificatesExpiryDays",
+			rolloutBefore: &controlplanev1.RolloutBefore{CertificatesExpiryDays: ptr.To[int32](90)},
+			machines: []*clusterv1.Machine{
+				machineWithCertificatesExpiry("m1", inDays(60)),
+			},
+			wantCondition:    true,
+			wantStatus:       corev1.ConditionTrue,
+			wantReason:       controlplanev1.CertificatesRotationInProgressReason,
+			wantMetricsCount: 1,
+		},
+		{
+			name:     "metrics are dropped when the KubeadmControlPlane is deleted",
+			deleting: true,
+			machines: []*clusterv1.Machine{
+				machineWithCertificatesExpiry("m1", inDays(10)),
+			},
+			wantCondition:    false,
+			wantMetricsCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			// Metrics of machines not existing anymore must be dropped.
+			machineCertificatesExpiry.WithLabelValues(metav1.NamespaceDefault, "cluster", "kcp", "old-machine").Set(1)
+
+			kcp := &controlplanev1.KubeadmControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "kcp", Namespace: metav1.NamespaceDefault},
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					RolloutBefore: tt.rolloutBefore,
+				},
+			}
+			if tt.deleting {
+				kcp.DeletionTimestamp = &metav1.Time{Time: now}
+			}
+			controlPlane := &internal.ControlPlane{
+				KCP:      kcp,
+				Cluster:  &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: metav1.NamespaceDefault}},
+				Machines: collections.FromMachines(tt.machines...),
+			}
+
+			reconcileCertificatesExpiringSoon(controlPlane, now)
+
+			g.Expect(testutil.CollectAndCount(machineCertificatesExpiry)).To(Equal(tt.wantMetricsCount))
+			for _, m := range tt.machines {
+				if m.Status.CertificatesExpiryDate != nil && !tt.deleting {
+					g.Expect(testutil.ToFloat64(machineCertificatesExpiry.WithLabelValues(metav1.NamespaceDefault, "cluster", "kcp", m.Name))).To(Equal(float64(m.Status.CertificatesExpiryDate.Unix())))
+				}
+			}
+
+			if !tt.wantCondition {
+				g.Expect(conditions.Has(kcp, controlplanev1.CertificatesExpiringSoonCondition)).To(BeFalse())
+				return
+			}
+			condition := conditions.Get(kcp, controlplanev1.CertificatesExpiringSoonCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantStatus))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+		})
+	}
+}
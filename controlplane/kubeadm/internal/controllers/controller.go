@@ -45,15 +45,16 @@ import (
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/feature"
-	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/collections"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/contract"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
 	"sigs.k8s.io/cluster-api/util/secret"
+	"sigs.k8s.io/cluster-api/util/shard"
 	"sigs.k8s.io/cluster-api/util/version"
 )
 
@@ -84,6 +85,9 @@ type KubeadmControlPlaneReconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
+
 	managementCluster         internal.ManagementCluster
 	managementClusterUncached internal.ManagementCluster
 	ssaCache                  ssa.Cache
@@ -95,6 +99,7 @@ func (r *KubeadmControlPlaneReconciler) SetupWithManager(ctx context.Context, mg
 		Owns(&clusterv1.Machine{}).
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
+		WithEventFilter(predicates.ResourceIsInShard(ctrl.LoggerFrom(ctx), r.Shard)).
 		Watches(
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(r.ClusterToKubeadmControlPlane),
@@ -329,6 +334,8 @@ func patchKubeadmControlPlane(ctx context.Context, patchHelper *patch.Helper, kc
 			controlplanev1.MachinesReadyCondition,
 			controlplanev1.AvailableCondition,
 			controlplanev1.CertificatesAvailableCondition,
+			controlplanev1.CertificatesExpiringSoonCondition,
+			controlplanev1.EtcdMaintenanceSucceededCondition,
 		}},
 		patch.WithStatusObservedGeneration{},
 	)
@@ -389,6 +396,11 @@ func (r *KubeadmControlPlaneReconciler) reconcile(ctx context.Context, controlPl
 		return ctrl.Result{}, err
 	}
 
+	// Cleans up etcd members and the kubeadm ClusterStatus of the machines deleted by an operator, if any.
+	if result, err := r.reconcilePreTerminateHook(ctx, controlPlane); err != nil || !result.IsZero() {
+		return result, err
+	}
+
 	// Reconcile unhealthy machines by triggering deletion and requeue if it is considered safe to remediate,
 	// otherwise continue with the other KCP operations.
 	if result, err := r.reconcileUnhealthyMachines(ctx, controlPlane); err != nil || !result.IsZero() {
@@ -404,7 +416,11 @@ func (r *KubeadmControlPlaneReconciler) reconcile(ctx context.Context, controlPl
 			reasons = append(reasons, rolloutReason)
 		}
 		log.Info(fmt.Sprintf("Rolling out Control Plane machines: %s", strings.Join(reasons, ",")), "machinesNeedingRollout", machinesNeedingRollout.Names())
-		conditions.MarkFalse(controlPlane.KCP, controlplanev1.MachinesSpecUpToDateCondition, controlplanev1.RollingUpdateInProgressReason, clusterv1.ConditionSeverityWarning, "Rolling %d replicas with outdated spec (%d replicas up to date)", len(machinesNeedingRollout), len(controlPlane.Machines)-len(machinesNeedingRollout))
+		if controlPlane.KCP.Spec.RolloutStrategy != nil && controlPlane.KCP.Spec.RolloutStrategy.Type == controlplanev1.OnDeleteStrategyType {
+			conditions.MarkFalse(controlPlane.KCP, controlplanev1.MachinesSpecUpToDateCondition, controlplanev1.WaitingForMachineDeletionReason, clusterv1.ConditionSeverityInfo, "Waiting for %d replicas with outdated spec to be deleted (%d replicas up to date)", len(machinesNeedingRollout), len(controlPlane.Machines)-len(machinesNeedingRollout))
+		} else {
+			conditions.MarkFalse(controlPlane.KCP, controlplanev1.MachinesSpecUpToDateCondition, controlplanev1.RollingUpdateInProgressReason, clusterv1.ConditionSeverityWarning, "Rolling %d replicas with outdated spec (%d replicas up to date)", len(machinesNeedingRollout), len(controlPlane.Machines)-len(machinesNeedingRollout))
+		}
 		return r.upgradeControlPlane(ctx, controlPlane, machinesNeedingRollout)
 	default:
 		// make sure last upgrade operation is marked as completed.
@@ -475,7 +491,10 @@ func (r *KubeadmControlPlaneReconciler) reconcile(ctx context.Context, controlPl
 	if err := r.reconcileCertificateExpiries(ctx, controlPlane); err != nil {
 		return ctrl.Result{}, err
 	}
-	return ctrl.Result{}, nil
+
+	// Perform etcd maintenance, if configured.
+	// Note: This is done at the end of the reconcile, when the control plane is not scaling nor rolling out machines.
+	return r.reconcileEtcdMaintenance(ctx, controlPlane)
 }
 
 // reconcileClusterCertificates ensures that all the cluster certificates exists and
@@ -518,6 +537,11 @@ func (r *KubeadmControlPlaneReconciler) reconcileDelete(ctx context.Context, con
 		return ctrl.Result{}, nil
 	}
 
+	// Remove the KCP pre-terminate hook from the deleting machines, if any.
+	if _, err := r.reconcilePreTerminateHook(ctx, controlPlane); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Updates conditions reporting the status of static pods and the status of the etcd cluster.
 	// NOTE: Ignoring failures given that we are deleting
 	if err := r.reconcileControlPlaneConditions(ctx, controlPlane); err != nil {
@@ -759,6 +783,108 @@ func (r *KubeadmControlPlaneReconciler) reconcileEtcdMembers(ctx context.Context
 	return nil
 }
 
+// reconcilePreTerminateHook removes the etcd member and the kubeadm ClusterStatus entry of control plane machines
+// deleted by an operator, e.g. when using the OnDelete rollout strategy, and then removes the KCP pre-terminate hook
+// so the deletion of the machines can complete.
+// Machines are cleaned up one at a time, only after they are drained and only if this does not lead to etcd quorum loss.
+func (r *KubeadmControlPlaneReconciler) reconcilePreTerminateHook(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	deletingMachines := controlPlane.Machines.Filter(
+		collections.HasDeletionTimestamp,
+		collections.HasAnnotationKey(controlplanev1.PreTerminateHookCleanupAnnotation),
+	)
+	if len(deletingMachines) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	// If the KubeadmControlPlane is being deleted, all the machines are deleted in parallel and there is
+	// no need to clean up etcd members, so the hook can be removed immediately.
+	if !controlPlane.KCP.DeletionTimestamp.IsZero() {
+		for _, m := range deletingMachines {
+			if err := r.removePreTerminateHook(ctx, m); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Pick the machine which has been deleted first.
+	var machine *clusterv1.Machine
+	for _, m := range deletingMachines {
+		if machine == nil || m.DeletionTimestamp.Before(machine.DeletionTimestamp) {
+			machine = m
+		}
+	}
+	log = log.WithValues("Machine", klog.KObj(machine))
+
+	// Wait for the machine to be drained and to reach the pre-terminate hook.
+	if !conditions.IsFalse(machine, clusterv1.PreTerminateDeleteHookSucceededCondition) {
+		log.V(3).Info("Waiting for the deleting Machine to reach the pre-terminate hook")
+		return ctrl.Result{RequeueAfter: deleteRequeueAfter}, nil
+	}
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile pre-terminate hook: cannot get remote client to workload cluster")
+	}
+
+	if controlPlane.IsEtcdManaged() {
+		remainingMachines := controlPlane.Machines.Filter(collections.Not(collections.HasDeletionTimestamp))
+		if remainingMachines.Len() == 0 {
+			return ctrl.Result{}, errors.Errorf("failed to remove etcd member for Machine %s: no other control plane machines exist", machine.Name)
+		}
+
+		// Remove the etcd member only if this does not lead to etcd quorum loss.
+		canSafelyRemove, err := r.canSafelyRemoveEtcdMember(ctx, controlPlane, machine)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !canSafelyRemove {
+			log.Info("Waiting for etcd members to be healthy before removing the etcd member of the deleting Machine, removing it now could result in etcd quorum loss")
+			return ctrl.Result{RequeueAfter: preflightFailedRequeueAfter}, nil
+		}
+
+		// If etcd leadership is on the machine that is about to be deleted, move it to the newest member available.
+		etcdLeaderCandidate := remainingMachines.Newest()
+		if err := workloadCluster.ForwardEtcdLeadership(ctx, machine, etcdLeaderCandidate); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "failed to move etcd leadership to candidate Machine %s", etcdLeaderCandidate.Name)
+		}
+		if err := workloadCluster.RemoveEtcdMemberForMachine(ctx, machine); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "failed to remove etcd member for Machine %s", machine.Name)
+		}
+	}
+
+	parsedVersion, err := semver.ParseTolerant(controlPlane.KCP.Spec.Version)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to parse kubernetes version %q", controlPlane.KCP.Spec.Version)
+	}
+	if err := workloadCluster.RemoveMachineFromKubeadmConfigMap(ctx, machine, parsedVersion); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to remove Machine %s from kubeadm ConfigMap", machine.Name)
+	}
+
+	if err := r.removePreTerminateHook(ctx, machine); err != nil {
+		return ctrl.Result{}, err
+	}
+	log.Info("Removed the etcd member and the kubeadm ClusterStatus entry of the deleting Machine")
+
+	// Requeue to clean up the other deleting machines, if any.
+	return ctrl.Result{RequeueAfter: deleteRequeueAfter}, nil
+}
+
+// removePreTerminateHook removes the KCP pre-terminate hook from the machine.
+func (r *KubeadmControlPlaneReconciler) removePreTerminateHook(ctx context.Context, machine *clusterv1.Machine) error {
+	patchHelper, err := patch.NewHelper(machine, r.Client)
+	if err != nil {
+		return errors.Wrapf(err, "failed to remove pre-terminate hook from Machine %s", machine.Name)
+	}
+	delete(machine.Annotations, controlplanev1.PreTerminateHookCleanupAnnotation)
+	if err := patchHelper.Patch(ctx, machine); err != nil {
+		return errors.Wrapf(err, "failed to remove pre-terminate hook from Machine %s", machine.Name)
+	}
+	return nil
+}
+
 func (r *KubeadmControlPlaneReconciler) reconcileCertificateExpiries(ctx context.Context, controlPlane *internal.ControlPlane) error {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -36,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -51,7 +52,6 @@ import (
 	controlplanev1webhooks "sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/webhooks"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/feature"
-	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/test/builder"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/internal/webhooks"
@@ -59,6 +59,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/certs"
 	"sigs.k8s.io/cluster-api/util/collections"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/contract"
 	"sigs.k8s.io/cluster-api/util/kubeconfig"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/secret"
@@ -1174,6 +1175,121 @@ func TestReconcileCertificateExpiries(t *testing.T) {
 	g.Expect(actualKubeadmConfig.Annotations).ToNot(ContainElement(clusterv1.MachineCertificatesExpiryDateAnnotation))
 }
 
+func TestKubeadmControlPlaneReconciler_reconcilePreTerminateHook(t *testing.T) {
+	machine := func(name string, opts ...func(m *clusterv1.Machine)) *clusterv1.Machine {
+		m := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				Annotations: map[string]string{
+					controlplanev1.PreTerminateHookCleanupAnnotation: "",
+				},
+			},
+			Status: clusterv1.MachineStatus{
+				NodeRef: &corev1.ObjectReference{Kind: "Node", Name: name},
+				Conditions: clusterv1.Conditions{
+					*conditions.TrueCondition(controlplanev1.MachineEtcdMemberHealthyCondition),
+				},
+			},
+		}
+		for _, opt := range opts {
+			opt(m)
+		}
+		return m
+	}
+	deleting := func(m *clusterv1.Machine) {
+		m.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		m.Finalizers = []string{clusterv1.MachineFinalizer}
+	}
+	waitingForHook := func(m *clusterv1.Machine) {
+		conditions.MarkFalse(m, clusterv1.PreTerminateDeleteHookSucceededCondition, clusterv1.WaitingExternalHookReason, clusterv1.ConditionSeverityInfo, "")
+	}
+	unhealthyEtcdMember := func(m *clusterv1.Machine) {
+		conditions.MarkFalse(m, controlplanev1.MachineEtcdMemberHealthyCondition, controlplanev1.EtcdMemberUnhealthyReason, clusterv1.ConditionSeverityError, "")
+	}
+
+	tests := []struct {
+		name               string
+		kcpDeleting        bool
+		machines           []*clusterv1.Machine
+		wantRequeue        bool
+		wantHookRemovedFor []string
+	}{
+		{
+			name:     "no-op if there are no deleting machines",
+			machines: []*clusterv1.Machine{machine("m1"), machine("m2"), machine("m3")},
+		},
+		{
+			name:        "wait for the deleting machine to reach the pre-terminate hook",
+			machines:    []*clusterv1.Machine{machine("m1", deleting), machine("m2"), machine("m3")},
+			wantRequeue: true,
+		},
+		{
+			name:               "remove the hook when the deleting machine reaches the pre-terminate hook",
+			machines:           []*clusterv1.Machine{machine("m1", deleting, waitingForHook), machine("m2"), machine("m3")},
+			wantRequeue:        true,
+			wantHookRemovedFor: []string{"m1"},
+		},
+		{
+			name:        "do not remove the hook if removing the etcd member could result in etcd quorum loss",
+			machines:    []*clusterv1.Machine{machine("m1", deleting, waitingForHook), machine("m2", unhealthyEtcdMember), machine("m3")},
+			wantRequeue: true,
+		},
+		{
+			name:               "remove the hook from all the deleting machines when the KubeadmControlPlane is deleted",
+			kcpDeleting:        true,
+			machines:           []*clusterv1.Machine{machine("m1", deleting), machine("m2", deleting), machine("m3", deleting)},
+			wantHookRemovedFor: []string{"m1", "m2", "m3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			objs := []client.Object{}
+			for _, m := range tt.machines {
+				objs = append(objs, m.DeepCopy())
+			}
+			fakeClient := newFakeClient(objs...)
+
+			kcp := &controlplanev1.KubeadmControlPlane{
+				ObjectMeta: metav1.ObjectMeta{Name: "kcp", Namespace: metav1.NamespaceDefault},
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					Replicas: ptr.To[int32](3),
+					Version:  "v1.29.0",
+				},
+			}
+			if tt.kcpDeleting {
+				kcp.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			}
+			controlPlane := &internal.ControlPlane{
+				KCP:      kcp,
+				Cluster:  &clusterv1.Cluster{},
+				Machines: collections.FromMachines(tt.machines...),
+			}
+			controlPlane.InjectTestManagementCluster(&fakeManagementCluster{
+				Workload: fakeWorkloadCluster{EtcdMembersResult: nodes(controlPlane.Machines)},
+			})
+
+			r := &KubeadmControlPlaneReconciler{Client: fakeClient}
+			result, err := r.reconcilePreTerminateHook(ctx, controlPlane)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.IsZero()).To(Equal(!tt.wantRequeue))
+
+			for _, m := range tt.machines {
+				gotMachine := &clusterv1.Machine{}
+				g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(m), gotMachine)).To(Succeed())
+				if sets.New[string](tt.wantHookRemovedFor...).Has(m.Name) {
+					g.Expect(gotMachine.Annotations).ToNot(HaveKey(controlplanev1.PreTerminateHookCleanupAnnotation))
+				} else {
+					g.Expect(gotMachine.Annotations).To(HaveKey(controlplanev1.PreTerminateHookCleanupAnnotation))
+				}
+			}
+		})
+	}
+}
+
 func TestReconcileInitializeControlPlane(t *testing.T) {
 	setup := func(t *testing.T, g *WithT) *corev1.Namespace {
 		t.Helper()
@@ -0,0 +1,164 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcileEtcdMaintenance performs the etcd maintenance operations defined in the KubeadmControlPlane, if any,
+// when the control plane is stable, the defragmentation interval since the last maintenance expired and
+// within the maintenance window, if defined.
+//
+// NOTE: this func should be called at the end of reconcile, when the control plane is not scaling nor rolling out machines.
+func (r *KubeadmControlPlaneReconciler) reconcileEtcdMaintenance(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	kcp := controlPlane.KCP
+
+	// If etcd maintenance is not configured or etcd is not managed by KCP this is a no-op.
+	if kcp.Spec.EtcdMaintenance == nil || !controlPlane.IsEtcdManaged() {
+		conditions.Delete(kcp, controlplanev1.EtcdMaintenanceSucceededCondition)
+		kcp.Status.EtcdMaintenance = nil
+		return ctrl.Result{}, nil
+	}
+	maintenance := kcp.Spec.EtcdMaintenance
+
+	// Maintenance operations are performed only when the control plane is stable.
+	if !isControlPlaneStableForEtcdMaintenance(controlPlane) {
+		log.V(3).Info("Waiting for the control plane to be stable before performing etcd maintenance")
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	if kcp.Status.EtcdMaintenance != nil && kcp.Status.EtcdMaintenance.LastDefragmentationTime != nil {
+		if wait := kcp.Status.EtcdMaintenance.LastDefragmentationTime.Add(maintenance.DefragmentationInterval.Duration).Sub(now); wait > 0 {
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+	}
+	if wait := etcdMaintenanceWindowWait(maintenance.MaintenanceWindow, now); wait > 0 {
+		log.V(3).Info("Waiting for the etcd maintenance window", "requeueIn", wait.Truncate(time.Second).String())
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile etcd maintenance: cannot get remote client to workload cluster")
+	}
+
+	log.Info("Defragmenting etcd members")
+	dbSizes, err := workloadCluster.DefragmentEtcdMembers(ctx, maintenance.ClearAlarms)
+	if err != nil {
+		conditions.MarkFalse(kcp, controlplanev1.EtcdMaintenanceSucceededCondition, controlplanev1.EtcdMaintenanceFailedReason, clusterv1.ConditionSeverityWarning, "%v", err)
+		return ctrl.Result{}, errors.Wrap(err, "failed to reconcile etcd maintenance")
+	}
+
+	lastDefragmentationTime := metav1.NewTime(now)
+	status := &controlplanev1.EtcdMaintenanceStatus{
+		LastDefragmentationTime: &lastDefragmentationTime,
+	}
+	dbSizeMessages := make([]string, 0, len(dbSizes))
+	for _, dbSize := range dbSizes {
+		status.Members = append(status.Members, controlplanev1.EtcdMemberMaintenanceStatus{
+			Name:        dbSize.Name,
+			DBSize:      dbSize.DBSize,
+			DBSizeInUse: dbSize.DBSizeInUse,
+		})
+		dbSizeMessages = append(dbSizeMessages, fmt.Sprintf("%s: %s (%s in use)", dbSize.Name, formatDBSize(dbSize.DBSize), formatDBSize(dbSize.DBSizeInUse)))
+	}
+	kcp.Status.EtcdMaintenance = status
+
+	condition := conditions.TrueCondition(controlplanev1.EtcdMaintenanceSucceededCondition)
+	condition.Message = fmt.Sprintf("Last defragmentation at %s, database size %s", lastDefragmentationTime.UTC().Format(time.RFC3339), strings.Join(dbSizeMessages, ", "))
+	conditions.Set(kcp, condition)
+
+	log.Info("Etcd members defragmented", "databaseSize", strings.Join(dbSizeMessages, ", "))
+	return ctrl.Result{RequeueAfter: maintenance.DefragmentationInterval.Duration}, nil
+}
+
+// isControlPlaneStableForEtcdMaintenance returns true if the control plane is not scaling, rolling out
+// nor remediating machines, and all the machines have a node.
+func isControlPlaneStableForEtcdMaintenance(controlPlane *internal.ControlPlane) bool {
+	if controlPlane.Machines.Len() == 0 || controlPlane.Machines.Len() != int(*controlPlane.KCP.Spec.Replicas) {
+		return false
+	}
+	if controlPlane.HasDeletingMachine() {
+		return false
+	}
+	if _, ok := controlPlane.KCP.Annotations[controlplanev1.RemediationInProgressAnnotation]; ok {
+		return false
+	}
+	for _, m := range controlPlane.Machines {
+		if m.Status.NodeRef == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// etcdMaintenanceWindowWait returns the time to wait for the next maintenance window to start,
+// or 0 if there is no maintenance window or the maintenance window is open.
+func etcdMaintenanceWindowWait(window *controlplanev1.EtcdMaintenanceWindow, now time.Time) time.Duration {
+	if window == nil {
+		return 0
+	}
+	startTime, err := time.Parse("15:04", window.StartTime)
+	if err != nil {
+		// NOTE: This should never happen given that StartTime is validated by the API server.
+		return 0
+	}
+
+	now = now.UTC()
+	todayStart := time.Date(now.Year(), now.Month(), now.Day(), startTime.Hour(), startTime.Minute(), 0, 0, time.UTC)
+
+	// Check both the window started today and the one started yesterday, which might still be open.
+	for _, start := range []time.Time{todayStart.AddDate(0, 0, -1), todayStart} {
+		if !now.Before(start) && now.Before(start.Add(window.Duration.Duration)) {
+			return 0
+		}
+	}
+
+	if now.Before(todayStart) {
+		return todayStart.Sub(now)
+	}
+	return todayStart.AddDate(0, 0, 1).Sub(now)
+}
+
+// formatDBSize formats a database size in bytes in a human readable format.
+func formatDBSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%dB", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileEtcdMaintenance(t *testing.T) {
+	machineWithNode := func(name string) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: clusterv1.MachineStatus{
+				NodeRef: &corev1.ObjectReference{Name: name},
+			},
+		}
+	}
+	maintenance := &controlplanev1.EtcdMaintenance{
+		DefragmentationInterval: metav1.Duration{Duration: 24 * time.Hour},
+	}
+	dbSizes := []internal.EtcdMemberDBSize{
+		{Name: "m1", DBSize: 2 * 1024 * 1024, DBSizeInUse: 1024 * 1024},
+	}
+
+	tests := []struct {
+		name                string
+		maintenance         *controlplanev1.EtcdMaintenance
+		status              *controlplanev1.EtcdMaintenanceStatus
+		machines            []*clusterv1.Machine
+		workload            fakeWorkloadCluster
+		wantErr             bool
+		wantRequeue         bool
+		wantDefragmented    bool
+		wantConditionStatus corev1.ConditionStatus
+	}{
+		{
+			name:        "no-op when etcd maintenance is not configured",
+			maintenance: nil,
+			status: &controlplanev1.EtcdMaintenanceStatus{
+				LastDefragmentationTime: &metav1.Time{Time: time.Now()},
+			},
+			machines: []*clusterv1.Machine{machineWithNode("m1")},
+		},
+		{
+			name:        "waits for the control plane to be stable",
+			maintenance: maintenance,
+			machines:    []*clusterv1.Machine{{ObjectMeta: metav1.ObjectMeta{Name: "m1"}}},
+		},
+		{
+			name:        "waits for the defragmentation interval to expire",
+			maintenance: maintenance,
+			status: &controlplanev1.EtcdMaintenanceStatus{
+				LastDefragmentationTime: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			},
+			machines:    []*clusterv1.Machine{machineWithNode("m1")},
+			wantRequeue: true,
+		},
+		{
+			name:                "defragments the etcd members",
+			maintenance:         maintenance,
+			machines:            []*clusterv1.Machine{machineWithNode("m1")},
+			workload:            fakeWorkloadCluster{EtcdDBSizes: dbSizes},
+			wantRequeue:         true,
+			wantDefragmented:    true,
+			wantConditionStatus: corev1.ConditionTrue,
+		},
+		{
+			name:        "defragments the etcd members when the defragmentation interval expired",
+			maintenance: maintenance,
+			status: &controlplanev1.EtcdMaintenanceStatus{
+				LastDefragmentationTime: &metav1.Time{Time: time.Now().Add(-25 * time.Hour)},
+			},
+			machines:            []*clusterv1.Machine{machineWithNode("m1")},
+			workload:            fakeWorkloadCluster{EtcdDBSizes: dbSizes},
+			wantRequeue:         true,
+			wantDefragmented:    true,
+			wantConditionStatus: corev1.ConditionTrue,
+		},
+		{
+			name:                "reports defragmentation failures",
+			maintenance:         maintenance,
+			machines:            []*clusterv1.Machine{machineWithNode("m1")},
+			workload:            fakeWorkloadCluster{DefragmentEtcdMembersErr: errors.New("defragment error")},
+			wantErr:             true,
+			wantConditionStatus: corev1.ConditionFalse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			kcp := &controlplanev1.KubeadmControlPlane{
+				Spec: controlplanev1.KubeadmControlPlaneSpec{
+					Replicas:        ptr.To[int32](int32(len(tt.machines))),
+					EtcdMaintenance: tt.maintenance,
+				},
+				Status: controlplanev1.KubeadmControlPlaneStatus{
+					EtcdMaintenance: tt.status,
+				},
+			}
+			controlPlane := &internal.ControlPlane{
+				KCP:      kcp,
+				Cluster:  &clusterv1.Cluster{},
+				Machines: collections.FromMachines(tt.machines...),
+			}
+			controlPlane.InjectTestManagementCluster(&fakeManagementCluster{Workload: tt.workload})
+
+			r := &KubeadmControlPlaneReconciler{}
+			result, err := r.reconcileEtcdMaintenance(ctx, controlPlane)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			if tt.wantRequeue {
+				g.Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			} else {
+				g.Expect(result.IsZero()).To(BeTrue())
+			}
+
+			if tt.maintenance == nil {
+				g.Expect(kcp.Status.EtcdMaintenance).To(BeNil())
+			}
+			if tt.wantDefragmented {
+				g.Expect(kcp.Status.EtcdMaintenance).ToNot(BeNil())
+				g.Expect(kcp.Status.EtcdMaintenance.LastDefragmentationTime).ToNot(BeNil())
+				g.Expect(kcp.Status.EtcdMaintenance.Members).To(ConsistOf(controlplanev1.EtcdMemberMaintenanceStatus{
+					Name:        "m1",
+					DBSize:      2 * 1024 * 1024,
+					DBSizeInUse: 1024 * 1024,
+				}))
+			}
+
+			if tt.wantConditionStatus == "" {
+				g.Expect(conditions.Has(kcp, controlplanev1.EtcdMaintenanceSucceededCondition)).To(BeFalse())
+				return
+			}
+			condition := conditions.Get(kcp, controlplanev1.EtcdMaintenanceSucceededCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantConditionStatus))
+			if tt.wantDefragmented {
+				g.Expect(condition.Message).To(ContainSubstring("m1: 2.0MiB (1.0MiB in use)"))
+			}
+		})
+	}
+}
+
+func TestEtcdMaintenanceWindowWait(t *testing.T) {
+	window := &controlplanev1.EtcdMaintenanceWindow{
+		StartTime: "22:00",
+		Duration:  metav1.Duration{Duration: 4 * time.Hour},
+	}
+
+	tests := []struct {
+		name     string
+		window   *controlplanev1.EtcdMaintenanceWindow
+		now      time.Time
+		wantWait time.Duration
+	}{
+		{
+			name:     "no maintenance window",
+			window:   nil,
+			now:      time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantWait: 0,
+		},
+		{
+			name:     "before the maintenance window",
+			window:   window,
+			now:      time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantWait: 10 * time.Hour,
+		},
+		{
+			name:     "within the maintenance window started today",
+			window:   window,
+			now:      time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC),
+			wantWait: 0,
+		},
+		{
+			name:     "within the maintenance window started yesterday",
+			window:   window,
+			now:      time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC),
+			wantWait: 0,
+		},
+		{
+			name:     "after the maintenance window started yesterday",
+			window:   window,
+			now:      time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC),
+			wantWait: 19 * time.Hour,
+		},
+		{
+			name: "time is always converted to UTC",
+			window: &controlplanev1.EtcdMaintenanceWindow{
+				StartTime: "02:00",
+				Duration:  metav1.Duration{Duration: time.Hour},
+			},
+			now:      time.Date(2024, 1, 1, 3, 30, 0, 0, time.FixedZone("UTC+2", 2*60*60)),
+			wantWait: 30 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(etcdMaintenanceWindowWait(tt.window, tt.now)).To(Equal(tt.wantWait))
+		})
+	}
+}
+
+func TestFormatDBSize(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(formatDBSize(512)).To(Equal("512B"))
+	g.Expect(formatDBSize(1536)).To(Equal("1.5KiB"))
+	g.Expect(formatDBSize(100 * 1024 * 1024)).To(Equal("100.0MiB"))
+	g.Expect(formatDBSize(8 * 1024 * 1024 * 1024)).To(Equal("8.0GiB"))
+}
@@ -71,6 +71,8 @@ type fakeWorkloadCluster struct {
 	Status                     internal.ClusterStatus
 	EtcdMembersResult          []string
 	APIServerCertificateExpiry *time.Time
+	EtcdDBSizes                []internal.EtcdMemberDBSize
+	DefragmentEtcdMembersErr   error
 }
 
 func (f fakeWorkloadCluster) ForwardEtcdLeadership(_ context.Context, _ *clusterv1.Machine, leaderCandidate *clusterv1.Machine) error {
@@ -132,6 +134,10 @@ func (f fakeWorkloadCluster) EtcdMembers(_ context.Context) ([]string, error) {
 	return f.EtcdMembersResult, nil
 }
 
+func (f fakeWorkloadCluster) DefragmentEtcdMembers(_ context.Context, _ bool) ([]internal.EtcdMemberDBSize, error) {
+	return f.EtcdDBSizes, f.DefragmentEtcdMembersErr
+}
+
 func (f fakeWorkloadCluster) UpdateClusterConfiguration(context.Context, semver.Version, ...func(*bootstrapv1.ClusterConfiguration)) error {
 	return nil
 }
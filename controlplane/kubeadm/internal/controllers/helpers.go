@@ -423,6 +423,11 @@ func (r *KubeadmControlPlaneReconciler) computeDesiredMachine(kcp *controlplanev
 	for k, v := range annotations {
 		desiredMachine.Annotations[k] = v
 	}
+	// When using the OnDelete rollout strategy, add the KCP pre-terminate hook, which allows KCP to clean up
+	// the etcd member of machines deleted by an operator.
+	if kcp.Spec.RolloutStrategy != nil && kcp.Spec.RolloutStrategy.Type == controlplanev1.OnDeleteStrategyType {
+		desiredMachine.Annotations[controlplanev1.PreTerminateHookCleanupAnnotation] = ""
+	}
 
 	// Set other in-place mutable fields
 	desiredMachine.Spec.NodeDrainTimeout = kcp.Spec.MachineTemplate.NodeDrainTimeout
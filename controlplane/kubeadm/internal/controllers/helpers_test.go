@@ -652,6 +652,23 @@ func TestKubeadmControlPlaneReconciler_computeDesiredMachine(t *testing.T) {
 		g.Expect(kcp.Spec.MachineTemplate.ObjectMeta.Labels).To(Equal(kcpMachineTemplateObjectMetaCopy.Labels))
 		g.Expect(kcp.Spec.MachineTemplate.ObjectMeta.Annotations).To(Equal(kcpMachineTemplateObjectMetaCopy.Annotations))
 	})
+
+	t.Run("should add the pre-terminate hook when using the OnDelete rollout strategy", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcpOnDelete := kcp.DeepCopy()
+		kcpOnDelete.Spec.RolloutStrategy = &controlplanev1.RolloutStrategy{Type: controlplanev1.OnDeleteStrategyType}
+
+		createdMachine, err := (&KubeadmControlPlaneReconciler{}).computeDesiredMachine(
+			kcpOnDelete, cluster,
+			nil, nil,
+		)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(createdMachine.Annotations).To(HaveKey(controlplanev1.PreTerminateHookCleanupAnnotation))
+
+		// Verify that machineTemplate.ObjectMeta in KCP has not been modified.
+		g.Expect(kcpOnDelete.Spec.MachineTemplate.ObjectMeta.Annotations).To(Equal(kcpMachineTemplateObjectMetaCopy.Annotations))
+	})
 }
 
 func TestKubeadmControlPlaneReconciler_generateKubeadmConfig(t *testing.T) {
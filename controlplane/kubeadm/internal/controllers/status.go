@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -46,6 +47,9 @@ func (r *KubeadmControlPlaneReconciler) updateStatus(ctx context.Context, contro
 	controlPlane.KCP.Status.ReadyReplicas = 0
 	controlPlane.KCP.Status.UnavailableReplicas = replicas
 
+	// Report the expiry of the certificates of the machines; this does not require interacting with the workload cluster.
+	reconcileCertificatesExpiringSoon(controlPlane, time.Now())
+
 	// Return early if the deletion timestamp is set, because we don't want to try to connect to the workload cluster
 	// and we don't want to report resize condition (because it is set to deleting into reconcile delete).
 	if !controlPlane.KCP.DeletionTimestamp.IsZero() {
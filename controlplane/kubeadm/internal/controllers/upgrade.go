@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"strings"
 
 	"github.com/blang/semver/v4"
 	"github.com/pkg/errors"
@@ -38,9 +39,12 @@ func (r *KubeadmControlPlaneReconciler) upgradeControlPlane(
 ) (ctrl.Result, error) {
 	logger := ctrl.LoggerFrom(ctx)
 
-	if controlPlane.KCP.Spec.RolloutStrategy == nil || controlPlane.KCP.Spec.RolloutStrategy.RollingUpdate == nil {
+	if controlPlane.KCP.Spec.RolloutStrategy == nil {
 		return ctrl.Result{}, errors.New("rolloutStrategy is not set")
 	}
+	if controlPlane.KCP.Spec.RolloutStrategy.Type == controlplanev1.RollingUpdateStrategyType && controlPlane.KCP.Spec.RolloutStrategy.RollingUpdate == nil {
+		return ctrl.Result{}, errors.New("rolloutStrategy.rollingUpdate is not set")
+	}
 
 	// TODO: handle reconciliation of etcd members and kubeadm config in case they get out of sync with cluster
 
@@ -124,8 +128,18 @@ func (r *KubeadmControlPlaneReconciler) upgradeControlPlane(
 			return r.scaleUpControlPlane(ctx, controlPlane)
 		}
 		return r.scaleDownControlPlane(ctx, controlPlane, machinesRequireUpgrade)
+	case controlplanev1.OnDeleteStrategyType:
+		// Machines with an outdated spec are replaced only after an operator deletes them; KCP only creates the
+		// replacement machine, with the current spec, once the deleted machine is gone.
+		// NOTE: The etcd member of the deleted machine is removed by KCP when the machine reaches the pre-terminate hook.
+		if int32(controlPlane.Machines.Len()) < *controlPlane.KCP.Spec.Replicas {
+			// scaleUp ensures that we don't continue scaling up while waiting for Machines to have NodeRefs
+			return r.scaleUpControlPlane(ctx, controlPlane)
+		}
+		logger.Info("Waiting for Machines with an outdated spec to be deleted", "Machines", strings.Join(machinesRequireUpgrade.Names(), ", "))
+		return ctrl.Result{}, nil
 	default:
-		logger.Info("RolloutStrategy type is not set to RollingUpdateStrategyType, unable to determine the strategy for rolling out machines")
+		logger.Info("RolloutStrategy type is not set to RollingUpdateStrategyType or OnDeleteStrategyType, unable to determine the strategy for rolling out machines")
 		return ctrl.Result{}, nil
 	}
 }
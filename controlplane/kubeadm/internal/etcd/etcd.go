@@ -37,8 +37,10 @@ type GRPCDial func(ctx context.Context, addr string) (net.Conn, error)
 // etcd wraps the etcd client from etcd's clientv3 package.
 // This interface is implemented by both the clientv3 package and the backoff adapter that adds retries to the client.
 type etcd interface {
+	AlarmDisarm(ctx context.Context, m *clientv3.AlarmMember) (*clientv3.AlarmResponse, error)
 	AlarmList(ctx context.Context) (*clientv3.AlarmResponse, error)
 	Close() error
+	Defragment(ctx context.Context, endpoint string) (*clientv3.DefragmentResponse, error)
 	Endpoints() []string
 	MemberList(ctx context.Context) (*clientv3.MemberListResponse, error)
 	MemberRemove(ctx context.Context, id uint64) (*clientv3.MemberRemoveResponse, error)
@@ -83,6 +85,11 @@ const (
 // for read and write operations to etcd.
 const DefaultCallTimeout = 15 * time.Second
 
+// DefragmentTimeout represents the duration that the etcd client waits at most
+// for the defragmentation of a member; defragmentation can take longer than other
+// operations, given that it rewrites the whole database of the member.
+const DefragmentTimeout = 5 * time.Minute
+
 // AlarmTypeName provides a text translation for AlarmType codes.
 var AlarmTypeName = map[AlarmType]string{
 	AlarmOK:      "NONE",
@@ -286,3 +293,38 @@ func (c *Client) Alarms(ctx context.Context) ([]MemberAlarm, error) {
 
 	return memberAlarms, nil
 }
+
+// DisarmAlarm disarms the given alarm.
+func (c *Client) DisarmAlarm(ctx context.Context, alarm MemberAlarm) error {
+	ctx, cancel := context.WithTimeout(ctx, c.CallTimeout)
+	defer cancel()
+
+	_, err := c.EtcdClient.AlarmDisarm(ctx, &clientv3.AlarmMember{
+		MemberID: alarm.MemberID,
+		Alarm:    etcdserverpb.AlarmType(alarm.Type),
+	})
+	return errors.Wrapf(err, "failed to disarm alarm %s for member: %v", AlarmTypeName[alarm.Type], alarm.MemberID)
+}
+
+// Defragment defragments the database of the member the client is connected to.
+// NOTE: The member cannot serve any request while it is being defragmented.
+func (c *Client) Defragment(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, DefragmentTimeout)
+	defer cancel()
+
+	_, err := c.EtcdClient.Defragment(ctx, c.Endpoint)
+	return errors.Wrapf(err, "failed to defragment member: %v", c.Endpoint)
+}
+
+// DBSize returns the physically allocated size of the database of the member the client is connected to,
+// and the logical size of the database actually in use, in bytes.
+func (c *Client) DBSize(ctx context.Context) (int64, int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.CallTimeout)
+	defer cancel()
+
+	status, err := c.EtcdClient.Status(ctx, c.Endpoint)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to get status for member: %v", c.Endpoint)
+	}
+	return status.DbSize, status.DbSizeInUse, nil
+}
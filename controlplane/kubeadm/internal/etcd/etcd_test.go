@@ -61,6 +61,12 @@ func TestEtcdMembers_WithErrors(t *testing.T) {
 
 	err = client.RemoveMember(ctx, 1234)
 	g.Expect(err).To(HaveOccurred())
+
+	err = client.Defragment(ctx)
+	g.Expect(err).To(HaveOccurred())
+
+	err = client.DisarmAlarm(ctx, MemberAlarm{MemberID: 1234, Type: AlarmNoSpace})
+	g.Expect(err).To(HaveOccurred())
 }
 
 func TestEtcdMembers_WithSuccess(t *testing.T) {
@@ -83,7 +89,8 @@ func TestEtcdMembers_WithSuccess(t *testing.T) {
 		},
 		MemberRemoveResponse: &clientv3.MemberRemoveResponse{},
 		AlarmResponse:        &clientv3.AlarmResponse{},
-		StatusResponse:       &clientv3.StatusResponse{},
+		DefragmentResponse:   &clientv3.DefragmentResponse{},
+		StatusResponse:       &clientv3.StatusResponse{DbSize: 2048, DbSizeInUse: 1024},
 	}
 
 	client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
@@ -103,4 +110,17 @@ func TestEtcdMembers_WithSuccess(t *testing.T) {
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(updatedMembers[0].PeerURLs).To(HaveLen(2))
 	g.Expect(updatedMembers[0].PeerURLs).To(Equal([]string{"https://1.2.3.4:2000", "https://4.5.6.7:2000"}))
+
+	err = client.Defragment(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(fakeEtcdClient.DefragmentedEndpoint).To(Equal("https://etcd-instance:2379"))
+
+	dbSize, dbSizeInUse, err := client.DBSize(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dbSize).To(Equal(int64(2048)))
+	g.Expect(dbSizeInUse).To(Equal(int64(1024)))
+
+	err = client.DisarmAlarm(ctx, MemberAlarm{MemberID: 1234, Type: AlarmNoSpace})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(fakeEtcdClient.DisarmedAlarms).To(ConsistOf(&clientv3.AlarmMember{MemberID: 1234, Alarm: etcdserverpb.AlarmType_NOSPACE}))
 }
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/coredns/corefile-migration/migration"
@@ -155,6 +156,10 @@ const (
 
 const minimumCertificatesExpiryDays = 7
 
+// minimumEtcdDefragmentationInterval is the minimum interval between two defragmentations of the etcd members;
+// defragmentation blocks the members while it runs, so it should not happen too often.
+const minimumEtcdDefragmentationInterval = time.Hour
+
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
 func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	// add a * to indicate everything beneath is ok.
@@ -220,6 +225,7 @@ func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldObj, ne
 		{spec, kubeadmConfigSpec, "format"},
 		{spec, kubeadmConfigSpec, "mounts"},
 		{spec, kubeadmConfigSpec, "useExperimentalRetryJoin"},
+		{spec, kubeadmConfigSpec, "fragments"},
 		// spec.machineTemplate
 		{spec, "machineTemplate", "metadata"},
 		{spec, "machineTemplate", "metadata", "*"},
@@ -234,6 +240,8 @@ func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldObj, ne
 		{spec, "version"},
 		{spec, "remediationStrategy"},
 		{spec, "remediationStrategy", "*"},
+		{spec, "etcdMaintenance"},
+		{spec, "etcdMaintenance", "*"},
 		{spec, "rolloutAfter"},
 		{spec, "rolloutBefore"},
 		{spec, "rolloutBefore", "*"},
@@ -392,6 +400,7 @@ func validateKubeadmControlPlaneSpec(s controlplanev1.KubeadmControlPlaneSpec, n
 
 	allErrs = append(allErrs, validateRolloutBefore(s.RolloutBefore, pathPrefix.Child("rolloutBefore"))...)
 	allErrs = append(allErrs, validateRolloutStrategy(s.RolloutStrategy, s.Replicas, pathPrefix.Child("rolloutStrategy"))...)
+	allErrs = append(allErrs, validateEtcdMaintenance(s.EtcdMaintenance, pathPrefix.Child("etcdMaintenance"))...)
 
 	return allErrs
 }
@@ -412,6 +421,26 @@ func validateRolloutBefore(rolloutBefore *controlplanev1.RolloutBefore, pathPref
 	return allErrs
 }
 
+func validateEtcdMaintenance(etcdMaintenance *controlplanev1.EtcdMaintenance, pathPrefix *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if etcdMaintenance == nil {
+		return allErrs
+	}
+
+	if etcdMaintenance.DefragmentationInterval.Duration < minimumEtcdDefragmentationInterval {
+		allErrs = append(allErrs, field.Invalid(pathPrefix.Child("defragmentationInterval"), etcdMaintenance.DefragmentationInterval.String(), fmt.Sprintf("must be greater than or equal to %s", minimumEtcdDefragmentationInterval)))
+	}
+
+	if window := etcdMaintenance.MaintenanceWindow; window != nil {
+		if window.Duration.Duration <= 0 || window.Duration.Duration > 24*time.Hour {
+			allErrs = append(allErrs, field.Invalid(pathPrefix.Child("maintenanceWindow", "duration"), window.Duration.String(), "must be greater than 0 and less than or equal to 24h"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateRolloutStrategy(rolloutStrategy *controlplanev1.RolloutStrategy, replicas *int32, pathPrefix *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -419,16 +448,44 @@ func validateRolloutStrategy(rolloutStrategy *controlplanev1.RolloutStrategy, re
 		return allErrs
 	}
 
-	if rolloutStrategy.Type != controlplanev1.RollingUpdateStrategyType {
+	switch rolloutStrategy.Type {
+	case controlplanev1.RollingUpdateStrategyType:
+	case controlplanev1.OnDeleteStrategyType:
+		if rolloutStrategy.RollingUpdate != nil {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(
+					pathPrefix.Child("rollingUpdate"),
+					"cannot be set when using the OnDelete strategy",
+				),
+			)
+		}
+		// Deleting a machine reduces the number of etcd members and the number of control plane machines serving
+		// the API server until the replacement is provisioned, so at least 3 replicas are required.
+		if replicas != nil && *replicas < int32(3) {
+			allErrs = append(
+				allErrs,
+				field.Required(
+					pathPrefix.Child("type"),
+					"when KubeadmControlPlane is configured with the OnDelete strategy, replica count needs to be at least 3",
+				),
+			)
+		}
+		return allErrs
+	default:
 		allErrs = append(
 			allErrs,
 			field.Required(
 				pathPrefix.Child("type"),
-				"only RollingUpdateStrategyType is supported",
+				"only RollingUpdateStrategyType and OnDeleteStrategyType are supported",
 			),
 		)
 	}
 
+	if rolloutStrategy.RollingUpdate == nil {
+		return allErrs
+	}
+
 	ios1 := intstr.FromInt(1)
 	ios0 := intstr.FromInt(0)
 
@@ -154,6 +154,32 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 		CertificatesExpiryDays: ptr.To[int32](5), // less than minimum
 	}
 
+	validEtcdMaintenance := valid.DeepCopy()
+	validEtcdMaintenance.Spec.EtcdMaintenance = &controlplanev1.EtcdMaintenance{
+		DefragmentationInterval: metav1.Duration{Duration: 24 * time.Hour},
+		MaintenanceWindow: &controlplanev1.EtcdMaintenanceWindow{
+			StartTime: "02:00",
+			Duration:  metav1.Duration{Duration: 2 * time.Hour},
+		},
+		ClearAlarms: true,
+	}
+
+	invalidEtcdMaintenanceInterval := validEtcdMaintenance.DeepCopy()
+	invalidEtcdMaintenanceInterval.Spec.EtcdMaintenance.DefragmentationInterval = metav1.Duration{Duration: time.Minute} // less than minimum
+
+	invalidEtcdMaintenanceWindow := validEtcdMaintenance.DeepCopy()
+	invalidEtcdMaintenanceWindow.Spec.EtcdMaintenance.MaintenanceWindow.Duration = metav1.Duration{Duration: 25 * time.Hour}
+
+	validOnDeleteStrategy := valid.DeepCopy()
+	validOnDeleteStrategy.Spec.Replicas = ptr.To[int32](3)
+	validOnDeleteStrategy.Spec.RolloutStrategy = &controlplanev1.RolloutStrategy{Type: controlplanev1.OnDeleteStrategyType}
+
+	invalidOnDeleteStrategyReplicas := validOnDeleteStrategy.DeepCopy()
+	invalidOnDeleteStrategyReplicas.Spec.Replicas = ptr.To[int32](1)
+
+	invalidOnDeleteStrategyRollingUpdate := validOnDeleteStrategy.DeepCopy()
+	invalidOnDeleteStrategyRollingUpdate.Spec.RolloutStrategy.RollingUpdate = valid.Spec.RolloutStrategy.RollingUpdate.DeepCopy()
+
 	invalidIgnitionConfiguration := valid.DeepCopy()
 	invalidIgnitionConfiguration.Spec.KubeadmConfigSpec.Ignition = &bootstrapv1.IgnitionSpec{}
 
@@ -242,6 +268,36 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 			expectErr: true,
 			kcp:       invalidRolloutBeforeCertificateExpiryDays,
 		},
+		{
+			name:      "should succeed when given a valid etcdMaintenance",
+			expectErr: false,
+			kcp:       validEtcdMaintenance,
+		},
+		{
+			name:      "should return error when given an invalid etcdMaintenance.defragmentationInterval value",
+			expectErr: true,
+			kcp:       invalidEtcdMaintenanceInterval,
+		},
+		{
+			name:      "should return error when given an invalid etcdMaintenance.maintenanceWindow.duration value",
+			expectErr: true,
+			kcp:       invalidEtcdMaintenanceWindow,
+		},
+		{
+			name:      "should succeed when given a valid OnDelete rollout strategy",
+			expectErr: false,
+			kcp:       validOnDeleteStrategy,
+		},
+		{
+			name:      "should return error when using the OnDelete rollout strategy with less than 3 replicas",
+			expectErr: true,
+			kcp:       invalidOnDeleteStrategyReplicas,
+		},
+		{
+			name:      "should return error when using the OnDelete rollout strategy with rollingUpdate set",
+			expectErr: true,
+			kcp:       invalidOnDeleteStrategyRollingUpdate,
+		},
 
 		{
 			name:                  "should return error when Ignition configuration is invalid",
@@ -438,6 +494,9 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 		MinHealthyPeriod: &metav1.Duration{Duration: 10 * time.Hour},
 		RetryPeriod:      metav1.Duration{Duration: 10 * time.Minute},
 	}
+	validUpdate.Spec.EtcdMaintenance = &controlplanev1.EtcdMaintenance{
+		DefragmentationInterval: metav1.Duration{Duration: 24 * time.Hour},
+	}
 	validUpdate.Spec.KubeadmConfigSpec.Format = bootstrapv1.CloudConfig
 
 	scaleToZero := before.DeepCopy()
@@ -705,6 +764,15 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 	updateUseExperimentalRetryJoin := before.DeepCopy()
 	updateUseExperimentalRetryJoin.Spec.KubeadmConfigSpec.UseExperimentalRetryJoin = false //nolint:staticcheck
 
+	validUpdateFragments := before.DeepCopy()
+	validUpdateFragments.Spec.KubeadmConfigSpec.Fragments = []bootstrapv1.BootstrapDataFragment{
+		{Name: "foo", Content: "runcmd:\n- foo"},
+	}
+	invalidUpdateFragments := before.DeepCopy()
+	invalidUpdateFragments.Spec.KubeadmConfigSpec.Fragments = []bootstrapv1.BootstrapDataFragment{
+		{Name: "foo", Content: "- foo"},
+	}
+
 	tests := []struct {
 		name                  string
 		enableIgnitionFeature bool
@@ -1045,6 +1113,18 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 			before:    beforeUseExperimentalRetryJoin,
 			kcp:       updateUseExperimentalRetryJoin,
 		},
+		{
+			name:      "should allow changes to fragments",
+			expectErr: false,
+			before:    before,
+			kcp:       validUpdateFragments,
+		},
+		{
+			name:      "should return error for fragments which cannot be parsed",
+			expectErr: true,
+			before:    before,
+			kcp:       invalidUpdateFragments,
+		},
 	}
 
 	for _, tt := range tests {
@@ -132,6 +132,7 @@ func validateKubeadmControlPlaneTemplateResourceSpec(s controlplanev1.KubeadmCon
 
 	allErrs = append(allErrs, validateRolloutBefore(s.RolloutBefore, pathPrefix.Child("rolloutBefore"))...)
 	allErrs = append(allErrs, validateRolloutStrategy(s.RolloutStrategy, nil, pathPrefix.Child("rolloutStrategy"))...)
+	allErrs = append(allErrs, validateEtcdMaintenance(s.EtcdMaintenance, pathPrefix.Child("etcdMaintenance"))...)
 
 	if s.MachineTemplate != nil {
 		// Validate the metadata of the MachineTemplate
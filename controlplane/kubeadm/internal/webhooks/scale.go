@@ -67,6 +67,10 @@ func (v *ScaleValidator) Handle(ctx context.Context, req admission.Request) admi
 		return admission.Denied("replicas cannot be 0")
 	}
 
+	if kcp.Spec.RolloutStrategy != nil && kcp.Spec.RolloutStrategy.Type == controlplanev1.OnDeleteStrategyType && scale.Spec.Replicas < 3 {
+		return admission.Denied("replicas cannot be less than 3 when using the OnDelete rollout strategy")
+	}
+
 	externalEtcd := false
 	if kcp.Spec.KubeadmConfigSpec.ClusterConfiguration != nil {
 		if kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External != nil {
@@ -132,6 +132,11 @@ func TestKubeadmControlPlaneValidateScale(t *testing.T) {
 	kcpExternalEtcd.ObjectMeta.Name = "kcp-external-etcd"
 	kcpExternalEtcd.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.External = &bootstrapv1.ExternalEtcd{}
 
+	kcpOnDelete := kcpManagedEtcd.DeepCopy()
+	kcpOnDelete.ObjectMeta.Name = "kcp-on-delete"
+	kcpOnDelete.Spec.Replicas = ptr.To[int32](3)
+	kcpOnDelete.Spec.RolloutStrategy = &controlplanev1.RolloutStrategy{Type: controlplanev1.OnDeleteStrategyType}
+
 	tests := []struct {
 		name              string
 		admissionRequest  admission.Request
@@ -193,12 +198,34 @@ func TestKubeadmControlPlaneValidateScale(t *testing.T) {
 				Object:    runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"kcp-external-etcd","namespace":"foo"},"spec":{"replicas":3}}`)},
 			}},
 		},
+		{
+			name:              "should return error when trying to scale to less than 3 replicas with the OnDelete rollout strategy",
+			expectRespAllowed: false,
+			expectRespMessage: "replicas cannot be less than 3 when using the OnDelete rollout strategy",
+			admissionRequest: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				UID:       uuid.NewUUID(),
+				Kind:      metav1.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "Scale"},
+				Operation: admissionv1.Update,
+				Object:    runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"kcp-on-delete","namespace":"foo"},"spec":{"replicas":1}}`)},
+			}},
+		},
+		{
+			name:              "should allow 5 replicas with the OnDelete rollout strategy",
+			expectRespAllowed: true,
+			expectRespMessage: "",
+			admissionRequest: admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				UID:       uuid.NewUUID(),
+				Kind:      metav1.GroupVersionKind{Group: "autoscaling", Version: "v1", Kind: "Scale"},
+				Operation: admissionv1.Update,
+				Object:    runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"kcp-on-delete","namespace":"foo"},"spec":{"replicas":5}}`)},
+			}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kcpManagedEtcd, kcpExternalEtcd).Build()
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kcpManagedEtcd, kcpExternalEtcd, kcpOnDelete).Build()
 
 			// Create the webhook and add the fakeClient as its client.
 			scaleHandler := ScaleValidator{
@@ -126,6 +126,9 @@ type WorkloadCluster interface {
 
 	// State recovery tasks.
 	ReconcileEtcdMembers(ctx context.Context, nodeNames []string, version semver.Version) ([]string, error)
+
+	// Maintenance tasks.
+	DefragmentEtcdMembers(ctx context.Context, clearAlarms bool) ([]EtcdMemberDBSize, error)
 }
 
 // Workload defines operations on workload clusters.
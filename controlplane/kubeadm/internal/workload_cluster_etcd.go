@@ -246,3 +246,104 @@ func (w *Workload) EtcdMembers(ctx context.Context) ([]string, error) {
 	}
 	return names, nil
 }
+
+// EtcdMemberDBSize contains the size of the database of a single etcd member.
+type EtcdMemberDBSize struct {
+	Name        string
+	DBSize      int64
+	DBSizeInUse int64
+}
+
+// DefragmentEtcdMembers defragments the etcd members one at a time, the leader last, given that a member cannot
+// serve requests while it is being defragmented; if clearAlarms is true, the NOSPACE alarms are disarmed
+// once all the members have been defragmented.
+// It returns the size of the database of the members after defragmentation.
+func (w *Workload) DefragmentEtcdMembers(ctx context.Context, clearAlarms bool) ([]EtcdMemberDBSize, error) {
+	nodes, err := w.getControlPlaneNodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list control plane nodes")
+	}
+	nodeNames := make([]string, 0, len(nodes.Items))
+	for _, node := range nodes.Items {
+		nodeNames = append(nodeNames, node.Name)
+	}
+	etcdClient, err := w.etcdClientGenerator.forLeader(ctx, nodeNames)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create etcd client")
+	}
+	defer etcdClient.Close()
+
+	members, err := etcdClient.Members(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list etcd members using etcd client")
+	}
+
+	// Defragment the followers first, and then the leader.
+	var leader *etcd.Member
+	orderedMembers := make([]*etcd.Member, 0, len(members))
+	for _, member := range members {
+		if member.ID == etcdClient.LeaderID {
+			leader = member
+			continue
+		}
+		orderedMembers = append(orderedMembers, member)
+	}
+	if leader != nil {
+		orderedMembers = append(orderedMembers, leader)
+	}
+
+	dbSizes := make([]EtcdMemberDBSize, 0, len(orderedMembers))
+	for _, member := range orderedMembers {
+		dbSize, err := w.defragmentEtcdMember(ctx, member)
+		if err != nil {
+			return nil, err
+		}
+		dbSizes = append(dbSizes, dbSize)
+	}
+
+	if clearAlarms {
+		alarms, err := etcdClient.Alarms(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list etcd alarms using etcd client")
+		}
+		for _, alarm := range alarms {
+			// NOTE: CORRUPT alarms are not disarmed given that they require manual intervention.
+			if alarm.Type != etcd.AlarmNoSpace {
+				continue
+			}
+			if err := etcdClient.DisarmAlarm(ctx, alarm); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return dbSizes, nil
+}
+
+func (w *Workload) defragmentEtcdMember(ctx context.Context, member *etcd.Member) (EtcdMemberDBSize, error) {
+	// NOTE: etcd members are named after the node hosting them.
+	if member.Name == "" {
+		return EtcdMemberDBSize{}, errors.Errorf("failed to defragment etcd member %d: member is not started", member.ID)
+	}
+
+	// Create the etcd Client for the etcd Pod scheduled on the Node hosting the member.
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, []string{member.Name})
+	if err != nil {
+		return EtcdMemberDBSize{}, errors.Wrapf(err, "failed to defragment etcd member %s: failed to create etcd client", member.Name)
+	}
+	defer etcdClient.Close()
+
+	if err := etcdClient.Defragment(ctx); err != nil {
+		return EtcdMemberDBSize{}, errors.Wrapf(err, "failed to defragment etcd member %s", member.Name)
+	}
+
+	dbSize, dbSizeInUse, err := etcdClient.DBSize(ctx)
+	if err != nil {
+		return EtcdMemberDBSize{}, errors.Wrapf(err, "failed to get the database size of etcd member %s", member.Name)
+	}
+	return EtcdMemberDBSize{
+		Name:        member.Name,
+		DBSize:      dbSize,
+		DBSizeInUse: dbSizeInUse,
+	}, nil
+}
@@ -552,6 +552,116 @@ func TestForwardEtcdLeadership(t *testing.T) {
 	})
 }
 
+func TestDefragmentEtcdMembers(t *testing.T) {
+	members := &clientv3.MemberListResponse{
+		Members: []*pb.Member{
+			{Name: "leader-node", ID: uint64(101)},
+			{Name: "follower-node-1", ID: uint64(102)},
+			{Name: "follower-node-2", ID: uint64(103)},
+		},
+	}
+	nodes := &corev1.NodeList{
+		Items: []corev1.Node{nodeNamed("leader-node"), nodeNamed("follower-node-1"), nodeNamed("follower-node-2")},
+	}
+
+	tests := []struct {
+		name                 string
+		clearAlarms          bool
+		alarms               []*pb.AlarmMember
+		forNodesClientErrFor string
+		defragmentErr        error
+		wantDefragmented     []string
+		wantDisarmedAlarms   []*clientv3.AlarmMember
+		wantDBSizes          []EtcdMemberDBSize
+		wantErr              bool
+	}{
+		{
+			name:             "defragments the followers first and the leader last",
+			wantDefragmented: []string{"follower-node-1", "follower-node-2", "leader-node"},
+			wantDBSizes: []EtcdMemberDBSize{
+				{Name: "follower-node-1", DBSize: 2048, DBSizeInUse: 1024},
+				{Name: "follower-node-2", DBSize: 2048, DBSizeInUse: 1024},
+				{Name: "leader-node", DBSize: 2048, DBSizeInUse: 1024},
+			},
+		},
+		{
+			name:        "disarms NOSPACE alarms only",
+			clearAlarms: true,
+			alarms: []*pb.AlarmMember{
+				{MemberID: 102, Alarm: pb.AlarmType_NOSPACE},
+				{MemberID: 103, Alarm: pb.AlarmType_CORRUPT},
+			},
+			wantDefragmented:   []string{"follower-node-1", "follower-node-2", "leader-node"},
+			wantDisarmedAlarms: []*clientv3.AlarmMember{{MemberID: 102, Alarm: pb.AlarmType_NOSPACE}},
+			wantDBSizes: []EtcdMemberDBSize{
+				{Name: "follower-node-1", DBSize: 2048, DBSizeInUse: 1024},
+				{Name: "follower-node-2", DBSize: 2048, DBSizeInUse: 1024},
+				{Name: "leader-node", DBSize: 2048, DBSizeInUse: 1024},
+			},
+		},
+		{
+			name:                 "stops at the first member which cannot be defragmented",
+			forNodesClientErrFor: "follower-node-2",
+			wantDefragmented:     []string{"follower-node-1"},
+			wantErr:              true,
+		},
+		{
+			name:             "returns error if defragmentation fails",
+			defragmentErr:    errors.New("defragment error"),
+			wantDefragmented: []string{"follower-node-1"},
+			wantErr:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			leaderEtcdClient := &fake2.FakeEtcdClient{
+				MemberListResponse: members,
+				AlarmResponse: &clientv3.AlarmResponse{
+					Alarms: tt.alarms,
+				},
+			}
+			defragmented := []string{}
+			etcdClientGenerator := &fakeEtcdClientGenerator{
+				forLeaderClient: &etcd.Client{
+					EtcdClient: leaderEtcdClient,
+					LeaderID:   101,
+				},
+				forNodesClientFunc: func(n []string) (*etcd.Client, error) {
+					if n[0] == tt.forNodesClientErrFor {
+						return nil, errors.New("no etcdClient")
+					}
+					defragmented = append(defragmented, n[0])
+					return &etcd.Client{
+						EtcdClient: &fake2.FakeEtcdClient{
+							ErrorResponse:      tt.defragmentErr,
+							DefragmentResponse: &clientv3.DefragmentResponse{},
+							StatusResponse:     &clientv3.StatusResponse{DbSize: 2048, DbSizeInUse: 1024},
+						},
+						Endpoint: n[0],
+					}, nil
+				},
+			}
+
+			w := &Workload{
+				Client:              &fakeClient{list: nodes},
+				etcdClientGenerator: etcdClientGenerator,
+			}
+			dbSizes, err := w.DefragmentEtcdMembers(ctx, tt.clearAlarms)
+			g.Expect(defragmented).To(Equal(tt.wantDefragmented))
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(dbSizes).To(Equal(tt.wantDBSizes))
+			g.Expect(leaderEtcdClient.DisarmedAlarms).To(Equal(tt.wantDisarmedAlarms))
+		})
+	}
+}
+
 func TestReconcileEtcdMembers(t *testing.T) {
 	kubeadmConfig := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -57,6 +57,7 @@ import (
 	controlplanev1alpha3 "sigs.k8s.io/cluster-api/internal/apis/controlplane/kubeadm/v1alpha3"
 	controlplanev1alpha4 "sigs.k8s.io/cluster-api/internal/apis/controlplane/kubeadm/v1alpha4"
 	"sigs.k8s.io/cluster-api/util/flags"
+	"sigs.k8s.io/cluster-api/util/shard"
 	"sigs.k8s.io/cluster-api/version"
 )
 
@@ -81,13 +82,19 @@ var (
 	webhookCertDir              string
 	healthAddr                  string
 	tlsOptions                  = flags.TLSOptions{}
+	shardOptions                = flags.ShardOptions{}
 	diagnosticsOptions          = flags.DiagnosticsOptions{}
 	logOptions                  = logs.NewOptions()
 	// KCP specific flags.
-	kubeadmControlPlaneConcurrency int
-	clusterCacheTrackerConcurrency int
-	etcdDialTimeout                time.Duration
-	etcdCallTimeout                time.Duration
+	kubeadmControlPlaneConcurrency              int
+	clusterCacheTrackerConcurrency              int
+	clusterCacheTrackerClientQPS                float32
+	clusterCacheTrackerClientBurst              int
+	clusterCacheTrackerHealthCheckInterval      time.Duration
+	clusterCacheTrackerHealthCheckTimeout       time.Duration
+	clusterCacheTrackerMaxConcurrentConnections int
+	etcdDialTimeout                             time.Duration
+	etcdCallTimeout                             time.Duration
 )
 
 func init() {
@@ -135,6 +142,21 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&clusterCacheTrackerConcurrency, "clustercachetracker-concurrency", 10,
 		"Number of clusters to process simultaneously")
 
+	fs.Float32Var(&clusterCacheTrackerClientQPS, "clustercachetracker-client-qps", 0,
+		"Maximum queries per second from the controller client to the Kubernetes API server of each workload cluster. If not set, the client-go default is used.")
+
+	fs.IntVar(&clusterCacheTrackerClientBurst, "clustercachetracker-client-burst", 0,
+		"Maximum number of queries that should be allowed in one burst from the controller client to the Kubernetes API server of each workload cluster. If not set, the client-go default is used.")
+
+	fs.DurationVar(&clusterCacheTrackerHealthCheckInterval, "clustercachetracker-health-check-interval", 10*time.Second,
+		"Interval between the health checks of the connection to each workload cluster (e.g. 10s)")
+
+	fs.DurationVar(&clusterCacheTrackerHealthCheckTimeout, "clustercachetracker-health-check-timeout", 5*time.Second,
+		"Timeout of each health check request to a workload cluster (e.g. 5s)")
+
+	fs.IntVar(&clusterCacheTrackerMaxConcurrentConnections, "clustercachetracker-max-concurrent-connections", 0,
+		"Maximum number of connections to workload clusters created simultaneously. If 0, the number of connections created simultaneously is not limited.")
+
 	fs.DurationVar(&syncPeriod, "sync-period", 10*time.Minute,
 		"The minimum interval at which watched resources are reconciled (e.g. 15m)")
 
@@ -161,6 +183,7 @@ func InitFlags(fs *pflag.FlagSet) {
 
 	flags.AddDiagnosticsOptions(fs, &diagnosticsOptions)
 	flags.AddTLSOptions(fs, &tlsOptions)
+	flags.AddShardOptions(fs, &shardOptions)
 
 	feature.MutableGates.AddFlag(fs)
 }
@@ -199,6 +222,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	managerShard, err := flags.GetShard(shardOptions)
+	if err != nil {
+		setupLog.Error(err, "unable to configure sharding")
+		os.Exit(1)
+	}
+
 	diagnosticsOpts := flags.GetDiagnosticsOptions(diagnosticsOptions)
 
 	var watchNamespaces map[string]cache.Config
@@ -218,7 +247,7 @@ func main() {
 	ctrlOptions := ctrl.Options{
 		Scheme:                     scheme,
 		LeaderElection:             enableLeaderElection,
-		LeaderElectionID:           "kubeadm-control-plane-manager-leader-election-capi",
+		LeaderElectionID:           managerShard.LeaderElectionID("kubeadm-control-plane-manager-leader-election-capi"),
 		LeaseDuration:              &leaderElectionLeaseDuration,
 		RenewDeadline:              &leaderElectionRenewDeadline,
 		RetryPeriod:                &leaderElectionRetryPeriod,
@@ -270,7 +299,7 @@ func main() {
 	ctx := ctrl.SetupSignalHandler()
 
 	setupChecks(mgr)
-	setupReconcilers(ctx, mgr)
+	setupReconcilers(ctx, mgr, managerShard)
 	setupWebhooks(mgr)
 
 	setupLog.Info("starting manager", "version", version.Get().String())
@@ -292,7 +321,7 @@ func setupChecks(mgr ctrl.Manager) {
 	}
 }
 
-func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
+func setupReconcilers(ctx context.Context, mgr ctrl.Manager, managerShard shard.Shard) {
 	secretCachingClient, err := client.New(mgr.GetConfig(), client.Options{
 		HTTPClient: mgr.GetHTTPClient(),
 		Cache: &client.CacheOptions{
@@ -307,9 +336,14 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 	// Set up a ClusterCacheTracker to provide to controllers
 	// requiring a connection to a remote cluster
 	tracker, err := remote.NewClusterCacheTracker(mgr, remote.ClusterCacheTrackerOptions{
-		SecretCachingClient: secretCachingClient,
-		ControllerName:      controllerName,
-		Log:                 &ctrl.Log,
+		SecretCachingClient:            secretCachingClient,
+		ControllerName:                 controllerName,
+		Log:                            &ctrl.Log,
+		ClientQPS:                      clusterCacheTrackerClientQPS,
+		ClientBurst:                    clusterCacheTrackerClientBurst,
+		HealthCheckInterval:            clusterCacheTrackerHealthCheckInterval,
+		HealthCheckTimeout:             clusterCacheTrackerHealthCheckTimeout,
+		MaxConcurrentAccessorCreations: clusterCacheTrackerMaxConcurrentConnections,
 		ClientUncachedObjects: []client.Object{
 			&corev1.ConfigMap{},
 			&corev1.Secret{},
@@ -326,6 +360,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		Client:           mgr.GetClient(),
 		Tracker:          tracker,
 		WatchFilterValue: watchFilterValue,
+		Shard:            managerShard,
 	}).SetupWithManager(ctx, mgr, concurrency(clusterCacheTrackerConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ClusterCacheReconciler")
 		os.Exit(1)
@@ -336,6 +371,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		SecretCachingClient: secretCachingClient,
 		Tracker:             tracker,
 		WatchFilterValue:    watchFilterValue,
+		Shard:               managerShard,
 		EtcdDialTimeout:     etcdDialTimeout,
 		EtcdCallTimeout:     etcdCallTimeout,
 	}).SetupWithManager(ctx, mgr, concurrency(kubeadmControlPlaneConcurrency)); err != nil {
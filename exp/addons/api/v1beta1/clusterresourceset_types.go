@@ -45,10 +45,21 @@ type ClusterResourceSetSpec struct {
 	// +optional
 	Resources []ResourceRef `json:"resources,omitempty"`
 
+	// ExternalResources is a list of OCI artifacts or Helm charts stored in an OCI registry, which are rendered
+	// and applied to remote clusters after Resources.
+	// +optional
+	ExternalResources []ExternalResource `json:"externalResources,omitempty"`
+
 	// Strategy is the strategy to be used during applying resources. Defaults to ApplyOnce. This field is immutable.
 	// +kubebuilder:validation:Enum=ApplyOnce;Reconcile
 	// +optional
 	Strategy string `json:"strategy,omitempty"`
+
+	// ReapplyInterval is the interval at which the objects applied to the clusters with the "Reconcile" strategy are
+	// checked for drift, i.e. objects deleted or modified in the clusters, and re-applied if required.
+	// If not set, resources are re-applied only when their definition changes.
+	// +optional
+	ReapplyInterval *metav1.Duration `json:"reapplyInterval,omitempty"`
 }
 
 // ANCHOR_END: ClusterResourceSetSpec
@@ -58,8 +69,10 @@ type ClusterResourceSetResourceKind string
 
 // Define the ClusterResourceSetResourceKind constants.
 const (
-	SecretClusterResourceSetResourceKind    ClusterResourceSetResourceKind = "Secret"
-	ConfigMapClusterResourceSetResourceKind ClusterResourceSetResourceKind = "ConfigMap"
+	SecretClusterResourceSetResourceKind      ClusterResourceSetResourceKind = "Secret"
+	ConfigMapClusterResourceSetResourceKind   ClusterResourceSetResourceKind = "ConfigMap"
+	OCIArtifactClusterResourceSetResourceKind ClusterResourceSetResourceKind = "OCIArtifact"
+	HelmChartClusterResourceSetResourceKind   ClusterResourceSetResourceKind = "HelmChart"
 )
 
 // ResourceRef specifies a resource.
@@ -69,10 +82,67 @@ type ResourceRef struct {
 	Name string `json:"name"`
 
 	// Kind of the resource. Supported kinds are: Secrets and ConfigMaps.
-	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	// OCIArtifact and HelmChart are used in ClusterResourceSetBindings to track external resources.
+	// +kubebuilder:validation:Enum=Secret;ConfigMap;OCIArtifact;HelmChart
 	Kind string `json:"kind"`
 }
 
+// ExternalResource specifies a resource stored outside of the management cluster.
+// Exactly one of OCIArtifact or HelmChart must be set.
+type ExternalResource struct {
+	// Name of the external resource; it must be unique among all the external resources of the ClusterResourceSet
+	// and it is used to track the external resource in ClusterResourceSetBindings.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// OCIArtifact references an OCI artifact whose layers contain the YAML or JSON manifests of the resources
+	// to be applied, either as plain files or as tar.gz archives.
+	// +optional
+	OCIArtifact *OCIArtifactSource `json:"ociArtifact,omitempty"`
+
+	// HelmChart references a Helm chart stored in an OCI registry, which is rendered and then applied.
+	// +optional
+	HelmChart *HelmChartSource `json:"helmChart,omitempty"`
+}
+
+// OCIArtifactSource references an artifact in an OCI registry.
+type OCIArtifactSource struct {
+	// URL of the artifact in the form oci://<registry>/<repository>:<tag> or oci://<registry>/<repository>@<digest>.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^oci://`
+	URL string `json:"url"`
+
+	// CredentialsSecretName is the name of a Secret in the same namespace of the ClusterResourceSet
+	// with the username and password keys to be used to authenticate to the registry.
+	// If not set, the artifact is pulled anonymously.
+	// +optional
+	CredentialsSecretName string `json:"credentialsSecretName,omitempty"`
+}
+
+// HelmChartSource references a Helm chart in an OCI registry.
+type HelmChartSource struct {
+	// OCIArtifactSource references the chart in the OCI registry.
+	OCIArtifactSource `json:",inline"`
+
+	// ReleaseName is the name of the release used when rendering the chart.
+	// If not set, the name of the external resource is used.
+	// +optional
+	ReleaseName string `json:"releaseName,omitempty"`
+
+	// ReleaseNamespace is the namespace of the release used when rendering the chart.
+	// If not set, the default namespace is used.
+	// +optional
+	ReleaseNamespace string `json:"releaseNamespace,omitempty"`
+
+	// ValuesTemplate is a Go template rendering the YAML values to be used to render the chart,
+	// which are merged with the default values of the chart.
+	// The template can reference the topology variables of the Cluster, e.g. {{ .myVariable }}, and
+	// the builtin.cluster variables, e.g. {{ .builtin.cluster.name }}.
+	// +optional
+	ValuesTemplate string `json:"valuesTemplate,omitempty"`
+}
+
 // ClusterResourceSetStrategy is a string representation of a ClusterResourceSet Strategy.
 type ClusterResourceSetStrategy string
 
@@ -41,6 +41,25 @@ type ResourceBinding struct {
 
 	// Applied is to track if a resource is applied to the cluster or not.
 	Applied bool `json:"applied"`
+
+	// LastError is the error that occurred the last time the resource was applied to the cluster, if any.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// DriftDetected is true if, at the last check, some of the objects of the resource were missing or
+	// modified in the cluster, and thus they have been re-applied.
+	// Drift is detected only for "Reconcile" ClusterResourceSet.spec.strategy, when ClusterResourceSet.spec.reapplyInterval is set.
+	// +optional
+	DriftDetected bool `json:"driftDetected,omitempty"`
+
+	// LastDriftDetectedTime identifies when drift was last detected for this resource.
+	// +optional
+	LastDriftDetectedTime *metav1.Time `json:"lastDriftDetectedTime,omitempty"`
+
+	// LastDriftCheckTime identifies when this resource was last checked for drift; the resource is checked
+	// again only after ClusterResourceSet.spec.reapplyInterval.
+	// +optional
+	LastDriftCheckTime *metav1.Time `json:"lastDriftCheckTime,omitempty"`
 }
 
 // ANCHOR_END: ResourceBinding
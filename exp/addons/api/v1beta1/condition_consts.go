@@ -39,4 +39,7 @@ const (
 
 	// WrongSecretTypeReason (Severity=Warning) documents at least one of the Secret's type in the resource list is not supported.
 	WrongSecretTypeReason = "WrongSecretType"
+
+	// RenderingResourceFailedReason (Severity=Warning) documents at least one of the external resources is not successfully rendered.
+	RenderingResourceFailedReason = "RenderingResourceFailed"
 )
@@ -19,6 +19,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,15 +44,26 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/remote"
 	addonsv1 "sigs.k8s.io/cluster-api/exp/addons/api/v1beta1"
 	resourcepredicates "sigs.k8s.io/cluster-api/exp/addons/internal/controllers/predicates"
+	"sigs.k8s.io/cluster-api/exp/addons/internal/helm"
+	"sigs.k8s.io/cluster-api/internal/oci"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 // ErrSecretTypeNotSupported signals that a Secret is not supported.
 var ErrSecretTypeNotSupported = errors.New("unsupported secret type")
 
+// externalResourcesSyncPeriod is the interval at which external resources are pulled again from OCI registries
+// when using the Reconcile strategy.
+const externalResourcesSyncPeriod = 10 * time.Minute
+
+// deleteRequeueAfter is the interval at which the primary shard checks if the other shards completed the
+// deletion of a ClusterResourceSet.
+const deleteRequeueAfter = 10 * time.Second
+
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=addons.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
@@ -61,16 +74,31 @@ type ClusterResourceSetReconciler struct {
 	Client  client.Client
 	Tracker *remote.ClusterCacheTracker
 
+	// OCIClient is used to pull external resources from OCI registries.
+	OCIClient oci.Client
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// Shard is the shard of Clusters reconciled by this controller.
+	// ClusterResourceSets are applied by each shard to the Clusters of the shard, while only the primary
+	// shard patches the ClusterResourceSets.
+	Shard shard.Shard
 }
 
 func (r *ClusterResourceSetReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	if r.OCIClient == nil {
+		r.OCIClient = oci.NewClient(nil)
+	}
+
 	err := ctrl.NewControllerManagedBy(mgr).
 		For(&addonsv1.ClusterResourceSet{}).
 		Watches(
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(r.clusterToClusterResourceSet),
+			builder.WithPredicates(
+				predicates.ResourceIsInShard(ctrl.LoggerFrom(ctx), r.Shard),
+			),
 		).
 		WatchesMetadata(
 			&corev1.ConfigMap{},
@@ -118,6 +146,10 @@ func (r *ClusterResourceSetReconciler) Reconcile(ctx context.Context, req ctrl.R
 	}
 
 	defer func() {
+		// If sharding is enabled, only the primary shard patches the ClusterResourceSet, so replicas do not compete on it.
+		if !r.Shard.IsPrimary() {
+			return
+		}
 		// Always attempt to Patch the ClusterResourceSet object and status after each reconciliation.
 		if err := patchHelper.Patch(ctx, clusterResourceSet, patch.WithStatusObservedGeneration{}); err != nil {
 			reterr = kerrors.NewAggregate([]error{reterr, err})
@@ -133,11 +165,13 @@ func (r *ClusterResourceSetReconciler) Reconcile(ctx context.Context, req ctrl.R
 
 	// Handle deletion reconciliation loop.
 	if !clusterResourceSet.ObjectMeta.DeletionTimestamp.IsZero() {
-		return ctrl.Result{}, r.reconcileDelete(ctx, clusters, clusterResourceSet)
+		return r.reconcileDelete(ctx, clusters, clusterResourceSet)
 	}
 
 	// Add finalizer first if not set to avoid the race condition between init and delete.
 	// Note: Finalizers in general can only be added when the deletionTimestamp is not set.
+	// Note: If sharding is enabled, the other shards wait for the primary shard to add the finalizer;
+	// they are notified when the ClusterResourceSet is updated.
 	if !controllerutil.ContainsFinalizer(clusterResourceSet, addonsv1.ClusterResourceSetFinalizer) {
 		controllerutil.AddFinalizer(clusterResourceSet, addonsv1.ClusterResourceSetFinalizer)
 		return ctrl.Result{}, nil
@@ -146,6 +180,10 @@ func (r *ClusterResourceSetReconciler) Reconcile(ctx context.Context, req ctrl.R
 	errs := []error{}
 	errClusterLockedOccurred := false
 	for _, cluster := range clusters {
+		// Only apply the ClusterResourceSet to the Clusters of the shard.
+		if !r.Shard.Contains(cluster) {
+			continue
+		}
 		if err := r.ApplyClusterResourceSet(ctx, cluster, clusterResourceSet); err != nil {
 			// Requeue if the reconcile failed because the ClusterCacheTracker was locked for
 			// the current cluster because of concurrent access.
@@ -171,12 +209,29 @@ func (r *ClusterResourceSetReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{RequeueAfter: time.Minute}, nil
 	}
 
-	return ctrl.Result{}, nil
+	// Requeue periodically to detect drift and changes of external resources, e.g. a new artifact pushed to the same tag.
+	result := ctrl.Result{}
+	if len(clusterResourceSet.Spec.ExternalResources) > 0 && clusterResourceSet.Spec.Strategy == string(addonsv1.ClusterResourceSetStrategyReconcile) {
+		result.RequeueAfter = externalResourcesSyncPeriod
+	}
+	if isDriftDetectionEnabled(clusterResourceSet) && (result.RequeueAfter == 0 || clusterResourceSet.Spec.ReapplyInterval.Duration < result.RequeueAfter) {
+		result.RequeueAfter = clusterResourceSet.Spec.ReapplyInterval.Duration
+	}
+
+	return result, nil
 }
 
 // reconcileDelete removes the deleted ClusterResourceSet from all the ClusterResourceSetBindings it is added to.
-func (r *ClusterResourceSetReconciler) reconcileDelete(ctx context.Context, clusters []*clusterv1.Cluster, crs *addonsv1.ClusterResourceSet) error {
+// If sharding is enabled, each shard cleans up the ClusterResourceSetBindings of its Clusters, and the primary shard
+// removes the finalizer once the ClusterResourceSetBindings of all the Clusters have been cleaned up.
+func (r *ClusterResourceSetReconciler) reconcileDelete(ctx context.Context, clusters []*clusterv1.Cluster, crs *addonsv1.ClusterResourceSet) (ctrl.Result, error) {
+	pendingClusters := []*clusterv1.Cluster{}
 	for _, cluster := range clusters {
+		if !r.Shard.Contains(cluster) {
+			pendingClusters = append(pendingClusters, cluster)
+			continue
+		}
+
 		log := ctrl.LoggerFrom(ctx, "Cluster", klog.KObj(cluster))
 
 		clusterResourceSetBinding := &addonsv1.ClusterResourceSetBinding{}
@@ -186,16 +241,15 @@ func (r *ClusterResourceSetReconciler) reconcileDelete(ctx context.Context, clus
 		}
 		if err := r.Client.Get(ctx, clusterResourceSetBindingKey, clusterResourceSetBinding); err != nil {
 			if !apierrors.IsNotFound(err) {
-				return errors.Wrapf(err, "failed to get ClusterResourceSetBinding during ClusterResourceSet deletion")
+				return ctrl.Result{}, errors.Wrapf(err, "failed to get ClusterResourceSetBinding during ClusterResourceSet deletion")
 			}
-			controllerutil.RemoveFinalizer(crs, addonsv1.ClusterResourceSetFinalizer)
-			return nil
+			continue
 		}
 
 		// Initialize the patch helper.
 		patchHelper, err := patch.NewHelper(clusterResourceSetBinding, r.Client)
 		if err != nil {
-			return err
+			return ctrl.Result{}, err
 		}
 
 		clusterResourceSetBinding.RemoveBinding(crs)
@@ -212,12 +266,31 @@ func (r *ClusterResourceSetReconciler) reconcileDelete(ctx context.Context, clus
 				log.Error(err, "failed to delete empty ClusterResourceSetBinding")
 			}
 		} else if err := patchHelper.Patch(ctx, clusterResourceSetBinding); err != nil {
-			return err
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Wait for the other shards to remove the ClusterResourceSet from the ClusterResourceSetBindings of their Clusters.
+	if r.Shard.IsPrimary() {
+		for _, cluster := range pendingClusters {
+			clusterResourceSetBinding := &addonsv1.ClusterResourceSetBinding{}
+			if err := r.Client.Get(ctx, util.ObjectKey(cluster), clusterResourceSetBinding); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return ctrl.Result{}, errors.Wrapf(err, "failed to get ClusterResourceSetBinding during ClusterResourceSet deletion")
+			}
+			for _, binding := range clusterResourceSetBinding.Spec.Bindings {
+				if binding.ClusterResourceSetName == crs.Name {
+					ctrl.LoggerFrom(ctx).V(4).Info("Waiting for the ClusterResourceSetBinding to be cleaned up by the shard of the Cluster", "Cluster", klog.KObj(cluster))
+					return ctrl.Result{RequeueAfter: deleteRequeueAfter}, nil
+				}
+			}
 		}
 	}
 
 	controllerutil.RemoveFinalizer(crs, addonsv1.ClusterResourceSetFinalizer)
-	return nil
+	return ctrl.Result{}, nil
 }
 
 // getClustersByClusterResourceSetSelector fetches Clusters matched by the ClusterResourceSet's label selector that are in the same namespace as the ClusterResourceSet object.
@@ -335,41 +408,64 @@ func (r *ClusterResourceSetReconciler) ApplyClusterResourceSet(ctx context.Conte
 				Hash:            "",
 				Applied:         false,
 				LastAppliedTime: &metav1.Time{Time: time.Now().UTC()},
+				LastError:       err.Error(),
 			})
 
 			errList = append(errList, err)
 			continue
 		}
 
-		if !resourceScope.needsApply() {
+		if err := r.applyResource(ctx, remoteClient, clusterResourceSet, resourceSetBinding, resource, resourceScope); err != nil {
+			errList = append(errList, err)
+		}
+	}
+
+	// Iterate all external resources, render and apply them to the cluster and update the resource status in the ClusterResourceSetBinding object.
+	// NOTE: The capabilities of the workload cluster are discovered only if there are Helm charts to render.
+	var capabilities *helm.Capabilities
+	for _, externalResource := range clusterResourceSet.Spec.ExternalResources {
+		resource := externalResourceRef(externalResource)
+
+		// With the ApplyOnce strategy, there is no need to pull external resources which are already applied.
+		if clusterResourceSet.Spec.Strategy == string(addonsv1.ClusterResourceSetStrategyApplyOnce) && resourceSetBinding.IsApplied(resource) {
 			continue
 		}
 
-		// Set status in ClusterResourceSetBinding in case of early continue due to a failure.
-		// Set only when resource is retrieved successfully.
-		resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
-			ResourceRef:     resource,
-			Hash:            "",
-			Applied:         false,
-			LastAppliedTime: &metav1.Time{Time: time.Now().UTC()},
-		})
+		artifact, err := r.getExternalResource(ctx, clusterResourceSet, externalResource)
+		if err != nil {
+			conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedCondition, addonsv1.RetrievingResourceFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			errList = append(errList, err)
+			continue
+		}
 
-		// Apply all values in the key-value pair of the resource to the cluster.
-		// As there can be multiple key-value pairs in a resource, each value may have multiple objects in it.
-		isSuccessful := true
-		if err := resourceScope.apply(ctx, remoteClient); err != nil {
-			isSuccessful = false
-			log.Error(err, "failed to apply ClusterResourceSet resource", "Resource kind", resource.Kind, "Resource name", resource.Name)
-			conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedCondition, addonsv1.ApplyFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		if externalResource.HelmChart != nil && capabilities == nil {
+			c, err := r.getCapabilities(ctx, cluster)
+			if err != nil {
+				conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedCondition, addonsv1.RemoteClusterClientFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+				errList = append(errList, err)
+				continue
+			}
+			capabilities = &c
+		}
+
+		data, err := renderExternalResource(cluster, externalResource, artifact, ptr.Deref(capabilities, helm.Capabilities{}))
+		if err != nil {
+			conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedCondition, addonsv1.RenderingResourceFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
 			errList = append(errList, err)
+			continue
 		}
 
-		resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
-			ResourceRef:     resource,
-			Hash:            resourceScope.hash(),
-			Applied:         isSuccessful,
-			LastAppliedTime: &metav1.Time{Time: time.Now().UTC()},
-		})
+		objs, err := objsFromYamlData(data)
+		if err != nil {
+			conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedCondition, addonsv1.RenderingResourceFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+			errList = append(errList, err)
+			continue
+		}
+
+		resourceScope := newResourceReconcileScope(clusterResourceSet, resource, resourceSetBinding, data, objs)
+		if err := r.applyResource(ctx, remoteClient, clusterResourceSet, resourceSetBinding, resource, resourceScope); err != nil {
+			errList = append(errList, err)
+		}
 	}
 	if len(errList) > 0 {
 		return kerrors.NewAggregate(errList)
@@ -380,6 +476,86 @@ func (r *ClusterResourceSetReconciler) ApplyClusterResourceSet(ctx context.Conte
 	return nil
 }
 
+// applyResource applies the objects of a resource to the cluster, if required by the strategy of the ClusterResourceSet,
+// and records the result in the ResourceSetBinding.
+// If drift detection is enabled, objects already applied are re-applied when they are missing or modified in the cluster;
+// each resource is checked for drift at most once per reapplyInterval.
+func (r *ClusterResourceSetReconciler) applyResource(ctx context.Context, remoteClient client.Client, clusterResourceSet *addonsv1.ClusterResourceSet, resourceSetBinding *addonsv1.ResourceSetBinding, resource addonsv1.ResourceRef, resourceScope resourceReconcileScope) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	now := time.Now().UTC()
+	previousBinding := resourceSetBinding.GetResource(resource)
+	driftDetected := false
+	if !resourceScope.needsApply() {
+		if !isDriftDetectionEnabled(clusterResourceSet) {
+			return nil
+		}
+
+		// Skip the drift check if the resource has been checked or applied within the reapplyInterval.
+		if previousBinding != nil && previousBinding.LastDriftCheckTime != nil &&
+			now.Before(previousBinding.LastDriftCheckTime.Add(clusterResourceSet.Spec.ReapplyInterval.Duration)) {
+			return nil
+		}
+
+		drifted, err := resourceScope.detectDrift(ctx, remoteClient)
+		if err != nil {
+			return errors.Wrapf(err, "failed to detect drift for ClusterResourceSet resource %s %s", resource.Kind, resource.Name)
+		}
+		if len(drifted) == 0 {
+			if previousBinding != nil {
+				previousBinding.DriftDetected = false
+				previousBinding.LastDriftCheckTime = &metav1.Time{Time: now}
+				resourceSetBinding.SetBinding(*previousBinding)
+			}
+			return nil
+		}
+
+		log.Info("Drift detected, re-applying ClusterResourceSet resource", "Resource kind", resource.Kind, "Resource name", resource.Name, "objects", strings.Join(drifted, ", "))
+		driftDetected = true
+	}
+
+	newBinding := addonsv1.ResourceBinding{
+		ResourceRef:     resource,
+		Hash:            "",
+		Applied:         false,
+		LastAppliedTime: &metav1.Time{Time: now},
+		DriftDetected:   driftDetected,
+	}
+	if previousBinding != nil {
+		newBinding.LastDriftDetectedTime = previousBinding.LastDriftDetectedTime
+	}
+	if isDriftDetectionEnabled(clusterResourceSet) {
+		// Objects applied now are up to date, so there is no need to check them for drift before the next reapplyInterval.
+		newBinding.LastDriftCheckTime = newBinding.LastAppliedTime
+	}
+	if driftDetected {
+		newBinding.LastDriftDetectedTime = newBinding.LastAppliedTime
+	}
+
+	// Set status in ClusterResourceSetBinding in case of early continue due to a failure.
+	// Set only when resource is retrieved successfully.
+	resourceSetBinding.SetBinding(newBinding)
+
+	// Apply all values in the key-value pair of the resource to the cluster.
+	// As there can be multiple key-value pairs in a resource, each value may have multiple objects in it.
+	err := resourceScope.apply(ctx, remoteClient)
+	if err != nil {
+		log.Error(err, "failed to apply ClusterResourceSet resource", "Resource kind", resource.Kind, "Resource name", resource.Name)
+		conditions.MarkFalse(clusterResourceSet, addonsv1.ResourcesAppliedCondition, addonsv1.ApplyFailedReason, clusterv1.ConditionSeverityWarning, err.Error())
+		newBinding.LastError = err.Error()
+	}
+
+	newBinding.Hash = resourceScope.hash()
+	newBinding.Applied = err == nil
+	resourceSetBinding.SetBinding(newBinding)
+	return err
+}
+
+// isDriftDetectionEnabled returns true if the objects applied by the ClusterResourceSet must be checked for drift.
+func isDriftDetectionEnabled(clusterResourceSet *addonsv1.ClusterResourceSet) bool {
+	return clusterResourceSet.Spec.Strategy == string(addonsv1.ClusterResourceSetStrategyReconcile) && clusterResourceSet.Spec.ReapplyInterval != nil
+}
+
 // getResource retrieves the requested resource and convert it to unstructured type.
 // Unsupported resource kinds are not denied by validation webhook, hence no need to check here.
 // Only supports Secrets/Configmaps as resource types and allow using resources in the same namespace with the cluster.
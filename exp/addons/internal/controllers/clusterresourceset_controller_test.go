@@ -17,6 +17,7 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
 	"crypto/sha1" //nolint: gosec
 	"fmt"
 	"reflect"
@@ -29,6 +30,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/yaml"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -36,6 +38,7 @@ import (
 	"sigs.k8s.io/cluster-api/internal/test/envtest"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 const (
@@ -1063,3 +1066,155 @@ func randomNamespaceForTest(tb testing.TB) string {
 	testNameHash := fmt.Sprintf("%x", h.Sum(nil))
 	return "ns-" + testNameHash[:7] + "-" + util.RandomString(6)
 }
+
+func TestApplyResourceDriftCheckInterval(t *testing.T) {
+	resource := addonsv1.ResourceRef{Name: "resource", Kind: string(addonsv1.ConfigMapClusterResourceSetResourceKind)}
+	clusterResourceSet := &addonsv1.ClusterResourceSet{
+		Spec: addonsv1.ClusterResourceSetSpec{
+			Strategy:        string(addonsv1.ClusterResourceSetStrategyReconcile),
+			ReapplyInterval: &metav1.Duration{Duration: 10 * time.Minute},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		lastDriftCheckTime *metav1.Time
+		drifted            []string
+		wantDriftChecks    int
+		wantApplies        int
+	}{
+		{
+			name:               "drift is not checked within the reapplyInterval",
+			lastDriftCheckTime: &metav1.Time{Time: time.Now().Add(-5 * time.Minute)},
+			wantDriftChecks:    0,
+		},
+		{
+			name:               "drift is checked after the reapplyInterval",
+			lastDriftCheckTime: &metav1.Time{Time: time.Now().Add(-15 * time.Minute)},
+			wantDriftChecks:    1,
+		},
+		{
+			name:            "drift is checked if never checked before",
+			wantDriftChecks: 1,
+		},
+		{
+			name:               "drifted objects are re-applied",
+			lastDriftCheckTime: &metav1.Time{Time: time.Now().Add(-15 * time.Minute)},
+			drifted:            []string{"ConfigMap default/cm (missing)"},
+			wantDriftChecks:    1,
+			wantApplies:        1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			resourceSetBinding := &addonsv1.ResourceSetBinding{}
+			resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
+				ResourceRef:        resource,
+				Hash:               "hash",
+				Applied:            true,
+				LastDriftCheckTime: tt.lastDriftCheckTime,
+			})
+			scope := &fakeResourceReconcileScope{drifted: tt.drifted}
+
+			r := &ClusterResourceSetReconciler{}
+			g.Expect(r.applyResource(context.Background(), nil, clusterResourceSet, resourceSetBinding, resource, scope)).To(Succeed())
+			g.Expect(scope.driftChecks).To(Equal(tt.wantDriftChecks))
+			g.Expect(scope.applies).To(Equal(tt.wantApplies))
+
+			binding := resourceSetBinding.GetResource(resource)
+			g.Expect(binding.DriftDetected).To(Equal(len(tt.drifted) > 0))
+			if tt.wantDriftChecks > 0 {
+				g.Expect(binding.LastDriftCheckTime.Time).To(BeTemporally("~", time.Now(), time.Minute))
+			} else {
+				g.Expect(binding.LastDriftCheckTime).To(Equal(tt.lastDriftCheckTime))
+			}
+		})
+	}
+}
+
+type fakeResourceReconcileScope struct {
+	drifted     []string
+	driftChecks int
+	applies     int
+}
+
+func (s *fakeResourceReconcileScope) needsApply() bool { return false }
+
+func (s *fakeResourceReconcileScope) apply(_ context.Context, _ client.Client) error {
+	s.applies++
+	return nil
+}
+
+func (s *fakeResourceReconcileScope) hash() string { return "hash" }
+
+func (s *fakeResourceReconcileScope) detectDrift(_ context.Context, _ client.Client) ([]string, error) {
+	s.driftChecks++
+	return s.drifted, nil
+}
+
+func TestReconcileDeleteWithShards(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterResourceSet := &addonsv1.ClusterResourceSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "crs",
+			Namespace:  metav1.NamespaceDefault,
+			Finalizers: []string{addonsv1.ClusterResourceSetFinalizer},
+		},
+	}
+
+	// Pick a Cluster for each shard.
+	clusters := make([]*clusterv1.Cluster, 2)
+	for i := 0; clusters[0] == nil || clusters[1] == nil; i++ {
+		name := fmt.Sprintf("cluster-%d", i)
+		index := shard.For(metav1.NamespaceDefault, name, 2)
+		if clusters[index] == nil {
+			clusters[index] = &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault}}
+		}
+	}
+
+	newBinding := func(cluster *clusterv1.Cluster) *addonsv1.ClusterResourceSetBinding {
+		return &addonsv1.ClusterResourceSetBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: cluster.Name, Namespace: cluster.Namespace},
+			Spec: addonsv1.ClusterResourceSetBindingSpec{
+				ClusterName: cluster.Name,
+				Bindings: []*addonsv1.ResourceSetBinding{
+					{ClusterResourceSetName: clusterResourceSet.Name},
+					{ClusterResourceSetName: "other-crs"},
+				},
+			},
+		}
+	}
+
+	c := fake.NewClientBuilder().
+		WithObjects(newBinding(clusters[0]), newBinding(clusters[1])).
+		Build()
+	primary := &ClusterResourceSetReconciler{Client: c, Shard: shard.Shard{Index: 0, Count: 2}}
+	secondary := &ClusterResourceSetReconciler{Client: c, Shard: shard.Shard{Index: 1, Count: 2}}
+
+	// The primary shard only cleans up the ClusterResourceSetBinding of its Cluster, and waits for the other shard.
+	result, err := primary.reconcileDelete(ctx, clusters, clusterResourceSet)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(deleteRequeueAfter))
+	g.Expect(clusterResourceSet.Finalizers).To(ContainElement(addonsv1.ClusterResourceSetFinalizer))
+
+	binding := &addonsv1.ClusterResourceSetBinding{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(clusters[0]), binding)).To(Succeed())
+	g.Expect(binding.Spec.Bindings).To(HaveLen(1))
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(clusters[1]), binding)).To(Succeed())
+	g.Expect(binding.Spec.Bindings).To(HaveLen(2))
+
+	// The other shard cleans up the ClusterResourceSetBinding of its Cluster.
+	_, err = secondary.reconcileDelete(ctx, clusters, clusterResourceSet.DeepCopy())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(clusters[1]), binding)).To(Succeed())
+	g.Expect(binding.Spec.Bindings).To(HaveLen(1))
+
+	// The primary shard removes the finalizer once all the ClusterResourceSetBindings are cleaned up.
+	result, err = primary.reconcileDelete(ctx, clusters, clusterResourceSet)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsZero()).To(BeTrue())
+	g.Expect(clusterResourceSet.Finalizers).ToNot(ContainElement(addonsv1.ClusterResourceSetFinalizer))
+}
@@ -0,0 +1,311 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/yaml"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	addonsv1 "sigs.k8s.io/cluster-api/exp/addons/api/v1beta1"
+	"sigs.k8s.io/cluster-api/exp/addons/internal/helm"
+	"sigs.k8s.io/cluster-api/internal/oci"
+	"sigs.k8s.io/cluster-api/util"
+)
+
+const (
+	// ociCredentialsUsernameKey is the key of the username in the Secret with the credentials of an OCI registry.
+	ociCredentialsUsernameKey = "username"
+
+	// ociCredentialsPasswordKey is the key of the password in the Secret with the credentials of an OCI registry.
+	ociCredentialsPasswordKey = "password"
+
+	// maxExternalResourceLayerSize is the maximum size of a decompressed layer of an OCI artifact.
+	maxExternalResourceLayerSize = 64 << 20
+)
+
+// externalResourceRef returns the ResourceRef used to track an external resource in ClusterResourceSetBindings.
+func externalResourceRef(externalResource addonsv1.ExternalResource) addonsv1.ResourceRef {
+	kind := addonsv1.OCIArtifactClusterResourceSetResourceKind
+	if externalResource.HelmChart != nil {
+		kind = addonsv1.HelmChartClusterResourceSetResourceKind
+	}
+	return addonsv1.ResourceRef{Name: externalResource.Name, Kind: string(kind)}
+}
+
+// getExternalResource pulls the artifact of an external resource from the OCI registry.
+func (r *ClusterResourceSetReconciler) getExternalResource(ctx context.Context, clusterResourceSet *addonsv1.ClusterResourceSet, externalResource addonsv1.ExternalResource) (*oci.Artifact, error) {
+	source := externalResource.OCIArtifact
+	if externalResource.HelmChart != nil {
+		source = &externalResource.HelmChart.OCIArtifactSource
+	}
+	if source == nil {
+		return nil, errors.Errorf("external resource %s does not define an OCI artifact nor a Helm chart", externalResource.Name)
+	}
+
+	var credentials *oci.Credentials
+	if source.CredentialsSecretName != "" {
+		secret, err := getSecret(ctx, r.Client, types.NamespacedName{Namespace: clusterResourceSet.Namespace, Name: source.CredentialsSecretName})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get credentials for external resource %s", externalResource.Name)
+		}
+		credentials = &oci.Credentials{
+			Username: string(secret.Data[ociCredentialsUsernameKey]),
+			Password: string(secret.Data[ociCredentialsPasswordKey]),
+		}
+	}
+
+	artifact, err := r.OCIClient.Pull(ctx, source.URL, credentials)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to pull external resource %s", externalResource.Name)
+	}
+	return artifact, nil
+}
+
+// getCapabilities returns the capabilities of the workload cluster exposed to Helm chart templates, i.e. the
+// Kubernetes version and the API versions served by the workload cluster.
+func (r *ClusterResourceSetReconciler) getCapabilities(ctx context.Context, cluster *clusterv1.Cluster) (helm.Capabilities, error) {
+	restConfig, err := r.Tracker.GetRESTConfig(ctx, util.ObjectKey(cluster))
+	if err != nil {
+		return helm.Capabilities{}, errors.Wrap(err, "failed to get REST config for the workload cluster")
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return helm.Capabilities{}, errors.Wrap(err, "failed to create discovery client for the workload cluster")
+	}
+
+	version, err := discoveryClient.ServerVersion()
+	if err != nil {
+		return helm.Capabilities{}, errors.Wrap(err, "failed to get the Kubernetes version of the workload cluster")
+	}
+	// NOTE: Partial results are used when some API groups cannot be discovered, e.g. because an aggregated API server is down.
+	_, resourceLists, err := discoveryClient.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return helm.Capabilities{}, errors.Wrap(err, "failed to discover the API versions of the workload cluster")
+	}
+
+	capabilities := helm.Capabilities{KubernetesVersion: version.GitVersion}
+	for _, resourceList := range resourceLists {
+		capabilities.APIVersions = append(capabilities.APIVersions, resourceList.GroupVersion)
+		for _, resource := range resourceList.APIResources {
+			// Skip subresources, e.g. deployments/status.
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+			capabilities.APIVersions = append(capabilities.APIVersions, fmt.Sprintf("%s/%s", resourceList.GroupVersion, resource.Kind))
+		}
+	}
+	return capabilities, nil
+}
+
+// renderExternalResource returns the YAML documents defined by an external resource for the given Cluster.
+// For OCI artifacts, the documents are read from the layers of the artifact; for Helm charts, the
+// chart is rendered using the values computed from the topology variables of the Cluster and the
+// given capabilities of the workload cluster.
+func renderExternalResource(cluster *clusterv1.Cluster, externalResource addonsv1.ExternalResource, artifact *oci.Artifact, capabilities helm.Capabilities) ([][]byte, error) {
+	if externalResource.HelmChart == nil {
+		docs, err := docsFromArtifact(artifact)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read external resource %s", externalResource.Name)
+		}
+		return docs, nil
+	}
+
+	chartSource := externalResource.HelmChart
+	var chartArchive []byte
+	for _, l := range artifact.Layers {
+		if l.MediaType == oci.HelmChartContentLayerMediaType {
+			chartArchive = l.Data
+			break
+		}
+	}
+	if chartArchive == nil {
+		return nil, errors.Errorf("failed to read external resource %s: artifact does not contain a Helm chart", externalResource.Name)
+	}
+	chart, err := helm.Load(chartArchive)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load Helm chart of external resource %s", externalResource.Name)
+	}
+
+	values, err := renderValues(cluster, chartSource.ValuesTemplate)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to render values of external resource %s", externalResource.Name)
+	}
+
+	release := helm.Release{Name: chartSource.ReleaseName, Namespace: chartSource.ReleaseNamespace}
+	if release.Name == "" {
+		release.Name = externalResource.Name
+	}
+	if release.Namespace == "" {
+		release.Namespace = "default"
+	}
+	if capabilities.KubernetesVersion == "" && cluster.Spec.Topology != nil {
+		capabilities.KubernetesVersion = cluster.Spec.Topology.Version
+	}
+	docs, err := chart.Render(release, values, capabilities)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to render Helm chart of external resource %s", externalResource.Name)
+	}
+	if len(docs) == 0 {
+		return nil, nil
+	}
+
+	// The rendered templates are joined in a single document, so all the objects of the release are sorted
+	// for creation priority, e.g. Namespaces and CustomResourceDefinitions are created first.
+	return [][]byte{bytes.Join(docs, []byte("\n---\n"))}, nil
+}
+
+// renderValues renders the values template of a Helm chart using the topology variables of the Cluster
+// and the builtin.cluster variables.
+func renderValues(cluster *clusterv1.Cluster, valuesTemplate string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if strings.TrimSpace(valuesTemplate) == "" {
+		return values, nil
+	}
+
+	tpl, err := template.New("values").Funcs(sprig.HermeticTxtFuncMap()).Option("missingkey=zero").Parse(valuesTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse values template")
+	}
+	variables, err := valuesTemplateVariables(cluster)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, variables); err != nil {
+		return nil, errors.Wrap(err, "failed to execute values template")
+	}
+
+	if err := yaml.Unmarshal([]byte(strings.ReplaceAll(buf.String(), "<no value>", "")), &values); err != nil {
+		return nil, errors.Wrap(err, "failed to parse rendered values")
+	}
+	return values, nil
+}
+
+// valuesTemplateVariables returns the variables available in values templates.
+func valuesTemplateVariables(cluster *clusterv1.Cluster) (map[string]interface{}, error) {
+	builtinCluster := map[string]interface{}{
+		"name":      cluster.Name,
+		"namespace": cluster.Namespace,
+	}
+	variables := map[string]interface{}{
+		"builtin": map[string]interface{}{"cluster": builtinCluster},
+	}
+	if cluster.Spec.Topology == nil {
+		return variables, nil
+	}
+
+	builtinCluster["topology"] = map[string]interface{}{
+		"version": cluster.Spec.Topology.Version,
+		"class":   cluster.Spec.Topology.Class,
+	}
+	for _, v := range cluster.Spec.Topology.Variables {
+		// If a variable is defined more than once, e.g. by different definitions, the first value wins.
+		if _, ok := variables[v.Name]; ok {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal(v.Value.Raw, &value); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse value of variable %s", v.Name)
+		}
+		variables[v.Name] = value
+	}
+	return variables, nil
+}
+
+// docsFromArtifact returns the YAML or JSON documents stored in the layers of an OCI artifact.
+// Layers can be plain documents or tar archives, optionally gzipped; in archives, only files with
+// the .yaml, .yml and .json extensions are considered, in lexical order.
+func docsFromArtifact(artifact *oci.Artifact) ([][]byte, error) {
+	docs := [][]byte{}
+	for _, l := range artifact.Layers {
+		data := l.Data
+		isArchive := strings.Contains(l.MediaType, "tar")
+		if bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+			gz, err := gzip.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read layer %s", l.Digest)
+			}
+			data, err = io.ReadAll(io.LimitReader(gz, maxExternalResourceLayerSize+1))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read layer %s", l.Digest)
+			}
+			if len(data) > maxExternalResourceLayerSize {
+				return nil, errors.Errorf("failed to read layer %s: decompressed content exceeds the maximum size of %d bytes", l.Digest, maxExternalResourceLayerSize)
+			}
+			isArchive = true
+		}
+
+		if !isArchive {
+			if len(bytes.TrimSpace(data)) > 0 {
+				docs = append(docs, data)
+			}
+			continue
+		}
+
+		files := map[string][]byte{}
+		tr := tar.NewReader(bytes.NewReader(data))
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read layer %s", l.Digest)
+			}
+			switch path.Ext(header.Name) {
+			case ".yaml", ".yml", ".json":
+			default:
+				continue
+			}
+			if header.Typeflag != tar.TypeReg {
+				continue
+			}
+			content, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read %s in layer %s", header.Name, l.Digest)
+			}
+			if len(bytes.TrimSpace(content)) > 0 {
+				files[header.Name] = content
+			}
+		}
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			docs = append(docs, files[name])
+		}
+	}
+	return docs, nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	addonsv1 "sigs.k8s.io/cluster-api/exp/addons/api/v1beta1"
+	"sigs.k8s.io/cluster-api/exp/addons/internal/helm"
+	"sigs.k8s.io/cluster-api/internal/oci"
+)
+
+func TestRenderExternalResource(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster1", Namespace: metav1.NamespaceDefault},
+		Spec: clusterv1.ClusterSpec{
+			Topology: &clusterv1.Topology{
+				Class:   "class1",
+				Version: "v1.29.0",
+				Variables: []clusterv1.ClusterVariable{
+					{Name: "cni", Value: apiextensionsv1.JSON{Raw: []byte(`{"mtu":1450}`)}},
+				},
+			},
+		},
+	}
+
+	configMap := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n  namespace: default\n"
+	service := "apiVersion: v1\nkind: Service\nmetadata:\n  name: svc\n  namespace: default\n"
+
+	chart := tarGz(t, map[string]string{
+		"cni/Chart.yaml":  "name: cni\nversion: 1.0.0\n",
+		"cni/values.yaml": "mtu: 1500\ncluster: \"\"\n",
+		"cni/templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}
+  namespace: {{ .Release.Namespace }}
+data:
+  mtu: "{{ .Values.mtu }}"
+  cluster: {{ .Values.cluster }}
+  version: {{ .Capabilities.KubeVersion.Version }}
+`,
+	})
+
+	tests := []struct {
+		name             string
+		externalResource addonsv1.ExternalResource
+		artifact         *oci.Artifact
+		want             []string
+		wantErr          bool
+	}{
+		{
+			name:             "plain YAML layers",
+			externalResource: addonsv1.ExternalResource{Name: "manifests", OCIArtifact: &addonsv1.OCIArtifactSource{}},
+			artifact: &oci.Artifact{Layers: []oci.Layer{
+				{MediaType: "application/yaml", Data: []byte(configMap)},
+				{MediaType: "application/yaml", Data: []byte(service)},
+			}},
+			want: []string{configMap, service},
+		},
+		{
+			name:             "tar.gz layer",
+			externalResource: addonsv1.ExternalResource{Name: "manifests", OCIArtifact: &addonsv1.OCIArtifactSource{}},
+			artifact: &oci.Artifact{Layers: []oci.Layer{
+				{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip", Data: tarGz(t, map[string]string{
+					"manifests/b.yaml":  service,
+					"manifests/a.yaml":  configMap,
+					"manifests/README":  "not a manifest",
+					"manifests/c.yml":   "",
+					"manifests/d.json ": "{}",
+				})},
+			}},
+			want: []string{configMap, service},
+		},
+		{
+			name: "Helm chart",
+			externalResource: addonsv1.ExternalResource{Name: "cni", HelmChart: &addonsv1.HelmChartSource{
+				ReleaseNamespace: "kube-system",
+				ValuesTemplate:   "mtu: {{ .cni.mtu }}\ncluster: {{ .builtin.cluster.name }}",
+			}},
+			artifact: &oci.Artifact{Layers: []oci.Layer{
+				{MediaType: oci.HelmChartContentLayerMediaType, Data: chart},
+			}},
+			want: []string{`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cni
+  namespace: kube-system
+data:
+  mtu: "1450"
+  cluster: cluster1
+  version: v1.29.0
+`},
+		},
+		{
+			name:             "Helm chart without chart layer",
+			externalResource: addonsv1.ExternalResource{Name: "cni", HelmChart: &addonsv1.HelmChartSource{}},
+			artifact: &oci.Artifact{Layers: []oci.Layer{
+				{MediaType: "application/yaml", Data: []byte(configMap)},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "Helm chart with invalid values",
+			externalResource: addonsv1.ExternalResource{Name: "cni", HelmChart: &addonsv1.HelmChartSource{
+				ValuesTemplate: "mtu: [",
+			}},
+			artifact: &oci.Artifact{Layers: []oci.Layer{
+				{MediaType: oci.HelmChartContentLayerMediaType, Data: chart},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			docs, err := renderExternalResource(cluster, tt.externalResource, tt.artifact, helm.Capabilities{})
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			got := []string{}
+			for _, d := range docs {
+				got = append(got, string(d))
+			}
+			g.Expect(got).To(Equal(tt.want))
+
+			_, err = objsFromYamlData(docs)
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func tarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -24,6 +24,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"sort"
 	"unicode"
 
@@ -93,6 +94,87 @@ func isJSONList(data []byte) (bool, error) {
 	return bytes.HasPrefix(trim, jsonListPrefix), nil
 }
 
+// isObjectInSync returns true if all the fields defined in the desired object, except metadata other
+// than labels and annotations, have the same value in the current object.
+// NOTE: Fields set by other controllers, e.g. status, or defaulted by the API server are ignored.
+func isObjectInSync(desired, current *unstructured.Unstructured) bool {
+	desiredContent := map[string]interface{}{}
+	for k, v := range desired.UnstructuredContent() {
+		if k == "metadata" || k == "status" {
+			continue
+		}
+		desiredContent[k] = v
+	}
+	if !isSubset(desiredContent, current.UnstructuredContent()) {
+		return false
+	}
+
+	for k, v := range desired.GetLabels() {
+		if current.GetLabels()[k] != v {
+			return false
+		}
+	}
+	for k, v := range desired.GetAnnotations() {
+		if current.GetAnnotations()[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// isSubset returns true if all the values in desired are set in current; maps are compared recursively,
+// while lists must have the same length and each element of the desired list must be a subset of the
+// corresponding element in the current list.
+// Empty maps and lists are considered a subset of unset values, because the API server may drop them.
+func isSubset(desired, current interface{}) bool {
+	switch desiredTyped := desired.(type) {
+	case map[string]interface{}:
+		if len(desiredTyped) == 0 && current == nil {
+			return true
+		}
+		currentTyped, ok := current.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range desiredTyped {
+			if !isSubset(v, currentTyped[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		if len(desiredTyped) == 0 && current == nil {
+			return true
+		}
+		currentTyped, ok := current.([]interface{})
+		if !ok || len(desiredTyped) != len(currentTyped) {
+			return false
+		}
+		for i := range desiredTyped {
+			if !isSubset(desiredTyped[i], currentTyped[i]) {
+				return false
+			}
+		}
+		return true
+	case int64, float64:
+		// Numbers can be decoded as int64 or float64 depending on their value.
+		return toFloat64(desired) == toFloat64(current)
+	default:
+		return reflect.DeepEqual(desired, current)
+	}
+}
+
+func toFloat64(v interface{}) interface{} {
+	switch n := v.(type) {
+	case int64:
+		return float64(n)
+	case float64:
+		return n
+	default:
+		return v
+	}
+}
+
 func createUnstructured(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
 	if err := c.Create(ctx, obj); err != nil {
 		return errors.Wrapf(
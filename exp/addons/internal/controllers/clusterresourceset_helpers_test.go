@@ -271,3 +271,67 @@ func TestEnsureKubernetesServiceCreated(t *testing.T) {
 		})
 	}
 }
+
+func TestIsSubset(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired interface{}
+		current interface{}
+		want    bool
+	}{
+		{
+			name:    "equal scalars",
+			desired: "foo",
+			current: "foo",
+			want:    true,
+		},
+		{
+			name:    "different scalars",
+			desired: "foo",
+			current: "bar",
+			want:    false,
+		},
+		{
+			name:    "numbers with different types",
+			desired: int64(1),
+			current: float64(1),
+			want:    true,
+		},
+		{
+			name:    "map with additional fields",
+			desired: map[string]interface{}{"a": map[string]interface{}{"b": "c"}},
+			current: map[string]interface{}{"a": map[string]interface{}{"b": "c", "d": "e"}, "f": "g"},
+			want:    true,
+		},
+		{
+			name:    "map with missing fields",
+			desired: map[string]interface{}{"a": map[string]interface{}{"b": "c"}},
+			current: map[string]interface{}{"a": map[string]interface{}{"d": "e"}},
+			want:    false,
+		},
+		{
+			name:    "lists with defaulted fields",
+			desired: []interface{}{map[string]interface{}{"port": int64(80)}},
+			current: []interface{}{map[string]interface{}{"port": int64(80), "protocol": "TCP"}},
+			want:    true,
+		},
+		{
+			name:    "lists with different length",
+			desired: []interface{}{"a"},
+			current: []interface{}{"a", "b"},
+			want:    false,
+		},
+		{
+			name:    "empty map and unset value",
+			desired: map[string]interface{}{"a": map[string]interface{}{}},
+			current: map[string]interface{}{},
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(isSubset(tt.desired, tt.current)).To(Equal(tt.want))
+		})
+	}
+}
@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -40,6 +41,8 @@ type resourceReconcileScope interface {
 	// hash returns a computed hash of the defined objects in the resource. It is consistent
 	// between runs.
 	hash() string
+	// detectDrift returns the objects defined by the resource which are missing or modified in the target cluster.
+	detectDrift(ctx context.Context, c client.Client) ([]string, error)
 }
 
 func reconcileScopeForResource(
@@ -149,6 +152,34 @@ func (r *reconcileStrategyScope) applyObj(ctx context.Context, c client.Client,
 	return nil
 }
 
+func (r *reconcileStrategyScope) detectDrift(ctx context.Context, c client.Client) ([]string, error) {
+	drifted := []string{}
+	objs := r.objs()
+	for i := range objs {
+		obj := &objs[i]
+		currentObj := &unstructured.Unstructured{}
+		currentObj.SetAPIVersion(obj.GetAPIVersion())
+		currentObj.SetKind(obj.GetKind())
+		err := c.Get(ctx, client.ObjectKeyFromObject(obj), currentObj)
+		if apierrors.IsNotFound(err) {
+			drifted = append(drifted, fmt.Sprintf("%s %s (missing)", obj.GetKind(), klog.KObj(obj)))
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(
+				err,
+				"reading object %s %s",
+				obj.GroupVersionKind(),
+				klog.KObj(obj),
+			)
+		}
+		if !isObjectInSync(obj, currentObj) {
+			drifted = append(drifted, fmt.Sprintf("%s %s (modified)", obj.GetKind(), klog.KObj(obj)))
+		}
+	}
+	return drifted, nil
+}
+
 type reconcileApplyOnceScope struct {
 	baseResourceReconcileScope
 }
@@ -161,6 +192,11 @@ func (r *reconcileApplyOnceScope) apply(ctx context.Context, c client.Client) er
 	return apply(ctx, c, r.applyObj, r.objs())
 }
 
+func (r *reconcileApplyOnceScope) detectDrift(_ context.Context, _ client.Client) ([]string, error) {
+	// Drift is not detected for the ApplyOnce strategy, because objects are never re-applied.
+	return nil, nil
+}
+
 func (r *reconcileApplyOnceScope) applyObj(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
 	// The create call is idempotent, so if the object already exists
 	// then do not consider it to be an error.
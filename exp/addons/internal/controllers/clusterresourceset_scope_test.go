@@ -249,3 +249,90 @@ func TestReconcileApplyOnceScopeApplyObj(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileStrategyScopeDetectDrift(t *testing.T) {
+	desired := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      "my-cm",
+				"namespace": "that-ns",
+				"labels": map[string]interface{}{
+					"foo": "bar",
+				},
+			},
+			"data": map[string]interface{}{
+				"key": "value",
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		existingObjs []client.Object
+		want         []string
+	}{
+		{
+			name: "object in sync",
+			existingObjs: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "my-cm",
+						Namespace:   "that-ns",
+						Labels:      map[string]string{"foo": "bar", "other": "label"},
+						Annotations: map[string]string{"some": "annotation"},
+					},
+					Data: map[string]string{"key": "value", "other": "value"},
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "object missing",
+			want: []string{"ConfigMap that-ns/my-cm (missing)"},
+		},
+		{
+			name: "object with modified data",
+			existingObjs: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-cm",
+						Namespace: "that-ns",
+						Labels:    map[string]string{"foo": "bar"},
+					},
+					Data: map[string]string{"key": "modified"},
+				},
+			},
+			want: []string{"ConfigMap that-ns/my-cm (modified)"},
+		},
+		{
+			name: "object with removed label",
+			existingObjs: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "my-cm",
+						Namespace: "that-ns",
+					},
+					Data: map[string]string{"key": "value"},
+				},
+			},
+			want: []string{"ConfigMap that-ns/my-cm (modified)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := NewWithT(t)
+			ctx := context.Background()
+			client := fake.NewClientBuilder().WithObjects(tt.existingObjs...).Build()
+			scope := &reconcileStrategyScope{
+				baseResourceReconcileScope: baseResourceReconcileScope{
+					normalizedObjs: []unstructured.Unstructured{*desired.DeepCopy()},
+				},
+			}
+			drifted, err := scope.detectDrift(ctx, client)
+			gs.Expect(err).ToNot(HaveOccurred())
+			gs.Expect(drifted).To(Equal(tt.want))
+		})
+	}
+}
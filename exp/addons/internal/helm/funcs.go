@@ -0,0 +1,175 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// funcMap returns the functions available in chart templates, i.e. the hermetic sprig functions
+// and the functions added by Helm.
+func funcMap(t *template.Template) template.FuncMap {
+	f := sprig.HermeticTxtFuncMap()
+	f["include"] = func(name string, data interface{}) (string, error) {
+		var buf bytes.Buffer
+		if err := t.ExecuteTemplate(&buf, name, data); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	}
+	f["tpl"] = func(text string, data interface{}) (string, error) {
+		clone, err := t.Clone()
+		if err != nil {
+			return "", err
+		}
+		tpl, err := clone.New("tpl").Parse(text)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to parse tpl")
+		}
+		var buf bytes.Buffer
+		if err := tpl.Execute(&buf, data); err != nil {
+			return "", errors.Wrap(err, "failed to render tpl")
+		}
+		return strings.ReplaceAll(buf.String(), "<no value>", ""), nil
+	}
+	f["required"] = func(msg string, v interface{}) (interface{}, error) {
+		if v == nil {
+			return nil, errors.New(msg)
+		}
+		if s, ok := v.(string); ok && s == "" {
+			return nil, errors.New(msg)
+		}
+		return v, nil
+	}
+	f["toYaml"] = func(v interface{}) string {
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSuffix(string(data), "\n")
+	}
+	f["fromYaml"] = func(s string) map[string]interface{} {
+		m := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(s), &m); err != nil {
+			m["Error"] = err.Error()
+		}
+		return m
+	}
+	f["fromYamlArray"] = func(s string) []interface{} {
+		a := []interface{}{}
+		if err := yaml.Unmarshal([]byte(s), &a); err != nil {
+			a = []interface{}{err.Error()}
+		}
+		return a
+	}
+	f["toJson"] = func(v interface{}) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+	f["fromJson"] = func(s string) map[string]interface{} {
+		m := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(s), &m); err != nil {
+			m["Error"] = err.Error()
+		}
+		return m
+	}
+	f["fromJsonArray"] = func(s string) []interface{} {
+		a := []interface{}{}
+		if err := json.Unmarshal([]byte(s), &a); err != nil {
+			a = []interface{}{err.Error()}
+		}
+		return a
+	}
+	f["lookup"] = func(string, string, string, string) (map[string]interface{}, error) {
+		return nil, errors.New("lookup is not supported")
+	}
+	return f
+}
+
+// files gives access to the non-template files of a chart, i.e. .Files in chart templates.
+type files map[string][]byte
+
+// Get returns the content of the file with the given name, or an empty string if the file does not exist.
+func (f files) Get(name string) string {
+	return string(f[name])
+}
+
+// GetBytes returns the content of the file with the given name, or nil if the file does not exist.
+func (f files) GetBytes(name string) []byte {
+	return f[name]
+}
+
+// Glob returns the files matching the given pattern.
+func (f files) Glob(pattern string) files {
+	matches := files{}
+	for name, data := range f {
+		if ok, _ := path.Match(pattern, name); ok {
+			matches[name] = data
+		}
+	}
+	return matches
+}
+
+// apiVersions is .Capabilities.APIVersions in chart templates.
+type apiVersions []string
+
+// Has returns true if the given API version, in the group/version or in the group/version/kind form,
+// is served by the workload cluster.
+func (a apiVersions) Has(version string) bool {
+	for _, v := range a {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeValues merges src into dst; maps are merged recursively and any other value in src replaces the value in dst.
+func mergeValues(dst, src map[string]interface{}) map[string]interface{} {
+	for k, srcValue := range src {
+		if srcMap, ok := srcValue.(map[string]interface{}); ok {
+			if dstMap, ok := dst[k].(map[string]interface{}); ok {
+				dst[k] = mergeValues(dstMap, srcMap)
+				continue
+			}
+		}
+		dst[k] = srcValue
+	}
+	return dst
+}
+
+func deepCopy(m map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if vm, ok := v.(map[string]interface{}); ok {
+			v = deepCopy(vm)
+		}
+		out[k] = v
+	}
+	return out
+}
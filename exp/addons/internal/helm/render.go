@@ -0,0 +1,233 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package helm implements a minimal renderer for Helm charts.
+// NOTE: Only the subset of Helm features required to render self-contained charts is supported;
+// charts with dependencies or subcharts are rejected when loaded, hooks are rendered as plain resources
+// and the lookup function is not supported.
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/blang/semver/v4"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+const maxChartFileSize = 8 << 20
+
+// Release defines the release used when rendering a chart.
+type Release struct {
+	Name      string
+	Namespace string
+}
+
+// Capabilities defines the capabilities of the workload cluster exposed to chart templates as .Capabilities.
+type Capabilities struct {
+	// KubernetesVersion is the Kubernetes version of the workload cluster, if known.
+	KubernetesVersion string
+
+	// APIVersions are the API versions served by the workload cluster, both in the
+	// group/version and in the group/version/kind form.
+	APIVersions []string
+}
+
+// Chart is a Helm chart loaded from an archive.
+type Chart struct {
+	Metadata map[string]interface{}
+	Values   map[string]interface{}
+
+	crds      map[string][]byte
+	templates map[string][]byte
+	files     map[string][]byte
+}
+
+// Load loads a Helm chart from a tar.gz archive.
+func Load(archive []byte) (*Chart, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read chart archive")
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read chart archive")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		// Files in a chart archive are nested in a folder named after the chart.
+		_, name, ok := strings.Cut(path.Clean(header.Name), "/")
+		if !ok {
+			continue
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxChartFileSize+1))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s from chart archive", header.Name)
+		}
+		if len(data) > maxChartFileSize {
+			return nil, errors.Errorf("file %s in chart archive exceeds the maximum size of %d bytes", header.Name, maxChartFileSize)
+		}
+		files[name] = data
+	}
+
+	chartYAML, ok := files["Chart.yaml"]
+	if !ok {
+		return nil, errors.New("invalid chart archive: Chart.yaml is missing")
+	}
+	c := &Chart{
+		Metadata:  map[string]interface{}{},
+		Values:    map[string]interface{}{},
+		crds:      map[string][]byte{},
+		templates: map[string][]byte{},
+		files:     map[string][]byte{},
+	}
+	if err := yaml.Unmarshal(chartYAML, &c.Metadata); err != nil {
+		return nil, errors.Wrap(err, "failed to parse Chart.yaml")
+	}
+	if name, _ := c.Metadata["name"].(string); name == "" {
+		return nil, errors.New("invalid chart archive: name is missing in Chart.yaml")
+	}
+	if deps, ok := c.Metadata["dependencies"].([]interface{}); ok && len(deps) > 0 {
+		return nil, errors.New("charts with dependencies are not supported")
+	}
+	if values, ok := files["values.yaml"]; ok {
+		if err := yaml.Unmarshal(values, &c.Values); err != nil {
+			return nil, errors.Wrap(err, "failed to parse values.yaml")
+		}
+	}
+
+	for name, data := range files {
+		switch {
+		case strings.HasPrefix(name, "charts/"):
+			return nil, errors.New("charts with subcharts are not supported")
+		case strings.HasPrefix(name, "templates/"):
+			c.templates[name] = data
+		case strings.HasPrefix(name, "crds/"):
+			c.crds[name] = data
+		default:
+			c.files[name] = data
+		}
+	}
+	return c, nil
+}
+
+// Render renders the chart with the given release, values and workload cluster capabilities and returns the
+// CRDs and the rendered templates as a list of YAML documents.
+// Values are merged into the default values of the chart.
+func (c *Chart) Render(release Release, values map[string]interface{}, clusterCapabilities Capabilities) ([][]byte, error) {
+	chartName := c.Metadata["name"].(string)
+
+	capabilities := map[string]interface{}{
+		"APIVersions": apiVersions(clusterCapabilities.APIVersions),
+		"HelmVersion": map[string]interface{}{"Version": "v3"},
+	}
+	if clusterCapabilities.KubernetesVersion != "" {
+		v, err := semver.ParseTolerant(clusterCapabilities.KubernetesVersion)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse Kubernetes version %q", clusterCapabilities.KubernetesVersion)
+		}
+		capabilities["KubeVersion"] = map[string]interface{}{
+			"Version":    "v" + v.String(),
+			"GitVersion": "v" + v.String(),
+			"Major":      strconv.FormatUint(v.Major, 10),
+			"Minor":      strconv.FormatUint(v.Minor, 10),
+		}
+	}
+
+	chartMetadata := map[string]interface{}{}
+	for k, v := range c.Metadata {
+		chartMetadata[strings.ToUpper(k[:1])+k[1:]] = v
+	}
+
+	data := map[string]interface{}{
+		"Values": mergeValues(deepCopy(c.Values), values),
+		"Release": map[string]interface{}{
+			"Name":      release.Name,
+			"Namespace": release.Namespace,
+			"IsInstall": true,
+			"IsUpgrade": false,
+			"Revision":  1,
+			"Service":   "Helm",
+		},
+		"Chart":        chartMetadata,
+		"Capabilities": capabilities,
+		"Files":        files(c.files),
+	}
+
+	t := template.New("chart").Option("missingkey=zero")
+	t.Funcs(funcMap(t))
+
+	names := make([]string, 0, len(c.templates))
+	for name := range c.templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := t.New(path.Join(chartName, name)).Parse(string(c.templates[name])); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse template %s", name)
+		}
+	}
+
+	docs := [][]byte{}
+	crdNames := make([]string, 0, len(c.crds))
+	for name := range c.crds {
+		crdNames = append(crdNames, name)
+	}
+	sort.Strings(crdNames)
+	for _, name := range crdNames {
+		docs = append(docs, c.crds[name])
+	}
+
+	for _, name := range names {
+		base := path.Base(name)
+		// Partials and notes are not rendered as resources.
+		if strings.HasPrefix(base, "_") || strings.EqualFold(base, "NOTES.txt") {
+			continue
+		}
+		templateName := path.Join(chartName, name)
+		data["Template"] = map[string]interface{}{
+			"Name":     templateName,
+			"BasePath": path.Join(chartName, "templates"),
+		}
+		var buf bytes.Buffer
+		if err := t.ExecuteTemplate(&buf, templateName, data); err != nil {
+			return nil, errors.Wrapf(err, "failed to render template %s", name)
+		}
+		out := strings.ReplaceAll(buf.String(), "<no value>", "")
+		if strings.TrimSpace(out) == "" {
+			continue
+		}
+		docs = append(docs, []byte(out))
+	}
+	return docs, nil
+}
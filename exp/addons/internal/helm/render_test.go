@@ -0,0 +1,181 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRender(t *testing.T) {
+	g := NewWithT(t)
+
+	archive := chartArchive(t, map[string]string{
+		"Chart.yaml":  "apiVersion: v2\nname: test\nversion: 1.2.3\n",
+		"values.yaml": "replicas: 1\nimage:\n  repository: example.com/test\n  tag: v1\n",
+		"templates/_helpers.tpl": `{{- define "test.fullname" -}}
+{{ .Release.Name }}-{{ .Chart.Name }}
+{{- end -}}`,
+		"templates/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ include "test.fullname" . }}
+  namespace: {{ .Release.Namespace }}
+  labels:
+    chart: {{ .Chart.Name }}-{{ .Chart.Version }}
+spec:
+  replicas: {{ .Values.replicas }}
+  template:
+    spec:
+      containers:
+      - image: {{ .Values.image.repository }}:{{ .Values.image.tag }}
+        args: {{ toJson .Values.args }}
+{{- if semverCompare ">=1.29" .Capabilities.KubeVersion.Version }}
+        env:{{ .Files.Get "env.yaml" | fromYaml | toYaml | nindent 8 }}
+{{- end }}
+`,
+		"templates/servicemonitor.yaml": `{{- if .Capabilities.APIVersions.Has "monitoring.coreos.com/v1/ServiceMonitor" }}
+apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+{{- end }}
+`,
+		"templates/podmonitor.yaml": `{{- if .Capabilities.APIVersions.Has "monitoring.coreos.com/v1/PodMonitor" }}
+apiVersion: monitoring.coreos.com/v1
+kind: PodMonitor
+{{- end }}
+`,
+		"templates/disabled.yaml": `{{- if .Values.disabled }}
+apiVersion: v1
+kind: ConfigMap
+{{- end }}
+`,
+		"templates/NOTES.txt": "Thanks for installing {{ .Chart.Name }}",
+		"crds/crd.yaml":       "apiVersion: apiextensions.k8s.io/v1\nkind: CustomResourceDefinition\n",
+		"env.yaml":            "name: FOO\n",
+	})
+
+	chart, err := Load(archive)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	docs, err := chart.Render(Release{Name: "release", Namespace: "kube-system"}, map[string]interface{}{
+		"replicas": 3,
+		"image":    map[string]interface{}{"tag": "v2"},
+		"args":     []interface{}{"--foo"},
+	}, Capabilities{
+		KubernetesVersion: "v1.29.1",
+		APIVersions:       []string{"v1", "v1/ConfigMap", "monitoring.coreos.com/v1", "monitoring.coreos.com/v1/ServiceMonitor"},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(docs).To(HaveLen(3))
+	g.Expect(string(docs[0])).To(Equal("apiVersion: apiextensions.k8s.io/v1\nkind: CustomResourceDefinition\n"))
+	g.Expect(string(docs[1])).To(Equal(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: release-test
+  namespace: kube-system
+  labels:
+    chart: test-1.2.3
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - image: example.com/test:v2
+        args: ["--foo"]
+        env:
+        name: FOO
+`))
+	g.Expect(string(docs[2])).To(Equal("\napiVersion: monitoring.coreos.com/v1\nkind: ServiceMonitor\n"))
+}
+
+func TestRenderErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		files     map[string]string
+		loadError bool
+	}{
+		{
+			name:      "missing Chart.yaml",
+			files:     map[string]string{"values.yaml": ""},
+			loadError: true,
+		},
+		{
+			name: "subcharts",
+			files: map[string]string{
+				"Chart.yaml":                 "name: test\n",
+				"charts/sub/Chart.yaml":      "name: sub\n",
+				"charts/sub/templates/a.yml": "",
+			},
+			loadError: true,
+		},
+		{
+			name: "invalid template",
+			files: map[string]string{
+				"Chart.yaml":       "name: test\n",
+				"templates/a.yaml": "{{ .Values.foo ",
+			},
+		},
+		{
+			name: "required value not set",
+			files: map[string]string{
+				"Chart.yaml":       "name: test\n",
+				"templates/a.yaml": `{{ required "foo is required" .Values.foo }}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			chart, err := Load(chartArchive(t, tt.files))
+			if tt.loadError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			_, err = chart.Render(Release{Name: "release", Namespace: "default"}, nil, Capabilities{})
+			g.Expect(err).To(HaveOccurred())
+		})
+	}
+}
+
+func chartArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: "test/" + name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -20,10 +20,14 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"text/template"
+	"time"
 
+	"github.com/Masterminds/sprig/v3"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -31,8 +35,12 @@ import (
 
 	addonsv1 "sigs.k8s.io/cluster-api/exp/addons/api/v1beta1"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/oci"
 )
 
+// minReapplyInterval is the minimum interval at which objects applied by a ClusterResourceSet can be checked for drift.
+const minReapplyInterval = time.Minute
+
 // ClusterResourceSet implements a validation and defaulting webhook for ClusterResourceSet.
 type ClusterResourceSet struct{}
 
@@ -132,9 +140,77 @@ func (webhook *ClusterResourceSet) validate(oldCRS, newCRS *addonsv1.ClusterReso
 		)
 	}
 
+	if newCRS.Spec.ReapplyInterval != nil {
+		if newCRS.Spec.Strategy != string(addonsv1.ClusterResourceSetStrategyReconcile) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(field.NewPath("spec", "reapplyInterval"), newCRS.Spec.ReapplyInterval.Duration.String(), "can be set only if strategy is Reconcile"),
+			)
+		} else if newCRS.Spec.ReapplyInterval.Duration < minReapplyInterval {
+			allErrs = append(
+				allErrs,
+				field.Invalid(field.NewPath("spec", "reapplyInterval"), newCRS.Spec.ReapplyInterval.Duration.String(), fmt.Sprintf("must be greater than or equal to %s", minReapplyInterval)),
+			)
+		}
+	}
+
+	allErrs = append(allErrs, validateResources(newCRS.Spec.Resources)...)
+	allErrs = append(allErrs, validateExternalResources(newCRS.Spec.ExternalResources)...)
+
 	if len(allErrs) == 0 {
 		return nil
 	}
 
 	return apierrors.NewInvalid(addonsv1.GroupVersion.WithKind("ClusterResourceSet").GroupKind(), newCRS.Name, allErrs)
 }
+
+// validateResources validates that resources only reference Secrets and ConfigMaps; the other kinds
+// are used only to track external resources in ClusterResourceSetBindings.
+func validateResources(resources []addonsv1.ResourceRef) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, resource := range resources {
+		switch addonsv1.ClusterResourceSetResourceKind(resource.Kind) {
+		case addonsv1.SecretClusterResourceSetResourceKind, addonsv1.ConfigMapClusterResourceSetResourceKind:
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				field.NewPath("spec", "resources").Index(i).Child("kind"),
+				resource.Kind,
+				[]string{string(addonsv1.SecretClusterResourceSetResourceKind), string(addonsv1.ConfigMapClusterResourceSetResourceKind)},
+			))
+		}
+	}
+	return allErrs
+}
+
+func validateExternalResources(externalResources []addonsv1.ExternalResource) field.ErrorList {
+	var allErrs field.ErrorList
+	names := sets.Set[string]{}
+	for i, externalResource := range externalResources {
+		fldPath := field.NewPath("spec", "externalResources").Index(i)
+
+		if names.Has(externalResource.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), externalResource.Name))
+		}
+		names.Insert(externalResource.Name)
+
+		if (externalResource.OCIArtifact == nil) == (externalResource.HelmChart == nil) {
+			allErrs = append(allErrs, field.Invalid(fldPath, externalResource.Name, "exactly one of ociArtifact or helmChart must be set"))
+			continue
+		}
+
+		if externalResource.OCIArtifact != nil {
+			if _, err := oci.ParseReference(externalResource.OCIArtifact.URL); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("ociArtifact", "url"), externalResource.OCIArtifact.URL, err.Error()))
+			}
+			continue
+		}
+
+		if _, err := oci.ParseReference(externalResource.HelmChart.URL); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("helmChart", "url"), externalResource.HelmChart.URL, err.Error()))
+		}
+		if _, err := template.New("values").Funcs(sprig.HermeticTxtFuncMap()).Parse(externalResource.HelmChart.ValuesTemplate); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("helmChart", "valuesTemplate"), externalResource.HelmChart.ValuesTemplate, err.Error()))
+		}
+	}
+	return allErrs
+}
@@ -18,6 +18,7 @@ package webhooks
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -213,3 +214,157 @@ func TestClusterResourceSetSelectorNotEmptyValidation(t *testing.T) {
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("selector must not be empty"))
 }
+
+func TestClusterResourceSetResourcesValidation(t *testing.T) {
+	tests := []struct {
+		name              string
+		resources         []addonsv1.ResourceRef
+		externalResources []addonsv1.ExternalResource
+		expectErr         bool
+	}{
+		{
+			name: "should not return error for valid resources",
+			resources: []addonsv1.ResourceRef{
+				{Name: "cm", Kind: string(addonsv1.ConfigMapClusterResourceSetResourceKind)},
+				{Name: "secret", Kind: string(addonsv1.SecretClusterResourceSetResourceKind)},
+			},
+			externalResources: []addonsv1.ExternalResource{
+				{Name: "manifests", OCIArtifact: &addonsv1.OCIArtifactSource{URL: "oci://registry.example.com/addons/manifests:v1.0.0"}},
+				{Name: "chart", HelmChart: &addonsv1.HelmChartSource{
+					OCIArtifactSource: addonsv1.OCIArtifactSource{URL: "oci://registry.example.com/charts/cni:v1.0.0"},
+					ValuesTemplate:    "clusterName: {{ .builtin.cluster.name }}",
+				}},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error for resources with external resource kinds",
+			resources: []addonsv1.ResourceRef{
+				{Name: "chart", Kind: string(addonsv1.HelmChartClusterResourceSetResourceKind)},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error for external resources with duplicate names",
+			externalResources: []addonsv1.ExternalResource{
+				{Name: "manifests", OCIArtifact: &addonsv1.OCIArtifactSource{URL: "oci://registry.example.com/addons/manifests:v1.0.0"}},
+				{Name: "manifests", OCIArtifact: &addonsv1.OCIArtifactSource{URL: "oci://registry.example.com/addons/manifests:v2.0.0"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error for external resources with both sources set",
+			externalResources: []addonsv1.ExternalResource{
+				{
+					Name:        "manifests",
+					OCIArtifact: &addonsv1.OCIArtifactSource{URL: "oci://registry.example.com/addons/manifests:v1.0.0"},
+					HelmChart:   &addonsv1.HelmChartSource{OCIArtifactSource: addonsv1.OCIArtifactSource{URL: "oci://registry.example.com/charts/cni:v1.0.0"}},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error for external resources without sources",
+			externalResources: []addonsv1.ExternalResource{
+				{Name: "manifests"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error for external resources with an invalid URL",
+			externalResources: []addonsv1.ExternalResource{
+				{Name: "manifests", OCIArtifact: &addonsv1.OCIArtifactSource{URL: "oci://registry.example.com"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error for Helm charts with an invalid values template",
+			externalResources: []addonsv1.ExternalResource{
+				{Name: "chart", HelmChart: &addonsv1.HelmChartSource{
+					OCIArtifactSource: addonsv1.OCIArtifactSource{URL: "oci://registry.example.com/charts/cni:v1.0.0"},
+					ValuesTemplate:    "clusterName: {{ .builtin.cluster.name ",
+				}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterResourceSet := &addonsv1.ClusterResourceSet{
+				Spec: addonsv1.ClusterResourceSetSpec{
+					ClusterSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Resources:         tt.resources,
+					ExternalResources: tt.externalResources,
+				},
+			}
+			webhook := ClusterResourceSet{}
+			warnings, err := webhook.ValidateCreate(ctx, clusterResourceSet)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
+func TestClusterResourceSetReapplyIntervalValidation(t *testing.T) {
+	tests := []struct {
+		name            string
+		strategy        addonsv1.ClusterResourceSetStrategy
+		reapplyInterval *metav1.Duration
+		expectErr       bool
+	}{
+		{
+			name:      "should not return error when reapplyInterval is not set",
+			strategy:  addonsv1.ClusterResourceSetStrategyApplyOnce,
+			expectErr: false,
+		},
+		{
+			name:            "should not return error for a valid reapplyInterval with the Reconcile strategy",
+			strategy:        addonsv1.ClusterResourceSetStrategyReconcile,
+			reapplyInterval: &metav1.Duration{Duration: 5 * time.Minute},
+			expectErr:       false,
+		},
+		{
+			name:            "should return error for reapplyInterval with the ApplyOnce strategy",
+			strategy:        addonsv1.ClusterResourceSetStrategyApplyOnce,
+			reapplyInterval: &metav1.Duration{Duration: 5 * time.Minute},
+			expectErr:       true,
+		},
+		{
+			name:            "should return error for a reapplyInterval lower than the minimum",
+			strategy:        addonsv1.ClusterResourceSetStrategyReconcile,
+			reapplyInterval: &metav1.Duration{Duration: 10 * time.Second},
+			expectErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterResourceSet := &addonsv1.ClusterResourceSet{
+				Spec: addonsv1.ClusterResourceSetSpec{
+					ClusterSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Strategy:        string(tt.strategy),
+					ReapplyInterval: tt.reapplyInterval,
+				},
+			}
+			webhook := ClusterResourceSet{}
+			warnings, err := webhook.ValidateCreate(ctx, clusterResourceSet)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
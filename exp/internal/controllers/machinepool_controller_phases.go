@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -277,6 +279,10 @@ func (r *MachinePoolReconciler) reconcileInfrastructure(ctx context.Context, clu
 
 	mp.Status.InfrastructureReady = ready
 
+	if err := setCapacityAnnotations(mp, infraConfig); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Report a summary of current status of the infrastructure object defined for this machine pool.
 	conditions.SetMirror(mp, clusterv1.InfrastructureReadyCondition,
 		conditions.UnstructuredGetter(infraConfig),
@@ -321,6 +327,66 @@ func (r *MachinePoolReconciler) reconcileInfrastructure(ctx context.Context, clu
 	return ctrl.Result{}, nil
 }
 
+// capacityAnnotations are the annotations set by setCapacityAnnotations.
+var capacityAnnotations = []string{
+	clusterv1.AutoscalerCapacityCPUAnnotation,
+	clusterv1.AutoscalerCapacityMemoryAnnotation,
+	clusterv1.AutoscalerCapacityEphemeralDiskAnnotation,
+	clusterv1.AutoscalerCapacityMaxPodsAnnotation,
+	clusterv1.AutoscalerCapacityGPUTypeAnnotation,
+	clusterv1.AutoscalerCapacityGPUCountAnnotation,
+}
+
+// setCapacityAnnotations sets the annotations used by the autoscaler to scale the MachinePool from zero, using the
+// capacity of a single instance reported by the InfrastructureMachinePool in status.capacity, if any.
+// Note: the capacity reported by the InfrastructureMachinePool takes precedence over annotations set by users, and
+// capacity annotations for resources which are not reported anymore are removed.
+// Note: if multiple GPU resources are reported, the first one in alphabetical order is used, so the result is deterministic.
+func setCapacityAnnotations(mp *expv1.MachinePool, infraMachinePool *unstructured.Unstructured) error {
+	capacity := corev1.ResourceList{}
+	if err := util.UnstructuredUnmarshalField(infraMachinePool, &capacity, "status", "capacity"); err != nil {
+		if errors.Is(err, util.ErrUnstructuredFieldNotFound) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to retrieve capacity from infrastructure provider for MachinePool %s", klog.KObj(mp))
+	}
+
+	desiredAnnotations := map[string]string{}
+	gpus := []string{}
+	for name, quantity := range capacity {
+		switch {
+		case name == corev1.ResourceCPU:
+			desiredAnnotations[clusterv1.AutoscalerCapacityCPUAnnotation] = quantity.String()
+		case name == corev1.ResourceMemory:
+			desiredAnnotations[clusterv1.AutoscalerCapacityMemoryAnnotation] = quantity.String()
+		case name == corev1.ResourceEphemeralStorage:
+			desiredAnnotations[clusterv1.AutoscalerCapacityEphemeralDiskAnnotation] = quantity.String()
+		case name == corev1.ResourcePods:
+			desiredAnnotations[clusterv1.AutoscalerCapacityMaxPodsAnnotation] = quantity.String()
+		case strings.HasSuffix(string(name), "/gpu"):
+			gpus = append(gpus, string(name))
+		}
+	}
+	if len(gpus) > 0 {
+		sort.Strings(gpus)
+		gpuQuantity := capacity[corev1.ResourceName(gpus[0])]
+		desiredAnnotations[clusterv1.AutoscalerCapacityGPUTypeAnnotation] = gpus[0]
+		desiredAnnotations[clusterv1.AutoscalerCapacityGPUCountAnnotation] = gpuQuantity.String()
+	}
+
+	// Remove the capacity annotations for resources which are not reported anymore.
+	mpAnnotations := mp.GetAnnotations()
+	for _, annotation := range capacityAnnotations {
+		if _, ok := desiredAnnotations[annotation]; !ok {
+			delete(mpAnnotations, annotation)
+		}
+	}
+	mp.SetAnnotations(mpAnnotations)
+
+	annotations.AddAnnotations(mp, desiredAnnotations)
+	return nil
+}
+
 // reconcileMachines reconciles Machines associated with a MachinePool.
 //
 // Note: In the case of MachinePools the machines are created in order to surface in CAPI what exists in the
@@ -380,6 +446,46 @@ func (r *MachinePoolReconciler) reconcileMachines(ctx context.Context, mp *expv1
 		return errors.Wrapf(err, "failed to create machines for MachinePool %q in namespace %q", mp.Name, mp.Namespace)
 	}
 
+	if err := r.reconcileUnhealthyMachines(ctx, mp, machineList.Items); err != nil {
+		return errors.Wrapf(err, "failed to remediate machines for MachinePool %q in namespace %q", mp.Name, mp.Namespace)
+	}
+
+	return nil
+}
+
+// reconcileUnhealthyMachines remediates the MachinePool Machines marked as unhealthy by the MachineHealthCheck controller.
+//
+// Note: Unhealthy Machines are remediated by deleting them; this triggers the deletion of the corresponding infraMachine,
+// and it is up to the InfraMachinePool controller to remove the instance from the pool and to replace it.
+func (r *MachinePoolReconciler) reconcileUnhealthyMachines(ctx context.Context, mp *expv1.MachinePool, machines []clusterv1.Machine) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	var errs []error
+	for i := range machines {
+		m := &machines[i]
+		// Skip remediation for Machines already in deleting status.
+		if !m.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if !conditions.IsFalse(m, clusterv1.MachineOwnerRemediatedCondition) {
+			continue
+		}
+
+		log.Info(fmt.Sprintf("Deleting Machine %s because it was marked as unhealthy by the MachineHealthCheck controller", klog.KObj(m)))
+		patch := client.MergeFrom(m.DeepCopy())
+		if err := r.Client.Delete(ctx, m); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, errors.Wrapf(err, "failed to delete Machine %s", klog.KObj(m)))
+			continue
+		}
+		conditions.MarkTrue(m, clusterv1.MachineOwnerRemediatedCondition)
+		if err := r.Client.Status().Patch(ctx, m, patch); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, errors.Wrapf(err, "failed to update status of Machine %s", klog.KObj(m)))
+		}
+	}
+
+	if len(errs) > 0 {
+		return kerrors.NewAggregate(errs)
+	}
 	return nil
 }
 
@@ -41,6 +41,7 @@ import (
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/internal/test/builder"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/kubeconfig"
 	"sigs.k8s.io/cluster-api/util/labels/format"
 )
@@ -1421,6 +1422,44 @@ func TestReconcileMachinePoolMachines(t *testing.T) {
 	})
 }
 
+func TestReconcileMachinePoolUnhealthyMachines(t *testing.T) {
+	g := NewWithT(t)
+
+	machinePool := getMachinePool(3, "machinepool-test", clusterName, metav1.NamespaceDefault)
+	machines := getMachines(3, machinePool.Name, clusterName, metav1.NamespaceDefault)
+	for i := range machines {
+		machines[i].Finalizers = []string{clusterv1.MachineFinalizer}
+	}
+	// machine-0 is unhealthy and must be remediated.
+	conditions.MarkFalse(&machines[0], clusterv1.MachineOwnerRemediatedCondition, clusterv1.WaitingForRemediationReason, clusterv1.ConditionSeverityWarning, "")
+	// machine-1 is healthy.
+	// machine-2 is unhealthy but already deleting.
+	conditions.MarkFalse(&machines[2], clusterv1.MachineOwnerRemediatedCondition, clusterv1.WaitingForRemediationReason, clusterv1.ConditionSeverityWarning, "")
+	machines[2].DeletionTimestamp = ptr.To(metav1.Now())
+
+	objs := []client.Object{}
+	for i := range machines {
+		objs = append(objs, &machines[i])
+	}
+	r := &MachinePoolReconciler{
+		Client: fake.NewClientBuilder().WithObjects(objs...).WithStatusSubresource(&clusterv1.Machine{}).Build(),
+	}
+
+	g.Expect(r.reconcileUnhealthyMachines(ctx, &machinePool, machines)).To(Succeed())
+
+	m := &clusterv1.Machine{}
+	g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(&machines[0]), m)).To(Succeed())
+	g.Expect(m.DeletionTimestamp.IsZero()).To(BeFalse())
+	g.Expect(conditions.IsTrue(m, clusterv1.MachineOwnerRemediatedCondition)).To(BeTrue())
+
+	g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(&machines[1]), m)).To(Succeed())
+	g.Expect(m.DeletionTimestamp.IsZero()).To(BeTrue())
+	g.Expect(conditions.Has(m, clusterv1.MachineOwnerRemediatedCondition)).To(BeFalse())
+
+	g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(&machines[2]), m)).To(Succeed())
+	g.Expect(conditions.IsFalse(m, clusterv1.MachineOwnerRemediatedCondition)).To(BeTrue())
+}
+
 func TestInfraMachineToMachinePoolMapper(t *testing.T) {
 	machinePool1 := expv1.MachinePool{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1985,3 +2024,109 @@ func getMachinePool(replicas int, mpName, clusterName, nsName string) expv1.Mach
 		},
 	}
 }
+
+func TestSetCapacityAnnotations(t *testing.T) {
+	tests := []struct {
+		name                string
+		annotations         map[string]string
+		capacity            map[string]interface{}
+		expectedAnnotations map[string]string
+	}{
+		{
+			name:                "no annotations if the InfrastructureMachinePool does not report capacity",
+			annotations:         map[string]string{"foo": "bar"},
+			expectedAnnotations: map[string]string{"foo": "bar"},
+		},
+		{
+			name:        "capacity annotations set from the InfrastructureMachinePool capacity",
+			annotations: map[string]string{"foo": "bar"},
+			capacity: map[string]interface{}{
+				"cpu":               "4",
+				"memory":            "16Gi",
+				"ephemeral-storage": "100Gi",
+				"pods":              "110",
+				"nvidia.com/gpu":    "2",
+				"example.com/other": "1",
+			},
+			expectedAnnotations: map[string]string{
+				"foo": "bar",
+				clusterv1.AutoscalerCapacityCPUAnnotation:           "4",
+				clusterv1.AutoscalerCapacityMemoryAnnotation:        "16Gi",
+				clusterv1.AutoscalerCapacityEphemeralDiskAnnotation: "100Gi",
+				clusterv1.AutoscalerCapacityMaxPodsAnnotation:       "110",
+				clusterv1.AutoscalerCapacityGPUTypeAnnotation:       "nvidia.com/gpu",
+				clusterv1.AutoscalerCapacityGPUCountAnnotation:      "2",
+			},
+		},
+		{
+			name: "capacity annotations overridden by the InfrastructureMachinePool capacity",
+			annotations: map[string]string{
+				clusterv1.AutoscalerCapacityCPUAnnotation:    "2",
+				clusterv1.AutoscalerCapacityMemoryAnnotation: "8Gi",
+			},
+			capacity: map[string]interface{}{
+				"cpu": "4",
+			},
+			expectedAnnotations: map[string]string{
+				clusterv1.AutoscalerCapacityCPUAnnotation: "4",
+			},
+		},
+		{
+			name: "capacity annotations removed if the InfrastructureMachinePool does not report the resource anymore",
+			annotations: map[string]string{
+				"foo": "bar",
+				clusterv1.AutoscalerCapacityCPUAnnotation:      "4",
+				clusterv1.AutoscalerCapacityGPUTypeAnnotation:  "nvidia.com/gpu",
+				clusterv1.AutoscalerCapacityGPUCountAnnotation: "2",
+			},
+			capacity: map[string]interface{}{
+				"cpu": "4",
+			},
+			expectedAnnotations: map[string]string{
+				"foo": "bar",
+				clusterv1.AutoscalerCapacityCPUAnnotation: "4",
+			},
+		},
+		{
+			name: "first GPU resource in alphabetical order used if the InfrastructureMachinePool reports multiple GPU resources",
+			capacity: map[string]interface{}{
+				"nvidia.com/gpu": "2",
+				"amd.com/gpu":    "1",
+				"intel.com/gpu":  "4",
+			},
+			expectedAnnotations: map[string]string{
+				clusterv1.AutoscalerCapacityGPUTypeAnnotation:  "amd.com/gpu",
+				clusterv1.AutoscalerCapacityGPUCountAnnotation: "1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mp := &expv1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "machinepool-test",
+					Namespace:   metav1.NamespaceDefault,
+					Annotations: tt.annotations,
+				},
+			}
+			infraMachinePool := &unstructured.Unstructured{Object: map[string]interface{}{
+				"kind":       "GenericInfrastructureMachinePool",
+				"apiVersion": "infrastructure.cluster.x-k8s.io/v1beta1",
+				"metadata": map[string]interface{}{
+					"name":      "infra-config1",
+					"namespace": metav1.NamespaceDefault,
+				},
+				"status": map[string]interface{}{},
+			}}
+			if tt.capacity != nil {
+				infraMachinePool.Object["status"] = map[string]interface{}{"capacity": tt.capacity}
+			}
+
+			g.Expect(setCapacityAnnotations(mp, infraMachinePool)).To(Succeed())
+			g.Expect(mp.GetAnnotations()).To(Equal(tt.expectedAnnotations))
+		})
+	}
+}
@@ -210,6 +210,9 @@ func (webhook *MachinePool) validate(oldObj, newObj *expv1.MachinePool) error {
 	// Validate the metadata of the MachinePool template.
 	allErrs = append(allErrs, newObj.Spec.Template.ObjectMeta.Validate(specPath.Child("template", "metadata"))...)
 
+	allErrs = append(allErrs, newObj.Spec.Template.Spec.NodeDrainRules.Validate(specPath.Child("template", "spec", "nodeDrainRules"))...)
+	allErrs = append(allErrs, newObj.Spec.Template.Spec.NodeMetadata.Validate(specPath.Child("template", "spec", "nodeMetadata"))...)
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -533,3 +533,122 @@ func TestMachinePoolMetadataValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestMachinePoolNodeDrainRulesValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		drainRules *clusterv1.NodeDrainRules
+		expectErr  bool
+	}{
+		{
+			name:       "should succeed when drain rules are not set",
+			drainRules: nil,
+			expectErr:  false,
+		},
+		{
+			name: "should succeed when ordering by a valid label",
+			drainRules: &clusterv1.NodeDrainRules{
+				Order:      clusterv1.LabelNodeDrainOrder,
+				OrderLabel: "example.com/drain-order",
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error when ordering by label without orderLabel",
+			drainRules: &clusterv1.NodeDrainRules{
+				Order: clusterv1.LabelNodeDrainOrder,
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when an exclusion matches all pods",
+			drainRules: &clusterv1.NodeDrainRules{
+				Exclusions: []clusterv1.NodeDrainExclusion{{}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mp := &expv1.MachinePool{
+				Spec: expv1.MachinePoolSpec{
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							Bootstrap:      clusterv1.Bootstrap{DataSecretName: ptr.To("data")},
+							NodeDrainRules: tt.drainRules,
+						},
+					},
+				},
+			}
+			webhook := &MachinePool{}
+			warnings, err := webhook.ValidateCreate(ctx, mp)
+			g.Expect(err != nil).To(Equal(tt.expectErr))
+			g.Expect(warnings).To(BeEmpty())
+			warnings, err = webhook.ValidateUpdate(ctx, mp, mp)
+			g.Expect(err != nil).To(Equal(tt.expectErr))
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
+func TestMachinePoolNodeMetadataValidation(t *testing.T) {
+	tests := []struct {
+		name         string
+		nodeMetadata *clusterv1.NodeMetadata
+		expectErr    bool
+	}{
+		{
+			name:         "should succeed when node metadata is not set",
+			nodeMetadata: nil,
+			expectErr:    false,
+		},
+		{
+			name: "should succeed with valid labels, annotations and taints",
+			nodeMetadata: &clusterv1.NodeMetadata{
+				Labels:      map[string]string{"example.com/role": "worker"},
+				Annotations: map[string]string{"example.com/owner": "team-a"},
+				Taints:      []corev1.Taint{{Key: "example.com/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error for invalid labels",
+			nodeMetadata: &clusterv1.NodeMetadata{
+				Labels: map[string]string{"/invalid-key": "foo"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error for taints with an invalid effect",
+			nodeMetadata: &clusterv1.NodeMetadata{
+				Taints: []corev1.Taint{{Key: "example.com/dedicated", Effect: "Invalid"}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mp := &expv1.MachinePool{
+				Spec: expv1.MachinePoolSpec{
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							Bootstrap:    clusterv1.Bootstrap{DataSecretName: ptr.To("data")},
+							NodeMetadata: tt.nodeMetadata,
+						},
+					},
+				},
+			}
+			webhook := &MachinePool{}
+			warnings, err := webhook.ValidateCreate(ctx, mp)
+			g.Expect(err != nil).To(Equal(tt.expectErr))
+			g.Expect(warnings).To(BeEmpty())
+			warnings, err = webhook.ValidateUpdate(ctx, mp, mp)
+			g.Expect(err != nil).To(Equal(tt.expectErr))
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
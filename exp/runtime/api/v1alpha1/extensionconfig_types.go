@@ -75,6 +75,28 @@ type ClientConfig struct {
 	// CABundle is a PEM encoded CA bundle which will be used to validate the Extension server's server certificate.
 	// +optional
 	CABundle []byte `json:"caBundle,omitempty"`
+
+	// ClientCertificate defines the client certificate presented when calling the Extension server,
+	// so the Extension server can authenticate the caller using mutual TLS.
+	// +optional
+	ClientCertificate *ClientCertificate `json:"clientCertificate,omitempty"`
+}
+
+// ClientCertificate defines the source of a client certificate.
+type ClientCertificate struct {
+	// SecretRef is a reference to a Secret containing the PEM encoded client certificate
+	// and private key in the "tls.crt" and "tls.key" entries, e.g. a Secret of type kubernetes.io/tls.
+	// Changes to the Secret are picked up automatically, so the client certificate can be rotated.
+	SecretRef SecretReference `json:"secretRef"`
+}
+
+// SecretReference holds a reference to a Kubernetes Secret.
+type SecretReference struct {
+	// Namespace is the namespace of the secret.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the secret.
+	Name string `json:"name"`
 }
 
 // ServiceReference holds a reference to a Kubernetes Service of an Extension server.
@@ -66,6 +66,15 @@ type RetryResponseObject interface {
 	SetRetryAfterSeconds(retryAfterSeconds int32)
 }
 
+// AcceptResponseObject is a ResponseObject which additionally defines the functionality
+// for a response to signal if a request has been accepted.
+// +kubebuilder:object:generate=false
+type AcceptResponseObject interface {
+	ResponseObject
+	GetAccepted() bool
+	SetAccepted(accepted bool)
+}
+
 // CommonResponse is the data structure common to all response types.
 // Note: By embedding CommonResponse in a runtime.Object the ResponseObject
 // interface is satisfied.
@@ -131,3 +140,26 @@ func (r *CommonRetryResponse) GetRetryAfterSeconds() int32 {
 func (r *CommonRetryResponse) SetRetryAfterSeconds(retryAfterSeconds int32) {
 	r.RetryAfterSeconds = retryAfterSeconds
 }
+
+// CommonAcceptResponse is the data structure which contains all
+// common and accept fields.
+// Note: By embedding CommonAcceptResponse in a runtime.Object the AcceptResponseObject
+// interface is satisfied.
+type CommonAcceptResponse struct {
+	// CommonResponse contains Status and Message fields common to all response types.
+	CommonResponse `json:",inline"`
+
+	// Accepted when set to true signifies that the Runtime Extension accepted the request
+	// and it is going to take care of it.
+	Accepted bool `json:"accepted"`
+}
+
+// GetAccepted returns the Accepted field for the CommonAcceptResponse.
+func (r *CommonAcceptResponse) GetAccepted() bool {
+	return r.Accepted
+}
+
+// SetAccepted sets the Accepted field for the CommonAcceptResponse.
+func (r *CommonAcceptResponse) SetAccepted(accepted bool) {
+	r.Accepted = accepted
+}
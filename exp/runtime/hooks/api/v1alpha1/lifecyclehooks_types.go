@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
@@ -139,6 +140,66 @@ type AfterControlPlaneUpgradeResponse struct {
 // Kubernetes version and before the target version is propagated to the workload machines.
 func AfterControlPlaneUpgrade(*AfterControlPlaneUpgradeRequest, *AfterControlPlaneUpgradeResponse) {}
 
+// BeforeWorkersUpgradeRequest is the request of the BeforeWorkersUpgrade hook.
+// +kubebuilder:object:root=true
+type BeforeWorkersUpgradeRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// Cluster is the cluster object the lifecycle hook corresponds to.
+	Cluster clusterv1.Cluster `json:"cluster"`
+
+	// KubernetesVersion is the Kubernetes version the MachineDeployments and MachinePools are going to be upgraded to.
+	KubernetesVersion string `json:"kubernetesVersion"`
+}
+
+var _ RetryResponseObject = &BeforeWorkersUpgradeResponse{}
+
+// BeforeWorkersUpgradeResponse is the response of the BeforeWorkersUpgrade hook.
+// +kubebuilder:object:root=true
+type BeforeWorkersUpgradeResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// BeforeWorkersUpgrade is the hook called after the AfterControlPlaneUpgrade hook completed and
+// before the target version is propagated to the MachineDeployments and MachinePools.
+func BeforeWorkersUpgrade(*BeforeWorkersUpgradeRequest, *BeforeWorkersUpgradeResponse) {}
+
+// AfterWorkersUpgradeRequest is the request of the AfterWorkersUpgrade hook.
+// +kubebuilder:object:root=true
+type AfterWorkersUpgradeRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// Cluster is the cluster object the lifecycle hook corresponds to.
+	Cluster clusterv1.Cluster `json:"cluster"`
+
+	// KubernetesVersion is the Kubernetes version of the MachineDeployments and MachinePools after the upgrade.
+	KubernetesVersion string `json:"kubernetesVersion"`
+}
+
+var _ RetryResponseObject = &AfterWorkersUpgradeResponse{}
+
+// AfterWorkersUpgradeResponse is the response of the AfterWorkersUpgrade hook.
+// +kubebuilder:object:root=true
+type AfterWorkersUpgradeResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// AfterWorkersUpgrade is the hook called after all the MachineDeployments and MachinePools are successfully
+// upgraded to the target Kubernetes version and before the upgrade of the Cluster is considered completed.
+func AfterWorkersUpgrade(*AfterWorkersUpgradeRequest, *AfterWorkersUpgradeResponse) {}
+
 // AfterClusterUpgradeRequest is the request of the AfterClusterUpgrade hook.
 // +kubebuilder:object:root=true
 type AfterClusterUpgradeRequest struct {
@@ -196,6 +257,83 @@ type BeforeClusterDeleteResponse struct {
 // and before the cluster and its underlying objects are deleted.
 func BeforeClusterDelete(*BeforeClusterDeleteRequest, *BeforeClusterDeleteResponse) {}
 
+// UpdateMachineRequest is the request of the UpdateMachine hook.
+// +kubebuilder:object:root=true
+type UpdateMachineRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// Cluster is the cluster object the lifecycle hook corresponds to.
+	Cluster clusterv1.Cluster `json:"cluster"`
+
+	// MachineDeployment is the MachineDeployment whose Machines should be updated.
+	MachineDeployment clusterv1.MachineDeployment `json:"machineDeployment"`
+
+	// Current are the templates currently used by the Machines of the MachineDeployment.
+	Current MachineTemplates `json:"current"`
+
+	// Desired are the templates which would be used by the Machines of the MachineDeployment after a rollout.
+	Desired MachineTemplates `json:"desired"`
+}
+
+// MachineTemplates are the templates used to create the Machines of a MachineDeployment.
+type MachineTemplates struct {
+	// BootstrapTemplate is the bootstrap config template.
+	// +optional
+	BootstrapTemplate *runtime.RawExtension `json:"bootstrapTemplate,omitempty"`
+
+	// InfrastructureMachineTemplate is the infrastructure machine template.
+	InfrastructureMachineTemplate runtime.RawExtension `json:"infrastructureMachineTemplate"`
+}
+
+var _ AcceptResponseObject = &UpdateMachineResponse{}
+
+// UpdateMachineResponse is the response of the UpdateMachine hook.
+// +kubebuilder:object:root=true
+type UpdateMachineResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonAcceptResponse contains Status, Message and Accepted fields.
+	CommonAcceptResponse `json:",inline"`
+}
+
+// UpdateMachine is the hook that will be called before the Machines of a MachineDeployment are rolled out
+// because of changes to its bootstrap or infrastructure machine templates, to allow a Runtime Extension
+// to update the Machines in-place instead.
+func UpdateMachine(*UpdateMachineRequest, *UpdateMachineResponse) {}
+
+// BeforeMachineCreateRequest is the request of the BeforeMachineCreate hook.
+// +kubebuilder:object:root=true
+type BeforeMachineCreateRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// Cluster is the cluster object the lifecycle hook corresponds to.
+	Cluster clusterv1.Cluster `json:"cluster"`
+
+	// MachineSet is the MachineSet which is going to create new Machines.
+	MachineSet clusterv1.MachineSet `json:"machineSet"`
+}
+
+var _ RetryResponseObject = &BeforeMachineCreateResponse{}
+
+// BeforeMachineCreateResponse is the response of the BeforeMachineCreate hook.
+// +kubebuilder:object:root=true
+type BeforeMachineCreateResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// BeforeMachineCreate is the hook that will be called as a MachineSet preflight check, before the MachineSet
+// creates new Machines.
+func BeforeMachineCreate(*BeforeMachineCreateRequest, *BeforeMachineCreateResponse) {}
+
 func init() {
 	catalogBuilder.RegisterHook(BeforeClusterCreate, &runtimecatalog.HookMeta{
 		Tags:    []string{"Lifecycle Hooks"},
@@ -247,6 +385,34 @@ func init() {
 			"tasks before the new version is propagated to the MachineDeployments",
 	})
 
+	catalogBuilder.RegisterHook(BeforeWorkersUpgrade, &runtimecatalog.HookMeta{
+		Tags:    []string{"Lifecycle Hooks"},
+		Summary: "Cluster API Runtime will call this hook before the MachineDeployments and MachinePools are upgraded",
+		Description: "Cluster API Runtime will call this hook after the AfterControlPlaneUpgrade hook completed, " +
+			"and immediately before the new version is going to be propagated to the MachineDeployments and MachinePools.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only for Clusters with a managed topology\n" +
+			"- The call's request contains the Cluster object and the Kubernetes version the workers are going to be upgraded to\n" +
+			"- This is a blocking hook; Runtime Extension implementers can use this hook to execute " +
+			"tasks before the new version is propagated to the MachineDeployments and MachinePools, e.g. to coordinate " +
+			"the upgrade of the workers with external systems",
+	})
+
+	catalogBuilder.RegisterHook(AfterWorkersUpgrade, &runtimecatalog.HookMeta{
+		Tags:    []string{"Lifecycle Hooks"},
+		Summary: "Cluster API Runtime will call this hook after the MachineDeployments and MachinePools are upgraded",
+		Description: "Cluster API Runtime will call this hook after all the MachineDeployments and MachinePools of a Cluster " +
+			"have been upgraded to the version specified in spec.topology.version, and immediately before the AfterClusterUpgrade hook. " +
+			"An upgrade of the workers is completed when all the MachineDeployment's and MachinePool's Machines have been upgraded.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only for Clusters with a managed topology\n" +
+			"- The call's request contains the Cluster object and the Kubernetes version we upgraded to\n" +
+			"- This is a blocking hook; Runtime Extension implementers can use this hook to execute " +
+			"tasks, e.g. validations, before the upgrade of the Cluster is considered completed",
+	})
+
 	catalogBuilder.RegisterHook(AfterClusterUpgrade, &runtimecatalog.HookMeta{
 		Tags:    []string{"Lifecycle Hooks"},
 		Summary: "Cluster API Runtime will call this hook after a Cluster is upgraded",
@@ -271,4 +437,34 @@ func init() {
 			"- This is a blocking hook; Runtime Extension implementers can use this hook  to execute " +
 			"tasks before objects of the Cluster are deleted",
 	})
+
+	catalogBuilder.RegisterHook(UpdateMachine, &runtimecatalog.HookMeta{
+		Tags:    []string{"Lifecycle Hooks"},
+		Summary: "Cluster API Runtime will call this hook before the Machines of a MachineDeployment are rolled out",
+		Description: "Cluster API Runtime will call this hook when the bootstrap or infrastructure machine template of a MachineDeployment " +
+			"changes, after the templates are rotated and immediately before the MachineDeployment is updated to use the new templates.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only for MachineDeployments of Clusters with a managed topology\n" +
+			"- This hook will not be called if the changes require the Machines to be reprovisioned, e.g. a Kubernetes version upgrade\n" +
+			"- The call's request contains the Cluster object, the MachineDeployment object and its current and desired templates\n" +
+			"- If all the Runtime Extensions accept the request, they are responsible for updating the existing Machines " +
+			"in-place and the references to the new templates are propagated to the existing MachineSet without a rollout; " +
+			"otherwise the Machines are rolled out",
+	})
+
+	catalogBuilder.RegisterHook(BeforeMachineCreate, &runtimecatalog.HookMeta{
+		Tags:    []string{"Lifecycle Hooks"},
+		Summary: "Cluster API Runtime will call this hook before a MachineSet creates new Machines",
+		Description: "Cluster API Runtime will call this hook as part of the MachineSet preflight checks, " +
+			"immediately before a MachineSet creates new Machines, e.g. when scaling up or when remediating a Machine.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only if both the MachineSetPreflightChecks and the RuntimeSDK feature flags are enabled, " +
+			"and the RuntimeExtensions preflight check is not skipped for the MachineSet\n" +
+			"- The call's request contains the Cluster object and the MachineSet object\n" +
+			"- This is a blocking hook; Runtime Extension implementers can use this hook to implement custom preflight checks, " +
+			"e.g. to verify that the machine image exists in the target region; the creation of new Machines is " +
+			"put on hold until all the Runtime Extensions return a response with RetryAfterSeconds set to 0",
+	})
 }
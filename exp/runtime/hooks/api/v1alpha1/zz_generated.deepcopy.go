@@ -178,6 +178,57 @@ func (in *AfterControlPlaneUpgradeResponse) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AfterWorkersUpgradeRequest) DeepCopyInto(out *AfterWorkersUpgradeRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AfterWorkersUpgradeRequest.
+func (in *AfterWorkersUpgradeRequest) DeepCopy() *AfterWorkersUpgradeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AfterWorkersUpgradeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AfterWorkersUpgradeRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AfterWorkersUpgradeResponse) DeepCopyInto(out *AfterWorkersUpgradeResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AfterWorkersUpgradeResponse.
+func (in *AfterWorkersUpgradeResponse) DeepCopy() *AfterWorkersUpgradeResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(AfterWorkersUpgradeResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AfterWorkersUpgradeResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BeforeClusterCreateRequest) DeepCopyInto(out *BeforeClusterCreateRequest) {
 	*out = *in
@@ -331,6 +382,109 @@ func (in *BeforeClusterUpgradeResponse) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeMachineCreateRequest) DeepCopyInto(out *BeforeMachineCreateRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.MachineSet.DeepCopyInto(&out.MachineSet)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeMachineCreateRequest.
+func (in *BeforeMachineCreateRequest) DeepCopy() *BeforeMachineCreateRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeMachineCreateRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeMachineCreateRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeMachineCreateResponse) DeepCopyInto(out *BeforeMachineCreateResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeMachineCreateResponse.
+func (in *BeforeMachineCreateResponse) DeepCopy() *BeforeMachineCreateResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeMachineCreateResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeMachineCreateResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeWorkersUpgradeRequest) DeepCopyInto(out *BeforeWorkersUpgradeRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeWorkersUpgradeRequest.
+func (in *BeforeWorkersUpgradeRequest) DeepCopy() *BeforeWorkersUpgradeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeWorkersUpgradeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeWorkersUpgradeRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeWorkersUpgradeResponse) DeepCopyInto(out *BeforeWorkersUpgradeResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeWorkersUpgradeResponse.
+func (in *BeforeWorkersUpgradeResponse) DeepCopy() *BeforeWorkersUpgradeResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeWorkersUpgradeResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeWorkersUpgradeResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Builtins) DeepCopyInto(out *Builtins) {
 	*out = *in
@@ -436,6 +590,22 @@ func (in *ClusterTopologyBuiltins) DeepCopy() *ClusterTopologyBuiltins {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonAcceptResponse) DeepCopyInto(out *CommonAcceptResponse) {
+	*out = *in
+	out.CommonResponse = in.CommonResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonAcceptResponse.
+func (in *CommonAcceptResponse) DeepCopy() *CommonAcceptResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(CommonAcceptResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonRequest) DeepCopyInto(out *CommonRequest) {
 	*out = *in
@@ -939,6 +1109,81 @@ func (in *MachinePoolBuiltins) DeepCopy() *MachinePoolBuiltins {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTemplates) DeepCopyInto(out *MachineTemplates) {
+	*out = *in
+	if in.BootstrapTemplate != nil {
+		in, out := &in.BootstrapTemplate, &out.BootstrapTemplate
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	in.InfrastructureMachineTemplate.DeepCopyInto(&out.InfrastructureMachineTemplate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTemplates.
+func (in *MachineTemplates) DeepCopy() *MachineTemplates {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTemplates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateMachineRequest) DeepCopyInto(out *UpdateMachineRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.MachineDeployment.DeepCopyInto(&out.MachineDeployment)
+	in.Current.DeepCopyInto(&out.Current)
+	in.Desired.DeepCopyInto(&out.Desired)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateMachineRequest.
+func (in *UpdateMachineRequest) DeepCopy() *UpdateMachineRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateMachineRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpdateMachineRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateMachineResponse) DeepCopyInto(out *UpdateMachineResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonAcceptResponse = in.CommonAcceptResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateMachineResponse.
+func (in *UpdateMachineResponse) DeepCopy() *UpdateMachineResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateMachineResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpdateMachineResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidateTopologyRequest) DeepCopyInto(out *ValidateTopologyRequest) {
 	*out = *in
@@ -40,6 +40,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 const (
@@ -57,6 +58,11 @@ type Reconciler struct {
 	RuntimeClient runtimeclient.Client
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// Shard is the shard of Clusters reconciled by this controller.
+	// ExtensionConfigs are discovered by all the shards to populate their registry, but only the
+	// primary shard patches them.
+	Shard shard.Shard
 }
 
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -77,12 +83,17 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		return errors.Wrap(err, "failed setting up with a controller manager")
 	}
 
+	if err := indexByExtensionClientCertificateSecretName(ctx, mgr); err != nil {
+		return errors.Wrap(err, "failed setting up with a controller manager")
+	}
+
 	// warmupRunnable will attempt to sync the RuntimeSDK registry with existing ExtensionConfig objects to ensure extensions
 	// are discovered before controllers begin reconciling.
 	err = mgr.Add(&warmupRunnable{
 		Client:        r.Client,
 		APIReader:     r.APIReader,
 		RuntimeClient: r.RuntimeClient,
+		Shard:         r.Shard,
 	})
 	if err != nil {
 		return errors.Wrap(err, "failed adding warmupRunnable to controller manager")
@@ -140,8 +151,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	// Always patch the ExtensionConfig as it may contain updates in conditions or clientConfig.caBundle.
-	if err = patchExtensionConfig(ctx, r.Client, original, discoveredExtensionConfig); err != nil {
-		errs = append(errs, err)
+	// NOTE: If sharding is enabled, only the primary shard patches the ExtensionConfig, so replicas do not compete on it.
+	if r.Shard.IsPrimary() {
+		if err = patchExtensionConfig(ctx, r.Client, original, discoveredExtensionConfig); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	if len(errs) != 0 {
@@ -180,23 +194,30 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, extensionConfig *runti
 }
 
 // secretToExtensionConfig maps a secret to ExtensionConfigs with the corresponding InjectCAFromSecretAnnotation
-// to reconcile them on updates of the secrets.
+// or client certificate Secret to reconcile them on updates of the secrets.
+// NOTE: reconciling the ExtensionConfig triggers a new discovery, which reloads the client certificate.
 func (r *Reconciler) secretToExtensionConfig(ctx context.Context, secret client.Object) []reconcile.Request {
 	result := []ctrl.Request{}
+	seen := map[string]bool{}
 
-	extensionConfigs := runtimev1.ExtensionConfigList{}
 	indexKey := secret.GetNamespace() + "/" + secret.GetName()
+	for _, field := range []string{injectCAFromSecretAnnotationField, clientCertificateSecretField} {
+		extensionConfigs := runtimev1.ExtensionConfigList{}
+		if err := r.Client.List(
+			ctx,
+			&extensionConfigs,
+			client.MatchingFields{field: indexKey},
+		); err != nil {
+			return nil
+		}
 
-	if err := r.Client.List(
-		ctx,
-		&extensionConfigs,
-		client.MatchingFields{injectCAFromSecretAnnotationField: indexKey},
-	); err != nil {
-		return nil
-	}
-
-	for _, ext := range extensionConfigs.Items {
-		result = append(result, ctrl.Request{NamespacedName: client.ObjectKey{Name: ext.Name}})
+		for _, ext := range extensionConfigs.Items {
+			if seen[ext.Name] {
+				continue
+			}
+			seen[ext.Name] = true
+			result = append(result, ctrl.Request{NamespacedName: client.ObjectKey{Name: ext.Name}})
+		}
 	}
 
 	return result
@@ -31,6 +31,10 @@ const (
 	// injectCAFromSecretAnnotationField is used by the Extension controller for indexing ExtensionConfigs
 	// which have the InjectCAFromSecretAnnotation set.
 	injectCAFromSecretAnnotationField = "metadata.annotations[" + runtimev1.InjectCAFromSecretAnnotation + "]"
+
+	// clientCertificateSecretField is used by the Extension controller for indexing ExtensionConfigs
+	// by the Secret containing their client certificate.
+	clientCertificateSecretField = "spec.clientConfig.clientCertificate.secretRef"
 )
 
 // indexByExtensionInjectCAFromSecretName adds the index by InjectCAFromSecretAnnotation to the
@@ -55,3 +59,26 @@ func extensionConfigByInjectCAFromSecretName(o client.Object) []string {
 	}
 	return nil
 }
+
+// indexByExtensionClientCertificateSecretName adds the index by the client certificate Secret to the
+// managers cache.
+func indexByExtensionClientCertificateSecretName(ctx context.Context, mgr ctrl.Manager) error {
+	if err := mgr.GetCache().IndexField(ctx, &runtimev1.ExtensionConfig{},
+		clientCertificateSecretField,
+		extensionConfigByClientCertificateSecretName,
+	); err != nil {
+		return errors.Wrap(err, "error setting index field for client certificate Secret")
+	}
+	return nil
+}
+
+func extensionConfigByClientCertificateSecretName(o client.Object) []string {
+	extensionConfig, ok := o.(*runtimev1.ExtensionConfig)
+	if !ok {
+		panic(fmt.Sprintf("Expected ExtensionConfig but got a %T", o))
+	}
+	if clientCertificate := extensionConfig.Spec.ClientConfig.ClientCertificate; clientCertificate != nil {
+		return []string{clientCertificate.SecretRef.Namespace + "/" + clientCertificate.SecretRef.Name}
+	}
+	return nil
+}
@@ -58,3 +58,41 @@ func TestExtensionConfigByInjectCAFromSecretName(t *testing.T) {
 		})
 	}
 }
+
+func TestExtensionConfigByClientCertificateSecretName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		object   client.Object
+		expected []string
+	}{
+		{
+			name:     "when extensionConfig has no client certificate",
+			object:   &runtimev1.ExtensionConfig{},
+			expected: nil,
+		},
+		{
+			name: "when extensionConfig has a client certificate",
+			object: &runtimev1.ExtensionConfig{
+				Spec: runtimev1.ExtensionConfigSpec{
+					ClientConfig: runtimev1.ClientConfig{
+						ClientCertificate: &runtimev1.ClientCertificate{
+							SecretRef: runtimev1.SecretReference{
+								Namespace: "foo",
+								Name:      "bar",
+							},
+						},
+					},
+				},
+			},
+			expected: []string{"foo/bar"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got := extensionConfigByClientCertificateSecretName(test.object)
+			g.Expect(got).To(Equal(test.expected))
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 
 	runtimev1 "sigs.k8s.io/cluster-api/exp/runtime/api/v1alpha1"
 	runtimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 const (
@@ -44,6 +45,7 @@ type warmupRunnable struct {
 	Client         client.Client
 	APIReader      client.Reader
 	RuntimeClient  runtimeclient.Client
+	Shard          shard.Shard
 	warmupTimeout  time.Duration
 	warmupInterval time.Duration
 }
@@ -73,7 +75,7 @@ func (r *warmupRunnable) Start(ctx context.Context) error {
 	defer cancel()
 
 	err := wait.PollUntilContextTimeout(ctx, r.warmupInterval, r.warmupTimeout, true, func(ctx context.Context) (done bool, err error) {
-		if err = warmupRegistry(ctx, r.Client, r.APIReader, r.RuntimeClient); err != nil {
+		if err = warmupRegistry(ctx, r.Client, r.APIReader, r.RuntimeClient, r.Shard); err != nil {
 			log.Error(err, "ExtensionConfig registry warmup failed")
 			return false, nil
 		}
@@ -89,7 +91,8 @@ func (r *warmupRunnable) Start(ctx context.Context) error {
 
 // warmupRegistry attempts to discover all existing ExtensionConfigs and patch their status with discovered Handlers.
 // It warms up the registry by passing it the up-to-date list of ExtensionConfigs.
-func warmupRegistry(ctx context.Context, client client.Client, reader client.Reader, runtimeClient runtimeclient.Client) error {
+// If sharding is enabled, only the primary shard patches the ExtensionConfigs.
+func warmupRegistry(ctx context.Context, client client.Client, reader client.Reader, runtimeClient runtimeclient.Client, s shard.Shard) error {
 	log := ctrl.LoggerFrom(ctx)
 
 	var errs []error
@@ -119,8 +122,10 @@ func warmupRegistry(ctx context.Context, client client.Client, reader client.Rea
 		}
 
 		// Always patch the ExtensionConfig as it may contain updates in conditions or clientConfig.caBundle.
-		if err = patchExtensionConfig(ctx, client, original, extensionConfig); err != nil {
-			errs = append(errs, err)
+		if s.IsPrimary() {
+			if err = patchExtensionConfig(ctx, client, original, extensionConfig); err != nil {
+				errs = append(errs, err)
+			}
 		}
 		extensionConfigList.Items[i] = *extensionConfig
 	}
@@ -70,6 +70,13 @@ type Options struct {
 	// It is used to set webhook.Server.CertDir.
 	CertDir string
 
+	// ClientCAName is the name of the file in CertDir containing the PEM encoded CA bundle used to verify
+	// the client certificates presented by the callers, e.g. the Cluster API controllers calling the Runtime Extension.
+	// If set, the server requires callers to present a valid client certificate (mutual TLS).
+	// Defaults to "", which means the server does not verify client certificates.
+	// It is used to set webhook.Server.ClientCAName.
+	ClientCAName string
+
 	// TLSOpts is used to allow configuring the TLS config used for the server.
 	// This also allows providing a certificate via GetCertificate.
 	TLSOpts []func(*tls.Config)
@@ -89,13 +96,14 @@ func New(options Options) (*Server, error) {
 
 	webhookServer := webhook.NewServer(
 		webhook.Options{
-			Port:       options.Port,
-			Host:       options.Host,
-			CertDir:    options.CertDir,
-			CertName:   "tls.crt",
-			KeyName:    "tls.key",
-			TLSOpts:    options.TLSOpts,
-			WebhookMux: http.NewServeMux(),
+			Port:         options.Port,
+			Host:         options.Host,
+			CertDir:      options.CertDir,
+			CertName:     "tls.crt",
+			KeyName:      "tls.key",
+			ClientCAName: options.ClientCAName,
+			TLSOpts:      options.TLSOpts,
+			WebhookMux:   http.NewServeMux(),
 		},
 	)
 
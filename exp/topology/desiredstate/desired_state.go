@@ -37,7 +37,6 @@ import (
 	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
 	"sigs.k8s.io/cluster-api/feature"
-	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/patches"
 	"sigs.k8s.io/cluster-api/internal/hooks"
 	tlog "sigs.k8s.io/cluster-api/internal/log"
@@ -48,6 +47,7 @@ import (
 	"sigs.k8s.io/cluster-api/internal/topology/selectors"
 	"sigs.k8s.io/cluster-api/internal/webhooks"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/contract"
 )
 
 // Generator is a generator to generate the desired state.
@@ -111,6 +111,15 @@ func (g *generator) Generate(ctx context.Context, s *scope.Scope) (*scope.Cluste
 	}
 	s.UpgradeTracker.MachineDeployments.MarkUpgrading(mdUpgradingNames...)
 
+	// Mark all the MachineDeployments using the OnDelete strategy.
+	// Machines of those MachineDeployments are replaced only when deleted by an operator or an external tool,
+	// thus they should not prevent other MachineDeployments from picking up a new version.
+	for _, md := range s.Current.MachineDeployments {
+		if md.Object.Spec.Strategy != nil && md.Object.Spec.Strategy.Type == clusterv1.OnDeleteMachineDeploymentStrategyType {
+			s.UpgradeTracker.MachineDeployments.MarkOnDeleteStrategy(md.Object.Name)
+		}
+	}
+
 	// Mark all the MachinePools that are currently upgrading.
 	// This captured information is used for:
 	// - Building the TopologyReconciled condition.
@@ -177,7 +186,7 @@ func (g *generator) Generate(ctx context.Context, s *scope.Scope) (*scope.Cluste
 	// are preserved during patching. When desired objects are computed their spec is copied from a template, in some cases
 	// further modifications to the spec are made afterwards. In those cases we have to make sure those fields are not overwritten
 	// in apply patches. Some examples are .spec.machineTemplate and .spec.version in control planes.
-	if err := g.patchEngine.Apply(ctx, s.Blueprint, desiredState); err != nil {
+	if err := g.patchEngine.Apply(ctx, s.Blueprint, desiredState, s.PatchTracker); err != nil {
 		return nil, errors.Wrap(err, "failed to apply patches")
 	}
 
@@ -484,6 +493,35 @@ func (g *generator) computeControlPlaneVersion(ctx context.Context, s *scope.Sco
 					}
 				}
 			}
+
+			// Call the BeforeWorkersUpgrade hook once the AfterControlPlaneUpgrade hook is completed.
+			// Call the hook only if we are tracking the intent to do so. If it is not tracked it means we don't need to call the
+			// hook because we didn't go through an upgrade or we already called the hook before upgrading the workers.
+			if hooks.IsPending(runtimehooksv1.BeforeWorkersUpgrade, s.Current.Cluster) &&
+				!hooks.IsPending(runtimehooksv1.AfterControlPlaneUpgrade, s.Current.Cluster) {
+				// Call all the registered extension for the hook.
+				hookRequest := &runtimehooksv1.BeforeWorkersUpgradeRequest{
+					Cluster:           *s.Current.Cluster,
+					KubernetesVersion: desiredVersion,
+				}
+				hookResponse := &runtimehooksv1.BeforeWorkersUpgradeResponse{}
+				if err := g.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.BeforeWorkersUpgrade, s.Current.Cluster, hookRequest, hookResponse); err != nil {
+					return "", err
+				}
+				// Add the response to the tracker so we can later update condition or requeue when required.
+				s.HookResponseTracker.Add(runtimehooksv1.BeforeWorkersUpgrade, hookResponse)
+
+				// If the extension responds to hold off on starting Machine deployments upgrades,
+				// change the UpgradeTracker accordingly, otherwise the hook call is completed and we
+				// can remove this hook from the list of pending-hooks.
+				if hookResponse.RetryAfterSeconds != 0 {
+					log.Infof("MachineDeployments/MachinePools upgrade to version %q are blocked by %q hook", desiredVersion, runtimecatalog.HookName(runtimehooksv1.BeforeWorkersUpgrade))
+				} else {
+					if err := hooks.MarkAsDone(ctx, g.Client, s.Current.Cluster, runtimehooksv1.BeforeWorkersUpgrade); err != nil {
+						return "", err
+					}
+				}
+			}
 		}
 
 		return *currentVersion, nil
@@ -532,8 +570,10 @@ func (g *generator) computeControlPlaneVersion(ctx context.Context, s *scope.Sco
 		}
 
 		// We are picking up the new version here.
-		// Track the intent of calling the AfterControlPlaneUpgrade and the AfterClusterUpgrade hooks once we are done with the upgrade.
-		if err := hooks.MarkAsPending(ctx, g.Client, s.Current.Cluster, runtimehooksv1.AfterControlPlaneUpgrade, runtimehooksv1.AfterClusterUpgrade); err != nil {
+		// Track the intent of calling the AfterControlPlaneUpgrade, the BeforeWorkersUpgrade, the AfterWorkersUpgrade
+		// and the AfterClusterUpgrade hooks once we are done with the corresponding step of the upgrade.
+		if err := hooks.MarkAsPending(ctx, g.Client, s.Current.Cluster, runtimehooksv1.AfterControlPlaneUpgrade,
+			runtimehooksv1.BeforeWorkersUpgrade, runtimehooksv1.AfterWorkersUpgrade, runtimehooksv1.AfterClusterUpgrade); err != nil {
 			return "", err
 		}
 	}
@@ -712,10 +752,20 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 		strategy = machineDeploymentTopology.Strategy
 	}
 
+	preflightChecks := machineDeploymentClass.PreflightChecks
+	if machineDeploymentTopology.PreflightChecks != nil {
+		preflightChecks = machineDeploymentTopology.PreflightChecks
+	}
+
 	failureDomain := machineDeploymentClass.FailureDomain
 	if machineDeploymentTopology.FailureDomain != nil {
 		failureDomain = machineDeploymentTopology.FailureDomain
 	}
+	// Machines spread across failure domains must not be pinned to the failure domain
+	// defined in the ClusterClass.
+	if clusterv1.FailureDomainSpreadPolicy(ptr.Deref(machineDeploymentTopology.FailureDomainSpread, "")) == clusterv1.EvenFailureDomainSpreadPolicy {
+		failureDomain = nil
+	}
 
 	nodeDrainTimeout := machineDeploymentClass.NodeDrainTimeout
 	if machineDeploymentTopology.NodeDrainTimeout != nil {
@@ -732,6 +782,11 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 		nodeDeletionTimeout = machineDeploymentTopology.NodeDeletionTimeout
 	}
 
+	nodeDrainRules := machineDeploymentClass.NodeDrainRules
+	if machineDeploymentTopology.NodeDrainRules != nil {
+		nodeDrainRules = machineDeploymentTopology.NodeDrainRules
+	}
+
 	// Compute the MachineDeployment object.
 	desiredBootstrapTemplateRef, err := calculateRefDesiredAPIVersion(currentBootstrapTemplateRef, desiredMachineDeployment.BootstrapTemplate)
 	if err != nil {
@@ -762,9 +817,12 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 			Namespace: s.Current.Cluster.Namespace,
 		},
 		Spec: clusterv1.MachineDeploymentSpec{
-			ClusterName:     s.Current.Cluster.Name,
-			MinReadySeconds: minReadySeconds,
-			Strategy:        strategy,
+			ClusterName:         s.Current.Cluster.Name,
+			MinReadySeconds:     minReadySeconds,
+			Strategy:            strategy,
+			RolloutAfter:        machineDeploymentTopology.RolloutAfter,
+			FailureDomainSpread: machineDeploymentTopology.FailureDomainSpread,
+			PreflightChecks:     preflightChecks,
 			Template: clusterv1.MachineTemplateSpec{
 				Spec: clusterv1.MachineSpec{
 					ClusterName:             s.Current.Cluster.Name,
@@ -775,6 +833,7 @@ func (g *generator) computeMachineDeployment(ctx context.Context, s *scope.Scope
 					NodeDrainTimeout:        nodeDrainTimeout,
 					NodeVolumeDetachTimeout: nodeVolumeDetachTimeout,
 					NodeDeletionTimeout:     nodeDeletionTimeout,
+					NodeDrainRules:          nodeDrainRules,
 				},
 			},
 		},
@@ -847,7 +906,7 @@ func (g *generator) computeMachineDeploymentVersion(s *scope.Scope, machineDeplo
 	// Example: join could fail if the load balancers are slow in detecting when CP machines are
 	// being deleted.
 	if currentMDState == nil || currentMDState.Object == nil {
-		if !s.UpgradeTracker.ControlPlane.IsControlPlaneStable() || s.HookResponseTracker.IsBlocking(runtimehooksv1.AfterControlPlaneUpgrade) {
+		if !s.UpgradeTracker.ControlPlane.IsControlPlaneStable() || isWorkersUpgradeBlocked(s) {
 			s.UpgradeTracker.MachineDeployments.MarkPendingCreate(machineDeploymentTopology.Name)
 		}
 		return desiredVersion
@@ -869,8 +928,8 @@ func (g *generator) computeMachineDeploymentVersion(s *scope.Scope, machineDeplo
 		return currentVersion
 	}
 
-	// Return early if the AfterControlPlaneUpgrade hook returns a blocking response.
-	if s.HookResponseTracker.IsBlocking(runtimehooksv1.AfterControlPlaneUpgrade) {
+	// Return early if the AfterControlPlaneUpgrade or the BeforeWorkersUpgrade hook returns a blocking response.
+	if isWorkersUpgradeBlocked(s) {
 		s.UpgradeTracker.MachineDeployments.MarkPendingUpgrade(currentMDState.Object.Name)
 		return currentVersion
 	}
@@ -895,6 +954,13 @@ func (g *generator) computeMachineDeploymentVersion(s *scope.Scope, machineDeplo
 	return desiredVersion
 }
 
+// isWorkersUpgradeBlocked returns true if a hook called before propagating the new version to the
+// MachineDeployments and MachinePools returns a blocking response.
+func isWorkersUpgradeBlocked(s *scope.Scope) bool {
+	return s.HookResponseTracker.IsBlocking(runtimehooksv1.AfterControlPlaneUpgrade) ||
+		s.HookResponseTracker.IsBlocking(runtimehooksv1.BeforeWorkersUpgrade)
+}
+
 // isMachineDeploymentDeferred returns true if the upgrade for the mdTopology is deferred.
 // This is the case when either:
 //   - the mdTopology has the ClusterTopologyDeferUpgradeAnnotation annotation.
@@ -1149,7 +1215,7 @@ func (g *generator) computeMachinePoolVersion(s *scope.Scope, machinePoolTopolog
 	// Example: join could fail if the load balancers are slow in detecting when CP machines are
 	// being deleted.
 	if currentMPState == nil || currentMPState.Object == nil {
-		if !s.UpgradeTracker.ControlPlane.IsControlPlaneStable() || s.HookResponseTracker.IsBlocking(runtimehooksv1.AfterControlPlaneUpgrade) {
+		if !s.UpgradeTracker.ControlPlane.IsControlPlaneStable() || isWorkersUpgradeBlocked(s) {
 			s.UpgradeTracker.MachinePools.MarkPendingCreate(machinePoolTopology.Name)
 		}
 		return desiredVersion
@@ -1171,8 +1237,8 @@ func (g *generator) computeMachinePoolVersion(s *scope.Scope, machinePoolTopolog
 		return currentVersion
 	}
 
-	// Return early if the AfterControlPlaneUpgrade hook returns a blocking response.
-	if s.HookResponseTracker.IsBlocking(runtimehooksv1.AfterControlPlaneUpgrade) {
+	// Return early if the AfterControlPlaneUpgrade or the BeforeWorkersUpgrade hook returns a blocking response.
+	if isWorkersUpgradeBlocked(s) {
 		s.UpgradeTracker.MachinePools.MarkPendingUpgrade(currentMPState.Object.Name)
 		return currentVersion
 	}
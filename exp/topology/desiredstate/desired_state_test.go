@@ -42,7 +42,6 @@ import (
 	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
 	"sigs.k8s.io/cluster-api/feature"
-	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/hooks"
 	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
 	"sigs.k8s.io/cluster-api/internal/test/builder"
@@ -50,6 +49,7 @@ import (
 	"sigs.k8s.io/cluster-api/internal/topology/names"
 	"sigs.k8s.io/cluster-api/internal/topology/ownerrefs"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/contract"
 )
 
 var (
@@ -1188,7 +1188,150 @@ func TestComputeControlPlaneVersion(t *testing.T) {
 		}
 	})
 
-	t.Run("register intent to call AfterClusterUpgrade and AfterControlPlaneUpgrade hooks", func(t *testing.T) {
+	t.Run("Calling BeforeWorkersUpgrade hook", func(t *testing.T) {
+		defer utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)()
+
+		catalog := runtimecatalog.New()
+		_ = runtimehooksv1.AddToCatalog(catalog)
+
+		afterControlPlaneUpgradeGVH, err := catalog.GroupVersionHook(runtimehooksv1.AfterControlPlaneUpgrade)
+		if err != nil {
+			panic(err)
+		}
+		beforeWorkersUpgradeGVH, err := catalog.GroupVersionHook(runtimehooksv1.BeforeWorkersUpgrade)
+		if err != nil {
+			panic(err)
+		}
+
+		blockingResponse := &runtimehooksv1.BeforeWorkersUpgradeResponse{
+			CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+				RetryAfterSeconds: int32(10),
+				CommonResponse: runtimehooksv1.CommonResponse{
+					Status: runtimehooksv1.ResponseStatusSuccess,
+				},
+			},
+		}
+		nonBlockingResponse := &runtimehooksv1.BeforeWorkersUpgradeResponse{
+			CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+				CommonResponse: runtimehooksv1.CommonResponse{
+					Status: runtimehooksv1.ResponseStatusSuccess,
+				},
+			},
+		}
+		afterControlPlaneUpgradeBlockingResponse := &runtimehooksv1.AfterControlPlaneUpgradeResponse{
+			CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+				RetryAfterSeconds: int32(10),
+				CommonResponse: runtimehooksv1.CommonResponse{
+					Status: runtimehooksv1.ResponseStatusSuccess,
+				},
+			},
+		}
+
+		topologyVersion := "v1.2.3"
+		controlPlaneStable := builder.ControlPlane("test-ns", "cp1").
+			WithSpecFields(map[string]interface{}{
+				"spec.version":  topologyVersion,
+				"spec.replicas": int64(2),
+			}).
+			WithStatusFields(map[string]interface{}{
+				"status.version":         topologyVersion,
+				"status.replicas":        int64(2),
+				"status.updatedReplicas": int64(2),
+				"status.readyReplicas":   int64(2),
+			}).
+			Build()
+
+		newScope := func(pendingHooks string) *scope.Scope {
+			return &scope.Scope{
+				Blueprint: &scope.ClusterBlueprint{
+					Topology: &clusterv1.Topology{
+						Version: topologyVersion,
+						ControlPlane: clusterv1.ControlPlaneTopology{
+							Replicas: ptr.To[int32](2),
+						},
+					},
+				},
+				Current: &scope.ClusterState{
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-cluster",
+							Namespace: "test-ns",
+							Annotations: map[string]string{
+								runtimev1.PendingHooksAnnotation: pendingHooks,
+							},
+						},
+					},
+					ControlPlane: &scope.ControlPlaneState{
+						Object: controlPlaneStable,
+					},
+				},
+				UpgradeTracker:      scope.NewUpgradeTracker(),
+				HookResponseTracker: scope.NewHookResponseTracker(),
+			}
+		}
+
+		tests := []struct {
+			name               string
+			s                  *scope.Scope
+			hookResponse       *runtimehooksv1.BeforeWorkersUpgradeResponse
+			wantIntentToCall   bool
+			wantHookToBeCalled bool
+			wantHookToBlock    bool
+		}{
+			{
+				name:               "should not call hook if the AfterControlPlaneUpgrade hook is blocking",
+				s:                  newScope("AfterControlPlaneUpgrade,BeforeWorkersUpgrade"),
+				hookResponse:       nonBlockingResponse,
+				wantIntentToCall:   true,
+				wantHookToBeCalled: false,
+			},
+			{
+				name:               "should call hook if the AfterControlPlaneUpgrade hook is completed - blocking response should leave the hook marked",
+				s:                  newScope("BeforeWorkersUpgrade"),
+				hookResponse:       blockingResponse,
+				wantIntentToCall:   true,
+				wantHookToBeCalled: true,
+				wantHookToBlock:    true,
+			},
+			{
+				name:               "should call hook if the AfterControlPlaneUpgrade hook is completed - non blocking response should unmark the hook",
+				s:                  newScope("BeforeWorkersUpgrade"),
+				hookResponse:       nonBlockingResponse,
+				wantIntentToCall:   false,
+				wantHookToBeCalled: true,
+				wantHookToBlock:    false,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				g := NewWithT(t)
+
+				fakeRuntimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+					WithCallAllExtensionResponses(map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+						afterControlPlaneUpgradeGVH: afterControlPlaneUpgradeBlockingResponse,
+						beforeWorkersUpgradeGVH:     tt.hookResponse,
+					}).
+					WithCatalog(catalog).
+					Build()
+
+				fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(tt.s.Current.Cluster).Build()
+
+				r := &generator{
+					Client:        fakeClient,
+					RuntimeClient: fakeRuntimeClient,
+				}
+
+				_, err := r.computeControlPlaneVersion(ctx, tt.s)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(fakeRuntimeClient.CallAllCount(runtimehooksv1.BeforeWorkersUpgrade) == 1).To(Equal(tt.wantHookToBeCalled))
+				g.Expect(hooks.IsPending(runtimehooksv1.BeforeWorkersUpgrade, tt.s.Current.Cluster)).To(Equal(tt.wantIntentToCall))
+				g.Expect(tt.s.HookResponseTracker.IsBlocking(runtimehooksv1.BeforeWorkersUpgrade)).To(Equal(tt.wantHookToBlock))
+			})
+		}
+	})
+
+	t.Run("register intent to call AfterClusterUpgrade, AfterControlPlaneUpgrade, BeforeWorkersUpgrade and AfterWorkersUpgrade hooks", func(t *testing.T) {
 		defer utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)()
 
 		catalog := runtimecatalog.New()
@@ -1256,9 +1399,11 @@ func TestComputeControlPlaneVersion(t *testing.T) {
 		desiredVersion, err := r.computeControlPlaneVersion(ctx, s)
 		g := NewWithT(t)
 		g.Expect(err).ToNot(HaveOccurred())
-		// When successfully picking up the new version the intent to call AfterControlPlaneUpgrade and AfterClusterUpgrade hooks should be registered.
+		// When successfully picking up the new version the intent to call the upgrade hooks should be registered.
 		g.Expect(desiredVersion).To(Equal("v1.2.3"))
 		g.Expect(hooks.IsPending(runtimehooksv1.AfterControlPlaneUpgrade, s.Current.Cluster)).To(BeTrue())
+		g.Expect(hooks.IsPending(runtimehooksv1.BeforeWorkersUpgrade, s.Current.Cluster)).To(BeTrue())
+		g.Expect(hooks.IsPending(runtimehooksv1.AfterWorkersUpgrade, s.Current.Cluster)).To(BeTrue())
 		g.Expect(hooks.IsPending(runtimehooksv1.AfterClusterUpgrade, s.Current.Cluster)).To(BeTrue())
 	})
 }
@@ -1330,6 +1475,12 @@ func TestComputeMachineDeployment(t *testing.T) {
 	clusterClassStrategy := clusterv1.MachineDeploymentStrategy{
 		Type: clusterv1.OnDeleteMachineDeploymentStrategyType,
 	}
+	clusterClassNodeDrainRules := clusterv1.NodeDrainRules{
+		Order: clusterv1.PodPriorityNodeDrainOrder,
+	}
+	clusterClassPreflightChecks := clusterv1.MachineSetPreflightChecks{
+		Skip: []clusterv1.MachineSetPreflightCheck{clusterv1.MachineSetPreflightCheckKubeadmVersionSkew},
+	}
 	md1 := builder.MachineDeploymentClass("linux-worker").
 		WithLabels(labels).
 		WithAnnotations(annotations).
@@ -1343,8 +1494,10 @@ func TestComputeMachineDeployment(t *testing.T) {
 		WithNodeDrainTimeout(&clusterClassDuration).
 		WithNodeVolumeDetachTimeout(&clusterClassDuration).
 		WithNodeDeletionTimeout(&clusterClassDuration).
+		WithNodeDrainRules(&clusterClassNodeDrainRules).
 		WithMinReadySeconds(&clusterClassMinReadySeconds).
 		WithStrategy(&clusterClassStrategy).
+		WithPreflightChecks(&clusterClassPreflightChecks).
 		Build()
 	mcds := []clusterv1.MachineDeploymentClass{*md1}
 	fakeClass := builder.ClusterClass(metav1.NamespaceDefault, "class1").
@@ -1391,6 +1544,14 @@ func TestComputeMachineDeployment(t *testing.T) {
 	topologyStrategy := clusterv1.MachineDeploymentStrategy{
 		Type: clusterv1.RollingUpdateMachineDeploymentStrategyType,
 	}
+	topologyNodeDrainRules := clusterv1.NodeDrainRules{
+		Order:      clusterv1.LabelNodeDrainOrder,
+		OrderLabel: "drain-order",
+	}
+	topologyPreflightChecks := clusterv1.MachineSetPreflightChecks{
+		Skip: []clusterv1.MachineSetPreflightCheck{clusterv1.MachineSetPreflightCheckAll},
+	}
+	topologyRolloutAfter := metav1.NewTime(time.Date(2023, time.March, 9, 9, 0, 0, 0, time.UTC))
 	mdTopology := clusterv1.MachineDeploymentTopology{
 		Metadata: clusterv1.ObjectMeta{
 			Labels: map[string]string{
@@ -1412,8 +1573,11 @@ func TestComputeMachineDeployment(t *testing.T) {
 		NodeDrainTimeout:        &topologyDuration,
 		NodeVolumeDetachTimeout: &topologyDuration,
 		NodeDeletionTimeout:     &topologyDuration,
+		NodeDrainRules:          &topologyNodeDrainRules,
 		MinReadySeconds:         &topologyMinReadySeconds,
 		Strategy:                &topologyStrategy,
+		PreflightChecks:         &topologyPreflightChecks,
+		RolloutAfter:            &topologyRolloutAfter,
 	}
 
 	t.Run("Generates the machine deployment and the referenced templates", func(t *testing.T) {
@@ -1444,10 +1608,13 @@ func TestComputeMachineDeployment(t *testing.T) {
 		g.Expect(*actualMd.Spec.Replicas).To(Equal(replicas))
 		g.Expect(*actualMd.Spec.MinReadySeconds).To(Equal(topologyMinReadySeconds))
 		g.Expect(*actualMd.Spec.Strategy).To(BeComparableTo(topologyStrategy))
+		g.Expect(*actualMd.Spec.PreflightChecks).To(BeComparableTo(topologyPreflightChecks))
+		g.Expect(*actualMd.Spec.RolloutAfter).To(Equal(topologyRolloutAfter))
 		g.Expect(*actualMd.Spec.Template.Spec.FailureDomain).To(Equal(topologyFailureDomain))
 		g.Expect(*actualMd.Spec.Template.Spec.NodeDrainTimeout).To(Equal(topologyDuration))
 		g.Expect(*actualMd.Spec.Template.Spec.NodeVolumeDetachTimeout).To(Equal(topologyDuration))
 		g.Expect(*actualMd.Spec.Template.Spec.NodeDeletionTimeout).To(Equal(topologyDuration))
+		g.Expect(*actualMd.Spec.Template.Spec.NodeDrainRules).To(BeComparableTo(topologyNodeDrainRules))
 		g.Expect(actualMd.Spec.ClusterName).To(Equal("cluster1"))
 		g.Expect(actualMd.Name).To(ContainSubstring("cluster1"))
 		g.Expect(actualMd.Name).To(ContainSubstring("big-pool-of-machines"))
@@ -1489,7 +1656,7 @@ func TestComputeMachineDeployment(t *testing.T) {
 			Class:    "linux-worker",
 			Name:     "big-pool-of-machines",
 			Replicas: &replicas,
-			// missing FailureDomain, NodeDrainTimeout, NodeVolumeDetachTimeout, NodeDeletionTimeout, MinReadySeconds, Strategy
+			// missing FailureDomain, NodeDrainTimeout, NodeVolumeDetachTimeout, NodeDeletionTimeout, NodeDrainRules, MinReadySeconds, Strategy, PreflightChecks
 		}
 
 		e := generator{}
@@ -1501,10 +1668,36 @@ func TestComputeMachineDeployment(t *testing.T) {
 		actualMd := actual.Object
 		g.Expect(*actualMd.Spec.MinReadySeconds).To(Equal(clusterClassMinReadySeconds))
 		g.Expect(*actualMd.Spec.Strategy).To(BeComparableTo(clusterClassStrategy))
+		g.Expect(*actualMd.Spec.PreflightChecks).To(BeComparableTo(clusterClassPreflightChecks))
+		g.Expect(actualMd.Spec.RolloutAfter).To(BeNil())
 		g.Expect(*actualMd.Spec.Template.Spec.FailureDomain).To(Equal(clusterClassFailureDomain))
 		g.Expect(*actualMd.Spec.Template.Spec.NodeDrainTimeout).To(Equal(clusterClassDuration))
 		g.Expect(*actualMd.Spec.Template.Spec.NodeVolumeDetachTimeout).To(Equal(clusterClassDuration))
 		g.Expect(*actualMd.Spec.Template.Spec.NodeDeletionTimeout).To(Equal(clusterClassDuration))
+		g.Expect(*actualMd.Spec.Template.Spec.NodeDrainRules).To(BeComparableTo(clusterClassNodeDrainRules))
+	})
+
+	t.Run("Generates the machine deployment without failure domain when machines are spread across failure domains", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := scope.New(cluster)
+		scope.Blueprint = blueprint
+
+		mdTopology := clusterv1.MachineDeploymentTopology{
+			Class:               "linux-worker",
+			Name:                "big-pool-of-machines",
+			Replicas:            &replicas,
+			FailureDomainSpread: ptr.To(string(clusterv1.EvenFailureDomainSpreadPolicy)),
+		}
+
+		e := generator{}
+
+		actual, err := e.computeMachineDeployment(ctx, scope, mdTopology)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		actualMd := actual.Object
+		g.Expect(*actualMd.Spec.FailureDomainSpread).To(Equal(string(clusterv1.EvenFailureDomainSpreadPolicy)))
+		// The failure domain from the ClusterClass must not be used.
+		g.Expect(actualMd.Spec.Template.Spec.FailureDomain).To(BeNil())
 	})
 
 	t.Run("If there is already a machine deployment, it preserves the object name and the reference names", func(t *testing.T) {
@@ -2122,12 +2315,14 @@ func TestComputeMachineDeploymentVersion(t *testing.T) {
 		machineDeploymentTopology            clusterv1.MachineDeploymentTopology
 		currentMachineDeploymentState        *scope.MachineDeploymentState
 		upgradingMachineDeployments          []string
+		onDeleteMachineDeployments           []string
 		upgradeConcurrency                   int
 		controlPlaneStartingUpgrade          bool
 		controlPlaneUpgrading                bool
 		controlPlaneScaling                  bool
 		controlPlaneProvisioning             bool
 		afterControlPlaneUpgradeHookBlocking bool
+		beforeWorkersUpgradeHookBlocking     bool
 		topologyVersion                      string
 		expectedVersion                      string
 		expectPendingCreate                  bool
@@ -2216,6 +2411,15 @@ func TestComputeMachineDeploymentVersion(t *testing.T) {
 			expectedVersion:                      "v1.2.2",
 			expectPendingUpgrade:                 true,
 		},
+		{
+			name:                             "should return machine deployment's spec.template.spec.version if control plane is stable, concurrency limit not reached but BeforeWorkersUpgrade hook is blocking",
+			currentMachineDeploymentState:    currentMachineDeploymentState,
+			upgradeConcurrency:               2,
+			beforeWorkersUpgradeHookBlocking: true,
+			topologyVersion:                  "v1.2.3",
+			expectedVersion:                  "v1.2.2",
+			expectPendingUpgrade:             true,
+		},
 		{
 			name:                          "should return cluster.spec.topology.version if control plane is stable, other machine deployments are upgrading, concurrency limit not reached",
 			currentMachineDeploymentState: currentMachineDeploymentState,
@@ -2234,6 +2438,16 @@ func TestComputeMachineDeploymentVersion(t *testing.T) {
 			expectedVersion:               "v1.2.2",
 			expectPendingUpgrade:          true,
 		},
+		{
+			name:                          "should return cluster.spec.topology.version if control plane is stable, other machine deployments are upgrading, concurrency limit not reached when ignoring machine deployments using the OnDelete strategy",
+			currentMachineDeploymentState: currentMachineDeploymentState,
+			upgradingMachineDeployments:   []string{"upgrading-md1", "upgrading-md2"},
+			onDeleteMachineDeployments:    []string{"upgrading-md2"},
+			upgradeConcurrency:            2,
+			topologyVersion:               "v1.2.3",
+			expectedVersion:               "v1.2.3",
+			expectPendingUpgrade:          false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -2261,11 +2475,19 @@ func TestComputeMachineDeploymentVersion(t *testing.T) {
 					},
 				})
 			}
+			if tt.beforeWorkersUpgradeHookBlocking {
+				s.HookResponseTracker.Add(runtimehooksv1.BeforeWorkersUpgrade, &runtimehooksv1.BeforeWorkersUpgradeResponse{
+					CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+						RetryAfterSeconds: 10,
+					},
+				})
+			}
 			s.UpgradeTracker.ControlPlane.IsStartingUpgrade = tt.controlPlaneStartingUpgrade
 			s.UpgradeTracker.ControlPlane.IsUpgrading = tt.controlPlaneUpgrading
 			s.UpgradeTracker.ControlPlane.IsScaling = tt.controlPlaneScaling
 			s.UpgradeTracker.ControlPlane.IsProvisioning = tt.controlPlaneProvisioning
 			s.UpgradeTracker.MachineDeployments.MarkUpgrading(tt.upgradingMachineDeployments...)
+			s.UpgradeTracker.MachineDeployments.MarkOnDeleteStrategy(tt.onDeleteMachineDeployments...)
 
 			e := generator{}
 
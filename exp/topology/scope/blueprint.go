@@ -117,10 +117,7 @@ func (b *ClusterBlueprint) IsControlPlaneMachineHealthCheckEnabled() bool {
 
 // ControlPlaneMachineHealthCheckClass returns the MachineHealthCheckClass that should be used to create the MachineHealthCheck object.
 func (b *ClusterBlueprint) ControlPlaneMachineHealthCheckClass() *clusterv1.MachineHealthCheckClass {
-	if b.Topology.ControlPlane.MachineHealthCheck != nil && !b.Topology.ControlPlane.MachineHealthCheck.MachineHealthCheckClass.IsZero() {
-		return &b.Topology.ControlPlane.MachineHealthCheck.MachineHealthCheckClass
-	}
-	return b.ControlPlane.MachineHealthCheck
+	return overrideMachineHealthCheckClass(b.ControlPlane.MachineHealthCheck, b.Topology.ControlPlane.MachineHealthCheck)
 }
 
 // HasControlPlaneMachineHealthCheck returns true if the ControlPlaneClass has both MachineInfrastructure and a MachineHealthCheck defined.
@@ -145,10 +142,35 @@ func (b *ClusterBlueprint) IsMachineDeploymentMachineHealthCheckEnabled(md *clus
 
 // MachineDeploymentMachineHealthCheckClass return the MachineHealthCheckClass that should be used to create the MachineHealthCheck object.
 func (b *ClusterBlueprint) MachineDeploymentMachineHealthCheckClass(md *clusterv1.MachineDeploymentTopology) *clusterv1.MachineHealthCheckClass {
-	if md.MachineHealthCheck != nil && !md.MachineHealthCheck.MachineHealthCheckClass.IsZero() {
-		return &md.MachineHealthCheck.MachineHealthCheckClass
+	return overrideMachineHealthCheckClass(b.MachineDeployments[md.Class].MachineHealthCheck, md.MachineHealthCheck)
+}
+
+// overrideMachineHealthCheckClass returns the MachineHealthCheckClass defined in ClusterClass overridden by the
+// MachineHealthCheck defined in the Cluster topology, if any, according to its override strategy.
+func overrideMachineHealthCheckClass(class *clusterv1.MachineHealthCheckClass, topology *clusterv1.MachineHealthCheckTopology) *clusterv1.MachineHealthCheckClass {
+	if topology == nil || topology.MachineHealthCheckClass.IsZero() {
+		return class
+	}
+	if class == nil || topology.OverrideStrategy != clusterv1.MergeMachineHealthCheckOverrideStrategy {
+		return &topology.MachineHealthCheckClass
+	}
+
+	merged := class.DeepCopy()
+	if len(topology.UnhealthyConditions) > 0 {
+		merged.UnhealthyConditions = topology.UnhealthyConditions
+	}
+	// UnhealthyRange takes precedence over MaxUnhealthy, so they are overridden together.
+	if topology.MaxUnhealthy != nil || topology.UnhealthyRange != nil {
+		merged.MaxUnhealthy = topology.MaxUnhealthy
+		merged.UnhealthyRange = topology.UnhealthyRange
+	}
+	if topology.NodeStartupTimeout != nil {
+		merged.NodeStartupTimeout = topology.NodeStartupTimeout
+	}
+	if topology.RemediationTemplate != nil {
+		merged.RemediationTemplate = topology.RemediationTemplate
 	}
-	return b.MachineDeployments[md.Class].MachineHealthCheck
+	return merged
 }
 
 // HasMachineDeployments checks whether the topology has MachineDeployments.
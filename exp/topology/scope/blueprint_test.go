@@ -237,6 +237,28 @@ func TestControlPlaneMachineHealthCheckClass(t *testing.T) {
 			},
 			want: mhcInClusterClass,
 		},
+		{
+			name: "should merge the MachineHealthCheck from cluster topology into the MachineHealthCheck in ClusterClass if the override strategy is Merge",
+			blueprint: &ClusterBlueprint{
+				Topology: builder.ClusterTopology().
+					WithControlPlaneMachineHealthCheck(&clusterv1.MachineHealthCheckTopology{
+						OverrideStrategy: clusterv1.MergeMachineHealthCheckOverrideStrategy,
+						MachineHealthCheckClass: clusterv1.MachineHealthCheckClass{
+							MaxUnhealthy:       &percent50,
+							NodeStartupTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+						},
+					}).
+					Build(),
+				ControlPlane: &ControlPlaneBlueprint{
+					MachineHealthCheck: mhcInClusterClass,
+				},
+			},
+			want: &clusterv1.MachineHealthCheckClass{
+				UnhealthyConditions: mhcInClusterClass.UnhealthyConditions,
+				MaxUnhealthy:        &percent50,
+				NodeStartupTimeout:  &metav1.Duration{Duration: 5 * time.Minute},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -418,6 +440,10 @@ func TestMachineDeploymentMachineHealthCheckClass(t *testing.T) {
 		MaxUnhealthy: &percent50,
 	}
 
+	mhcWithRangeInClusterClass := mhcInClusterClass.DeepCopy()
+	mhcWithRangeInClusterClass.UnhealthyRange = ptr.To("[1-3]")
+	mhcWithRangeInClusterClass.NodeStartupTimeout = &metav1.Duration{Duration: 15 * time.Minute}
+
 	tests := []struct {
 		name       string
 		blueprint  *ClusterBlueprint
@@ -456,6 +482,30 @@ func TestMachineDeploymentMachineHealthCheckClass(t *testing.T) {
 			},
 			want: mhcInClusterClass,
 		},
+		{
+			name: "should merge the MachineHealthCheck from cluster topology into the MachineHealthCheck in ClusterClass if the override strategy is Merge",
+			blueprint: &ClusterBlueprint{
+				MachineDeployments: map[string]*MachineDeploymentBlueprint{
+					"worker-class": {
+						MachineHealthCheck: mhcWithRangeInClusterClass,
+					},
+				},
+			},
+			mdTopology: &clusterv1.MachineDeploymentTopology{
+				Class: "worker-class",
+				MachineHealthCheck: &clusterv1.MachineHealthCheckTopology{
+					OverrideStrategy: clusterv1.MergeMachineHealthCheckOverrideStrategy,
+					MachineHealthCheckClass: clusterv1.MachineHealthCheckClass{
+						MaxUnhealthy: &percent50,
+					},
+				},
+			},
+			want: &clusterv1.MachineHealthCheckClass{
+				UnhealthyConditions: mhcWithRangeInClusterClass.UnhealthyConditions,
+				MaxUnhealthy:        &percent50,
+				NodeStartupTimeout:  mhcWithRangeInClusterClass.NodeStartupTimeout,
+			},
+		},
 	}
 
 	for _, tt := range tests {
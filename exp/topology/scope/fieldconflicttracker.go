@@ -0,0 +1,99 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/structuredmerge"
+)
+
+// FieldConflictTracker is a helper to capture the field conflicts detected while applying
+// the objects of a managed topology.
+type FieldConflictTracker struct {
+	conflicts map[string][]structuredmerge.FieldConflict
+}
+
+// NewFieldConflictTracker returns a new FieldConflictTracker.
+func NewFieldConflictTracker() *FieldConflictTracker {
+	return &FieldConflictTracker{
+		conflicts: map[string][]structuredmerge.FieldConflict{},
+	}
+}
+
+// Add adds the conflicts detected for an object to the tracker.
+// The object is identified by a human readable string, e.g. "MachineDeployment/md-1".
+func (f *FieldConflictTracker) Add(object string, conflicts ...structuredmerge.FieldConflict) {
+	if len(conflicts) == 0 {
+		return
+	}
+	f.conflicts[object] = append(f.conflicts[object], conflicts...)
+}
+
+// HasConflicts returns true if any conflict has been tracked.
+func (f *FieldConflictTracker) HasConflicts() bool {
+	return len(f.conflicts) > 0
+}
+
+// Objects returns the sorted list of objects with conflicts.
+func (f *FieldConflictTracker) Objects() []string {
+	objects := make([]string, 0, len(f.conflicts))
+	for object := range f.conflicts {
+		objects = append(objects, object)
+	}
+	sort.Strings(objects)
+	return objects
+}
+
+// ObjectMessage returns a human friendly message about the conflicts of an object.
+func (f *FieldConflictTracker) ObjectMessage(object string) string {
+	conflicts := append([]structuredmerge.FieldConflict{}, f.conflicts[object]...)
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Path < conflicts[j].Path
+	})
+
+	conflictMessages := []string{}
+	for _, c := range conflicts {
+		resolution := "yielded"
+		if c.Forced {
+			resolution = "forced"
+		}
+		conflictMessages = append(conflictMessages, fmt.Sprintf("%s owned by %q (%s)", c.Path, c.Manager, resolution))
+	}
+	return strings.Join(conflictMessages, ", ")
+}
+
+// AggregateMessage returns a human friendly message about the conflicts of all the objects.
+func (f *FieldConflictTracker) AggregateMessage() string {
+	objectMessages := []string{}
+	for _, object := range f.Objects() {
+		objectMessages = append(objectMessages, f.objectAggregateMessage(object))
+	}
+	return strings.Join(objectMessages, "; ")
+}
+
+// IsReportedIn returns true if the conflicts of an object are already part of a message returned by AggregateMessage,
+// e.g. the message of a condition set by a previous reconcile.
+func (f *FieldConflictTracker) IsReportedIn(message, object string) bool {
+	return strings.Contains(message, f.objectAggregateMessage(object))
+}
+
+func (f *FieldConflictTracker) objectAggregateMessage(object string) string {
+	return fmt.Sprintf("%s: %s", object, f.ObjectMessage(object))
+}
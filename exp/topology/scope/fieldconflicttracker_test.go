@@ -0,0 +1,80 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/structuredmerge"
+)
+
+func TestFieldConflictTracker(t *testing.T) {
+	t.Run("should not have conflicts if nothing is tracked", func(t *testing.T) {
+		g := NewWithT(t)
+
+		f := NewFieldConflictTracker()
+		f.Add("MachineDeployment/md-1")
+		g.Expect(f.HasConflicts()).To(BeFalse())
+		g.Expect(f.AggregateMessage()).To(Equal(""))
+	})
+
+	t.Run("should aggregate conflicts sorted by object and path", func(t *testing.T) {
+		g := NewWithT(t)
+
+		f := NewFieldConflictTracker()
+		f.Add("MachineDeployment/md-1",
+			structuredmerge.FieldConflict{Path: ".spec.replicas", Manager: "argocd", Forced: false},
+			structuredmerge.FieldConflict{Path: ".metadata.labels.foo", Manager: "kubectl", Forced: true},
+		)
+		f.Add("DockerCluster/cluster-1",
+			structuredmerge.FieldConflict{Path: ".spec.loadBalancer", Manager: "flux", Forced: true},
+		)
+
+		g.Expect(f.HasConflicts()).To(BeTrue())
+		g.Expect(f.Objects()).To(Equal([]string{"DockerCluster/cluster-1", "MachineDeployment/md-1"}))
+		g.Expect(f.ObjectMessage("MachineDeployment/md-1")).To(Equal(`.metadata.labels.foo owned by "kubectl" (forced), .spec.replicas owned by "argocd" (yielded)`))
+		g.Expect(f.AggregateMessage()).To(Equal(`DockerCluster/cluster-1: .spec.loadBalancer owned by "flux" (forced); ` +
+			`MachineDeployment/md-1: .metadata.labels.foo owned by "kubectl" (forced), .spec.replicas owned by "argocd" (yielded)`))
+	})
+
+	t.Run("should detect conflicts already reported in a message", func(t *testing.T) {
+		g := NewWithT(t)
+
+		previous := NewFieldConflictTracker()
+		previous.Add("MachineDeployment/md-1",
+			structuredmerge.FieldConflict{Path: ".spec.replicas", Manager: "argocd", Forced: false},
+		)
+		message := previous.AggregateMessage()
+
+		f := NewFieldConflictTracker()
+		f.Add("MachineDeployment/md-1",
+			structuredmerge.FieldConflict{Path: ".spec.replicas", Manager: "argocd", Forced: false},
+		)
+		f.Add("MachineDeployment/md-2",
+			structuredmerge.FieldConflict{Path: ".spec.replicas", Manager: "argocd", Forced: false},
+		)
+		g.Expect(f.IsReportedIn(message, "MachineDeployment/md-1")).To(BeTrue())
+		g.Expect(f.IsReportedIn(message, "MachineDeployment/md-2")).To(BeFalse())
+
+		f.Add("MachineDeployment/md-1",
+			structuredmerge.FieldConflict{Path: ".metadata.labels.foo", Manager: "kubectl", Forced: true},
+		)
+		g.Expect(f.IsReportedIn(message, "MachineDeployment/md-1")).To(BeFalse())
+	})
+}
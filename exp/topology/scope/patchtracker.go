@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"sort"
+)
+
+// PatchContribution describes the fields of a template changed by a ClusterClass patch.
+type PatchContribution struct {
+	// Patch is the name of the ClusterClass patch.
+	Patch string
+
+	// HolderKind is the kind of the object holding the template, e.g. "MachineDeployment".
+	HolderKind string
+
+	// HolderName is the name of the object holding the template.
+	HolderName string
+
+	// HolderFieldPath is the path of the field in the holder object where the template is used,
+	// e.g. "spec.template.spec.bootstrap.configRef".
+	HolderFieldPath string
+
+	// Paths are the paths of the fields changed by the patch, e.g. ".spec.template.spec.files".
+	Paths []string
+}
+
+// PatchTracker is a helper to capture which ClusterClass patches changed which fields
+// of the templates of a managed topology.
+type PatchTracker struct {
+	contributions []PatchContribution
+}
+
+// NewPatchTracker returns a new PatchTracker.
+func NewPatchTracker() *PatchTracker {
+	return &PatchTracker{}
+}
+
+// Add adds a contribution to the tracker.
+// Contributions without any changed path are ignored.
+func (p *PatchTracker) Add(contribution PatchContribution) {
+	if len(contribution.Paths) == 0 {
+		return
+	}
+	p.contributions = append(p.contributions, contribution)
+}
+
+// Contributions returns the tracked contributions sorted by holder and patch.
+// Note: contributions of the same holder are returned in the order the patches have been applied.
+func (p *PatchTracker) Contributions() []PatchContribution {
+	contributions := append([]PatchContribution{}, p.contributions...)
+	sort.SliceStable(contributions, func(i, j int) bool {
+		if contributions[i].HolderKind != contributions[j].HolderKind {
+			return contributions[i].HolderKind < contributions[j].HolderKind
+		}
+		if contributions[i].HolderName != contributions[j].HolderName {
+			return contributions[i].HolderName < contributions[j].HolderName
+		}
+		return contributions[i].HolderFieldPath < contributions[j].HolderFieldPath
+	})
+	return contributions
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPatchTracker(t *testing.T) {
+	t.Run("should ignore contributions without paths", func(t *testing.T) {
+		g := NewWithT(t)
+
+		p := NewPatchTracker()
+		p.Add(PatchContribution{Patch: "patch-1", HolderKind: "Cluster", HolderName: "cluster-1"})
+		g.Expect(p.Contributions()).To(BeEmpty())
+	})
+
+	t.Run("should return contributions sorted by holder preserving the patch order", func(t *testing.T) {
+		g := NewWithT(t)
+
+		p := NewPatchTracker()
+		p.Add(PatchContribution{Patch: "patch-1", HolderKind: "MachineDeployment", HolderName: "md-1", HolderFieldPath: "spec.template.spec.infrastructureRef", Paths: []string{".spec.template.spec.image"}})
+		p.Add(PatchContribution{Patch: "patch-1", HolderKind: "Cluster", HolderName: "cluster-1", HolderFieldPath: "spec.infrastructureRef", Paths: []string{".spec.template.spec.loadBalancer"}})
+		p.Add(PatchContribution{Patch: "patch-2", HolderKind: "MachineDeployment", HolderName: "md-1", HolderFieldPath: "spec.template.spec.infrastructureRef", Paths: []string{".spec.template.spec.image"}})
+
+		g.Expect(p.Contributions()).To(Equal([]PatchContribution{
+			{Patch: "patch-1", HolderKind: "Cluster", HolderName: "cluster-1", HolderFieldPath: "spec.infrastructureRef", Paths: []string{".spec.template.spec.loadBalancer"}},
+			{Patch: "patch-1", HolderKind: "MachineDeployment", HolderName: "md-1", HolderFieldPath: "spec.template.spec.infrastructureRef", Paths: []string{".spec.template.spec.image"}},
+			{Patch: "patch-2", HolderKind: "MachineDeployment", HolderName: "md-1", HolderFieldPath: "spec.template.spec.infrastructureRef", Paths: []string{".spec.template.spec.image"}},
+		}))
+	})
+}
@@ -39,6 +39,14 @@ type Scope struct {
 	// HookResponseTracker holds the hook responses that will be used to
 	// calculate a combined reconcile result.
 	HookResponseTracker *HookResponseTracker
+
+	// FieldConflictTracker holds the conflicts with other field managers detected
+	// while applying the objects of the managed topology.
+	FieldConflictTracker *FieldConflictTracker
+
+	// PatchTracker optionally records which ClusterClass patches changed which fields
+	// of the templates of the managed topology; tracking is disabled if nil.
+	PatchTracker *PatchTracker
 }
 
 // New returns a new Scope with only the cluster; while processing a request in the topology/ClusterReconciler controller
@@ -65,6 +73,7 @@ func New(cluster *clusterv1.Cluster) *Scope {
 			MaxMDUpgradeConcurrency(maxMDUpgradeConcurrency),
 			MaxMPUpgradeConcurrency(maxMPUpgradeConcurrency),
 		),
-		HookResponseTracker: NewHookResponseTracker(),
+		HookResponseTracker:  NewHookResponseTracker(),
+		FieldConflictTracker: NewFieldConflictTracker(),
 	}
 }
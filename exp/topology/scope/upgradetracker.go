@@ -95,6 +95,11 @@ type WorkerUpgradeTracker struct {
 	// - decide if the AfterClusterUpgrade hook can be called.
 	upgradingNames sets.Set[string]
 
+	// onDeleteNames is the set of MachineDeployment names using the OnDelete strategy.
+	// Machines of those MachineDeployments are only replaced when they are deleted by an operator or by an external tool,
+	// so those MachineDeployments are not taken into account when calculating the upgrade concurrency.
+	onDeleteNames sets.Set[string]
+
 	// maxUpgradeConcurrency defines the maximum number of MachineDeployments/MachinePools that should be in an
 	// upgrading state. This includes the MachineDeployments/MachinePools that are currently upgrading and the
 	// MachineDeployments/MachinePools that will start the upgrade after the current reconcile loop.
@@ -150,6 +155,7 @@ func NewUpgradeTracker(opts ...UpgradeTrackerOption) *UpgradeTracker {
 			pendingUpgradeNames:        sets.Set[string]{},
 			deferredNames:              sets.Set[string]{},
 			upgradingNames:             sets.Set[string]{},
+			onDeleteNames:              sets.Set[string]{},
 			maxUpgradeConcurrency:      options.maxMDUpgradeConcurrency,
 		},
 		MachinePools: WorkerUpgradeTracker{
@@ -157,6 +163,7 @@ func NewUpgradeTracker(opts ...UpgradeTrackerOption) *UpgradeTracker {
 			pendingUpgradeNames:        sets.Set[string]{},
 			deferredNames:              sets.Set[string]{},
 			upgradingNames:             sets.Set[string]{},
+			onDeleteNames:              sets.Set[string]{},
 			maxUpgradeConcurrency:      options.maxMPUpgradeConcurrency,
 		},
 	}
@@ -204,8 +211,22 @@ func (m *WorkerUpgradeTracker) UpgradingNames() []string {
 }
 
 // UpgradeConcurrencyReached returns true if the number of MachineDeployments/MachinePools upgrading is at the concurrency limit.
+// Note: MachineDeployments using the OnDelete strategy are not taken into account.
 func (m *WorkerUpgradeTracker) UpgradeConcurrencyReached() bool {
-	return m.upgradingNames.Len() >= m.maxUpgradeConcurrency
+	return m.upgradingNames.Difference(m.onDeleteNames).Len() >= m.maxUpgradeConcurrency
+}
+
+// MarkOnDeleteStrategy marks a MachineDeployment as using the OnDelete strategy.
+func (m *WorkerUpgradeTracker) MarkOnDeleteStrategy(names ...string) {
+	for _, name := range names {
+		m.onDeleteNames.Insert(name)
+	}
+}
+
+// OnDeleteUpgradingNames returns the list of machine deployments using the OnDelete strategy that are
+// upgrading or are about to upgrade, and thus are waiting for their Machines to be deleted.
+func (m *WorkerUpgradeTracker) OnDeleteUpgradingNames() []string {
+	return sets.List(m.upgradingNames.Intersection(m.onDeleteNames))
 }
 
 // MarkPendingCreate marks a machine deployment topology that is pending to be created.
@@ -54,3 +54,18 @@ func TestNewUpgradeTracker(t *testing.T) {
 		}
 	})
 }
+
+func TestWorkerUpgradeTracker(t *testing.T) {
+	t.Run("should not count MachineDeployments using the OnDelete strategy when calculating concurrency", func(t *testing.T) {
+		g := NewWithT(t)
+
+		tracker := NewUpgradeTracker(MaxMDUpgradeConcurrency(2))
+		tracker.MachineDeployments.MarkUpgrading("md-1", "md-2")
+		g.Expect(tracker.MachineDeployments.UpgradeConcurrencyReached()).To(BeTrue())
+
+		tracker.MachineDeployments.MarkOnDeleteStrategy("md-2", "md-3")
+		g.Expect(tracker.MachineDeployments.UpgradeConcurrencyReached()).To(BeFalse())
+		g.Expect(tracker.MachineDeployments.UpgradingNames()).To(Equal([]string{"md-1", "md-2"}))
+		g.Expect(tracker.MachineDeployments.OnDeleteUpgradingNames()).To(Equal([]string{"md-2"}))
+	})
+}
@@ -68,6 +68,7 @@ func (src *KubeadmControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.KubeadmConfigSpec.Ignition = restored.Spec.KubeadmConfigSpec.Ignition
+	dst.Spec.KubeadmConfigSpec.Fragments = restored.Spec.KubeadmConfigSpec.Fragments
 	if restored.Spec.KubeadmConfigSpec.InitConfiguration != nil {
 		if dst.Spec.KubeadmConfigSpec.InitConfiguration == nil {
 			dst.Spec.KubeadmConfigSpec.InitConfiguration = &bootstrapv1.InitConfiguration{}
@@ -105,6 +106,8 @@ func (src *KubeadmControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Status.LastRemediation != nil {
 		dst.Status.LastRemediation = restored.Status.LastRemediation
 	}
+	dst.Spec.EtcdMaintenance = restored.Spec.EtcdMaintenance
+	dst.Status.EtcdMaintenance = restored.Status.EtcdMaintenance
 
 	return nil
 }
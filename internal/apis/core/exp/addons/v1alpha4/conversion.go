@@ -27,13 +27,28 @@ import (
 func (src *ClusterResourceSet) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*addonsv1.ClusterResourceSet)
 
-	return Convert_v1alpha4_ClusterResourceSet_To_v1beta1_ClusterResourceSet(src, dst, nil)
+	if err := Convert_v1alpha4_ClusterResourceSet_To_v1beta1_ClusterResourceSet(src, dst, nil); err != nil {
+		return err
+	}
+	// Manually restore data.
+	restored := &addonsv1.ClusterResourceSet{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+	dst.Spec.ExternalResources = restored.Spec.ExternalResources
+	dst.Spec.ReapplyInterval = restored.Spec.ReapplyInterval
+	return nil
 }
 
 func (dst *ClusterResourceSet) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*addonsv1.ClusterResourceSet)
 
-	return Convert_v1beta1_ClusterResourceSet_To_v1alpha4_ClusterResourceSet(src, dst, nil)
+	if err := Convert_v1beta1_ClusterResourceSet_To_v1alpha4_ClusterResourceSet(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata
+	return utilconversion.MarshalData(src, dst)
 }
 
 func (src *ClusterResourceSetList) ConvertTo(dstRaw conversion.Hub) error {
@@ -60,6 +75,25 @@ func (src *ClusterResourceSetBinding) ConvertTo(dstRaw conversion.Hub) error {
 		return err
 	}
 	dst.Spec.ClusterName = restored.Spec.ClusterName
+	for _, binding := range dst.Spec.Bindings {
+		if binding == nil {
+			continue
+		}
+		restoredBinding := findResourceSetBinding(restored.Spec.Bindings, binding.ClusterResourceSetName)
+		if restoredBinding == nil {
+			continue
+		}
+		for i := range binding.Resources {
+			restoredResource := restoredBinding.GetResource(binding.Resources[i].ResourceRef)
+			if restoredResource == nil {
+				continue
+			}
+			binding.Resources[i].LastError = restoredResource.LastError
+			binding.Resources[i].DriftDetected = restoredResource.DriftDetected
+			binding.Resources[i].LastDriftDetectedTime = restoredResource.LastDriftDetectedTime
+			binding.Resources[i].LastDriftCheckTime = restoredResource.LastDriftCheckTime
+		}
+	}
 	return nil
 }
 
@@ -95,3 +129,24 @@ func Convert_v1beta1_ClusterResourceSetBindingSpec_To_v1alpha4_ClusterResourceSe
 	// Spec.ClusterName does not exist in ClusterResourceSetBinding v1alpha4 API.
 	return autoConvert_v1beta1_ClusterResourceSetBindingSpec_To_v1alpha4_ClusterResourceSetBindingSpec(in, out, s)
 }
+
+// Convert_v1beta1_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec is a conversion function.
+func Convert_v1beta1_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec(in *addonsv1.ClusterResourceSetSpec, out *ClusterResourceSetSpec, s apiconversion.Scope) error {
+	// Spec.ExternalResources and Spec.ReapplyInterval do not exist in ClusterResourceSet v1alpha4 API.
+	return autoConvert_v1beta1_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec(in, out, s)
+}
+
+// Convert_v1beta1_ResourceBinding_To_v1alpha4_ResourceBinding is a conversion function.
+func Convert_v1beta1_ResourceBinding_To_v1alpha4_ResourceBinding(in *addonsv1.ResourceBinding, out *ResourceBinding, s apiconversion.Scope) error {
+	// LastError, DriftDetected, LastDriftDetectedTime and LastDriftCheckTime do not exist in ResourceBinding v1alpha4 API.
+	return autoConvert_v1beta1_ResourceBinding_To_v1alpha4_ResourceBinding(in, out, s)
+}
+
+func findResourceSetBinding(bindings []*addonsv1.ResourceSetBinding, clusterResourceSetName string) *addonsv1.ResourceSetBinding {
+	for _, binding := range bindings {
+		if binding != nil && binding.ClusterResourceSetName == clusterResourceSetName {
+			return binding
+		}
+	}
+	return nil
+}
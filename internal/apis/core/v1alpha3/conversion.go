@@ -48,6 +48,7 @@ func (src *Cluster) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Spec.Topology != nil {
 		dst.Spec.Topology = restored.Spec.Topology
 	}
+	dst.Spec.DeletionPolicy = restored.Spec.DeletionPolicy
 
 	return nil
 }
@@ -98,9 +99,12 @@ func (src *Machine) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.NodeDeletionTimeout = restored.Spec.NodeDeletionTimeout
+	dst.Spec.NodeDrainRules = restored.Spec.NodeDrainRules
+	dst.Spec.NodeMetadata = restored.Spec.NodeMetadata
 	dst.Spec.NodeVolumeDetachTimeout = restored.Spec.NodeVolumeDetachTimeout
 	dst.Status.NodeInfo = restored.Status.NodeInfo
 	dst.Status.CertificatesExpiryDate = restored.Status.CertificatesExpiryDate
+	dst.Status.PendingDeletionHooks = restored.Status.PendingDeletionHooks
 	return nil
 }
 
@@ -143,7 +147,11 @@ func (src *MachineSet) ConvertTo(dstRaw conversion.Hub) error {
 		return err
 	}
 	dst.Spec.Template.Spec.NodeDeletionTimeout = restored.Spec.Template.Spec.NodeDeletionTimeout
+	dst.Spec.Template.Spec.NodeDrainRules = restored.Spec.Template.Spec.NodeDrainRules
+	dst.Spec.Template.Spec.NodeMetadata = restored.Spec.Template.Spec.NodeMetadata
 	dst.Spec.Template.Spec.NodeVolumeDetachTimeout = restored.Spec.Template.Spec.NodeVolumeDetachTimeout
+	dst.Spec.FailureDomainSpread = restored.Spec.FailureDomainSpread
+	dst.Spec.PreflightChecks = restored.Spec.PreflightChecks
 	dst.Status.Conditions = restored.Status.Conditions
 	return nil
 }
@@ -198,8 +206,12 @@ func (src *MachineDeployment) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.Template.Spec.NodeDeletionTimeout = restored.Spec.Template.Spec.NodeDeletionTimeout
+	dst.Spec.Template.Spec.NodeDrainRules = restored.Spec.Template.Spec.NodeDrainRules
+	dst.Spec.Template.Spec.NodeMetadata = restored.Spec.Template.Spec.NodeMetadata
 	dst.Spec.Template.Spec.NodeVolumeDetachTimeout = restored.Spec.Template.Spec.NodeVolumeDetachTimeout
 	dst.Spec.RolloutAfter = restored.Spec.RolloutAfter
+	dst.Spec.FailureDomainSpread = restored.Spec.FailureDomainSpread
+	dst.Spec.PreflightChecks = restored.Spec.PreflightChecks
 	dst.Status.Conditions = restored.Status.Conditions
 	return nil
 }
@@ -247,6 +259,10 @@ func (src *MachineHealthCheck) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Spec.UnhealthyRange != nil {
 		dst.Spec.UnhealthyRange = restored.Spec.UnhealthyRange
 	}
+	dst.Spec.RemediationBackoff = restored.Spec.RemediationBackoff
+	dst.Spec.RemediationStrategies = restored.Spec.RemediationStrategies
+	dst.Status.RemediationHistory = restored.Status.RemediationHistory
+	dst.Status.RemediationProgress = restored.Status.RemediationProgress
 
 	return nil
 }
@@ -300,6 +316,10 @@ func Convert_v1beta1_MachineHealthCheckSpec_To_v1alpha3_MachineHealthCheckSpec(i
 	return autoConvert_v1beta1_MachineHealthCheckSpec_To_v1alpha3_MachineHealthCheckSpec(in, out, s)
 }
 
+func Convert_v1beta1_MachineHealthCheckStatus_To_v1alpha3_MachineHealthCheckStatus(in *clusterv1.MachineHealthCheckStatus, out *MachineHealthCheckStatus, s apiconversion.Scope) error {
+	return autoConvert_v1beta1_MachineHealthCheckStatus_To_v1alpha3_MachineHealthCheckStatus(in, out, s)
+}
+
 func Convert_v1alpha3_ClusterStatus_To_v1beta1_ClusterStatus(in *ClusterStatus, out *clusterv1.ClusterStatus, s apiconversion.Scope) error {
 	return autoConvert_v1alpha3_ClusterStatus_To_v1beta1_ClusterStatus(in, out, s)
 }
@@ -321,6 +341,11 @@ func Convert_v1beta1_MachineDeploymentSpec_To_v1alpha3_MachineDeploymentSpec(in
 	return autoConvert_v1beta1_MachineDeploymentSpec_To_v1alpha3_MachineDeploymentSpec(in, out, s)
 }
 
+func Convert_v1beta1_MachineSetSpec_To_v1alpha3_MachineSetSpec(in *clusterv1.MachineSetSpec, out *MachineSetSpec, s apiconversion.Scope) error {
+	// spec.failureDomainSpread and spec.preflightChecks have been added with v1beta1.
+	return autoConvert_v1beta1_MachineSetSpec_To_v1alpha3_MachineSetSpec(in, out, s)
+}
+
 func Convert_v1beta1_MachineDeploymentStatus_To_v1alpha3_MachineDeploymentStatus(in *clusterv1.MachineDeploymentStatus, out *MachineDeploymentStatus, s apiconversion.Scope) error {
 	// Status.Conditions was introduced in v1alpha4, thus requiring a custom conversion function; the values is going to be preserved in an annotation thus allowing roundtrip without loosing informations
 	return autoConvert_v1beta1_MachineDeploymentStatus_To_v1alpha3_MachineDeploymentStatus(in, out, s)
@@ -65,12 +65,16 @@ func (src *Cluster) ConvertTo(dstRaw conversion.Hub) error {
 			}
 			for i := range restored.Spec.Topology.Workers.MachineDeployments {
 				dst.Spec.Topology.Workers.MachineDeployments[i].FailureDomain = restored.Spec.Topology.Workers.MachineDeployments[i].FailureDomain
+				dst.Spec.Topology.Workers.MachineDeployments[i].FailureDomainSpread = restored.Spec.Topology.Workers.MachineDeployments[i].FailureDomainSpread
 				dst.Spec.Topology.Workers.MachineDeployments[i].Variables = restored.Spec.Topology.Workers.MachineDeployments[i].Variables
 				dst.Spec.Topology.Workers.MachineDeployments[i].NodeDrainTimeout = restored.Spec.Topology.Workers.MachineDeployments[i].NodeDrainTimeout
 				dst.Spec.Topology.Workers.MachineDeployments[i].NodeVolumeDetachTimeout = restored.Spec.Topology.Workers.MachineDeployments[i].NodeVolumeDetachTimeout
 				dst.Spec.Topology.Workers.MachineDeployments[i].NodeDeletionTimeout = restored.Spec.Topology.Workers.MachineDeployments[i].NodeDeletionTimeout
+				dst.Spec.Topology.Workers.MachineDeployments[i].NodeDrainRules = restored.Spec.Topology.Workers.MachineDeployments[i].NodeDrainRules
 				dst.Spec.Topology.Workers.MachineDeployments[i].MinReadySeconds = restored.Spec.Topology.Workers.MachineDeployments[i].MinReadySeconds
 				dst.Spec.Topology.Workers.MachineDeployments[i].Strategy = restored.Spec.Topology.Workers.MachineDeployments[i].Strategy
+				dst.Spec.Topology.Workers.MachineDeployments[i].PreflightChecks = restored.Spec.Topology.Workers.MachineDeployments[i].PreflightChecks
+				dst.Spec.Topology.Workers.MachineDeployments[i].RolloutAfter = restored.Spec.Topology.Workers.MachineDeployments[i].RolloutAfter
 				dst.Spec.Topology.Workers.MachineDeployments[i].MachineHealthCheck = restored.Spec.Topology.Workers.MachineDeployments[i].MachineHealthCheck
 			}
 
@@ -78,6 +82,8 @@ func (src *Cluster) ConvertTo(dstRaw conversion.Hub) error {
 		}
 	}
 
+	dst.Spec.DeletionPolicy = restored.Spec.DeletionPolicy
+
 	return nil
 }
 
@@ -129,6 +135,7 @@ func (src *ClusterClass) ConvertTo(dstRaw conversion.Hub) error {
 	dst.Spec.ControlPlane.NodeVolumeDetachTimeout = restored.Spec.ControlPlane.NodeVolumeDetachTimeout
 	dst.Spec.ControlPlane.NodeDeletionTimeout = restored.Spec.ControlPlane.NodeDeletionTimeout
 	dst.Spec.Workers.MachinePools = restored.Spec.Workers.MachinePools
+	dst.Spec.FieldOwnership = restored.Spec.FieldOwnership
 
 	for i := range restored.Spec.Workers.MachineDeployments {
 		dst.Spec.Workers.MachineDeployments[i].MachineHealthCheck = restored.Spec.Workers.MachineDeployments[i].MachineHealthCheck
@@ -137,8 +144,10 @@ func (src *ClusterClass) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Spec.Workers.MachineDeployments[i].NodeDrainTimeout = restored.Spec.Workers.MachineDeployments[i].NodeDrainTimeout
 		dst.Spec.Workers.MachineDeployments[i].NodeVolumeDetachTimeout = restored.Spec.Workers.MachineDeployments[i].NodeVolumeDetachTimeout
 		dst.Spec.Workers.MachineDeployments[i].NodeDeletionTimeout = restored.Spec.Workers.MachineDeployments[i].NodeDeletionTimeout
+		dst.Spec.Workers.MachineDeployments[i].NodeDrainRules = restored.Spec.Workers.MachineDeployments[i].NodeDrainRules
 		dst.Spec.Workers.MachineDeployments[i].MinReadySeconds = restored.Spec.Workers.MachineDeployments[i].MinReadySeconds
 		dst.Spec.Workers.MachineDeployments[i].Strategy = restored.Spec.Workers.MachineDeployments[i].Strategy
+		dst.Spec.Workers.MachineDeployments[i].PreflightChecks = restored.Spec.Workers.MachineDeployments[i].PreflightChecks
 	}
 
 	dst.Status = restored.Status
@@ -187,7 +196,10 @@ func (src *Machine) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.NodeDeletionTimeout = restored.Spec.NodeDeletionTimeout
+	dst.Spec.NodeDrainRules = restored.Spec.NodeDrainRules
+	dst.Spec.NodeMetadata = restored.Spec.NodeMetadata
 	dst.Status.CertificatesExpiryDate = restored.Status.CertificatesExpiryDate
+	dst.Status.PendingDeletionHooks = restored.Status.PendingDeletionHooks
 	dst.Spec.NodeVolumeDetachTimeout = restored.Spec.NodeVolumeDetachTimeout
 	return nil
 }
@@ -233,7 +245,11 @@ func (src *MachineSet) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.Template.Spec.NodeDeletionTimeout = restored.Spec.Template.Spec.NodeDeletionTimeout
+	dst.Spec.Template.Spec.NodeDrainRules = restored.Spec.Template.Spec.NodeDrainRules
+	dst.Spec.Template.Spec.NodeMetadata = restored.Spec.Template.Spec.NodeMetadata
 	dst.Spec.Template.Spec.NodeVolumeDetachTimeout = restored.Spec.Template.Spec.NodeVolumeDetachTimeout
+	dst.Spec.FailureDomainSpread = restored.Spec.FailureDomainSpread
+	dst.Spec.PreflightChecks = restored.Spec.PreflightChecks
 	return nil
 }
 
@@ -274,8 +290,12 @@ func (src *MachineDeployment) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	dst.Spec.Template.Spec.NodeDeletionTimeout = restored.Spec.Template.Spec.NodeDeletionTimeout
+	dst.Spec.Template.Spec.NodeDrainRules = restored.Spec.Template.Spec.NodeDrainRules
+	dst.Spec.Template.Spec.NodeMetadata = restored.Spec.Template.Spec.NodeMetadata
 	dst.Spec.Template.Spec.NodeVolumeDetachTimeout = restored.Spec.Template.Spec.NodeVolumeDetachTimeout
 	dst.Spec.RolloutAfter = restored.Spec.RolloutAfter
+	dst.Spec.FailureDomainSpread = restored.Spec.FailureDomainSpread
+	dst.Spec.PreflightChecks = restored.Spec.PreflightChecks
 	return nil
 }
 
@@ -305,13 +325,32 @@ func (dst *MachineDeploymentList) ConvertFrom(srcRaw conversion.Hub) error {
 func (src *MachineHealthCheck) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*clusterv1.MachineHealthCheck)
 
-	return Convert_v1alpha4_MachineHealthCheck_To_v1beta1_MachineHealthCheck(src, dst, nil)
+	if err := Convert_v1alpha4_MachineHealthCheck_To_v1beta1_MachineHealthCheck(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &clusterv1.MachineHealthCheck{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.RemediationBackoff = restored.Spec.RemediationBackoff
+	dst.Spec.RemediationStrategies = restored.Spec.RemediationStrategies
+	dst.Status.RemediationHistory = restored.Status.RemediationHistory
+	dst.Status.RemediationProgress = restored.Status.RemediationProgress
+	return nil
 }
 
 func (dst *MachineHealthCheck) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*clusterv1.MachineHealthCheck)
 
-	return Convert_v1beta1_MachineHealthCheck_To_v1alpha4_MachineHealthCheck(src, dst, nil)
+	if err := Convert_v1beta1_MachineHealthCheck_To_v1alpha4_MachineHealthCheck(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata
+	return utilconversion.MarshalData(src, dst)
 }
 
 func (src *MachineHealthCheckList) ConvertTo(dstRaw conversion.Hub) error {
@@ -331,8 +370,13 @@ func Convert_v1alpha4_MachineStatus_To_v1beta1_MachineStatus(in *MachineStatus,
 	return autoConvert_v1alpha4_MachineStatus_To_v1beta1_MachineStatus(in, out, s)
 }
 
+func Convert_v1beta1_ClusterSpec_To_v1alpha4_ClusterSpec(in *clusterv1.ClusterSpec, out *ClusterSpec, s apiconversion.Scope) error {
+	// spec.deletionPolicy has been added with v1beta1.
+	return autoConvert_v1beta1_ClusterSpec_To_v1alpha4_ClusterSpec(in, out, s)
+}
+
 func Convert_v1beta1_ClusterClassSpec_To_v1alpha4_ClusterClassSpec(in *clusterv1.ClusterClassSpec, out *ClusterClassSpec, s apiconversion.Scope) error {
-	// spec.{variables,patches} has been added with v1beta1.
+	// spec.{variables,patches,fieldOwnership} has been added with v1beta1.
 	return autoConvert_v1beta1_ClusterClassSpec_To_v1alpha4_ClusterClassSpec(in, out, s)
 }
 
@@ -345,6 +389,11 @@ func Convert_v1beta1_MachineDeploymentSpec_To_v1alpha4_MachineDeploymentSpec(in
 	return autoConvert_v1beta1_MachineDeploymentSpec_To_v1alpha4_MachineDeploymentSpec(in, out, s)
 }
 
+func Convert_v1beta1_MachineSetSpec_To_v1alpha4_MachineSetSpec(in *clusterv1.MachineSetSpec, out *MachineSetSpec, s apiconversion.Scope) error {
+	// spec.failureDomainSpread and spec.preflightChecks have been added with v1beta1.
+	return autoConvert_v1beta1_MachineSetSpec_To_v1alpha4_MachineSetSpec(in, out, s)
+}
+
 func Convert_v1beta1_Topology_To_v1alpha4_Topology(in *clusterv1.Topology, out *Topology, s apiconversion.Scope) error {
 	// spec.topology.variables has been added with v1beta1.
 	return autoConvert_v1beta1_Topology_To_v1alpha4_Topology(in, out, s)
@@ -372,7 +421,7 @@ func Convert_v1beta1_ControlPlaneTopology_To_v1alpha4_ControlPlaneTopology(in *c
 }
 
 func Convert_v1beta1_MachineStatus_To_v1alpha4_MachineStatus(in *clusterv1.MachineStatus, out *MachineStatus, s apiconversion.Scope) error {
-	// MachineStatus.CertificatesExpiryDate has been added in v1beta1.
+	// MachineStatus.CertificatesExpiryDate and MachineStatus.PendingDeletionHooks have been added in v1beta1.
 	return autoConvert_v1beta1_MachineStatus_To_v1alpha4_MachineStatus(in, out, s)
 }
 
@@ -390,3 +439,13 @@ func Convert_v1beta1_WorkersTopology_To_v1alpha4_WorkersTopology(in *clusterv1.W
 	// WorkersTopology.MachinePools has been added in v1beta1.
 	return autoConvert_v1beta1_WorkersTopology_To_v1alpha4_WorkersTopology(in, out, s)
 }
+
+func Convert_v1beta1_MachineHealthCheckSpec_To_v1alpha4_MachineHealthCheckSpec(in *clusterv1.MachineHealthCheckSpec, out *MachineHealthCheckSpec, s apiconversion.Scope) error {
+	// MachineHealthCheckSpec.{RemediationBackoff,RemediationStrategies} have been added in v1beta1.
+	return autoConvert_v1beta1_MachineHealthCheckSpec_To_v1alpha4_MachineHealthCheckSpec(in, out, s)
+}
+
+func Convert_v1beta1_MachineHealthCheckStatus_To_v1alpha4_MachineHealthCheckStatus(in *clusterv1.MachineHealthCheckStatus, out *MachineHealthCheckStatus, s apiconversion.Scope) error {
+	// MachineHealthCheckStatus.{RemediationHistory,RemediationProgress} have been added in v1beta1.
+	return autoConvert_v1beta1_MachineHealthCheckStatus_To_v1alpha4_MachineHealthCheckStatus(in, out, s)
+}
@@ -20,7 +20,9 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -28,6 +30,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -48,6 +51,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 const (
@@ -61,6 +65,7 @@ const (
 // +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io;controlplane.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status;clusters/finalizers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments;machinehealthchecks,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 
 // Reconciler reconciles a Cluster object.
@@ -72,19 +77,45 @@ type Reconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
+
 	recorder        record.EventRecorder
 	externalTracker external.ObjectTracker
+
+	// crdDeprecations caches the deprecations of API versions read from CustomResourceDefinitions.
+	crdDeprecationsLock sync.Mutex
+	crdDeprecations     map[schema.GroupVersionKind]crdVersionDeprecation
 }
 
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
-	c, err := ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&clusterv1.Cluster{}).
 		Watches(
 			&clusterv1.Machine{},
 			handler.EnqueueRequestsFromMapFunc(r.controlPlaneMachineToCluster),
 		).
+		// Watch the components of the Cluster summarized by the Available condition.
+		Watches(
+			&clusterv1.MachineDeployment{},
+			handler.EnqueueRequestsFromMapFunc(clusterComponentToCluster),
+		).
+		Watches(
+			&clusterv1.MachineHealthCheck{},
+			handler.EnqueueRequestsFromMapFunc(clusterComponentToCluster),
+		)
+
+	if feature.Gates.Enabled(feature.MachinePool) {
+		b = b.Watches(
+			&expv1.MachinePool{},
+			handler.EnqueueRequestsFromMapFunc(clusterComponentToCluster),
+		)
+	}
+
+	c, err := b.
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
+		WithEventFilter(predicates.ResourceIsInShard(ctrl.LoggerFrom(ctx), r.Shard)).
 		Build(r)
 
 	if err != nil {
@@ -175,6 +206,11 @@ func patchCluster(ctx context.Context, patchHelper *patch.Helper, cluster *clust
 			clusterv1.ReadyCondition,
 			clusterv1.ControlPlaneReadyCondition,
 			clusterv1.InfrastructureReadyCondition,
+			clusterv1.PreControlPlaneDeleteHookSucceededCondition,
+			clusterv1.PreInfrastructureDeleteHookSucceededCondition,
+			clusterv1.ClusterAvailableCondition,
+			clusterv1.VersionSkewSupportedCondition,
+			clusterv1.RefVersionsNotDeprecatedCondition,
 		}},
 	)
 	return patchHelper.Patch(ctx, cluster, options...)
@@ -197,6 +233,9 @@ func (r *Reconciler) reconcile(ctx context.Context, cluster *clusterv1.Cluster)
 		r.reconcileControlPlane,
 		r.reconcileKubeconfig,
 		r.reconcileControlPlaneInitialized,
+		r.reconcileAvailable,
+		r.reconcileVersionSkew,
+		r.reconcileDeprecations,
 	}
 
 	res := ctrl.Result{}
@@ -233,42 +272,25 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, cluster *clusterv1.Clu
 		return reconcile.Result{}, err
 	}
 
-	children, err := descendants.filterOwnedDescendants(cluster)
-	if err != nil {
-		log.Error(err, "Failed to extract direct descendants")
-		return reconcile.Result{}, err
-	}
-
-	if len(children) > 0 {
-		log.Info("Cluster still has children - deleting them first", "count", len(children))
+	orderedDeletion := cluster.Spec.DeletionPolicy != nil && cluster.Spec.DeletionPolicy.Order == clusterv1.OrderedClusterDeletionOrder
 
-		var errs []error
-
-		for _, child := range children {
-			if !child.GetDeletionTimestamp().IsZero() {
-				// Don't handle deleted child
-				continue
-			}
-			gvk := child.GetObjectKind().GroupVersionKind().String()
-
-			log.Info("Deleting child object", "gvk", gvk, "name", child.GetName())
-			if err := r.Client.Delete(ctx, child); err != nil {
-				err = errors.Wrapf(err, "error deleting cluster %s/%s: failed to delete %s %s", cluster.Namespace, cluster.Name, gvk, child.GetName())
-				log.Error(err, "Error deleting resource", "gvk", gvk, "name", child.GetName())
-				errs = append(errs, err)
-			}
+	// With the Ordered deletion policy, the workers are deleted first, and the control plane Machines are
+	// deleted only once all the workers are gone and the pre-control-plane.delete hooks are removed.
+	if orderedDeletion {
+		workers := descendants
+		workers.controlPlaneMachines = clusterv1.MachineList{}
+		if workers.length() > 0 {
+			return r.deleteDescendants(ctx, cluster, workers)
 		}
 
-		if len(errs) > 0 {
-			return ctrl.Result{}, kerrors.NewAggregate(errs)
+		if reconcileDeletionHooks(cluster, clusterv1.PreControlPlaneDeleteHookAnnotationPrefix, clusterv1.PreControlPlaneDeleteHookSucceededCondition) {
+			log.Info("Waiting for pre-control-plane.delete hooks to be removed before deleting the control plane")
+			return ctrl.Result{}, nil
 		}
 	}
 
-	if descendantCount := descendants.length(); descendantCount > 0 {
-		indirect := descendantCount - len(children)
-		log.Info("Cluster still has descendants - need to requeue", "descendants", descendants.descendantNames(), "indirect descendants count", indirect)
-		// Requeue so we can check the next time to see if there are still any descendants left.
-		return ctrl.Result{RequeueAfter: deleteRequeueAfter}, nil
+	if descendants.length() > 0 {
+		return r.deleteDescendants(ctx, cluster, descendants)
 	}
 
 	if cluster.Spec.ControlPlaneRef != nil {
@@ -302,6 +324,14 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, cluster *clusterv1.Clu
 		}
 	}
 
+	// With the Ordered deletion policy, the infrastructure is deleted only once the pre-infrastructure.delete hooks are removed.
+	if orderedDeletion {
+		if reconcileDeletionHooks(cluster, clusterv1.PreInfrastructureDeleteHookAnnotationPrefix, clusterv1.PreInfrastructureDeleteHookSucceededCondition) {
+			log.Info("Waiting for pre-infrastructure.delete hooks to be removed before deleting the infrastructure")
+			return ctrl.Result{}, nil
+		}
+	}
+
 	if cluster.Spec.InfrastructureRef != nil {
 		obj, err := external.Get(ctx, r.UnstructuredCachingClient, cluster.Spec.InfrastructureRef, cluster.Namespace)
 		switch {
@@ -338,6 +368,68 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, cluster *clusterv1.Clu
 	return ctrl.Result{}, nil
 }
 
+// deleteDescendants issues a deletion request for the given descendants owned by the Cluster,
+// and requeues until all the descendants are gone.
+func (r *Reconciler) deleteDescendants(ctx context.Context, cluster *clusterv1.Cluster, descendants clusterDescendants) (reconcile.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	children, err := descendants.filterOwnedDescendants(cluster)
+	if err != nil {
+		log.Error(err, "Failed to extract direct descendants")
+		return reconcile.Result{}, err
+	}
+
+	if len(children) > 0 {
+		log.Info("Cluster still has children - deleting them first", "count", len(children))
+
+		var errs []error
+
+		for _, child := range children {
+			if !child.GetDeletionTimestamp().IsZero() {
+				// Don't handle deleted child
+				continue
+			}
+			gvk := child.GetObjectKind().GroupVersionKind().String()
+
+			log.Info("Deleting child object", "gvk", gvk, "name", child.GetName())
+			if err := r.Client.Delete(ctx, child); err != nil {
+				err = errors.Wrapf(err, "error deleting cluster %s/%s: failed to delete %s %s", cluster.Namespace, cluster.Name, gvk, child.GetName())
+				log.Error(err, "Error deleting resource", "gvk", gvk, "name", child.GetName())
+				errs = append(errs, err)
+			}
+		}
+
+		if len(errs) > 0 {
+			return ctrl.Result{}, kerrors.NewAggregate(errs)
+		}
+	}
+
+	indirect := descendants.length() - len(children)
+	log.Info("Cluster still has descendants - need to requeue", "descendants", descendants.descendantNames(), "indirect descendants count", indirect)
+	// Requeue so we can check the next time to see if there are still any descendants left.
+	return ctrl.Result{RequeueAfter: deleteRequeueAfter}, nil
+}
+
+// reconcileDeletionHooks reports the hooks with the given annotation prefix blocking a phase of the Cluster
+// deletion in the given condition. It returns true if the phase is blocked.
+func reconcileDeletionHooks(cluster *clusterv1.Cluster, prefix string, conditionType clusterv1.ConditionType) bool {
+	hookMessages := []string{}
+	for key, owner := range cluster.GetAnnotations() {
+		if strings.HasPrefix(key, prefix) {
+			hookMessages = append(hookMessages, fmt.Sprintf("%s (owner: %q)", key, owner))
+		}
+	}
+	sort.Strings(hookMessages)
+
+	if len(hookMessages) == 0 {
+		conditions.MarkTrue(cluster, conditionType)
+		return false
+	}
+
+	conditions.MarkFalse(cluster, conditionType, clusterv1.WaitingExternalHookReason, clusterv1.ConditionSeverityInfo, "Waiting for hooks: %s", strings.Join(hookMessages, ", "))
+	return true
+}
+
 type clusterDescendants struct {
 	machineDeployments   clusterv1.MachineDeploymentList
 	machineSets          clusterv1.MachineSetList
@@ -536,3 +628,16 @@ func (r *Reconciler) controlPlaneMachineToCluster(ctx context.Context, o client.
 		NamespacedName: util.ObjectKey(cluster),
 	}}
 }
+
+// clusterComponentToCluster is a handler.ToRequestsFunc to be used to enqueue requests for reconciliation
+// for Cluster to update its Available condition when one of its components changes.
+func clusterComponentToCluster(_ context.Context, o client.Object) []ctrl.Request {
+	clusterName, ok := o.GetLabels()[clusterv1.ClusterNameLabel]
+	if !ok {
+		return nil
+	}
+
+	return []ctrl.Request{{
+		NamespacedName: client.ObjectKey{Namespace: o.GetNamespace(), Name: clusterName},
+	}}
+}
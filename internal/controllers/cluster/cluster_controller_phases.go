@@ -19,26 +19,36 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/blang/semver/v4"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	capierrors "sigs.k8s.io/cluster-api/errors"
+	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/contract"
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 	"sigs.k8s.io/cluster-api/util/kubeconfig"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/secret"
+	"sigs.k8s.io/cluster-api/util/version"
 )
 
 func (r *Reconciler) reconcilePhase(_ context.Context, cluster *clusterv1.Cluster) {
@@ -307,3 +317,303 @@ func (r *Reconciler) reconcileKubeconfig(ctx context.Context, cluster *clusterv1
 
 	return ctrl.Result{}, nil
 }
+
+// reconcileAvailable sets the Available condition on the Cluster, summarizing the status of the InfrastructureCluster,
+// the ControlPlane, the MachineDeployments, the MachinePools and the MachineHealthChecks of the Cluster.
+func (r *Reconciler) reconcileAvailable(ctx context.Context, cluster *clusterv1.Cluster) (ctrl.Result, error) {
+	var reason string
+	var messages []string
+	degraded := func(degradedReason, message string) {
+		if reason == "" {
+			reason = degradedReason
+		}
+		messages = append(messages, message)
+	}
+
+	if cluster.Spec.InfrastructureRef != nil && !conditions.IsTrue(cluster, clusterv1.InfrastructureReadyCondition) {
+		degraded(clusterv1.InfrastructureNotReadyReason, fmt.Sprintf("%s %s not ready", cluster.Spec.InfrastructureRef.Kind, cluster.Spec.InfrastructureRef.Name))
+	}
+
+	switch {
+	case cluster.Spec.ControlPlaneRef != nil:
+		if !conditions.IsTrue(cluster, clusterv1.ControlPlaneReadyCondition) {
+			degraded(clusterv1.ControlPlaneNotAvailableReason, fmt.Sprintf("%s %s not ready", cluster.Spec.ControlPlaneRef.Kind, cluster.Spec.ControlPlaneRef.Name))
+		}
+	default:
+		if !conditions.IsTrue(cluster, clusterv1.ControlPlaneInitializedCondition) {
+			degraded(clusterv1.ControlPlaneNotAvailableReason, "Control plane not initialized")
+		}
+	}
+
+	listOptions := []client.ListOption{
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name},
+	}
+
+	machineDeployments := &clusterv1.MachineDeploymentList{}
+	if err := r.Client.List(ctx, machineDeployments, listOptions...); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to list MachineDeployments")
+	}
+	var notAvailableMachineDeployments []string
+	for i := range machineDeployments.Items {
+		md := &machineDeployments.Items[i]
+		if md.DeletionTimestamp.IsZero() && !conditions.IsTrue(md, clusterv1.MachineDeploymentAvailableCondition) {
+			notAvailableMachineDeployments = append(notAvailableMachineDeployments, md.Name)
+		}
+	}
+	if len(notAvailableMachineDeployments) > 0 {
+		degraded(clusterv1.MachineDeploymentsNotAvailableReason, fmt.Sprintf("MachineDeployments %s not available", componentNames(notAvailableMachineDeployments)))
+	}
+
+	if feature.Gates.Enabled(feature.MachinePool) {
+		machinePools := &expv1.MachinePoolList{}
+		if err := r.Client.List(ctx, machinePools, listOptions...); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "failed to list MachinePools")
+		}
+		var notReadyMachinePools []string
+		for i := range machinePools.Items {
+			mp := &machinePools.Items[i]
+			if mp.DeletionTimestamp.IsZero() && !conditions.IsTrue(mp, expv1.ReplicasReadyCondition) {
+				notReadyMachinePools = append(notReadyMachinePools, mp.Name)
+			}
+		}
+		if len(notReadyMachinePools) > 0 {
+			degraded(clusterv1.MachinePoolsNotReadyReason, fmt.Sprintf("MachinePools %s not ready", componentNames(notReadyMachinePools)))
+		}
+	}
+
+	machineHealthChecks := &clusterv1.MachineHealthCheckList{}
+	if err := r.Client.List(ctx, machineHealthChecks, listOptions...); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to list MachineHealthChecks")
+	}
+	var unhealthyMachineHealthChecks []string
+	for i := range machineHealthChecks.Items {
+		mhc := &machineHealthChecks.Items[i]
+		if mhc.Status.CurrentHealthy < mhc.Status.ExpectedMachines {
+			unhealthyMachineHealthChecks = append(unhealthyMachineHealthChecks, mhc.Name)
+		}
+	}
+	if len(unhealthyMachineHealthChecks) > 0 {
+		degraded(clusterv1.MachinesUnhealthyReason, fmt.Sprintf("MachineHealthChecks %s reporting unhealthy Machines", componentNames(unhealthyMachineHealthChecks)))
+	}
+
+	if len(messages) > 0 {
+		conditions.MarkFalse(cluster, clusterv1.ClusterAvailableCondition, reason, clusterv1.ConditionSeverityWarning, "%s", strings.Join(messages, "; "))
+		return ctrl.Result{}, nil
+	}
+	conditions.MarkTrue(cluster, clusterv1.ClusterAvailableCondition)
+	return ctrl.Result{}, nil
+}
+
+// componentNames returns a sorted, comma separated list of names, truncated to keep the condition message short.
+func componentNames(names []string) string {
+	const maxNames = 3
+
+	sort.Strings(names)
+	if len(names) > maxNames {
+		return fmt.Sprintf("%s, ... (%d more)", strings.Join(names[:maxNames], ", "), len(names)-maxNames)
+	}
+	return strings.Join(names, ", ")
+}
+
+// reconcileVersionSkew sets the VersionSkewSupported condition on the Cluster, comparing the Kubernetes version of
+// the control plane Machines with the Kubernetes version of the worker Machines and of the MachinePools.
+func (r *Reconciler) reconcileVersionSkew(ctx context.Context, cluster *clusterv1.Cluster) (ctrl.Result, error) {
+	listOptions := []client.ListOption{
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name},
+	}
+
+	machines := &clusterv1.MachineList{}
+	if err := r.Client.List(ctx, machines, listOptions...); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to list Machines")
+	}
+
+	// Note: the control plane version is the version of the oldest control plane Machine, because the
+	// version skew policy applies to every kube-apiserver instance.
+	var controlPlaneVersion *semver.Version
+	workerVersions := map[string]semver.Version{}
+	for i := range machines.Items {
+		m := &machines.Items[i]
+		if !m.DeletionTimestamp.IsZero() || m.Spec.Version == nil {
+			continue
+		}
+		v, err := version.ParseMajorMinorPatchTolerant(*m.Spec.Version)
+		if err != nil {
+			continue
+		}
+		if util.IsControlPlaneMachine(m) {
+			if controlPlaneVersion == nil || v.LT(*controlPlaneVersion) {
+				controlPlaneVersion = &v
+			}
+			continue
+		}
+		workerVersions[fmt.Sprintf("Machine %s", m.Name)] = v
+	}
+
+	if feature.Gates.Enabled(feature.MachinePool) {
+		machinePools := &expv1.MachinePoolList{}
+		if err := r.Client.List(ctx, machinePools, listOptions...); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "failed to list MachinePools")
+		}
+		for i := range machinePools.Items {
+			mp := &machinePools.Items[i]
+			if !mp.DeletionTimestamp.IsZero() || mp.Spec.Template.Spec.Version == nil {
+				continue
+			}
+			v, err := version.ParseMajorMinorPatchTolerant(*mp.Spec.Template.Spec.Version)
+			if err != nil {
+				continue
+			}
+			workerVersions[fmt.Sprintf("MachinePool %s", mp.Name)] = v
+		}
+	}
+
+	// The version skew can't be computed if the control plane does not have Machines, e.g. with managed control planes.
+	if controlPlaneVersion == nil {
+		conditions.Delete(cluster, clusterv1.VersionSkewSupportedCondition)
+		return ctrl.Result{}, nil
+	}
+
+	maxSkew := maxWorkerMinorVersionSkew(*controlPlaneVersion)
+	var unsupported, atLimit []string
+	for worker, v := range workerVersions {
+		skew := int64(controlPlaneVersion.Minor) - int64(v.Minor)
+		switch {
+		case v.Major != controlPlaneVersion.Major || skew < 0 || skew > maxSkew:
+			unsupported = append(unsupported, worker)
+		case skew == maxSkew:
+			atLimit = append(atLimit, worker)
+		}
+	}
+
+	switch {
+	case len(unsupported) > 0:
+		conditions.MarkFalse(cluster, clusterv1.VersionSkewSupportedCondition, clusterv1.UnsupportedVersionSkewReason, clusterv1.ConditionSeverityWarning,
+			"%s not within the version skew supported by control plane version v%s (at most %d minor versions older)",
+			componentNames(unsupported), controlPlaneVersion, maxSkew)
+	case len(atLimit) > 0:
+		conditions.MarkFalse(cluster, clusterv1.VersionSkewSupportedCondition, clusterv1.VersionSkewAtLimitReason, clusterv1.ConditionSeverityInfo,
+			"%s %d minor versions older than control plane version v%s; they must be upgraded before upgrading the control plane",
+			componentNames(atLimit), maxSkew, controlPlaneVersion)
+	default:
+		conditions.MarkTrue(cluster, clusterv1.VersionSkewSupportedCondition)
+	}
+	return ctrl.Result{}, nil
+}
+
+// maxWorkerMinorVersionSkew returns the maximum number of minor versions a kubelet can be older than the kube-apiserver,
+// according to the Kubernetes version skew policy.
+func maxWorkerMinorVersionSkew(controlPlaneVersion semver.Version) int64 {
+	// Starting with Kubernetes v1.28 kubelets can be up to three minor versions older than the kube-apiserver.
+	if controlPlaneVersion.Minor >= 28 {
+		return 3
+	}
+	return 2
+}
+
+// reconcileDeprecations sets the RefVersionsNotDeprecated condition on the Cluster, checking if the API versions of
+// the InfrastructureCluster, of the control plane and of the templates of the MachineDeployments are deprecated.
+func (r *Reconciler) reconcileDeprecations(ctx context.Context, cluster *clusterv1.Cluster) (ctrl.Result, error) {
+	refs := []*corev1.ObjectReference{}
+	if cluster.Spec.InfrastructureRef != nil {
+		refs = append(refs, cluster.Spec.InfrastructureRef)
+	}
+	if cluster.Spec.ControlPlaneRef != nil {
+		refs = append(refs, cluster.Spec.ControlPlaneRef)
+	}
+
+	machineDeployments := &clusterv1.MachineDeploymentList{}
+	if err := r.Client.List(ctx, machineDeployments,
+		client.InNamespace(cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name},
+	); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to list MachineDeployments")
+	}
+	for i := range machineDeployments.Items {
+		md := &machineDeployments.Items[i]
+		refs = append(refs, &md.Spec.Template.Spec.InfrastructureRef)
+		if md.Spec.Template.Spec.Bootstrap.ConfigRef != nil {
+			refs = append(refs, md.Spec.Template.Spec.Bootstrap.ConfigRef)
+		}
+	}
+
+	// Note: every GroupVersionKind is checked only once.
+	var deprecated []string
+	checked := map[schema.GroupVersionKind]bool{}
+	for _, ref := range refs {
+		gvk := ref.GroupVersionKind()
+		if checked[gvk] {
+			continue
+		}
+		checked[gvk] = true
+
+		deprecation, err := r.getCRDVersionDeprecation(ctx, gvk)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !deprecation.deprecated {
+			continue
+		}
+		message := fmt.Sprintf("%s %s is deprecated", gvk.Kind, ref.APIVersion)
+		if deprecation.warning != nil {
+			message = fmt.Sprintf("%s: %s", message, *deprecation.warning)
+		}
+		deprecated = append(deprecated, message)
+	}
+
+	if len(deprecated) > 0 {
+		sort.Strings(deprecated)
+		conditions.MarkFalse(cluster, clusterv1.RefVersionsNotDeprecatedCondition, clusterv1.DeprecatedRefVersionsReason, clusterv1.ConditionSeverityWarning,
+			"%s", strings.Join(deprecated, "; "))
+		return ctrl.Result{}, nil
+	}
+	conditions.MarkTrue(cluster, clusterv1.RefVersionsNotDeprecatedCondition)
+	return ctrl.Result{}, nil
+}
+
+// crdDeprecationsRefreshInterval is the interval after which the deprecation of an API version is read again
+// from the corresponding CustomResourceDefinition.
+const crdDeprecationsRefreshInterval = 10 * time.Minute
+
+// crdVersionDeprecation is the deprecation of an API version read from the corresponding CustomResourceDefinition.
+type crdVersionDeprecation struct {
+	deprecated bool
+	warning    *string
+	readTime   time.Time
+}
+
+// getCRDVersionDeprecation returns the deprecation of the API version of a GroupVersionKind.
+// Note: CustomResourceDefinitions are read using the APIReader, so the controller does not keep all the
+// CustomResourceDefinitions of the management cluster in memory; in order to avoid a call to the API server for
+// every reconcile, the result is cached and refreshed after crdDeprecationsRefreshInterval.
+func (r *Reconciler) getCRDVersionDeprecation(ctx context.Context, gvk schema.GroupVersionKind) (crdVersionDeprecation, error) {
+	r.crdDeprecationsLock.Lock()
+	deprecation, ok := r.crdDeprecations[gvk]
+	r.crdDeprecationsLock.Unlock()
+	if ok && time.Since(deprecation.readTime) < crdDeprecationsRefreshInterval {
+		return deprecation, nil
+	}
+
+	deprecation = crdVersionDeprecation{readTime: time.Now()}
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := r.APIReader.Get(ctx, client.ObjectKey{Name: contract.CalculateCRDName(gvk.Group, gvk.Kind)}, crd); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return crdVersionDeprecation{}, errors.Wrapf(err, "failed to get CustomResourceDefinition for %s", gvk.GroupKind())
+		}
+	}
+	for _, v := range crd.Spec.Versions {
+		if v.Name == gvk.Version && v.Deprecated {
+			deprecation.deprecated = true
+			deprecation.warning = v.DeprecationWarning
+		}
+	}
+
+	r.crdDeprecationsLock.Lock()
+	defer r.crdDeprecationsLock.Unlock()
+	if r.crdDeprecations == nil {
+		r.crdDeprecations = map[schema.GroupVersionKind]crdVersionDeprecation{}
+	}
+	r.crdDeprecations[gvk] = deprecation
+	return deprecation, nil
+}
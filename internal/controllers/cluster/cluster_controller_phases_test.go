@@ -25,13 +25,18 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/tools/record"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	capierrors "sigs.k8s.io/cluster-api/errors"
+	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/test/builder"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestClusterReconcilePhases(t *testing.T) {
@@ -530,3 +535,346 @@ func generateInfraRef(withFailureDomain bool) map[string]interface{} {
 
 	return infraRef
 }
+
+func TestClusterReconciler_reconcileAvailable(t *testing.T) {
+	defer utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)()
+
+	newCluster := func(infrastructureReady, controlPlaneReady bool) *clusterv1.Cluster {
+		cluster := &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"},
+			Spec: clusterv1.ClusterSpec{
+				InfrastructureRef: &corev1.ObjectReference{Kind: "GenericInfrastructureCluster", Name: "test-infra"},
+				ControlPlaneRef:   &corev1.ObjectReference{Kind: "GenericControlPlane", Name: "test-cp"},
+			},
+		}
+		if infrastructureReady {
+			conditions.MarkTrue(cluster, clusterv1.InfrastructureReadyCondition)
+		}
+		if controlPlaneReady {
+			conditions.MarkTrue(cluster, clusterv1.ControlPlaneReadyCondition)
+		}
+		return cluster
+	}
+	newMachineDeployment := func(name string, available bool) *clusterv1.MachineDeployment {
+		md := &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+			},
+		}
+		if available {
+			conditions.MarkTrue(md, clusterv1.MachineDeploymentAvailableCondition)
+		}
+		return md
+	}
+	newMachinePool := func(name string, ready bool) *expv1.MachinePool {
+		mp := &expv1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+			},
+		}
+		if ready {
+			conditions.MarkTrue(mp, expv1.ReplicasReadyCondition)
+		}
+		return mp
+	}
+	newMachineHealthCheck := func(name string, expected, healthy int32) *clusterv1.MachineHealthCheck {
+		return &clusterv1.MachineHealthCheck{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+			},
+			Status: clusterv1.MachineHealthCheckStatus{ExpectedMachines: expected, CurrentHealthy: healthy},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		cluster           *clusterv1.Cluster
+		objs              []client.Object
+		expectedCondition *clusterv1.Condition
+	}{
+		{
+			name:    "available if all the components are available",
+			cluster: newCluster(true, true),
+			objs: []client.Object{
+				newMachineDeployment("md-1", true),
+				newMachinePool("mp-1", true),
+				newMachineHealthCheck("mhc-1", 3, 3),
+			},
+			expectedCondition: conditions.TrueCondition(clusterv1.ClusterAvailableCondition),
+		},
+		{
+			name:    "not available if the infrastructure is not ready",
+			cluster: newCluster(false, true),
+			expectedCondition: conditions.FalseCondition(clusterv1.ClusterAvailableCondition, clusterv1.InfrastructureNotReadyReason, clusterv1.ConditionSeverityWarning,
+				"GenericInfrastructureCluster test-infra not ready"),
+		},
+		{
+			name:    "not available if the control plane is not ready",
+			cluster: newCluster(true, false),
+			expectedCondition: conditions.FalseCondition(clusterv1.ClusterAvailableCondition, clusterv1.ControlPlaneNotAvailableReason, clusterv1.ConditionSeverityWarning,
+				"GenericControlPlane test-cp not ready"),
+		},
+		{
+			name:    "not available if some MachineDeployments are not available",
+			cluster: newCluster(true, true),
+			objs: []client.Object{
+				newMachineDeployment("md-5", false),
+				newMachineDeployment("md-4", false),
+				newMachineDeployment("md-3", false),
+				newMachineDeployment("md-2", false),
+				newMachineDeployment("md-1", true),
+			},
+			expectedCondition: conditions.FalseCondition(clusterv1.ClusterAvailableCondition, clusterv1.MachineDeploymentsNotAvailableReason, clusterv1.ConditionSeverityWarning,
+				"MachineDeployments md-2, md-3, md-4, ... (1 more) not available"),
+		},
+		{
+			name:    "not available with all the degraded components listed in the message",
+			cluster: newCluster(true, false),
+			objs: []client.Object{
+				newMachineDeployment("md-1", false),
+				newMachinePool("mp-1", false),
+				newMachineHealthCheck("mhc-1", 3, 2),
+			},
+			expectedCondition: conditions.FalseCondition(clusterv1.ClusterAvailableCondition, clusterv1.ControlPlaneNotAvailableReason, clusterv1.ConditionSeverityWarning,
+				"GenericControlPlane test-cp not ready; MachineDeployments md-1 not available; MachinePools mp-1 not ready; MachineHealthChecks mhc-1 reporting unhealthy Machines"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().WithObjects(tt.objs...).Build()
+			r := &Reconciler{
+				Client: c,
+			}
+
+			_, err := r.reconcileAvailable(ctx, tt.cluster)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			condition := conditions.Get(tt.cluster, clusterv1.ClusterAvailableCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(*tt.expectedCondition))
+		})
+	}
+}
+
+func TestClusterReconciler_reconcileVersionSkew(t *testing.T) {
+	defer utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachinePool, true)()
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"},
+	}
+	newMachine := func(name, version string, controlPlane bool) *clusterv1.Machine {
+		m := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+			},
+			Spec: clusterv1.MachineSpec{
+				ClusterName: "test-cluster",
+				Version:     ptr.To(version),
+			},
+		}
+		if controlPlane {
+			m.Labels[clusterv1.MachineControlPlaneLabel] = ""
+		}
+		return m
+	}
+	newMachinePool := func(name, version string) *expv1.MachinePool {
+		return &expv1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: "test-cluster"},
+			},
+			Spec: expv1.MachinePoolSpec{
+				Template: clusterv1.MachineTemplateSpec{
+					Spec: clusterv1.MachineSpec{
+						ClusterName: "test-cluster",
+						Version:     ptr.To(version),
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		objs              []client.Object
+		expectedCondition *clusterv1.Condition
+	}{
+		{
+			name: "no condition without control plane Machines",
+			objs: []client.Object{
+				newMachine("worker-1", "v1.29.0", false),
+			},
+		},
+		{
+			name: "supported if the workers are within the supported version skew",
+			objs: []client.Object{
+				newMachine("cp-1", "v1.29.0", true),
+				newMachine("worker-1", "v1.29.0", false),
+				newMachinePool("mp-1", "v1.28.3"),
+			},
+			expectedCondition: conditions.TrueCondition(clusterv1.VersionSkewSupportedCondition),
+		},
+		{
+			name: "at limit if the workers are at the oldest supported version",
+			objs: []client.Object{
+				newMachine("cp-1", "v1.29.0", true),
+				newMachine("worker-1", "v1.26.5", false),
+			},
+			expectedCondition: conditions.FalseCondition(clusterv1.VersionSkewSupportedCondition, clusterv1.VersionSkewAtLimitReason, clusterv1.ConditionSeverityInfo,
+				"Machine worker-1 3 minor versions older than control plane version v1.29.0; they must be upgraded before upgrading the control plane"),
+		},
+		{
+			name: "unsupported if the workers are newer than the oldest control plane Machine",
+			objs: []client.Object{
+				newMachine("cp-1", "v1.26.0", true),
+				newMachine("cp-2", "v1.27.0", true),
+				newMachine("worker-1", "v1.27.0", false),
+				newMachinePool("mp-1", "v1.24.0"),
+			},
+			expectedCondition: conditions.FalseCondition(clusterv1.VersionSkewSupportedCondition, clusterv1.UnsupportedVersionSkewReason, clusterv1.ConditionSeverityWarning,
+				"Machine worker-1 not within the version skew supported by control plane version v1.26.0 (at most 2 minor versions older)"),
+		},
+		{
+			name: "unsupported if the workers are too old",
+			objs: []client.Object{
+				newMachine("cp-1", "v1.29.0", true),
+				newMachine("worker-1", "v1.25.0", false),
+				newMachinePool("mp-1", "v1.24.0"),
+			},
+			expectedCondition: conditions.FalseCondition(clusterv1.VersionSkewSupportedCondition, clusterv1.UnsupportedVersionSkewReason, clusterv1.ConditionSeverityWarning,
+				"Machine worker-1, MachinePool mp-1 not within the version skew supported by control plane version v1.29.0 (at most 3 minor versions older)"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().WithObjects(tt.objs...).Build()
+			r := &Reconciler{
+				Client: c,
+			}
+
+			cluster := cluster.DeepCopy()
+			_, err := r.reconcileVersionSkew(ctx, cluster)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			condition := conditions.Get(cluster, clusterv1.VersionSkewSupportedCondition)
+			if tt.expectedCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(*tt.expectedCondition))
+		})
+	}
+}
+
+func TestClusterReconciler_reconcileDeprecations(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test-namespace"},
+		Spec: clusterv1.ClusterSpec{
+			InfrastructureRef: &corev1.ObjectReference{
+				APIVersion: "infrastructure.cluster.x-k8s.io/v1beta1",
+				Kind:       "GenericInfrastructureCluster",
+				Name:       "test-infra",
+			},
+		},
+	}
+
+	deprecatedCRD := builder.GenericInfrastructureClusterCRD.DeepCopy()
+	for i := range deprecatedCRD.Spec.Versions {
+		if deprecatedCRD.Spec.Versions[i].Name == "v1beta1" {
+			deprecatedCRD.Spec.Versions[i].Deprecated = true
+			deprecatedCRD.Spec.Versions[i].DeprecationWarning = ptr.To("use v1beta2 instead")
+		}
+	}
+
+	tests := []struct {
+		name              string
+		objs              []client.Object
+		expectedCondition *clusterv1.Condition
+	}{
+		{
+			name:              "not deprecated if the CRD version is not deprecated",
+			objs:              []client.Object{builder.GenericInfrastructureClusterCRD.DeepCopy()},
+			expectedCondition: conditions.TrueCondition(clusterv1.RefVersionsNotDeprecatedCondition),
+		},
+		{
+			name:              "not deprecated if the CRD does not exist",
+			expectedCondition: conditions.TrueCondition(clusterv1.RefVersionsNotDeprecatedCondition),
+		},
+		{
+			name: "deprecated if the CRD version is deprecated",
+			objs: []client.Object{deprecatedCRD},
+			expectedCondition: conditions.FalseCondition(clusterv1.RefVersionsNotDeprecatedCondition, clusterv1.DeprecatedRefVersionsReason, clusterv1.ConditionSeverityWarning,
+				"GenericInfrastructureCluster infrastructure.cluster.x-k8s.io/v1beta1 is deprecated: use v1beta2 instead"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().WithObjects(tt.objs...).Build()
+			r := &Reconciler{
+				Client:    c,
+				APIReader: c,
+			}
+
+			cluster := cluster.DeepCopy()
+			_, err := r.reconcileDeprecations(ctx, cluster)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			condition := conditions.Get(cluster, clusterv1.RefVersionsNotDeprecatedCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(*condition).To(conditions.MatchCondition(*tt.expectedCondition))
+		})
+	}
+
+	t.Run("caches deprecations until the refresh interval expires", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := fake.NewClientBuilder().WithObjects(builder.GenericInfrastructureClusterCRD.DeepCopy()).Build()
+		r := &Reconciler{
+			Client:    c,
+			APIReader: c,
+		}
+
+		cluster := cluster.DeepCopy()
+		_, err := r.reconcileDeprecations(ctx, cluster)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(conditions.IsTrue(cluster, clusterv1.RefVersionsNotDeprecatedCondition)).To(BeTrue())
+
+		// Deprecate the API version; the change is not detected until the refresh interval expires.
+		crd := builder.GenericInfrastructureClusterCRD.DeepCopy()
+		g.Expect(c.Get(ctx, client.ObjectKeyFromObject(crd), crd)).To(Succeed())
+		crd.Spec = deprecatedCRD.Spec
+		g.Expect(c.Update(ctx, crd)).To(Succeed())
+
+		_, err = r.reconcileDeprecations(ctx, cluster)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(conditions.IsTrue(cluster, clusterv1.RefVersionsNotDeprecatedCondition)).To(BeTrue())
+
+		for gvk, deprecation := range r.crdDeprecations {
+			deprecation.readTime = deprecation.readTime.Add(-crdDeprecationsRefreshInterval)
+			r.crdDeprecations[gvk] = deprecation
+		}
+
+		_, err = r.reconcileDeprecations(ctx, cluster)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(conditions.IsFalse(cluster, clusterv1.RefVersionsNotDeprecatedCondition)).To(BeTrue())
+	})
+}
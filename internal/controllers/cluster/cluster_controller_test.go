@@ -402,6 +402,145 @@ func TestClusterReconciler_reconcileDelete(t *testing.T) {
 	}
 }
 
+func TestClusterReconciler_reconcileDeleteOrdered(t *testing.T) {
+	fakeInfraCluster := builder.InfrastructureCluster("test-ns", "test-cluster").Build()
+
+	newCluster := func(annotations map[string]string) *clusterv1.Cluster {
+		cluster := builder.Cluster("test-ns", "test-cluster").WithInfrastructureCluster(fakeInfraCluster).Build()
+		cluster.Spec.DeletionPolicy = &clusterv1.ClusterDeletionPolicy{Order: clusterv1.OrderedClusterDeletionOrder}
+		cluster.Annotations = annotations
+		return cluster
+	}
+	newMachine := func(cluster *clusterv1.Cluster, name string, controlPlane bool) *clusterv1.Machine {
+		b := newMachineBuilder().named(name).ownedBy(cluster)
+		if controlPlane {
+			b = b.controlPlane()
+		}
+		m := b.build()
+		m.Namespace = cluster.Namespace
+		if m.Labels == nil {
+			m.Labels = map[string]string{}
+		}
+		m.Labels[clusterv1.ClusterNameLabel] = cluster.Name
+		return &m
+	}
+
+	tests := []struct {
+		name                    string
+		cluster                 *clusterv1.Cluster
+		withWorker              bool
+		withControlPlaneMachine bool
+		wantWorkerDeleted       bool
+		wantControlPlaneDeleted bool
+		wantInfraDeleted        bool
+		wantCondition           *clusterv1.Condition
+	}{
+		{
+			name:                    "should delete workers before control plane machines",
+			cluster:                 newCluster(nil),
+			withWorker:              true,
+			withControlPlaneMachine: true,
+			wantWorkerDeleted:       true,
+			wantControlPlaneDeleted: false,
+			wantInfraDeleted:        false,
+		},
+		{
+			name: "should not delete control plane machines if a pre-control-plane.delete hook is set",
+			cluster: newCluster(map[string]string{
+				clusterv1.PreControlPlaneDeleteHookAnnotationPrefix + "/backup": "backup-controller",
+			}),
+			withControlPlaneMachine: true,
+			wantControlPlaneDeleted: false,
+			wantInfraDeleted:        false,
+			wantCondition: &clusterv1.Condition{
+				Type:     clusterv1.PreControlPlaneDeleteHookSucceededCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityInfo,
+				Reason:   clusterv1.WaitingExternalHookReason,
+				Message:  "Waiting for hooks: pre-control-plane.delete.hook.cluster.cluster.x-k8s.io/backup (owner: \"backup-controller\")",
+			},
+		},
+		{
+			name:                    "should delete control plane machines once all the workers are gone",
+			cluster:                 newCluster(nil),
+			withControlPlaneMachine: true,
+			wantControlPlaneDeleted: true,
+			wantInfraDeleted:        false,
+			wantCondition: &clusterv1.Condition{
+				Type:   clusterv1.PreControlPlaneDeleteHookSucceededCondition,
+				Status: corev1.ConditionTrue,
+			},
+		},
+		{
+			name: "should not delete infrastructure if a pre-infrastructure.delete hook is set",
+			cluster: newCluster(map[string]string{
+				clusterv1.PreInfrastructureDeleteHookAnnotationPrefix + "/load-balancers": "lb-controller",
+			}),
+			wantInfraDeleted: false,
+			wantCondition: &clusterv1.Condition{
+				Type:     clusterv1.PreInfrastructureDeleteHookSucceededCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityInfo,
+				Reason:   clusterv1.WaitingExternalHookReason,
+				Message:  "Waiting for hooks: pre-infrastructure.delete.hook.cluster.cluster.x-k8s.io/load-balancers (owner: \"lb-controller\")",
+			},
+		},
+		{
+			name:             "should delete infrastructure if no pre-infrastructure.delete hook is set",
+			cluster:          newCluster(nil),
+			wantInfraDeleted: true,
+			wantCondition: &clusterv1.Condition{
+				Type:   clusterv1.PreInfrastructureDeleteHookSucceededCondition,
+				Status: corev1.ConditionTrue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			objs := []client.Object{fakeInfraCluster.DeepCopy(), tt.cluster}
+			worker := newMachine(tt.cluster, "worker", false)
+			if tt.withWorker {
+				objs = append(objs, worker)
+			}
+			controlPlaneMachine := newMachine(tt.cluster, "control-plane", true)
+			if tt.withControlPlaneMachine {
+				objs = append(objs, controlPlaneMachine)
+			}
+
+			fakeClient := fake.NewClientBuilder().WithObjects(objs...).Build()
+			r := &Reconciler{
+				Client:                    fakeClient,
+				UnstructuredCachingClient: fakeClient,
+				APIReader:                 fakeClient,
+			}
+
+			_, err := r.reconcileDelete(ctx, tt.cluster)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			if tt.withWorker {
+				err := fakeClient.Get(ctx, client.ObjectKeyFromObject(worker), &clusterv1.Machine{})
+				g.Expect(apierrors.IsNotFound(err)).To(Equal(tt.wantWorkerDeleted))
+			}
+			if tt.withControlPlaneMachine {
+				err := fakeClient.Get(ctx, client.ObjectKeyFromObject(controlPlaneMachine), &clusterv1.Machine{})
+				g.Expect(apierrors.IsNotFound(err)).To(Equal(tt.wantControlPlaneDeleted))
+			}
+			infraCluster := builder.InfrastructureCluster("", "").Build()
+			err = fakeClient.Get(ctx, client.ObjectKeyFromObject(fakeInfraCluster), infraCluster)
+			g.Expect(apierrors.IsNotFound(err)).To(Equal(tt.wantInfraDeleted))
+
+			if tt.wantCondition != nil {
+				condition := conditions.Get(tt.cluster, tt.wantCondition.Type)
+				g.Expect(condition).ToNot(BeNil())
+				g.Expect(*condition).To(conditions.MatchCondition(*tt.wantCondition))
+			}
+		})
+	}
+}
+
 func TestClusterReconcilerNodeRef(t *testing.T) {
 	t.Run("machine to cluster", func(t *testing.T) {
 		cluster := &clusterv1.Cluster{
@@ -0,0 +1,116 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// reconcileDeletionHooks reconciles the deletion hooks of the Machine with the given annotation prefix.
+// The hooks blocking the deletion of the Machine are recorded in Status.PendingDeletionHooks and reported
+// in the given condition; if a hook blocks the deletion for longer than its timeout, the condition is
+// marked with the ExternalHookTimedOutReason.
+// It returns true if the deletion of the Machine is blocked, and the time after which the Machine should be
+// reconciled again to check the hook timeouts, if any.
+func reconcileDeletionHooks(m *clusterv1.Machine, prefix, timeoutPrefix string, conditionType clusterv1.ConditionType) (bool, time.Duration) {
+	now := metav1.Now()
+
+	// Keep the hooks with other prefixes, and the time since the hooks with this prefix are waiting.
+	pendingHooks := []clusterv1.MachineDeletionHook{}
+	waitingSince := map[string]metav1.Time{}
+	for _, hook := range m.Status.PendingDeletionHooks {
+		if strings.HasPrefix(hook.Name, prefix) {
+			waitingSince[hook.Name] = hook.WaitingSince
+			continue
+		}
+		pendingHooks = append(pendingHooks, hook)
+	}
+
+	hookNames := []string{}
+	for key := range m.GetAnnotations() {
+		if strings.HasPrefix(key, prefix) {
+			hookNames = append(hookNames, key)
+		}
+	}
+	sort.Strings(hookNames)
+
+	var (
+		requeueAfter     time.Duration
+		timedOutMessages []string
+		waitingMessages  []string
+	)
+	for _, name := range hookNames {
+		hook := clusterv1.MachineDeletionHook{
+			Name:         name,
+			Owner:        m.Annotations[name],
+			WaitingSince: now,
+		}
+		if t, ok := waitingSince[name]; ok {
+			hook.WaitingSince = t
+		}
+		pendingHooks = append(pendingHooks, hook)
+
+		// Note: The message must not change over time to avoid patching the Machine on every reconcile.
+		message := fmt.Sprintf("%s (owner: %q, waiting since %s)", name, hook.Owner, hook.WaitingSince.UTC().Format(time.RFC3339))
+
+		timeoutAnnotation := timeoutPrefix + strings.TrimPrefix(name, prefix)
+		if value, ok := m.Annotations[timeoutAnnotation]; ok {
+			// Timeouts which are not valid durations are ignored.
+			if timeout, err := time.ParseDuration(value); err == nil {
+				elapsed := now.Sub(hook.WaitingSince.Time)
+				if elapsed >= timeout {
+					timedOutMessages = append(timedOutMessages, message)
+					continue
+				}
+				if requeueAfter == 0 || timeout-elapsed < requeueAfter {
+					requeueAfter = timeout - elapsed
+				}
+			}
+		}
+		waitingMessages = append(waitingMessages, message)
+	}
+
+	m.Status.PendingDeletionHooks = nil
+	if len(pendingHooks) > 0 {
+		m.Status.PendingDeletionHooks = pendingHooks
+	}
+
+	if len(hookNames) == 0 {
+		conditions.MarkTrue(m, conditionType)
+		return false, 0
+	}
+
+	if len(timedOutMessages) > 0 {
+		message := fmt.Sprintf("Timed out waiting for hooks: %s", strings.Join(timedOutMessages, ", "))
+		if len(waitingMessages) > 0 {
+			message += fmt.Sprintf("; waiting for hooks: %s", strings.Join(waitingMessages, ", "))
+		}
+		conditions.MarkFalse(m, conditionType, clusterv1.ExternalHookTimedOutReason, clusterv1.ConditionSeverityError, "%s", message)
+		return true, requeueAfter
+	}
+
+	conditions.MarkFalse(m, conditionType, clusterv1.WaitingExternalHookReason, clusterv1.ConditionSeverityInfo, "Waiting for hooks: %s", strings.Join(waitingMessages, ", "))
+	return true, requeueAfter
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileDeletionHooks(t *testing.T) {
+	const (
+		hookA        = clusterv1.PreDrainDeleteHookAnnotationPrefix + "/hook-a"
+		hookB        = clusterv1.PreDrainDeleteHookAnnotationPrefix + "/hook-b"
+		timeoutHookA = clusterv1.PreDrainDeleteHookTimeoutAnnotationPrefix + "/hook-a"
+		terminate    = clusterv1.PreTerminateDeleteHookAnnotationPrefix + "/hook-c"
+	)
+	oneHourAgo := metav1.NewTime(time.Now().Add(-1 * time.Hour).Truncate(time.Second))
+
+	tests := []struct {
+		name              string
+		annotations       map[string]string
+		pendingHooks      []clusterv1.MachineDeletionHook
+		wantBlocked       bool
+		wantRequeue       bool
+		wantPendingHooks  []string
+		wantWaitingSince  map[string]metav1.Time
+		wantReason        string
+		wantSeverity      clusterv1.ConditionSeverity
+		wantConditionTrue bool
+	}{
+		{
+			name:              "no hooks",
+			annotations:       map[string]string{},
+			wantBlocked:       false,
+			wantConditionTrue: true,
+		},
+		{
+			name: "no hooks left, hooks with other prefixes are preserved",
+			annotations: map[string]string{
+				terminate: "owner-c",
+			},
+			pendingHooks: []clusterv1.MachineDeletionHook{
+				{Name: hookA, Owner: "owner-a", WaitingSince: oneHourAgo},
+				{Name: terminate, Owner: "owner-c", WaitingSince: oneHourAgo},
+			},
+			wantBlocked:       false,
+			wantPendingHooks:  []string{terminate},
+			wantConditionTrue: true,
+		},
+		{
+			name: "hooks are recorded and the time they are waiting since is preserved",
+			annotations: map[string]string{
+				hookB: "owner-b",
+				hookA: "owner-a",
+			},
+			pendingHooks: []clusterv1.MachineDeletionHook{
+				{Name: hookA, Owner: "owner-a", WaitingSince: oneHourAgo},
+			},
+			wantBlocked:      true,
+			wantPendingHooks: []string{hookA, hookB},
+			wantWaitingSince: map[string]metav1.Time{hookA: oneHourAgo},
+			wantReason:       clusterv1.WaitingExternalHookReason,
+			wantSeverity:     clusterv1.ConditionSeverityInfo,
+		},
+		{
+			name: "hook not timed out yet requires requeue",
+			annotations: map[string]string{
+				hookA:        "owner-a",
+				timeoutHookA: "2h",
+			},
+			pendingHooks: []clusterv1.MachineDeletionHook{
+				{Name: hookA, Owner: "owner-a", WaitingSince: oneHourAgo},
+			},
+			wantBlocked:      true,
+			wantRequeue:      true,
+			wantPendingHooks: []string{hookA},
+			wantReason:       clusterv1.WaitingExternalHookReason,
+			wantSeverity:     clusterv1.ConditionSeverityInfo,
+		},
+		{
+			name: "hook timed out",
+			annotations: map[string]string{
+				hookA:        "owner-a",
+				hookB:        "owner-b",
+				timeoutHookA: "30m",
+			},
+			pendingHooks: []clusterv1.MachineDeletionHook{
+				{Name: hookA, Owner: "owner-a", WaitingSince: oneHourAgo},
+			},
+			wantBlocked:      true,
+			wantPendingHooks: []string{hookA, hookB},
+			wantReason:       clusterv1.ExternalHookTimedOutReason,
+			wantSeverity:     clusterv1.ConditionSeverityError,
+		},
+		{
+			name: "invalid timeouts are ignored",
+			annotations: map[string]string{
+				hookA:        "owner-a",
+				timeoutHookA: "not-a-duration",
+			},
+			pendingHooks: []clusterv1.MachineDeletionHook{
+				{Name: hookA, Owner: "owner-a", WaitingSince: oneHourAgo},
+			},
+			wantBlocked:      true,
+			wantPendingHooks: []string{hookA},
+			wantReason:       clusterv1.WaitingExternalHookReason,
+			wantSeverity:     clusterv1.ConditionSeverityInfo,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := &clusterv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "machine",
+					Namespace:   metav1.NamespaceDefault,
+					Annotations: tt.annotations,
+				},
+				Status: clusterv1.MachineStatus{
+					PendingDeletionHooks: tt.pendingHooks,
+				},
+			}
+
+			blocked, requeueAfter := reconcileDeletionHooks(m, clusterv1.PreDrainDeleteHookAnnotationPrefix, clusterv1.PreDrainDeleteHookTimeoutAnnotationPrefix, clusterv1.PreDrainDeleteHookSucceededCondition)
+			g.Expect(blocked).To(Equal(tt.wantBlocked))
+			if tt.wantRequeue {
+				g.Expect(requeueAfter).To(BeNumerically(">", 0))
+				g.Expect(requeueAfter).To(BeNumerically("<=", time.Hour))
+			} else {
+				g.Expect(requeueAfter).To(BeZero())
+			}
+
+			pendingHookNames := []string{}
+			for _, hook := range m.Status.PendingDeletionHooks {
+				pendingHookNames = append(pendingHookNames, hook.Name)
+				g.Expect(hook.Owner).To(Equal(tt.annotations[hook.Name]))
+				if waitingSince, ok := tt.wantWaitingSince[hook.Name]; ok {
+					g.Expect(hook.WaitingSince.Equal(&waitingSince)).To(BeTrue())
+				}
+			}
+			g.Expect(pendingHookNames).To(ConsistOf(tt.wantPendingHooks))
+
+			condition := conditions.Get(m, clusterv1.PreDrainDeleteHookSucceededCondition)
+			g.Expect(condition).ToNot(BeNil())
+			if tt.wantConditionTrue {
+				g.Expect(condition.Status).To(BeEquivalentTo("True"))
+				return
+			}
+			g.Expect(condition.Status).To(BeEquivalentTo("False"))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+			g.Expect(condition.Severity).To(Equal(tt.wantSeverity))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	kubedrain "k8s.io/kubectl/pkg/drain"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// runNodeDrain drains the Node like kubedrain.RunNodeDrain, but evicts the Pods in the order defined
+// in the NodeDrainRules; the Pods of a group are only evicted once all the Pods of the previous groups are gone.
+func runNodeDrain(drainer *kubedrain.Helper, nodeName string, rules *clusterv1.NodeDrainRules) error {
+	list, errs := drainer.GetPodsForDeletion(nodeName)
+	if errs != nil {
+		return kerrors.NewAggregate(errs)
+	}
+	if warnings := list.Warnings(); warnings != "" {
+		fmt.Fprintf(drainer.ErrOut, "WARNING: %s\n", warnings)
+	}
+
+	for _, pods := range podsByDrainOrder(list.Pods(), rules) {
+		if err := drainer.DeleteOrEvictPods(pods); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nodeDrainExclusionFilter returns a filter skipping the Pods matching any of the given exclusions.
+func nodeDrainExclusionFilter(exclusions []clusterv1.NodeDrainExclusion) (kubedrain.PodFilter, error) {
+	selectors := make([]labels.Selector, len(exclusions))
+	for i, exclusion := range exclusions {
+		selectors[i] = labels.Everything()
+		if exclusion.PodSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(exclusion.PodSelector)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse podSelector of node drain exclusion %d", i)
+			}
+			selectors[i] = selector
+		}
+	}
+
+	return func(pod corev1.Pod) kubedrain.PodDeleteStatus {
+		for i, exclusion := range exclusions {
+			if exclusion.Namespace != "" && exclusion.Namespace != pod.Namespace {
+				continue
+			}
+			if !selectors[i].Matches(labels.Set(pod.Labels)) {
+				continue
+			}
+			return kubedrain.MakePodDeleteStatusSkip()
+		}
+		return kubedrain.MakePodDeleteStatusOkay()
+	}, nil
+}
+
+// podsByDrainOrder groups the Pods by the order defined in the NodeDrainRules, and returns
+// the groups in the order they should be evicted.
+func podsByDrainOrder(pods []corev1.Pod, rules *clusterv1.NodeDrainRules) [][]corev1.Pod {
+	if rules == nil || rules.Order == "" {
+		return [][]corev1.Pod{pods}
+	}
+
+	groups := map[int64][]corev1.Pod{}
+	for _, pod := range pods {
+		order := podDrainOrder(pod, rules)
+		groups[order] = append(groups[order], pod)
+	}
+
+	orders := make([]int64, 0, len(groups))
+	for order := range groups {
+		orders = append(orders, order)
+	}
+	sort.Slice(orders, func(i, j int) bool { return orders[i] < orders[j] })
+
+	podGroups := make([][]corev1.Pod, 0, len(orders))
+	for _, order := range orders {
+		podGroups = append(podGroups, groups[order])
+	}
+	return podGroups
+}
+
+// podDrainOrder returns the order of the Pod according to the NodeDrainRules.
+func podDrainOrder(pod corev1.Pod, rules *clusterv1.NodeDrainRules) int64 {
+	switch rules.Order {
+	case clusterv1.PodPriorityNodeDrainOrder:
+		return int64(ptr.Deref(pod.Spec.Priority, 0))
+	case clusterv1.LabelNodeDrainOrder:
+		order, err := strconv.ParseInt(pod.Labels[rules.OrderLabel], 10, 64)
+		if err != nil {
+			return 0
+		}
+		return order
+	default:
+		return 0
+	}
+}
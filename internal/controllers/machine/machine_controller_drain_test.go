@@ -0,0 +1,125 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubedrain "k8s.io/kubectl/pkg/drain"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestPodsByDrainOrder(t *testing.T) {
+	newPod := func(name string, priority *int32, labels map[string]string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Spec:       corev1.PodSpec{Priority: priority},
+		}
+	}
+	pods := []corev1.Pod{
+		newPod("high", ptr.To[int32](1000), map[string]string{"drain-order": "10"}),
+		newPod("low", ptr.To[int32](-10), map[string]string{"drain-order": "-1"}),
+		newPod("default", nil, nil),
+		newPod("invalid-label", ptr.To[int32](1000), map[string]string{"drain-order": "first"}),
+	}
+
+	tests := []struct {
+		name  string
+		rules *clusterv1.NodeDrainRules
+		want  [][]string
+	}{
+		{
+			name:  "all pods in a single group without rules",
+			rules: nil,
+			want:  [][]string{{"high", "low", "default", "invalid-label"}},
+		},
+		{
+			name:  "all pods in a single group without order",
+			rules: &clusterv1.NodeDrainRules{},
+			want:  [][]string{{"high", "low", "default", "invalid-label"}},
+		},
+		{
+			name:  "pods ordered by priority",
+			rules: &clusterv1.NodeDrainRules{Order: clusterv1.PodPriorityNodeDrainOrder},
+			want:  [][]string{{"low"}, {"default"}, {"high", "invalid-label"}},
+		},
+		{
+			name:  "pods ordered by label",
+			rules: &clusterv1.NodeDrainRules{Order: clusterv1.LabelNodeDrainOrder, OrderLabel: "drain-order"},
+			want:  [][]string{{"low"}, {"default", "invalid-label"}, {"high"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got := [][]string{}
+			for _, group := range podsByDrainOrder(pods, tt.rules) {
+				names := []string{}
+				for _, pod := range group {
+					names = append(names, pod.Name)
+				}
+				got = append(got, names)
+			}
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestNodeDrainExclusionFilter(t *testing.T) {
+	g := NewWithT(t)
+
+	filter, err := nodeDrainExclusionFilter([]clusterv1.NodeDrainExclusion{
+		{Namespace: "kube-system"},
+		{Namespace: "monitoring", PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "node-exporter"}}},
+		{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"drain": "skip"}}},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	tests := []struct {
+		namespace string
+		labels    map[string]string
+		excluded  bool
+	}{
+		{namespace: "kube-system", excluded: true},
+		{namespace: "monitoring", labels: map[string]string{"app": "node-exporter"}, excluded: true},
+		{namespace: "monitoring", labels: map[string]string{"app": "prometheus"}, excluded: false},
+		{namespace: "default", labels: map[string]string{"app": "node-exporter"}, excluded: false},
+		{namespace: "default", labels: map[string]string{"drain": "skip"}, excluded: true},
+		{namespace: "default", excluded: false},
+	}
+	for _, tt := range tests {
+		pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: tt.namespace, Labels: tt.labels}}
+		status := filter(pod)
+		if tt.excluded {
+			g.Expect(status).To(Equal(kubedrain.MakePodDeleteStatusSkip()), "pod in namespace %q with labels %v should be excluded", tt.namespace, tt.labels)
+		} else {
+			g.Expect(status).To(Equal(kubedrain.MakePodDeleteStatusOkay()), "pod in namespace %q with labels %v should not be excluded", tt.namespace, tt.labels)
+		}
+	}
+
+	_, err = nodeDrainExclusionFilter([]clusterv1.NodeDrainExclusion{
+		{PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Invalid"}}}},
+	})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -19,6 +19,7 @@ package machine
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -132,12 +133,28 @@ func (r *Reconciler) reconcileNode(ctx context.Context, s *scope) (ctrl.Result,
 		}
 	}
 
+	// Compute the labels, annotations and taints to be continuously synced from the Machine's nodeMetadata;
+	// keys managed by the Node itself or by CAPI are reported as conflicts and never set on the Node.
+	nodeMetadata, conflicts := getNodeMetadata(machine)
+	for k, v := range nodeMetadata.Labels {
+		nodeLabels[k] = v
+	}
+
 	_, nodeHadInterruptibleLabel := node.Labels[clusterv1.InterruptibleLabel]
 
 	// Reconcile node taints
-	if err := r.patchNode(ctx, remoteClient, node, nodeLabels, nodeAnnotations, machine); err != nil {
+	if err := r.patchNode(ctx, remoteClient, node, nodeLabels, nodeAnnotations, nodeMetadata, machine); err != nil {
 		return ctrl.Result{}, errors.Wrapf(err, "failed to reconcile Node %s", klog.KObj(node))
 	}
+	switch {
+	case machine.Spec.NodeMetadata == nil:
+		conditions.Delete(machine, clusterv1.NodeMetadataSyncedCondition)
+	case len(conflicts) > 0:
+		conditions.MarkFalse(machine, clusterv1.NodeMetadataSyncedCondition, clusterv1.NodeMetadataConflictReason, clusterv1.ConditionSeverityWarning,
+			"Keys managed by the Node or by Cluster API cannot be set from the Machine: %s", strings.Join(conflicts, ", "))
+	default:
+		conditions.MarkTrue(machine, clusterv1.NodeMetadataSyncedCondition)
+	}
 	if !nodeHadInterruptibleLabel && interruptible {
 		// If the interruptible label is added to the node then record the event.
 		// Nb. Only record the event if the node previously did not have the label to avoid recording
@@ -180,6 +197,60 @@ func getManagedLabels(labels map[string]string) map[string]string {
 	return managedLabels
 }
 
+// getNodeMetadata returns the labels, annotations and taints from the Machine's nodeMetadata which should be
+// synced to the Node, and the list of the ones which are conflicting with keys managed by the Node or by CAPI.
+func getNodeMetadata(machine *clusterv1.Machine) (*clusterv1.NodeMetadata, []string) {
+	nodeMetadata := &clusterv1.NodeMetadata{
+		Labels:      map[string]string{},
+		Annotations: map[string]string{},
+	}
+	conflicts := []string{}
+	if machine.Spec.NodeMetadata == nil {
+		return nodeMetadata, conflicts
+	}
+
+	for k, v := range machine.Spec.NodeMetadata.Labels {
+		// Labels in the domains managed by CAPI can be always set.
+		if _, ok := getManagedLabels(map[string]string{k: v})[k]; !ok && (isNodeManagedKey(k) || k == clusterv1.InterruptibleLabel) {
+			conflicts = append(conflicts, fmt.Sprintf("label %s", k))
+			continue
+		}
+		nodeMetadata.Labels[k] = v
+	}
+	for k, v := range machine.Spec.NodeMetadata.Annotations {
+		if isNodeManagedKey(k) {
+			conflicts = append(conflicts, fmt.Sprintf("annotation %s", k))
+			continue
+		}
+		nodeMetadata.Annotations[k] = v
+	}
+	for _, taint := range machine.Spec.NodeMetadata.Taints {
+		if isNodeManagedKey(taint.Key) {
+			conflicts = append(conflicts, fmt.Sprintf("taint %s:%s", taint.Key, taint.Effect))
+			continue
+		}
+		nodeMetadata.Taints = append(nodeMetadata.Taints, taint)
+	}
+	sort.Strings(conflicts)
+
+	return nodeMetadata, conflicts
+}
+
+// isNodeManagedKey returns true if the label, annotation or taint key belongs to a domain managed by the Node itself
+// (i.e. by the kubelet, the cloud provider or other Kubernetes components) or by CAPI.
+func isNodeManagedKey(key string) bool {
+	if !strings.Contains(key, "/") {
+		return false
+	}
+	dnsSubdomain := strings.Split(key, "/")[0]
+	for _, domain := range []string{"kubernetes.io", "k8s.io", clusterv1.GroupVersion.Group} {
+		if dnsSubdomain == domain || strings.HasSuffix(dnsSubdomain, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
 // summarizeNodeConditions summarizes a Node's conditions and returns the summary of condition statuses and concatenate failed condition messages:
 // if there is at least 1 semantically-negative condition, summarized status = False;
 // if there is at least 1 semantically-positive condition when there is 0 semantically negative condition, summarized status = True;
@@ -257,7 +328,7 @@ func (r *Reconciler) getNode(ctx context.Context, c client.Reader, providerID st
 
 // PatchNode is required to workaround an issue on Node.Status.Address which is incorrectly annotated as patchStrategy=merge
 // and this causes SSA patch to fail in case there are two addresses with the same key https://github.com/kubernetes-sigs/cluster-api/issues/8417
-func (r *Reconciler) patchNode(ctx context.Context, remoteClient client.Client, node *corev1.Node, newLabels, newAnnotations map[string]string, m *clusterv1.Machine) error {
+func (r *Reconciler) patchNode(ctx context.Context, remoteClient client.Client, node *corev1.Node, newLabels, newAnnotations map[string]string, nodeMetadata *clusterv1.NodeMetadata, m *clusterv1.Machine) error {
 	newNode := node.DeepCopy()
 
 	// Adds the annotations CAPI sets on the node.
@@ -291,8 +362,14 @@ func (r *Reconciler) patchNode(ctx context.Context, remoteClient client.Client,
 	}
 	annotations.AddAnnotations(newNode, map[string]string{clusterv1.LabelsFromMachineAnnotation: strings.Join(labelsFromCurrentReconcile, ",")})
 
+	// Adds the annotations and taints from the Machine's nodeMetadata.
+	// NOTE: Same as for labels, the annotations and taints set from the Machine are tracked in annotations
+	// in order to remove them from the Node when they are removed from the Machine.
+	hasAnnotationChanges = syncNodeMetadataAnnotations(newNode, nodeMetadata.Annotations) || hasAnnotationChanges
+	hasMetadataTaintChanges := syncNodeMetadataTaints(newNode, nodeMetadata.Taints)
+
 	// Drop the NodeUninitializedTaint taint on the node given that we are reconciling labels.
-	hasTaintChanges := taints.RemoveNodeTaint(newNode, clusterv1.NodeUninitializedTaint)
+	hasTaintChanges := taints.RemoveNodeTaint(newNode, clusterv1.NodeUninitializedTaint) || hasMetadataTaintChanges
 
 	// Set Taint to a node in an old MachineSet and unset Taint from a node in a new MachineSet
 	isOutdated, err := shouldNodeHaveOutdatedTaint(ctx, r.Client, m)
@@ -312,6 +389,84 @@ func (r *Reconciler) patchNode(ctx context.Context, remoteClient client.Client,
 	return remoteClient.Patch(ctx, newNode, client.StrategicMergeFrom(node))
 }
 
+// syncNodeMetadataAnnotations sets the annotations from the Machine's nodeMetadata on the Node, and removes
+// the ones set at the previous reconcile which are not present anymore.
+// It returns true if the annotations are modified, false otherwise.
+func syncNodeMetadataAnnotations(node *corev1.Node, newAnnotations map[string]string) bool {
+	if node.Annotations == nil && len(newAnnotations) > 0 {
+		node.Annotations = map[string]string{}
+	}
+	hasChanges := false
+	for _, k := range splitTrackingAnnotation(node.Annotations[clusterv1.AnnotationsFromMachineAnnotation]) {
+		if _, ok := newAnnotations[k]; !ok {
+			delete(node.Annotations, k)
+			hasChanges = true
+		}
+	}
+	annotationsFromCurrentReconcile := []string{}
+	for k, v := range newAnnotations {
+		if cur, ok := node.Annotations[k]; !ok || cur != v {
+			node.Annotations[k] = v
+			hasChanges = true
+		}
+		annotationsFromCurrentReconcile = append(annotationsFromCurrentReconcile, k)
+	}
+	return setTrackingAnnotation(node, clusterv1.AnnotationsFromMachineAnnotation, annotationsFromCurrentReconcile) || hasChanges
+}
+
+// syncNodeMetadataTaints sets the taints from the Machine's nodeMetadata on the Node, and removes
+// the ones set at the previous reconcile which are not present anymore.
+// It returns true if the taints are modified, false otherwise.
+func syncNodeMetadataTaints(node *corev1.Node, newTaints []corev1.Taint) bool {
+	hasChanges := false
+	newTaintKeys := map[string]bool{}
+	taintsFromCurrentReconcile := []string{}
+	for _, taint := range newTaints {
+		k := fmt.Sprintf("%s:%s", taint.Key, taint.Effect)
+		newTaintKeys[k] = true
+		hasChanges = taints.SetNodeTaint(node, taint) || hasChanges
+		taintsFromCurrentReconcile = append(taintsFromCurrentReconcile, k)
+	}
+	for _, k := range splitTrackingAnnotation(node.Annotations[clusterv1.TaintsFromMachineAnnotation]) {
+		if newTaintKeys[k] {
+			continue
+		}
+		key, effect, _ := strings.Cut(k, ":")
+		hasChanges = taints.RemoveNodeTaint(node, corev1.Taint{Key: key, Effect: corev1.TaintEffect(effect)}) || hasChanges
+	}
+	return setTrackingAnnotation(node, clusterv1.TaintsFromMachineAnnotation, taintsFromCurrentReconcile) || hasChanges
+}
+
+// splitTrackingAnnotation returns the keys tracked in an annotation.
+func splitTrackingAnnotation(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, ",")
+}
+
+// setTrackingAnnotation sets the annotation tracking the given keys on the Node, or removes it if there are no keys.
+// It returns true if the annotations are modified, false otherwise.
+func setTrackingAnnotation(node *corev1.Node, annotation string, keys []string) bool {
+	if len(keys) == 0 {
+		if _, ok := node.Annotations[annotation]; !ok {
+			return false
+		}
+		delete(node.Annotations, annotation)
+		return true
+	}
+	sort.Strings(keys)
+	value := strings.Join(keys, ",")
+	if cur, ok := node.Annotations[annotation]; ok && cur == value {
+		return false
+	}
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[annotation] = value
+	return true
+}
+
 func shouldNodeHaveOutdatedTaint(ctx context.Context, c client.Client, m *clusterv1.Machine) (bool, error) {
 	if _, hasLabel := m.Labels[clusterv1.MachineDeploymentNameLabel]; !hasLabel {
 		return false, nil
@@ -514,6 +514,57 @@ func TestGetManagedLabels(t *testing.T) {
 	g.Expect(got).To(BeEquivalentTo(managedLabels))
 }
 
+func TestGetNodeMetadata(t *testing.T) {
+	g := NewWithT(t)
+
+	machine := &clusterv1.Machine{
+		Spec: clusterv1.MachineSpec{
+			NodeMetadata: &clusterv1.NodeMetadata{
+				Labels: map[string]string{
+					"foo":                                  "bar",
+					clusterv1.NodeRoleLabelPrefix + "/gpu": "",
+					"topology.kubernetes.io/zone":          "zone-a",
+					clusterv1.InterruptibleLabel:           "",
+				},
+				Annotations: map[string]string{
+					"example.com/owner":                        "team-a",
+					"node.alpha.kubernetes.io/ttl":             "0",
+					clusterv1.AnnotationsFromMachineAnnotation: "foo",
+				},
+				Taints: []corev1.Taint{
+					{Key: "example.com/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+					{Key: clusterv1.NodeUninitializedTaint.Key, Effect: clusterv1.NodeUninitializedTaint.Effect},
+				},
+			},
+		},
+	}
+
+	nodeMetadata, conflicts := getNodeMetadata(machine)
+	g.Expect(nodeMetadata.Labels).To(Equal(map[string]string{
+		"foo":                                  "bar",
+		clusterv1.NodeRoleLabelPrefix + "/gpu": "",
+	}))
+	g.Expect(nodeMetadata.Annotations).To(Equal(map[string]string{
+		"example.com/owner": "team-a",
+	}))
+	g.Expect(nodeMetadata.Taints).To(Equal([]corev1.Taint{
+		{Key: "example.com/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+	}))
+	g.Expect(conflicts).To(Equal([]string{
+		"annotation " + clusterv1.AnnotationsFromMachineAnnotation,
+		"annotation node.alpha.kubernetes.io/ttl",
+		"label " + clusterv1.InterruptibleLabel,
+		"label topology.kubernetes.io/zone",
+		"taint " + clusterv1.NodeUninitializedTaint.Key + ":" + string(clusterv1.NodeUninitializedTaint.Effect),
+	}))
+
+	nodeMetadata, conflicts = getNodeMetadata(&clusterv1.Machine{})
+	g.Expect(nodeMetadata.Labels).To(BeEmpty())
+	g.Expect(nodeMetadata.Annotations).To(BeEmpty())
+	g.Expect(nodeMetadata.Taints).To(BeEmpty())
+	g.Expect(conflicts).To(BeEmpty())
+}
+
 func TestPatchNode(t *testing.T) {
 	clusterName := "test-cluster"
 
@@ -522,6 +573,7 @@ func TestPatchNode(t *testing.T) {
 		oldNode             *corev1.Node
 		newLabels           map[string]string
 		newAnnotations      map[string]string
+		nodeMetadata        *clusterv1.NodeMetadata
 		expectedLabels      map[string]string
 		expectedAnnotations map[string]string
 		expectedTaints      []corev1.Taint
@@ -690,6 +742,74 @@ func TestPatchNode(t *testing.T) {
 			ms:      newFakeMachineSet(metav1.NamespaceDefault, clusterName),
 			md:      newFakeMachineDeployment(metav1.NamespaceDefault, clusterName),
 		},
+		// Annotations and taints from nodeMetadata
+		{
+			name: "Add annotations and taints from nodeMetadata must preserve existing annotations and taints",
+			oldNode: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf("node-%s", util.RandomString(6)),
+					Annotations: map[string]string{
+						"not-managed-by-capi": "foo",
+					},
+				},
+				Spec: corev1.NodeSpec{
+					Taints: []corev1.Taint{
+						{Key: "not-managed-by-capi", Effect: corev1.TaintEffectNoExecute},
+					},
+				},
+			},
+			nodeMetadata: &clusterv1.NodeMetadata{
+				Annotations: map[string]string{
+					"example.com/owner": "team-a",
+				},
+				Taints: []corev1.Taint{
+					{Key: "example.com/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+				},
+			},
+			expectedAnnotations: map[string]string{
+				"not-managed-by-capi":                      "foo",
+				"example.com/owner":                        "team-a",
+				clusterv1.AnnotationsFromMachineAnnotation: "example.com/owner",
+				clusterv1.TaintsFromMachineAnnotation:      "example.com/dedicated:NoSchedule",
+			},
+			expectedTaints: []corev1.Taint{
+				{Key: "not-managed-by-capi", Effect: corev1.TaintEffectNoExecute},
+				{Key: "example.com/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "node.kubernetes.io/not-ready", Effect: "NoSchedule"}, // Added by the API server
+			},
+			machine: newFakeMachine(metav1.NamespaceDefault, clusterName),
+			ms:      newFakeMachineSet(metav1.NamespaceDefault, clusterName),
+			md:      newFakeMachineDeployment(metav1.NamespaceDefault, clusterName),
+		},
+		{
+			name: "Delete annotations and taints previously set from nodeMetadata",
+			oldNode: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf("node-%s", util.RandomString(6)),
+					Annotations: map[string]string{
+						"not-managed-by-capi":                      "foo",
+						"example.com/owner":                        "team-a",
+						clusterv1.AnnotationsFromMachineAnnotation: "example.com/owner",
+						clusterv1.TaintsFromMachineAnnotation:      "example.com/dedicated:NoSchedule",
+					},
+				},
+				Spec: corev1.NodeSpec{
+					Taints: []corev1.Taint{
+						{Key: "example.com/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+					},
+				},
+			},
+			nodeMetadata: &clusterv1.NodeMetadata{},
+			expectedAnnotations: map[string]string{
+				"not-managed-by-capi": "foo",
+			},
+			expectedTaints: []corev1.Taint{
+				{Key: "node.kubernetes.io/not-ready", Effect: "NoSchedule"}, // Added by the API server
+			},
+			machine: newFakeMachine(metav1.NamespaceDefault, clusterName),
+			ms:      newFakeMachineSet(metav1.NamespaceDefault, clusterName),
+			md:      newFakeMachineDeployment(metav1.NamespaceDefault, clusterName),
+		},
 		{
 			name: "Label previously set from machine, already removed out of band, annotation should be cleaned up",
 			oldNode: &corev1.Node{
@@ -921,7 +1041,12 @@ func TestPatchNode(t *testing.T) {
 				_ = env.CleanupAndWait(ctx, oldNode, machine, ms, md)
 			})
 
-			err := r.patchNode(ctx, env, oldNode, tc.newLabels, tc.newAnnotations, tc.machine)
+			nodeMetadata := tc.nodeMetadata
+			if nodeMetadata == nil {
+				nodeMetadata = &clusterv1.NodeMetadata{}
+			}
+
+			err := r.patchNode(ctx, env, oldNode, tc.newLabels, tc.newAnnotations, nodeMetadata, tc.machine)
 			g.Expect(err).ToNot(HaveOccurred())
 
 			g.Eventually(func(g Gomega) {
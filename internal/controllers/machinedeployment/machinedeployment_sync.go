@@ -38,7 +38,6 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/internal/controllers/machinedeployment/mdutil"
-	"sigs.k8s.io/cluster-api/internal/util/hash"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
@@ -219,7 +218,7 @@ func (r *Reconciler) computeDesiredMachineSet(ctx context.Context, deployment *c
 		// As a result, we use the hash of the machine template while ignoring all in-place mutable fields, i.e. the
 		// machine template with only fields that could trigger a rollout for the machine-template-hash, making it
 		// independent of the changes to any in-place mutable fields.
-		templateHash, err := hash.Compute(mdutil.MachineTemplateDeepCopyRolloutFields(&deployment.Spec.Template))
+		templateHash, err := mdutil.ComputeMachineTemplateHash(&deployment.Spec.Template)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute desired MachineSet: failed to compute machine template hash")
 		}
@@ -228,7 +227,7 @@ func (r *Reconciler) computeDesiredMachineSet(ctx context.Context, deployment *c
 		// will end up updating the existing MachineSet instead of creating a new one.
 		var randomSuffix string
 		name, randomSuffix = computeNewMachineSetName(deployment.Name + "-")
-		uniqueIdentifierLabelValue = fmt.Sprintf("%d-%s", templateHash, randomSuffix)
+		uniqueIdentifierLabelValue = fmt.Sprintf("%s-%s", templateHash, randomSuffix)
 
 		// Add foregroundDeletion finalizer to MachineSet if the MachineDeployment has it.
 		if sets.New[string](deployment.Finalizers...).Has(metav1.FinalizerDeleteDependents) {
@@ -270,6 +269,13 @@ func (r *Reconciler) computeDesiredMachineSet(ctx context.Context, deployment *c
 		replicas = *existingMS.Spec.Replicas
 
 		machineTemplateSpec = *existingMS.Spec.Template.Spec.DeepCopy()
+
+		// If the Machines of the existingMS have been updated in-place, propagate the references to the
+		// new bootstrap and infrastructure machine templates.
+		if mdutil.IsMachineSetUpdatedInPlace(deployment, existingMS) {
+			machineTemplateSpec.InfrastructureRef = deployment.Spec.Template.Spec.InfrastructureRef
+			machineTemplateSpec.Bootstrap.ConfigRef = deployment.Spec.Template.Spec.Bootstrap.ConfigRef.DeepCopy()
+		}
 	}
 
 	// Construct the basic MachineSet.
@@ -326,9 +332,13 @@ func (r *Reconciler) computeDesiredMachineSet(ctx context.Context, deployment *c
 	} else {
 		desiredMS.Spec.DeletePolicy = ""
 	}
+	desiredMS.Spec.FailureDomainSpread = ptr.Deref(deployment.Spec.FailureDomainSpread, "")
+	desiredMS.Spec.PreflightChecks = deployment.Spec.PreflightChecks
 	desiredMS.Spec.Template.Spec.NodeDrainTimeout = deployment.Spec.Template.Spec.NodeDrainTimeout
 	desiredMS.Spec.Template.Spec.NodeDeletionTimeout = deployment.Spec.Template.Spec.NodeDeletionTimeout
 	desiredMS.Spec.Template.Spec.NodeVolumeDetachTimeout = deployment.Spec.Template.Spec.NodeVolumeDetachTimeout
+	desiredMS.Spec.Template.Spec.NodeDrainRules = deployment.Spec.Template.Spec.NodeDrainRules
+	desiredMS.Spec.Template.Spec.NodeMetadata = deployment.Spec.Template.Spec.NodeMetadata
 
 	return desiredMS, nil
 }
@@ -557,9 +557,13 @@ func TestComputeDesiredMachineSet(t *testing.T) {
 			Annotations: map[string]string{"top-level-annotation": "top-level-annotation-value"},
 		},
 		Spec: clusterv1.MachineDeploymentSpec{
-			ClusterName:     "test-cluster",
-			Replicas:        ptr.To[int32](3),
-			MinReadySeconds: ptr.To[int32](10),
+			ClusterName:         "test-cluster",
+			Replicas:            ptr.To[int32](3),
+			MinReadySeconds:     ptr.To[int32](10),
+			FailureDomainSpread: ptr.To(string(clusterv1.EvenFailureDomainSpreadPolicy)),
+			PreflightChecks: &clusterv1.MachineSetPreflightChecks{
+				Skip: []clusterv1.MachineSetPreflightCheck{clusterv1.MachineSetPreflightCheckKubeadmVersionSkew},
+			},
 			Strategy: &clusterv1.MachineDeploymentStrategy{
 				Type: clusterv1.RollingUpdateMachineDeploymentStrategyType,
 				RollingUpdate: &clusterv1.MachineRollingUpdateDeployment{
@@ -597,12 +601,14 @@ func TestComputeDesiredMachineSet(t *testing.T) {
 			Annotations: map[string]string{"top-level-annotation": "top-level-annotation-value"},
 		},
 		Spec: clusterv1.MachineSetSpec{
-			ClusterName:     "test-cluster",
-			Replicas:        ptr.To[int32](3),
-			MinReadySeconds: 10,
-			DeletePolicy:    string(clusterv1.RandomMachineSetDeletePolicy),
-			Selector:        metav1.LabelSelector{MatchLabels: map[string]string{"k1": "v1"}},
-			Template:        *deployment.Spec.Template.DeepCopy(),
+			ClusterName:         "test-cluster",
+			Replicas:            ptr.To[int32](3),
+			MinReadySeconds:     10,
+			DeletePolicy:        string(clusterv1.RandomMachineSetDeletePolicy),
+			FailureDomainSpread: string(clusterv1.EvenFailureDomainSpreadPolicy),
+			PreflightChecks:     deployment.Spec.PreflightChecks.DeepCopy(),
+			Selector:            metav1.LabelSelector{MatchLabels: map[string]string{"k1": "v1"}},
+			Template:            *deployment.Spec.Template.DeepCopy(),
 		},
 	}
 
@@ -651,6 +657,8 @@ func TestComputeDesiredMachineSet(t *testing.T) {
 		existingMS.Spec.Template.Spec.NodeDeletionTimeout = duration5s
 		existingMS.Spec.Template.Spec.NodeVolumeDetachTimeout = duration5s
 		existingMS.Spec.DeletePolicy = string(clusterv1.NewestMachineSetDeletePolicy)
+		existingMS.Spec.FailureDomainSpread = string(clusterv1.NoneFailureDomainSpreadPolicy)
+		existingMS.Spec.PreflightChecks = nil
 		existingMS.Spec.MinReadySeconds = 0
 
 		expectedMS := skeletonMSBasedOnMD.DeepCopy()
@@ -685,6 +693,8 @@ func TestComputeDesiredMachineSet(t *testing.T) {
 		existingMS.Spec.Template.Spec.NodeDeletionTimeout = duration5s
 		existingMS.Spec.Template.Spec.NodeVolumeDetachTimeout = duration5s
 		existingMS.Spec.DeletePolicy = string(clusterv1.NewestMachineSetDeletePolicy)
+		existingMS.Spec.FailureDomainSpread = string(clusterv1.NoneFailureDomainSpreadPolicy)
+		existingMS.Spec.PreflightChecks = nil
 		existingMS.Spec.MinReadySeconds = 0
 
 		oldMS := skeletonMSBasedOnMD.DeepCopy()
@@ -736,6 +746,8 @@ func TestComputeDesiredMachineSet(t *testing.T) {
 		existingMS.Spec.Template.Spec.NodeDeletionTimeout = duration5s
 		existingMS.Spec.Template.Spec.NodeVolumeDetachTimeout = duration5s
 		existingMS.Spec.DeletePolicy = string(clusterv1.NewestMachineSetDeletePolicy)
+		existingMS.Spec.FailureDomainSpread = string(clusterv1.NoneFailureDomainSpreadPolicy)
+		existingMS.Spec.PreflightChecks = nil
 		existingMS.Spec.MinReadySeconds = 0
 
 		expectedMS := skeletonMSBasedOnMD.DeepCopy()
@@ -751,6 +763,31 @@ func TestComputeDesiredMachineSet(t *testing.T) {
 		g.Expect(err).ToNot(HaveOccurred())
 		assertMachineSet(g, actualMS, expectedMS)
 	})
+
+	t.Run("should propagate the template references to the updated MachineSet when its Machines have been updated in-place", func(t *testing.T) {
+		g := NewWithT(t)
+
+		uniqueID := apirand.String(5)
+		existingMS := skeletonMSBasedOnMD.DeepCopy()
+		existingMS.UID = types.UID("abc-123-uid")
+		existingMS.Name = deployment.Name + "-" + uniqueID
+		existingMS.Labels = map[string]string{
+			clusterv1.MachineDeploymentUniqueLabel: uniqueID,
+		}
+		existingMS.Spec.Template.Spec.InfrastructureRef.Name = "infra-template-0"
+		existingMS.Spec.Template.Spec.Bootstrap.ConfigRef.Name = "bootstrap-template-0"
+
+		templateHash, err := mdutil.ComputeMachineTemplateHash(&existingMS.Spec.Template)
+		g.Expect(err).ToNot(HaveOccurred())
+		deployment := deployment.DeepCopy()
+		deployment.Annotations[clusterv1.ClusterTopologyInPlaceUpdateHashAnnotation] = templateHash
+
+		actualMS, err := (&Reconciler{}).computeDesiredMachineSet(ctx, deployment, existingMS, nil)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(actualMS.Spec.Template.Spec.InfrastructureRef).To(Equal(infraRef))
+		g.Expect(actualMS.Spec.Template.Spec.Bootstrap.ConfigRef).To(Equal(&bootstrapRef))
+		g.Expect(actualMS.Annotations).ToNot(HaveKey(clusterv1.ClusterTopologyInPlaceUpdateHashAnnotation))
+	})
 }
 
 func assertMachineSet(g *WithT, actualMS *clusterv1.MachineSet, expectedMS *clusterv1.MachineSet) {
@@ -798,6 +835,12 @@ func assertMachineSet(g *WithT, actualMS *clusterv1.MachineSet, expectedMS *clus
 	// Check DeletePolicy
 	g.Expect(actualMS.Spec.DeletePolicy).Should(Equal(expectedMS.Spec.DeletePolicy))
 
+	// Check FailureDomainSpread
+	g.Expect(actualMS.Spec.FailureDomainSpread).Should(Equal(expectedMS.Spec.FailureDomainSpread))
+
+	// Check PreflightChecks
+	g.Expect(actualMS.Spec.PreflightChecks).Should(BeComparableTo(expectedMS.Spec.PreflightChecks))
+
 	// Check MachineTemplateSpec
 	g.Expect(actualMS.Spec.Template.Spec).Should(BeComparableTo(expectedMS.Spec.Template.Spec))
 }
@@ -37,6 +37,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/internal/util/hash"
 	"sigs.k8s.io/cluster-api/util/conversion"
 )
 
@@ -151,6 +152,9 @@ var annotationsToSkip = map[string]bool{
 	clusterv1.DesiredReplicasAnnotation: true,
 	clusterv1.MaxReplicasAnnotation:     true,
 
+	// Exclude the in-place update annotation, which is only relevant for the MachineDeployment.
+	clusterv1.ClusterTopologyInPlaceUpdateHashAnnotation: true,
+
 	// Exclude the conversion annotation, to avoid infinite loops between the conversion webhook
 	// and the MachineDeployment controller syncing the annotations between a MachineDeployment
 	// and its linked MachineSets.
@@ -388,10 +392,12 @@ func MachineTemplateDeepCopyRolloutFields(template *clusterv1.MachineTemplateSpe
 	templateCopy.Labels = nil
 	templateCopy.Annotations = nil
 
-	// Drop node timeout values
+	// Drop node timeout values, drain rules and node metadata
 	templateCopy.Spec.NodeDrainTimeout = nil
 	templateCopy.Spec.NodeDeletionTimeout = nil
 	templateCopy.Spec.NodeVolumeDetachTimeout = nil
+	templateCopy.Spec.NodeDrainRules = nil
+	templateCopy.Spec.NodeMetadata = nil
 
 	// Remove the version part from the references APIVersion field,
 	// for more details see issue #2183 and #2140.
@@ -403,6 +409,37 @@ func MachineTemplateDeepCopyRolloutFields(template *clusterv1.MachineTemplateSpe
 	return templateCopy
 }
 
+// ComputeMachineTemplateHash returns the hash of a machineTemplateSpec, ignoring all the in-place propagated fields,
+// and the version from external references.
+func ComputeMachineTemplateHash(template *clusterv1.MachineTemplateSpec) (string, error) {
+	templateHash, err := hash.Compute(MachineTemplateDeepCopyRolloutFields(template))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d", templateHash), nil
+}
+
+// IsMachineSetUpdatedInPlace returns true if the Machines of the MachineSet have been updated in-place to the
+// machine template of the given deployment, i.e. if the machine template of the MachineSet is the one tracked in the
+// in-place update annotation of the deployment, and it only differs from the machine template of the deployment
+// in the references to the bootstrap and infrastructure machine templates.
+func IsMachineSetUpdatedInPlace(deployment *clusterv1.MachineDeployment, ms *clusterv1.MachineSet) bool {
+	inPlaceUpdateHash, ok := deployment.Annotations[clusterv1.ClusterTopologyInPlaceUpdateHashAnnotation]
+	if !ok {
+		return false
+	}
+
+	templateHash, err := ComputeMachineTemplateHash(&ms.Spec.Template)
+	if err != nil || templateHash != inPlaceUpdateHash {
+		return false
+	}
+
+	template := ms.Spec.Template.DeepCopy()
+	template.Spec.InfrastructureRef = deployment.Spec.Template.Spec.InfrastructureRef
+	template.Spec.Bootstrap.ConfigRef = deployment.Spec.Template.Spec.Bootstrap.ConfigRef.DeepCopy()
+	return EqualMachineTemplate(template, &deployment.Spec.Template)
+}
+
 // FindNewMachineSet returns the new MS this given deployment targets (the one with the same machine template, ignoring
 // in-place mutable fields).
 // Note: If the reconciliation time is after the deployment's `rolloutAfter` time, a MS has to be newer than
@@ -415,6 +452,9 @@ func MachineTemplateDeepCopyRolloutFields(template *clusterv1.MachineTemplateSpe
 // not face a case where there exists a machine set matching the old logic but there does not exist a machineset matching the new logic.
 // In fact previously not matching MS can now start matching the target. Since there could be multiple matches, lets choose the
 // MS with the most replicas so that there is minimum machine churn.
+// NOTE: If the Machines have been updated in-place, the MachineSet with the machine template that has been replaced in-place
+// is considered matching the intent of the MachineDeployment; the references to the new bootstrap and infrastructure machine
+// templates are then propagated to it in-place.
 func FindNewMachineSet(deployment *clusterv1.MachineDeployment, msList []*clusterv1.MachineSet, reconciliationTime *metav1.Time) *clusterv1.MachineSet {
 	sort.Sort(MachineSetsByDecreasingReplicas(msList))
 	for i := range msList {
@@ -427,6 +467,12 @@ func FindNewMachineSet(deployment *clusterv1.MachineDeployment, msList []*cluste
 			return msList[i]
 		}
 	}
+	for i := range msList {
+		if IsMachineSetUpdatedInPlace(deployment, msList[i]) &&
+			!shouldRolloutAfter(msList[i], reconciliationTime, deployment.Spec.RolloutAfter) {
+			return msList[i]
+		}
+	}
 	// new MachineSet does not exist.
 	return nil
 }
@@ -334,6 +334,16 @@ func TestFindNewMachineSet(t *testing.T) {
 	msCreatedAfterRolloutAfter := generateMS(deployment)
 	msCreatedAfterRolloutAfter.CreationTimestamp = oneAfterRolloutAfter
 
+	oldMSTemplateHash, err := ComputeMachineTemplateHash(&oldMS.Spec.Template)
+	if err != nil {
+		panic(err)
+	}
+	deploymentUpdatedInPlace := *deployment.DeepCopy()
+	deploymentUpdatedInPlace.Annotations = map[string]string{clusterv1.ClusterTopologyInPlaceUpdateHashAnnotation: oldMSTemplateHash}
+
+	deploymentUpdatedInPlaceWithVersionChange := *deploymentUpdatedInPlace.DeepCopy()
+	deploymentUpdatedInPlaceWithVersionChange.Spec.Template.Spec.Version = ptr.To("v1.2.3")
+
 	tests := []struct {
 		Name               string
 		deployment         clusterv1.MachineDeployment
@@ -365,6 +375,24 @@ func TestFindNewMachineSet(t *testing.T) {
 			msList:     []*clusterv1.MachineSet{&oldMS},
 			expected:   nil,
 		},
+		{
+			Name:       "Get the MachineSet with the MachineTemplate that has been updated in-place",
+			deployment: deploymentUpdatedInPlace,
+			msList:     []*clusterv1.MachineSet{&oldMS},
+			expected:   &oldMS,
+		},
+		{
+			Name:       "Get the MachineSet with the MachineTemplate that matches the intent of the MachineDeployment, even if the MachineDeployment has been updated in-place",
+			deployment: deploymentUpdatedInPlace,
+			msList:     []*clusterv1.MachineSet{&oldMS, &matchingMS},
+			expected:   &matchingMS,
+		},
+		{
+			Name:       "Get nil if the MachineTemplate that has been updated in-place differs in other fields from the intent of the MachineDeployment",
+			deployment: deploymentUpdatedInPlaceWithVersionChange,
+			msList:     []*clusterv1.MachineSet{&oldMS},
+			expected:   nil,
+		},
 		{
 			Name:               "Get the MachineSet if reconciliationTime < rolloutAfter",
 			deployment:         deployment,
@@ -52,6 +52,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 const (
@@ -80,6 +81,9 @@ type Reconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
+
 	controller controller.Controller
 	recorder   record.EventRecorder
 }
@@ -93,6 +97,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		).
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
+		WithEventFilter(predicates.ResourceIsInShard(ctrl.LoggerFrom(ctx), r.Shard)).
 		Watches(
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(r.clusterToMachineHealthCheck),
@@ -236,6 +241,12 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 	healthy, unhealthy, nextCheckTimes := r.healthCheckTargets(targets, logger, *nodeStartupTimeout)
 	m.Status.CurrentHealthy = int32(len(healthy))
 
+	// drop the remediations not relevant anymore for the remediation backoff
+	pruneRemediationHistory(m, time.Now())
+
+	// drop the remediation progress of the machines not targeted anymore
+	pruneRemediationProgress(m)
+
 	// check MHC current health against MaxUnhealthy
 	remediationAllowed, remediationCount, err := isAllowedRemediation(m)
 	if err != nil {
@@ -316,10 +327,13 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 
 	// Remediation is allowed so unhealthyMachineCount is within unhealthyRange (or) maxUnhealthy - unhealthyMachineCount >= 0
 	m.Status.RemediationsAllowed = remediationCount
-	conditions.MarkTrue(m, clusterv1.RemediationAllowedCondition)
 
-	errList := r.patchUnhealthyTargets(ctx, logger, unhealthy, cluster, m)
+	errList, remediationBackoff, rateLimitMessage := r.patchUnhealthyTargets(ctx, logger, unhealthy, cluster, m)
 	errList = append(errList, r.patchHealthyTargets(ctx, logger, healthy, m)...)
+	r.setRemediationAllowedCondition(m, rateLimitMessage)
+	if remediationBackoff > 0 {
+		nextCheckTimes = append(nextCheckTimes, remediationBackoff)
+	}
 
 	// handle update errors
 	if len(errList) > 0 {
@@ -341,25 +355,11 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 func (r *Reconciler) patchHealthyTargets(ctx context.Context, logger logr.Logger, healthy []healthCheckTarget, m *clusterv1.MachineHealthCheck) []error {
 	errList := []error{}
 	for _, t := range healthy {
-		if m.Spec.RemediationTemplate != nil {
-			// Get remediation request object
-			obj, err := r.getExternalRemediationRequest(ctx, m, t.Machine.Name)
-			if err != nil {
-				if !apierrors.IsNotFound(errors.Cause(err)) {
-					wrappedErr := errors.Wrapf(err, "failed to fetch remediation request for machine %q in namespace %q within cluster %q", t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName)
-					errList = append(errList, wrappedErr)
-				}
-				continue
-			}
-			// Check that obj has no DeletionTimestamp to avoid hot loop
-			if obj.GetDeletionTimestamp() == nil {
-				// Issue a delete for remediation request.
-				if err := r.Client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
-					errList = append(errList, errors.Wrapf(err, "failed to delete %v %q for Machine %q", obj.GroupVersionKind(), obj.GetName(), t.Machine.Name))
-					continue
-				}
-			}
+		if err := r.deleteRemediationRequests(ctx, m, t.Machine); err != nil {
+			errList = append(errList, err)
+			continue
 		}
+		removeRemediationProgress(m, t.Machine.Name)
 
 		if err := t.patchHelper.Patch(ctx, t.Machine); err != nil {
 			logger.Error(err, "failed to patch healthy machine status for machine", "machine", t.Machine.GetName())
@@ -369,71 +369,98 @@ func (r *Reconciler) patchHealthyTargets(ctx context.Context, logger logr.Logger
 	return errList
 }
 
+// deleteRemediationRequests deletes the remediation requests created by the MachineHealthCheck for a Machine.
+func (r *Reconciler) deleteRemediationRequests(ctx context.Context, m *clusterv1.MachineHealthCheck, machine *clusterv1.Machine) error {
+	for _, templateRef := range remediationTemplates(m) {
+		// Get remediation request object
+		obj, err := r.getRemediationRequest(ctx, templateRef, m.Namespace, machine.Name)
+		if err != nil {
+			if !apierrors.IsNotFound(errors.Cause(err)) {
+				return errors.Wrapf(err, "failed to fetch remediation request for machine %q in namespace %q within cluster %q", machine.Name, machine.Namespace, machine.Spec.ClusterName)
+			}
+			continue
+		}
+		// Check that obj has no DeletionTimestamp to avoid hot loop
+		if obj.GetDeletionTimestamp() == nil {
+			// Issue a delete for remediation request.
+			if err := r.Client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "failed to delete %v %q for Machine %q", obj.GroupVersionKind(), obj.GetName(), machine.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// setRemediationAllowedCondition sets the RemediationAllowedCondition once all the targets have been processed;
+// the RemediationRestricted event is emitted only when remediations start being delayed by the remediation rate limit.
+func (r *Reconciler) setRemediationAllowedCondition(m *clusterv1.MachineHealthCheck, rateLimitMessage string) {
+	if rateLimitMessage == "" {
+		conditions.MarkTrue(m, clusterv1.RemediationAllowedCondition)
+		return
+	}
+
+	if !conditions.IsFalse(m, clusterv1.RemediationAllowedCondition) || conditions.GetReason(m, clusterv1.RemediationAllowedCondition) != clusterv1.RemediationRateLimitedReason {
+		r.recorder.Event(m, corev1.EventTypeWarning, EventRemediationRestricted, rateLimitMessage)
+	}
+	conditions.MarkFalse(m, clusterv1.RemediationAllowedCondition, clusterv1.RemediationRateLimitedReason, clusterv1.ConditionSeverityWarning, "%s", rateLimitMessage)
+}
+
 // patchUnhealthyTargets patches machines with MachineOwnerRemediatedCondition for remediation.
-func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logger, unhealthy []healthCheckTarget, cluster *clusterv1.Cluster, m *clusterv1.MachineHealthCheck) []error {
+// It returns the time after which remediations delayed by the remediation backoff should be triggered, if any,
+// and the reason why remediations are delayed by the remediation rate limit, if any.
+func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logger, unhealthy []healthCheckTarget, cluster *clusterv1.Cluster, m *clusterv1.MachineHealthCheck) ([]error, time.Duration, string) {
 	// mark for remediation
 	errList := []error{}
+	now := time.Now()
+	var remediationBackoff time.Duration
+	var rateLimitMessage string
 	for _, t := range unhealthy {
 		condition := conditions.Get(t.Machine, clusterv1.MachineHealthCheckSucceededCondition)
 
+		remediationTriggered := false
 		if annotations.IsPaused(cluster, t.Machine) {
 			logger.Info("Machine has failed health check, but machine is paused so skipping remediation", "target", t.string(), "reason", condition.Reason, "message", condition.Message)
+		} else if wait, message := r.remediationDelay(ctx, logger, m, t, now); wait > 0 {
+			if remediationBackoff == 0 || wait < remediationBackoff {
+				remediationBackoff = wait
+			}
+			if message != "" {
+				rateLimitMessage = message
+			}
+			if err := t.patchHelper.Patch(ctx, t.Machine); err != nil {
+				errList = append(errList, errors.Wrapf(err, "failed to patch unhealthy machine status for machine: %s/%s", t.Machine.Namespace, t.Machine.Name))
+			}
+			continue
 		} else {
-			if m.Spec.RemediationTemplate != nil {
-				// If external remediation request already exists,
-				// return early
-				if r.externalRemediationRequestExists(ctx, m, t.Machine.Name) {
-					return errList
-				}
-
-				cloneOwnerRef := &metav1.OwnerReference{
-					APIVersion: clusterv1.GroupVersion.String(),
-					Kind:       "Machine",
-					Name:       t.Machine.Name,
-					UID:        t.Machine.UID,
-				}
-
-				from, err := external.Get(ctx, r.Client, m.Spec.RemediationTemplate, t.Machine.Namespace)
+			if len(m.Spec.RemediationStrategies) > 0 {
+				triggered, wait, err := r.remediateWithStrategies(ctx, logger, m, t, condition, now)
 				if err != nil {
-					conditions.MarkFalse(m, clusterv1.ExternalRemediationTemplateAvailableCondition, clusterv1.ExternalRemediationTemplateNotFoundReason, clusterv1.ConditionSeverityError, err.Error())
-					errList = append(errList, errors.Wrapf(err, "error retrieving remediation template %v %q for machine %q in namespace %q within cluster %q", m.Spec.RemediationTemplate.GroupVersionKind(), m.Spec.RemediationTemplate.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName))
-					return errList
+					errList = append(errList, err)
+					return errList, remediationBackoff, rateLimitMessage
 				}
-
-				generateTemplateInput := &external.GenerateTemplateInput{
-					Template:    from,
-					TemplateRef: m.Spec.RemediationTemplate,
-					Namespace:   t.Machine.Namespace,
-					ClusterName: t.Machine.Spec.ClusterName,
-					OwnerRef:    cloneOwnerRef,
+				if wait > 0 && (remediationBackoff == 0 || wait < remediationBackoff) {
+					remediationBackoff = wait
 				}
-				to, err := external.GenerateTemplate(generateTemplateInput)
-				if err != nil {
-					errList = append(errList, errors.Wrapf(err, "failed to create template for remediation request %v %q for machine %q in namespace %q within cluster %q", m.Spec.RemediationTemplate.GroupVersionKind(), m.Spec.RemediationTemplate.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName))
-					return errList
+				remediationTriggered = triggered
+			} else if m.Spec.RemediationTemplate != nil {
+				// If external remediation request already exists,
+				// return early
+				if r.externalRemediationRequestExists(ctx, m, t.Machine.Name) {
+					return errList, remediationBackoff, rateLimitMessage
 				}
 
-				// Set the Remediation Request to match the Machine name, the name is used to
-				// guarantee uniqueness between runs. A Machine should only ever have a single
-				// remediation object of a specific GVK created.
-				//
-				// NOTE: This doesn't guarantee uniqueness across different MHC objects watching
-				// the same Machine, users are in charge of setting health checks and remediation properly.
-				to.SetName(t.Machine.Name)
-
-				logger.Info("Target has failed health check, creating an external remediation request", "remediation request name", to.GetName(), "target", t.string(), "reason", condition.Reason, "message", condition.Message)
-				// Create the external clone.
-				if err := r.Client.Create(ctx, to); err != nil {
-					conditions.MarkFalse(m, clusterv1.ExternalRemediationRequestAvailableCondition, clusterv1.ExternalRemediationRequestCreationFailedReason, clusterv1.ConditionSeverityError, err.Error())
-					errList = append(errList, errors.Wrapf(err, "error creating remediation request for machine %q in namespace %q within cluster %q", t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName))
-					return errList
+				if err := r.createExternalRemediationRequest(ctx, logger, m, t, m.Spec.RemediationTemplate, condition, nil); err != nil {
+					errList = append(errList, err)
+					return errList, remediationBackoff, rateLimitMessage
 				}
+				remediationTriggered = true
 			} else {
 				logger.Info("Target has failed health check, marking for remediation", "target", t.string(), "reason", condition.Reason, "message", condition.Message)
 				// NOTE: MHC is responsible for creating MachineOwnerRemediatedCondition if missing or to trigger another remediation if the previous one is completed;
 				// instead, if a remediation is in already progress, the remediation owner is responsible for completing the process and MHC should not overwrite the condition.
 				if !conditions.Has(t.Machine, clusterv1.MachineOwnerRemediatedCondition) || conditions.IsTrue(t.Machine, clusterv1.MachineOwnerRemediatedCondition) {
 					conditions.MarkFalse(t.Machine, clusterv1.MachineOwnerRemediatedCondition, clusterv1.WaitingForRemediationReason, clusterv1.ConditionSeverityWarning, "")
+					remediationTriggered = true
 				}
 			}
 		}
@@ -442,6 +469,9 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 			errList = append(errList, errors.Wrapf(err, "failed to patch unhealthy machine status for machine: %s/%s", t.Machine.Namespace, t.Machine.Name))
 			continue
 		}
+		if remediationTriggered {
+			recordRemediation(m, t.Machine, now)
+		}
 		r.recorder.Eventf(
 			t.Machine,
 			corev1.EventTypeNormal,
@@ -450,7 +480,94 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 			t.string(),
 		)
 	}
-	return errList
+	return errList, remediationBackoff, rateLimitMessage
+}
+
+// createExternalRemediationRequest creates a remediation request for the target from the given remediation template.
+func (r *Reconciler) createExternalRemediationRequest(ctx context.Context, logger logr.Logger, m *clusterv1.MachineHealthCheck, t healthCheckTarget, templateRef *corev1.ObjectReference, condition *clusterv1.Condition, annotations map[string]string) error {
+	cloneOwnerRef := &metav1.OwnerReference{
+		APIVersion: clusterv1.GroupVersion.String(),
+		Kind:       "Machine",
+		Name:       t.Machine.Name,
+		UID:        t.Machine.UID,
+	}
+
+	from, err := external.Get(ctx, r.Client, templateRef, t.Machine.Namespace)
+	if err != nil {
+		conditions.MarkFalse(m, clusterv1.ExternalRemediationTemplateAvailableCondition, clusterv1.ExternalRemediationTemplateNotFoundReason, clusterv1.ConditionSeverityError, err.Error())
+		return errors.Wrapf(err, "error retrieving remediation template %v %q for machine %q in namespace %q within cluster %q", templateRef.GroupVersionKind(), templateRef.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName)
+	}
+
+	generateTemplateInput := &external.GenerateTemplateInput{
+		Template:    from,
+		TemplateRef: templateRef,
+		Namespace:   t.Machine.Namespace,
+		ClusterName: t.Machine.Spec.ClusterName,
+		OwnerRef:    cloneOwnerRef,
+	}
+	to, err := external.GenerateTemplate(generateTemplateInput)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create template for remediation request %v %q for machine %q in namespace %q within cluster %q", templateRef.GroupVersionKind(), templateRef.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName)
+	}
+
+	// Set the Remediation Request to match the Machine name, the name is used to
+	// guarantee uniqueness between runs. A Machine should only ever have a single
+	// remediation object of a specific GVK created.
+	//
+	// NOTE: This doesn't guarantee uniqueness across different MHC objects watching
+	// the same Machine, users are in charge of setting health checks and remediation properly.
+	to.SetName(t.Machine.Name)
+
+	if len(annotations) > 0 {
+		toAnnotations := to.GetAnnotations()
+		if toAnnotations == nil {
+			toAnnotations = map[string]string{}
+		}
+		for k, v := range annotations {
+			toAnnotations[k] = v
+		}
+		to.SetAnnotations(toAnnotations)
+	}
+
+	logger.Info("Target has failed health check, creating an external remediation request", "remediation request name", to.GetName(), "target", t.string(), "reason", condition.Reason, "message", condition.Message)
+	// Create the external clone.
+	if err := r.Client.Create(ctx, to); err != nil {
+		conditions.MarkFalse(m, clusterv1.ExternalRemediationRequestAvailableCondition, clusterv1.ExternalRemediationRequestCreationFailedReason, clusterv1.ConditionSeverityError, err.Error())
+		return errors.Wrapf(err, "error creating remediation request for machine %q in namespace %q within cluster %q", t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName)
+	}
+	return nil
+}
+
+// remediationDelay returns the time to wait before triggering a new remediation of the target according to the
+// remediation backoff of the MachineHealthCheck, if any; remediations already in progress are never delayed.
+// If the remediation is delayed by the remediation rate limit, the reason is returned too.
+func (r *Reconciler) remediationDelay(ctx context.Context, logger logr.Logger, m *clusterv1.MachineHealthCheck, t healthCheckTarget, now time.Time) (time.Duration, string) {
+	if m.Spec.RemediationBackoff == nil {
+		return 0, ""
+	}
+
+	if len(m.Spec.RemediationStrategies) > 0 {
+		if hasRemediationProgress(m, t.Machine.Name) {
+			return 0, ""
+		}
+	} else if m.Spec.RemediationTemplate != nil {
+		if r.externalRemediationRequestExists(ctx, m, t.Machine.Name) {
+			return 0, ""
+		}
+	} else if conditions.IsFalse(t.Machine, clusterv1.MachineOwnerRemediatedCondition) {
+		return 0, ""
+	}
+
+	if wait, message := remediationRateLimit(m, now); wait > 0 {
+		logger.V(3).Info("Delaying remediation because of the remediation rate limit", "target", t.string(), "requeueIn", wait.Truncate(time.Second).String())
+		return wait, message
+	}
+
+	if wait := machineRemediationBackoff(m, t.Machine, now); wait > 0 {
+		logger.V(3).Info("Delaying remediation because the target has been recently remediated", "target", t.string(), "requeueIn", wait.Truncate(time.Second).String())
+		return wait, ""
+	}
+	return 0, ""
 }
 
 // clusterToMachineHealthCheck maps events from Cluster objects to
@@ -644,12 +761,17 @@ func unhealthyMachineCount(mhc *clusterv1.MachineHealthCheck) int {
 
 // getExternalRemediationRequest gets reference to External Remediation Request, unstructured object.
 func (r *Reconciler) getExternalRemediationRequest(ctx context.Context, m *clusterv1.MachineHealthCheck, machineName string) (*unstructured.Unstructured, error) {
+	return r.getRemediationRequest(ctx, m.Spec.RemediationTemplate, m.Namespace, machineName)
+}
+
+// getRemediationRequest gets the remediation request created for a Machine from the given remediation template.
+func (r *Reconciler) getRemediationRequest(ctx context.Context, templateRef *corev1.ObjectReference, namespace, machineName string) (*unstructured.Unstructured, error) {
 	remediationRef := &corev1.ObjectReference{
-		APIVersion: m.Spec.RemediationTemplate.APIVersion,
-		Kind:       strings.TrimSuffix(m.Spec.RemediationTemplate.Kind, clusterv1.TemplateSuffix),
+		APIVersion: templateRef.APIVersion,
+		Kind:       strings.TrimSuffix(templateRef.Kind, clusterv1.TemplateSuffix),
 		Name:       machineName,
 	}
-	remediationReq, err := external.Get(ctx, r.Client, remediationRef, m.Namespace)
+	remediationReq, err := external.Get(ctx, r.Client, remediationRef, namespace)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to retrieve external remediation request object")
 	}
@@ -0,0 +1,189 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinehealthcheck
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// remediationRateLimitWindow is the window used to enforce the maximum number of remediations per hour.
+const remediationRateLimitWindow = time.Hour
+
+// pruneRemediationHistory removes from the remediation history the entries which are not required anymore
+// to enforce the remediation backoff, i.e. the entries of the targets not remediated since one hour, or since
+// maxBackoff if longer.
+func pruneRemediationHistory(m *clusterv1.MachineHealthCheck, now time.Time) {
+	if m.Spec.RemediationBackoff == nil {
+		m.Status.RemediationHistory = nil
+		return
+	}
+
+	retention := remediationRateLimitWindow
+	if maxBackoff := remediationMaxBackoff(m); maxBackoff > retention {
+		retention = maxBackoff
+	}
+
+	lastRemediation := map[string]time.Time{}
+	for _, r := range m.Status.RemediationHistory {
+		if r.Time.After(lastRemediation[historyTarget(r)]) {
+			lastRemediation[historyTarget(r)] = r.Time.Time
+		}
+	}
+
+	history := []clusterv1.MachineHealthCheckRemediation{}
+	for _, r := range m.Status.RemediationHistory {
+		if now.Sub(lastRemediation[historyTarget(r)]) < retention {
+			history = append(history, r)
+		}
+	}
+
+	m.Status.RemediationHistory = nil
+	if len(history) > 0 {
+		m.Status.RemediationHistory = history
+	}
+}
+
+// remediationTarget returns the identity used to track the remediations of a Machine across replacements,
+// i.e. Kind/Name of the controller owner of the Machine, or the name of the Machine if it has no controller owner.
+// NOTE: Remediation usually deletes the Machine and its owner creates a replacement with a different name,
+// so keying the remediation history by Machine name would never delay the remediation of a flapping replacement.
+func remediationTarget(machine *clusterv1.Machine) string {
+	if owner := metav1.GetControllerOf(machine); owner != nil {
+		return fmt.Sprintf("%s/%s", owner.Kind, owner.Name)
+	}
+	return machine.Name
+}
+
+// historyTarget returns the target of an entry of the remediation history; entries recorded before
+// the target was introduced are tracked by Machine name.
+func historyTarget(r clusterv1.MachineHealthCheckRemediation) string {
+	if r.Target != "" {
+		return r.Target
+	}
+	return r.MachineName
+}
+
+// recordRemediation records a remediation of the Machine in the remediation history, if a remediation backoff is set.
+func recordRemediation(m *clusterv1.MachineHealthCheck, machine *clusterv1.Machine, now time.Time) {
+	if m.Spec.RemediationBackoff == nil {
+		return
+	}
+	m.Status.RemediationHistory = append(m.Status.RemediationHistory, clusterv1.MachineHealthCheckRemediation{
+		MachineName: machine.Name,
+		Target:      remediationTarget(machine),
+		Time:        metav1.NewTime(now),
+	})
+}
+
+// remediationRateLimit returns the time to wait before the MachineHealthCheck can trigger a new remediation
+// according to the cooldown period and to the maximum number of remediations per hour, if any, and a message
+// explaining why remediations are not allowed.
+func remediationRateLimit(m *clusterv1.MachineHealthCheck, now time.Time) (time.Duration, string) {
+	backoff := m.Spec.RemediationBackoff
+	if backoff == nil || len(m.Status.RemediationHistory) == 0 {
+		return 0, ""
+	}
+
+	var wait time.Duration
+	var message string
+
+	if backoff.CooldownPeriod != nil {
+		var lastRemediation time.Time
+		for _, r := range m.Status.RemediationHistory {
+			if r.Time.After(lastRemediation) {
+				lastRemediation = r.Time.Time
+			}
+		}
+		if w := lastRemediation.Add(backoff.CooldownPeriod.Duration).Sub(now); w > 0 {
+			wait = w
+			message = fmt.Sprintf("Remediation is not allowed, the cooldown period of %s since the last remediation is not expired yet", backoff.CooldownPeriod.Duration)
+		}
+	}
+
+	if backoff.MaxRemediationsPerHour != nil {
+		recentRemediations := []time.Time{}
+		for _, r := range m.Status.RemediationHistory {
+			if now.Sub(r.Time.Time) < remediationRateLimitWindow {
+				recentRemediations = append(recentRemediations, r.Time.Time)
+			}
+		}
+		if excess := len(recentRemediations) - int(*backoff.MaxRemediationsPerHour); excess >= 0 {
+			// Remediations are allowed again when the oldest remediations exceeding the limit move out of the window.
+			sort.Slice(recentRemediations, func(i, j int) bool { return recentRemediations[i].Before(recentRemediations[j]) })
+			if w := recentRemediations[excess].Add(remediationRateLimitWindow).Sub(now); w > wait {
+				wait = w
+				message = fmt.Sprintf("Remediation is not allowed, the maximum number of remediations per hour (%d) has been reached", *backoff.MaxRemediationsPerHour)
+			}
+		}
+	}
+
+	return wait, message
+}
+
+// machineRemediationBackoff returns the time to wait before the MachineHealthCheck can trigger again the remediation
+// of a Machine; the time to wait starts from initialBackoff and it doubles at every remediation of the same target,
+// i.e. of Machines with the same owner, recorded in the remediation history, up to maxBackoff.
+func machineRemediationBackoff(m *clusterv1.MachineHealthCheck, machine *clusterv1.Machine, now time.Time) time.Duration {
+	backoff := m.Spec.RemediationBackoff
+	if backoff == nil || backoff.InitialBackoff == nil {
+		return 0
+	}
+
+	target := remediationTarget(machine)
+	remediations := 0
+	var lastRemediation time.Time
+	for _, r := range m.Status.RemediationHistory {
+		if historyTarget(r) != target {
+			continue
+		}
+		remediations++
+		if r.Time.After(lastRemediation) {
+			lastRemediation = r.Time.Time
+		}
+	}
+	if remediations == 0 {
+		return 0
+	}
+
+	maxBackoff := remediationMaxBackoff(m)
+	wait := backoff.InitialBackoff.Duration
+	for i := 1; i < remediations && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		wait = maxBackoff
+	}
+
+	if w := lastRemediation.Add(wait).Sub(now); w > 0 {
+		return w
+	}
+	return 0
+}
+
+// remediationMaxBackoff returns the maximum time to wait before triggering again the remediation of the same target.
+func remediationMaxBackoff(m *clusterv1.MachineHealthCheck) time.Duration {
+	if m.Spec.RemediationBackoff == nil || m.Spec.RemediationBackoff.MaxBackoff == nil {
+		return clusterv1.DefaultRemediationMaxBackoff.Duration
+	}
+	return m.Spec.RemediationBackoff.MaxBackoff.Duration
+}
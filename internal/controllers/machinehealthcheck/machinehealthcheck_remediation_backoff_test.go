@@ -0,0 +1,355 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinehealthcheck
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestPruneRemediationHistory(t *testing.T) {
+	now := time.Now()
+	remediation := func(name string, ago time.Duration) clusterv1.MachineHealthCheckRemediation {
+		return clusterv1.MachineHealthCheckRemediation{MachineName: name, Time: metav1.NewTime(now.Add(-ago))}
+	}
+
+	tests := []struct {
+		name        string
+		backoff     *clusterv1.MachineHealthCheckRemediationBackoff
+		history     []clusterv1.MachineHealthCheckRemediation
+		wantHistory []clusterv1.MachineHealthCheckRemediation
+	}{
+		{
+			name:        "history is dropped when remediationBackoff is not set",
+			backoff:     nil,
+			history:     []clusterv1.MachineHealthCheckRemediation{remediation("a", time.Minute)},
+			wantHistory: nil,
+		},
+		{
+			name:    "remediations of machines not remediated since maxBackoff are dropped",
+			backoff: &clusterv1.MachineHealthCheckRemediationBackoff{MaxBackoff: &metav1.Duration{Duration: 2 * time.Hour}},
+			history: []clusterv1.MachineHealthCheckRemediation{
+				remediation("a", 3*time.Hour),
+				remediation("b", 3*time.Hour),
+				remediation("b", 90*time.Minute),
+			},
+			wantHistory: []clusterv1.MachineHealthCheckRemediation{
+				remediation("b", 3*time.Hour),
+				remediation("b", 90*time.Minute),
+			},
+		},
+		{
+			name:    "remediations are kept for at least the rate limit window",
+			backoff: &clusterv1.MachineHealthCheckRemediationBackoff{MaxBackoff: &metav1.Duration{Duration: time.Minute}},
+			history: []clusterv1.MachineHealthCheckRemediation{
+				remediation("a", 2*time.Hour),
+				remediation("b", 30*time.Minute),
+			},
+			wantHistory: []clusterv1.MachineHealthCheckRemediation{
+				remediation("b", 30*time.Minute),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := &clusterv1.MachineHealthCheck{
+				Spec:   clusterv1.MachineHealthCheckSpec{RemediationBackoff: tt.backoff},
+				Status: clusterv1.MachineHealthCheckStatus{RemediationHistory: tt.history},
+			}
+			pruneRemediationHistory(m, now)
+			g.Expect(m.Status.RemediationHistory).To(Equal(tt.wantHistory))
+		})
+	}
+}
+
+func TestRemediationRateLimit(t *testing.T) {
+	now := time.Now()
+	remediation := func(name string, ago time.Duration) clusterv1.MachineHealthCheckRemediation {
+		return clusterv1.MachineHealthCheckRemediation{MachineName: name, Time: metav1.NewTime(now.Add(-ago))}
+	}
+
+	tests := []struct {
+		name     string
+		backoff  *clusterv1.MachineHealthCheckRemediationBackoff
+		history  []clusterv1.MachineHealthCheckRemediation
+		wantWait time.Duration
+	}{
+		{
+			name:     "no rate limit when remediationBackoff is not set",
+			backoff:  nil,
+			history:  []clusterv1.MachineHealthCheckRemediation{remediation("a", time.Second)},
+			wantWait: 0,
+		},
+		{
+			name:     "cooldown period not expired",
+			backoff:  &clusterv1.MachineHealthCheckRemediationBackoff{CooldownPeriod: &metav1.Duration{Duration: 10 * time.Minute}},
+			history:  []clusterv1.MachineHealthCheckRemediation{remediation("a", 20*time.Minute), remediation("b", 4*time.Minute)},
+			wantWait: 6 * time.Minute,
+		},
+		{
+			name:     "cooldown period expired",
+			backoff:  &clusterv1.MachineHealthCheckRemediationBackoff{CooldownPeriod: &metav1.Duration{Duration: 10 * time.Minute}},
+			history:  []clusterv1.MachineHealthCheckRemediation{remediation("a", 20*time.Minute)},
+			wantWait: 0,
+		},
+		{
+			name:     "maximum number of remediations per hour not reached",
+			backoff:  &clusterv1.MachineHealthCheckRemediationBackoff{MaxRemediationsPerHour: ptr.To[int32](2)},
+			history:  []clusterv1.MachineHealthCheckRemediation{remediation("a", 50*time.Minute), remediation("b", 70*time.Minute)},
+			wantWait: 0,
+		},
+		{
+			name:    "maximum number of remediations per hour reached",
+			backoff: &clusterv1.MachineHealthCheckRemediationBackoff{MaxRemediationsPerHour: ptr.To[int32](2)},
+			history: []clusterv1.MachineHealthCheckRemediation{
+				remediation("a", 10*time.Minute),
+				remediation("b", 40*time.Minute),
+				remediation("c", 50*time.Minute),
+			},
+			wantWait: 20 * time.Minute,
+		},
+		{
+			name: "the longest wait wins",
+			backoff: &clusterv1.MachineHealthCheckRemediationBackoff{
+				MaxRemediationsPerHour: ptr.To[int32](1),
+				CooldownPeriod:         &metav1.Duration{Duration: 10 * time.Minute},
+			},
+			history:  []clusterv1.MachineHealthCheckRemediation{remediation("a", 5*time.Minute)},
+			wantWait: 55 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := &clusterv1.MachineHealthCheck{
+				Spec:   clusterv1.MachineHealthCheckSpec{RemediationBackoff: tt.backoff},
+				Status: clusterv1.MachineHealthCheckStatus{RemediationHistory: tt.history},
+			}
+			wait, message := remediationRateLimit(m, now)
+			g.Expect(wait).To(BeNumerically("~", tt.wantWait, time.Second))
+			if tt.wantWait > 0 {
+				g.Expect(message).ToNot(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestMachineRemediationBackoff(t *testing.T) {
+	now := time.Now()
+	remediation := func(name string, ago time.Duration) clusterv1.MachineHealthCheckRemediation {
+		return clusterv1.MachineHealthCheckRemediation{MachineName: name, Time: metav1.NewTime(now.Add(-ago))}
+	}
+	ownedRemediation := func(name, owner string, ago time.Duration) clusterv1.MachineHealthCheckRemediation {
+		return clusterv1.MachineHealthCheckRemediation{MachineName: name, Target: "MachineSet/" + owner, Time: metav1.NewTime(now.Add(-ago))}
+	}
+	backoff := &clusterv1.MachineHealthCheckRemediationBackoff{
+		InitialBackoff: &metav1.Duration{Duration: 5 * time.Minute},
+		MaxBackoff:     &metav1.Duration{Duration: 15 * time.Minute},
+	}
+
+	tests := []struct {
+		name     string
+		backoff  *clusterv1.MachineHealthCheckRemediationBackoff
+		machine  *clusterv1.Machine
+		history  []clusterv1.MachineHealthCheckRemediation
+		wantWait time.Duration
+	}{
+		{
+			name:     "no backoff when remediationBackoff is not set",
+			backoff:  nil,
+			history:  []clusterv1.MachineHealthCheckRemediation{remediation("a", time.Second)},
+			wantWait: 0,
+		},
+		{
+			name:     "no backoff when the machine has never been remediated",
+			backoff:  backoff,
+			history:  []clusterv1.MachineHealthCheckRemediation{remediation("b", time.Second)},
+			wantWait: 0,
+		},
+		{
+			name:     "initialBackoff after the first remediation",
+			backoff:  backoff,
+			history:  []clusterv1.MachineHealthCheckRemediation{remediation("a", 2*time.Minute)},
+			wantWait: 3 * time.Minute,
+		},
+		{
+			name:     "backoff doubles at every remediation",
+			backoff:  backoff,
+			history:  []clusterv1.MachineHealthCheckRemediation{remediation("a", 12*time.Minute), remediation("a", 2*time.Minute)},
+			wantWait: 8 * time.Minute,
+		},
+		{
+			name:    "backoff is capped to maxBackoff",
+			backoff: backoff,
+			history: []clusterv1.MachineHealthCheckRemediation{
+				remediation("a", 40*time.Minute),
+				remediation("a", 30*time.Minute),
+				remediation("a", 20*time.Minute),
+				remediation("a", 10*time.Minute),
+			},
+			wantWait: 5 * time.Minute,
+		},
+		{
+			name:     "backoff expired",
+			backoff:  backoff,
+			history:  []clusterv1.MachineHealthCheckRemediation{remediation("a", 6*time.Minute)},
+			wantWait: 0,
+		},
+		{
+			name:    "backoff applies to the replacement of a remediated machine with the same owner",
+			backoff: backoff,
+			machine: ownedMachine("replacement", "ms-1"),
+			history: []clusterv1.MachineHealthCheckRemediation{
+				ownedRemediation("a", "ms-1", 12*time.Minute),
+				ownedRemediation("b", "ms-1", 2*time.Minute),
+			},
+			wantWait: 8 * time.Minute,
+		},
+		{
+			name:     "no backoff for machines with a different owner",
+			backoff:  backoff,
+			machine:  ownedMachine("replacement", "ms-2"),
+			history:  []clusterv1.MachineHealthCheckRemediation{ownedRemediation("a", "ms-1", 2*time.Minute)},
+			wantWait: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := tt.machine
+			if machine == nil {
+				machine = &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "a"}}
+			}
+			m := &clusterv1.MachineHealthCheck{
+				Spec:   clusterv1.MachineHealthCheckSpec{RemediationBackoff: tt.backoff},
+				Status: clusterv1.MachineHealthCheckStatus{RemediationHistory: tt.history},
+			}
+			g.Expect(machineRemediationBackoff(m, machine, now)).To(BeNumerically("~", tt.wantWait, time.Second))
+		})
+	}
+}
+
+func TestRecordRemediation(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now()
+	m := &clusterv1.MachineHealthCheck{
+		Spec: clusterv1.MachineHealthCheckSpec{RemediationBackoff: &clusterv1.MachineHealthCheckRemediationBackoff{}},
+	}
+	recordRemediation(m, ownedMachine("a", "ms-1"), now)
+	recordRemediation(m, &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "b"}}, now)
+	g.Expect(m.Status.RemediationHistory).To(Equal([]clusterv1.MachineHealthCheckRemediation{
+		{MachineName: "a", Target: "MachineSet/ms-1", Time: metav1.NewTime(now)},
+		{MachineName: "b", Target: "b", Time: metav1.NewTime(now)},
+	}))
+}
+
+func ownedMachine(name, owner string) *clusterv1.Machine {
+	return &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: clusterv1.GroupVersion.String(), Kind: "MachineSet", Name: owner, Controller: ptr.To(true)},
+			},
+		},
+	}
+}
+
+func TestReconcileRemediationRateLimited(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: metav1.NamespaceDefault},
+	}
+	labels := map[string]string{"selector": "rate-limited"}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machine",
+			Namespace: metav1.NamespaceDefault,
+			Labels: map[string]string{
+				"selector":                 "rate-limited",
+				clusterv1.ClusterNameLabel: cluster.Name,
+			},
+		},
+		Spec: clusterv1.MachineSpec{ClusterName: cluster.Name},
+		Status: clusterv1.MachineStatus{
+			FailureReason: ptr.To(capierrors.MachineStatusError("InvalidConfiguration")),
+		},
+	}
+	maxUnhealthy := intstr.FromString("100%")
+	m := &clusterv1.MachineHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{Name: "mhc", Namespace: metav1.NamespaceDefault},
+		Spec: clusterv1.MachineHealthCheckSpec{
+			ClusterName:        cluster.Name,
+			Selector:           metav1.LabelSelector{MatchLabels: labels},
+			MaxUnhealthy:       &maxUnhealthy,
+			RemediationBackoff: &clusterv1.MachineHealthCheckRemediationBackoff{MaxRemediationsPerHour: ptr.To[int32](1)},
+		},
+		Status: clusterv1.MachineHealthCheckStatus{
+			RemediationHistory: []clusterv1.MachineHealthCheckRemediation{
+				{MachineName: "another-machine", Time: metav1.NewTime(time.Now().Add(-5 * time.Minute))},
+			},
+		},
+	}
+
+	recorder := record.NewFakeRecorder(32)
+	r := &Reconciler{
+		Client:   fake.NewClientBuilder().WithObjects(machine).WithStatusSubresource(&clusterv1.Machine{}).Build(),
+		recorder: recorder,
+	}
+
+	res, err := r.reconcile(ctx, ctrl.LoggerFrom(ctx), cluster, m)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.RequeueAfter).To(BeNumerically(">", 0))
+	g.Expect(conditions.IsFalse(m, clusterv1.RemediationAllowedCondition)).To(BeTrue())
+	g.Expect(conditions.GetReason(m, clusterv1.RemediationAllowedCondition)).To(Equal(clusterv1.RemediationRateLimitedReason))
+	g.Expect(recorder.Events).To(HaveLen(1))
+	g.Expect(<-recorder.Events).To(ContainSubstring(EventRemediationRestricted))
+
+	// Move the last transition time in the past, so it is possible to detect if the condition is flipped.
+	lastTransitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	for i := range m.Status.Conditions {
+		if m.Status.Conditions[i].Type == clusterv1.RemediationAllowedCondition {
+			m.Status.Conditions[i].LastTransitionTime = lastTransitionTime
+		}
+	}
+
+	_, err = r.reconcile(ctx, ctrl.LoggerFrom(ctx), cluster, m)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(conditions.IsFalse(m, clusterv1.RemediationAllowedCondition)).To(BeTrue())
+	g.Expect(conditions.GetLastTransitionTime(m, clusterv1.RemediationAllowedCondition)).To(Equal(&lastTransitionTime))
+	g.Expect(recorder.Events).To(BeEmpty())
+}
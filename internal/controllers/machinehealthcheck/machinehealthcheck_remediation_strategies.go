@@ -0,0 +1,254 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinehealthcheck
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// EventRemediationEscalated is emitted when the remediation of a machine is escalated
+	// to the next remediation strategy.
+	EventRemediationEscalated string = "RemediationEscalated"
+
+	// remediationRequestDeletionRequeue is the time to wait before checking again a remediation request
+	// which is being deleted.
+	remediationRequestDeletionRequeue = 10 * time.Second
+
+	// remediationAttemptAnnotation is the annotation set on the remediation requests created for a remediation strategy,
+	// recording the number of the attempt; it allows to recover the remediation progress if the status of the
+	// MachineHealthCheck failed to be patched after creating the remediation request.
+	remediationAttemptAnnotation = "machinehealthcheck.cluster.x-k8s.io/remediation-attempt"
+)
+
+// remediateWithStrategies applies to an unhealthy target the remediation strategy currently selected in the
+// remediation progress, escalating to the next strategy when the attempts of the current one are exhausted.
+// It returns true if a new remediation has been triggered, and the time after which the target should be checked
+// again, e.g. to enforce the timeout of the current remediation request.
+func (r *Reconciler) remediateWithStrategies(ctx context.Context, logger logr.Logger, m *clusterv1.MachineHealthCheck, t healthCheckTarget, condition *clusterv1.Condition, now time.Time) (bool, time.Duration, error) {
+	strategies := m.Spec.RemediationStrategies
+	progress := getRemediationProgress(m, t.Machine.Name)
+
+	i := remediationStrategyIndex(m, progress.Strategy)
+	if i < 0 {
+		// The strategy of the remediation in progress has been removed from the MachineHealthCheck, start again from the first one.
+		i = 0
+		*progress = clusterv1.MachineHealthCheckRemediationProgress{MachineName: t.Machine.Name, Strategy: strategies[0].Name}
+	}
+
+	for {
+		strategy := strategies[i]
+
+		// Remediation is handed off to the owner of the Machine.
+		if strategy.RemediationTemplate == nil {
+			// NOTE: MHC is responsible for creating MachineOwnerRemediatedCondition if missing or to trigger another remediation if the previous one is completed;
+			// instead, if a remediation is in already progress, the remediation owner is responsible for completing the process and MHC should not overwrite the condition.
+			if conditions.Has(t.Machine, clusterv1.MachineOwnerRemediatedCondition) && !conditions.IsTrue(t.Machine, clusterv1.MachineOwnerRemediatedCondition) {
+				return false, 0, nil
+			}
+			logger.Info("Target has failed health check, marking for remediation", "target", t.string(), "strategy", strategy.Name, "reason", condition.Reason, "message", condition.Message)
+			conditions.MarkFalse(t.Machine, clusterv1.MachineOwnerRemediatedCondition, clusterv1.WaitingForRemediationReason, clusterv1.ConditionSeverityWarning, "")
+			progress.Attempts++
+			progress.LastAttemptTime = ptr.To(metav1.NewTime(now))
+			return true, 0, nil
+		}
+
+		obj, err := r.getRemediationRequest(ctx, strategy.RemediationTemplate, m.Namespace, t.Machine.Name)
+		if err != nil && !apierrors.IsNotFound(errors.Cause(err)) {
+			return false, 0, errors.Wrapf(err, "failed to fetch remediation request for machine %q in namespace %q within cluster %q", t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName)
+		}
+
+		// The remediation request of the current attempt still exists, wait for it to complete or to time out.
+		if err == nil {
+			syncRemediationProgress(progress, obj)
+			if obj.GetDeletionTimestamp() != nil {
+				return false, remediationRequestDeletionRequeue, nil
+			}
+			if strategy.Timeout == nil || progress.LastAttemptTime == nil {
+				return false, 0, nil
+			}
+			if wait := progress.LastAttemptTime.Add(strategy.Timeout.Duration).Sub(now); wait > 0 {
+				return false, wait, nil
+			}
+			logger.Info("Remediation request timed out, deleting it", "target", t.string(), "strategy", strategy.Name, "remediation request name", obj.GetName(), "timeout", strategy.Timeout.Duration.String())
+			if err := r.Client.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+				return false, 0, errors.Wrapf(err, "failed to delete %v %q for Machine %q", obj.GroupVersionKind(), obj.GetName(), t.Machine.Name)
+			}
+			return false, remediationRequestDeletionRequeue, nil
+		}
+
+		if progress.Attempts < remediationStrategyMaxAttempts(strategy) {
+			annotations := map[string]string{remediationAttemptAnnotation: strconv.Itoa(int(progress.Attempts) + 1)}
+			if err := r.createExternalRemediationRequest(ctx, logger, m, t, strategy.RemediationTemplate, condition, annotations); err != nil {
+				return false, 0, err
+			}
+			progress.Attempts++
+			progress.LastAttemptTime = ptr.To(metav1.NewTime(now))
+			if strategy.Timeout != nil {
+				return true, strategy.Timeout.Duration, nil
+			}
+			return true, 0, nil
+		}
+
+		if i == len(strategies)-1 {
+			logger.V(3).Info("All the remediation strategies have been attempted", "target", t.string(), "strategy", strategy.Name)
+			return false, 0, nil
+		}
+
+		i++
+		logger.Info("Escalating remediation to the next strategy", "target", t.string(), "from", strategy.Name, "to", strategies[i].Name)
+		r.recorder.Eventf(
+			t.Machine,
+			corev1.EventTypeWarning,
+			EventRemediationEscalated,
+			"Remediation of Machine %v escalated from strategy %s to %s after %d attempt(s)",
+			t.string(),
+			strategy.Name,
+			strategies[i].Name,
+			progress.Attempts,
+		)
+		*progress = clusterv1.MachineHealthCheckRemediationProgress{MachineName: t.Machine.Name, Strategy: strategies[i].Name}
+	}
+}
+
+// syncRemediationProgress updates the remediation progress of a Machine with the attempt recorded on an existing
+// remediation request of the current strategy, so attempts are not lost if the status of the MachineHealthCheck
+// failed to be patched after creating the remediation request.
+func syncRemediationProgress(progress *clusterv1.MachineHealthCheckRemediationProgress, obj *unstructured.Unstructured) {
+	// If a remediation request exists, at least one attempt has been made.
+	attempt := int32(1)
+	if v, err := strconv.ParseInt(obj.GetAnnotations()[remediationAttemptAnnotation], 10, 32); err == nil && v > 0 {
+		attempt = int32(v)
+	}
+
+	creationTimestamp := obj.GetCreationTimestamp()
+	if progress.Attempts < attempt {
+		progress.Attempts = attempt
+		progress.LastAttemptTime = nil
+	}
+	if progress.LastAttemptTime == nil && !creationTimestamp.IsZero() {
+		progress.LastAttemptTime = &creationTimestamp
+	}
+}
+
+// getRemediationProgress returns the remediation progress of a Machine, adding a new entry for the first remediation
+// strategy if the remediation of the Machine is not in progress yet.
+func getRemediationProgress(m *clusterv1.MachineHealthCheck, machineName string) *clusterv1.MachineHealthCheckRemediationProgress {
+	for i := range m.Status.RemediationProgress {
+		if m.Status.RemediationProgress[i].MachineName == machineName {
+			return &m.Status.RemediationProgress[i]
+		}
+	}
+	m.Status.RemediationProgress = append(m.Status.RemediationProgress, clusterv1.MachineHealthCheckRemediationProgress{
+		MachineName: machineName,
+		Strategy:    m.Spec.RemediationStrategies[0].Name,
+	})
+	return &m.Status.RemediationProgress[len(m.Status.RemediationProgress)-1]
+}
+
+// hasRemediationProgress returns true if the remediation of a Machine through the remediation strategies is in progress.
+func hasRemediationProgress(m *clusterv1.MachineHealthCheck, machineName string) bool {
+	for _, p := range m.Status.RemediationProgress {
+		if p.MachineName == machineName && p.Attempts > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// removeRemediationProgress removes the remediation progress of a Machine, e.g. when it is healthy again.
+func removeRemediationProgress(m *clusterv1.MachineHealthCheck, machineName string) {
+	progress := []clusterv1.MachineHealthCheckRemediationProgress{}
+	for _, p := range m.Status.RemediationProgress {
+		if p.MachineName != machineName {
+			progress = append(progress, p)
+		}
+	}
+
+	m.Status.RemediationProgress = nil
+	if len(progress) > 0 {
+		m.Status.RemediationProgress = progress
+	}
+}
+
+// pruneRemediationProgress removes from the remediation progress the entries of the Machines which are not targets
+// of the MachineHealthCheck anymore.
+func pruneRemediationProgress(m *clusterv1.MachineHealthCheck) {
+	if len(m.Spec.RemediationStrategies) == 0 {
+		m.Status.RemediationProgress = nil
+		return
+	}
+
+	targets := sets.New[string](m.Status.Targets...)
+	progress := []clusterv1.MachineHealthCheckRemediationProgress{}
+	for _, p := range m.Status.RemediationProgress {
+		if targets.Has(p.MachineName) {
+			progress = append(progress, p)
+		}
+	}
+
+	m.Status.RemediationProgress = nil
+	if len(progress) > 0 {
+		m.Status.RemediationProgress = progress
+	}
+}
+
+// remediationStrategyIndex returns the index of the remediation strategy with the given name, or -1 if it does not exist.
+func remediationStrategyIndex(m *clusterv1.MachineHealthCheck, name string) int {
+	for i, s := range m.Spec.RemediationStrategies {
+		if s.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// remediationStrategyMaxAttempts returns the number of remediation requests to create with a strategy before escalating.
+func remediationStrategyMaxAttempts(s clusterv1.MachineHealthCheckRemediationStrategy) int32 {
+	if s.MaxAttempts == nil {
+		return 1
+	}
+	return *s.MaxAttempts
+}
+
+// remediationTemplates returns the remediation templates which can be used by the MachineHealthCheck.
+func remediationTemplates(m *clusterv1.MachineHealthCheck) []*corev1.ObjectReference {
+	if m.Spec.RemediationTemplate != nil {
+		return []*corev1.ObjectReference{m.Spec.RemediationTemplate}
+	}
+	templates := []*corev1.ObjectReference{}
+	for _, s := range m.Spec.RemediationStrategies {
+		if s.RemediationTemplate != nil {
+			templates = append(templates, s.RemediationTemplate)
+		}
+	}
+	return templates
+}
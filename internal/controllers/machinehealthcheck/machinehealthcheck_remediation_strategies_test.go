@@ -0,0 +1,226 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinehealthcheck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestPruneRemediationProgress(t *testing.T) {
+	g := NewWithT(t)
+
+	mhc := &clusterv1.MachineHealthCheck{
+		Spec: clusterv1.MachineHealthCheckSpec{
+			RemediationStrategies: []clusterv1.MachineHealthCheckRemediationStrategy{{Name: "delete"}},
+		},
+		Status: clusterv1.MachineHealthCheckStatus{
+			Targets: []string{"a"},
+			RemediationProgress: []clusterv1.MachineHealthCheckRemediationProgress{
+				{MachineName: "a", Strategy: "delete", Attempts: 1},
+				{MachineName: "b", Strategy: "delete", Attempts: 1},
+			},
+		},
+	}
+
+	pruneRemediationProgress(mhc)
+	g.Expect(mhc.Status.RemediationProgress).To(Equal([]clusterv1.MachineHealthCheckRemediationProgress{
+		{MachineName: "a", Strategy: "delete", Attempts: 1},
+	}))
+
+	mhc.Spec.RemediationStrategies = nil
+	pruneRemediationProgress(mhc)
+	g.Expect(mhc.Status.RemediationProgress).To(BeNil())
+}
+
+func TestRemediateWithStrategies(t *testing.T) {
+	now := time.Now()
+	namespace := metav1.NamespaceDefault
+
+	templateRef := &corev1.ObjectReference{
+		APIVersion: "remediation.test.io/v1beta1",
+		Kind:       "RebootRemediationTemplate",
+		Name:       "reboot",
+		Namespace:  namespace,
+	}
+	template := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": templateRef.APIVersion,
+			"kind":       templateRef.Kind,
+			"metadata": map[string]interface{}{
+				"name":      templateRef.Name,
+				"namespace": namespace,
+			},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{},
+				},
+			},
+		},
+	}
+	request := func(machineName string) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(templateRef.APIVersion)
+		u.SetKind("RebootRemediation")
+		u.SetName(machineName)
+		u.SetNamespace(namespace)
+		return u
+	}
+	recordedRequest := func(machineName, attempt string, creationTimestamp metav1.Time) *unstructured.Unstructured {
+		u := request(machineName)
+		u.SetAnnotations(map[string]string{remediationAttemptAnnotation: attempt})
+		u.SetCreationTimestamp(creationTimestamp)
+		return u
+	}
+	strategies := []clusterv1.MachineHealthCheckRemediationStrategy{
+		{Name: "reboot", RemediationTemplate: templateRef, MaxAttempts: ptr.To[int32](2), Timeout: &metav1.Duration{Duration: 10 * time.Minute}},
+		{Name: "delete"},
+	}
+	requestCreationTimestamp := metav1.NewTime(now.Add(-4 * time.Minute).Truncate(time.Second))
+
+	tests := []struct {
+		name                 string
+		progress             []clusterv1.MachineHealthCheckRemediationProgress
+		objects              []client.Object
+		wantTriggered        bool
+		wantWait             time.Duration
+		wantProgress         clusterv1.MachineHealthCheckRemediationProgress
+		wantRequest          bool
+		wantRequestAttempt   string
+		wantOwnerRemediation bool
+	}{
+		{
+			name:               "creates a remediation request with the first strategy",
+			progress:           nil,
+			wantTriggered:      true,
+			wantWait:           10 * time.Minute,
+			wantProgress:       clusterv1.MachineHealthCheckRemediationProgress{MachineName: "m", Strategy: "reboot", Attempts: 1, LastAttemptTime: ptr.To(metav1.NewTime(now))},
+			wantRequest:        true,
+			wantRequestAttempt: "1",
+		},
+		{
+			name:          "recovers the attempt from the remediation request if it is not recorded in the progress",
+			progress:      nil,
+			objects:       []client.Object{recordedRequest("m", "2", requestCreationTimestamp)},
+			wantTriggered: false,
+			wantWait:      requestCreationTimestamp.Add(10 * time.Minute).Sub(now),
+			wantProgress:  clusterv1.MachineHealthCheckRemediationProgress{MachineName: "m", Strategy: "reboot", Attempts: 2, LastAttemptTime: &requestCreationTimestamp},
+			wantRequest:   true,
+		},
+		{
+			name: "waits for the remediation request to time out",
+			progress: []clusterv1.MachineHealthCheckRemediationProgress{
+				{MachineName: "m", Strategy: "reboot", Attempts: 1, LastAttemptTime: ptr.To(metav1.NewTime(now.Add(-4 * time.Minute)))},
+			},
+			objects:       []client.Object{request("m")},
+			wantTriggered: false,
+			wantWait:      6 * time.Minute,
+			wantProgress:  clusterv1.MachineHealthCheckRemediationProgress{MachineName: "m", Strategy: "reboot", Attempts: 1, LastAttemptTime: ptr.To(metav1.NewTime(now.Add(-4 * time.Minute)))},
+			wantRequest:   true,
+		},
+		{
+			name: "deletes the remediation request after the timeout",
+			progress: []clusterv1.MachineHealthCheckRemediationProgress{
+				{MachineName: "m", Strategy: "reboot", Attempts: 1, LastAttemptTime: ptr.To(metav1.NewTime(now.Add(-11 * time.Minute)))},
+			},
+			objects:       []client.Object{request("m")},
+			wantTriggered: false,
+			wantWait:      remediationRequestDeletionRequeue,
+			wantProgress:  clusterv1.MachineHealthCheckRemediationProgress{MachineName: "m", Strategy: "reboot", Attempts: 1, LastAttemptTime: ptr.To(metav1.NewTime(now.Add(-11 * time.Minute)))},
+			wantRequest:   false,
+		},
+		{
+			name: "retries with the same strategy until maxAttempts is reached",
+			progress: []clusterv1.MachineHealthCheckRemediationProgress{
+				{MachineName: "m", Strategy: "reboot", Attempts: 1, LastAttemptTime: ptr.To(metav1.NewTime(now.Add(-11 * time.Minute)))},
+			},
+			wantTriggered:      true,
+			wantWait:           10 * time.Minute,
+			wantProgress:       clusterv1.MachineHealthCheckRemediationProgress{MachineName: "m", Strategy: "reboot", Attempts: 2, LastAttemptTime: ptr.To(metav1.NewTime(now))},
+			wantRequest:        true,
+			wantRequestAttempt: "2",
+		},
+		{
+			name: "escalates to the next strategy when maxAttempts is reached",
+			progress: []clusterv1.MachineHealthCheckRemediationProgress{
+				{MachineName: "m", Strategy: "reboot", Attempts: 2, LastAttemptTime: ptr.To(metav1.NewTime(now.Add(-11 * time.Minute)))},
+			},
+			wantTriggered:        true,
+			wantWait:             0,
+			wantProgress:         clusterv1.MachineHealthCheckRemediationProgress{MachineName: "m", Strategy: "delete", Attempts: 1, LastAttemptTime: ptr.To(metav1.NewTime(now))},
+			wantRequest:          false,
+			wantOwnerRemediation: true,
+		},
+		{
+			name: "starts again from the first strategy when the current one does not exist anymore",
+			progress: []clusterv1.MachineHealthCheckRemediationProgress{
+				{MachineName: "m", Strategy: "power-cycle", Attempts: 3},
+			},
+			wantTriggered: true,
+			wantWait:      10 * time.Minute,
+			wantProgress:  clusterv1.MachineHealthCheckRemediationProgress{MachineName: "m", Strategy: "reboot", Attempts: 1, LastAttemptTime: ptr.To(metav1.NewTime(now))},
+			wantRequest:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mhc := newMachineHealthCheck(namespace, testClusterName)
+			mhc.Spec.RemediationStrategies = strategies
+			mhc.Status.RemediationProgress = tt.progress
+			machine := newTestMachine("m", namespace, testClusterName, "node", nil)
+
+			c := fake.NewClientBuilder().WithObjects(append(tt.objects, template)...).Build()
+			r := &Reconciler{
+				Client:   c,
+				recorder: record.NewFakeRecorder(32),
+			}
+			target := healthCheckTarget{MHC: mhc, Machine: machine}
+			condition := &clusterv1.Condition{Type: clusterv1.MachineHealthCheckSucceededCondition}
+
+			triggered, wait, err := r.remediateWithStrategies(ctx, logr.New(log.NullLogSink{}), mhc, target, condition, now)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(triggered).To(Equal(tt.wantTriggered))
+			g.Expect(wait).To(Equal(tt.wantWait))
+			g.Expect(mhc.Status.RemediationProgress).To(HaveLen(1))
+			g.Expect(mhc.Status.RemediationProgress[0]).To(BeComparableTo(tt.wantProgress))
+
+			obj, err := r.getRemediationRequest(ctx, templateRef, namespace, machine.Name)
+			g.Expect(err == nil).To(Equal(tt.wantRequest))
+			if tt.wantRequestAttempt != "" {
+				g.Expect(obj.GetAnnotations()).To(HaveKeyWithValue(remediationAttemptAnnotation, tt.wantRequestAttempt))
+			}
+			g.Expect(conditions.IsFalse(machine, clusterv1.MachineOwnerRemediatedCondition)).To(Equal(tt.wantOwnerRemediation))
+		})
+	}
+}
@@ -43,18 +43,21 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
 	"sigs.k8s.io/cluster-api/controllers/remote"
-	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/controllers/machine"
+	runtimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/collections"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/contract"
 	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
+	"sigs.k8s.io/cluster-api/util/failuredomains"
 	"sigs.k8s.io/cluster-api/util/labels/format"
 	clog "sigs.k8s.io/cluster-api/util/log"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 var (
@@ -84,9 +87,16 @@ type Reconciler struct {
 	APIReader                 client.Reader
 	Tracker                   *remote.ClusterCacheTracker
 
+	// RuntimeClient is used to call the Runtime Extensions implementing the BeforeMachineCreate hook
+	// as part of the preflight checks.
+	RuntimeClient runtimeclient.Client
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
+
 	ssaCache ssa.Cache
 	recorder record.EventRecorder
 }
@@ -106,6 +116,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		).
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
+		WithEventFilter(predicates.ResourceIsInShard(ctrl.LoggerFrom(ctx), r.Shard)).
 		Watches(
 			&clusterv1.Cluster{},
 			handler.EnqueueRequestsFromMapFunc(clusterToMachineSets),
@@ -459,10 +470,17 @@ func (r *Reconciler) syncReplicas(ctx context.Context, cluster *clusterv1.Cluste
 			errs        []error
 		)
 
+		// Keep track of the Machines in each failure domain, including the ones created below,
+		// so that new Machines are spread evenly when the FailureDomainSpread policy is set.
+		failureDomainMachines := collections.FromMachines(machines...)
+
 		for i := 0; i < diff; i++ {
 			// Create a new logger so the global logger is not modified.
 			log := log
 			machine := r.computeDesiredMachine(ms, nil)
+			if shouldSpreadAcrossFailureDomains(ms) {
+				machine.Spec.FailureDomain = failuredomains.PickFewest(ctx, cluster.Status.FailureDomains, failureDomainMachines)
+			}
 			// Clone and set the infrastructure and bootstrap references.
 			var (
 				infraRef, bootstrapRef *corev1.ObjectReference
@@ -544,6 +562,7 @@ func (r *Reconciler) syncReplicas(ctx context.Context, cluster *clusterv1.Cluste
 			log.Info(fmt.Sprintf("Created machine %d of %d", i+1, diff), "Machine", klog.KObj(machine))
 			r.recorder.Eventf(ms, corev1.EventTypeNormal, "SuccessfulCreate", "Created machine %q", machine.Name)
 			machineList = append(machineList, machine)
+			failureDomainMachines.Insert(machine)
 		}
 
 		if len(errs) > 0 {
@@ -553,13 +572,12 @@ func (r *Reconciler) syncReplicas(ctx context.Context, cluster *clusterv1.Cluste
 	case diff > 0:
 		log.Info(fmt.Sprintf("MachineSet is scaling down to %d replicas by deleting %d machines", *(ms.Spec.Replicas), diff), "replicas", *(ms.Spec.Replicas), "machineCount", len(machines), "deletePolicy", ms.Spec.DeletePolicy)
 
-		deletePriorityFunc, err := getDeletePriorityFunc(ms)
+		machinesToDelete, err := getMachinesToDelete(ms, machines, diff)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 
 		var errs []error
-		machinesToDelete := getMachinesToDeletePrioritized(machines, diff, deletePriorityFunc)
 		for i, machine := range machinesToDelete {
 			log := log.WithValues("Machine", klog.KObj(machine))
 			if machine.GetDeletionTimestamp().IsZero() {
@@ -623,15 +641,18 @@ func (r *Reconciler) computeDesiredMachine(machineSet *clusterv1.MachineSet, exi
 	desiredMachine.Spec.InfrastructureRef = corev1.ObjectReference{}
 	desiredMachine.Spec.Bootstrap.ConfigRef = nil
 
-	// If we are updating an existing Machine reuse the name, uid, infrastructureRef and bootstrap.configRef
-	// from the existingMachine.
+	// If we are updating an existing Machine reuse the name, uid, infrastructureRef, bootstrap.configRef
+	// and failureDomain from the existingMachine.
 	// Note: we use UID to force SSA to update the existing Machine and to not accidentally create a new Machine.
-	// infrastructureRef and bootstrap.configRef remain the same for an existing Machine.
+	// infrastructureRef, bootstrap.configRef and failureDomain remain the same for an existing Machine; in particular
+	// the failure domain of a Machine spread across failure domains is picked on creation, and it must be retained
+	// even if the failureDomainSpread policy of the MachineSet changes afterwards.
 	if existingMachine != nil {
 		desiredMachine.SetName(existingMachine.Name)
 		desiredMachine.SetUID(existingMachine.UID)
 		desiredMachine.Spec.Bootstrap.ConfigRef = existingMachine.Spec.Bootstrap.ConfigRef
 		desiredMachine.Spec.InfrastructureRef = existingMachine.Spec.InfrastructureRef
+		desiredMachine.Spec.FailureDomain = existingMachine.Spec.FailureDomain
 	}
 
 	// Set the in-place mutable fields.
@@ -648,10 +669,20 @@ func (r *Reconciler) computeDesiredMachine(machineSet *clusterv1.MachineSet, exi
 	desiredMachine.Spec.NodeDrainTimeout = machineSet.Spec.Template.Spec.NodeDrainTimeout
 	desiredMachine.Spec.NodeDeletionTimeout = machineSet.Spec.Template.Spec.NodeDeletionTimeout
 	desiredMachine.Spec.NodeVolumeDetachTimeout = machineSet.Spec.Template.Spec.NodeVolumeDetachTimeout
+	desiredMachine.Spec.NodeDrainRules = machineSet.Spec.Template.Spec.NodeDrainRules
+	desiredMachine.Spec.NodeMetadata = machineSet.Spec.Template.Spec.NodeMetadata
 
 	return desiredMachine
 }
 
+// shouldSpreadAcrossFailureDomains returns true if the Machines of the MachineSet should be spread
+// evenly across the failure domains of the Cluster.
+// Note: A failure domain defined in the Machine template always takes precedence.
+func shouldSpreadAcrossFailureDomains(machineSet *clusterv1.MachineSet) bool {
+	return clusterv1.FailureDomainSpreadPolicy(machineSet.Spec.FailureDomainSpread) == clusterv1.EvenFailureDomainSpreadPolicy &&
+		machineSet.Spec.Template.Spec.FailureDomain == nil
+}
+
 // updateExternalObject updates the external object passed in with the
 // updated labels and annotations from the MachineSet.
 func (r *Reconciler) updateExternalObject(ctx context.Context, obj client.Object, machineSet *clusterv1.MachineSet) error {
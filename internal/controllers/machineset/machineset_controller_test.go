@@ -35,11 +35,11 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/feature"
-	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/test/builder"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/contract"
 	"sigs.k8s.io/cluster-api/util/patch"
 )
 
@@ -1640,32 +1640,111 @@ func TestComputeDesiredMachine(t *testing.T) {
 	expectedUpdatedMachine.Spec.InfrastructureRef = *existingMachine.Spec.InfrastructureRef.DeepCopy()
 	expectedUpdatedMachine.Spec.Bootstrap.ConfigRef = existingMachine.Spec.Bootstrap.ConfigRef.DeepCopy()
 
+	// Updating an existing Machine of a MachineSet spread across failure domains
+	spreadMS := ms.DeepCopy()
+	spreadMS.Spec.FailureDomainSpread = string(clusterv1.EvenFailureDomainSpreadPolicy)
+
+	existingSpreadMachine := existingMachine.DeepCopy()
+	existingSpreadMachine.Spec.FailureDomain = ptr.To("fd-2")
+
+	expectedUpdatedSpreadMachine := expectedUpdatedMachine.DeepCopy()
+	expectedUpdatedSpreadMachine.Spec.FailureDomain = ptr.To("fd-2")
+
 	tests := []struct {
 		name            string
+		ms              *clusterv1.MachineSet
 		existingMachine *clusterv1.Machine
 		want            *clusterv1.Machine
 	}{
 		{
 			name:            "creating a new Machine",
+			ms:              ms,
 			existingMachine: nil,
 			want:            expectedNewMachine,
 		},
 		{
 			name:            "updating an existing Machine",
+			ms:              ms,
 			existingMachine: existingMachine,
 			want:            expectedUpdatedMachine,
 		},
+		{
+			name:            "creating a new Machine spread across failure domains",
+			ms:              spreadMS,
+			existingMachine: nil,
+			want:            expectedNewMachine,
+		},
+		{
+			name:            "updating an existing Machine spread across failure domains retains the failure domain",
+			ms:              spreadMS,
+			existingMachine: existingSpreadMachine,
+			want:            expectedUpdatedSpreadMachine,
+		},
+		{
+			name:            "updating an existing Machine retains the failure domain after the MachineSet stops spreading across failure domains",
+			ms:              ms,
+			existingMachine: existingSpreadMachine,
+			want:            expectedUpdatedSpreadMachine,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
-			got := (&Reconciler{}).computeDesiredMachine(ms, tt.existingMachine)
+			got := (&Reconciler{}).computeDesiredMachine(tt.ms, tt.existingMachine)
 			assertMachine(g, got, tt.want)
 		})
 	}
 }
 
+func TestShouldSpreadAcrossFailureDomains(t *testing.T) {
+	tests := []struct {
+		name                string
+		failureDomainSpread string
+		failureDomain       *string
+		want                bool
+	}{
+		{
+			name:                "should not spread if the policy is not set",
+			failureDomainSpread: "",
+			want:                false,
+		},
+		{
+			name:                "should not spread if the policy is None",
+			failureDomainSpread: string(clusterv1.NoneFailureDomainSpreadPolicy),
+			want:                false,
+		},
+		{
+			name:                "should spread if the policy is Even",
+			failureDomainSpread: string(clusterv1.EvenFailureDomainSpreadPolicy),
+			want:                true,
+		},
+		{
+			name:                "should not spread if the policy is Even but the Machine template defines a failure domain",
+			failureDomainSpread: string(clusterv1.EvenFailureDomainSpreadPolicy),
+			failureDomain:       ptr.To("fd-1"),
+			want:                false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ms := &clusterv1.MachineSet{
+				Spec: clusterv1.MachineSetSpec{
+					FailureDomainSpread: tt.failureDomainSpread,
+					Template: clusterv1.MachineTemplateSpec{
+						Spec: clusterv1.MachineSpec{
+							FailureDomain: tt.failureDomain,
+						},
+					},
+				},
+			}
+			g.Expect(shouldSpreadAcrossFailureDomains(ms)).To(Equal(tt.want))
+		})
+	}
+}
+
 func assertMachine(g *WithT, actualMachine *clusterv1.Machine, expectedMachine *clusterv1.Machine) {
 	// Check Name
 	if expectedMachine.Name != "" {
@@ -19,6 +19,7 @@ package machineset
 import (
 	"math"
 	"sort"
+	"strconv"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -89,6 +90,36 @@ func randomDeletePolicy(machine *clusterv1.Machine) deletePriority {
 	return couldDelete
 }
 
+// withDeletePriorityAnnotation returns a deletePriorityFunc that honors the DeleteMachinePriorityAnnotation
+// on Machines that are not deleted first, and falls back to fun for all the other Machines.
+func withDeletePriorityAnnotation(fun deletePriorityFunc) deletePriorityFunc {
+	return func(machine *clusterv1.Machine) deletePriority {
+		if isMachineDeletedFirst(machine) {
+			return fun(machine)
+		}
+		if value, ok := machine.ObjectMeta.Annotations[clusterv1.DeleteMachinePriorityAnnotation]; ok {
+			// Values which are not valid integers are ignored; valid values are capped to the 0-100 priority range.
+			if priority, err := strconv.Atoi(value); err == nil {
+				return deletePriority(math.Max(float64(mustNotDelete), math.Min(float64(priority), float64(mustDelete))))
+			}
+		}
+		return fun(machine)
+	}
+}
+
+// isMachineDeletedFirst returns true if the Machine is deleted before all the other Machines, independently of
+// its delete priority, i.e. if the Machine is already being deleted, if it has the DeleteMachineAnnotation or
+// if it is unhealthy.
+func isMachineDeletedFirst(machine *clusterv1.Machine) bool {
+	if !machine.DeletionTimestamp.IsZero() {
+		return true
+	}
+	if _, ok := machine.ObjectMeta.Annotations[clusterv1.DeleteMachineAnnotation]; ok {
+		return true
+	}
+	return !isMachineHealthy(machine)
+}
+
 type sortableMachines struct {
 	machines []*clusterv1.Machine
 	priority deletePriorityFunc
@@ -97,6 +128,11 @@ type sortableMachines struct {
 func (m sortableMachines) Len() int      { return len(m.machines) }
 func (m sortableMachines) Swap(i, j int) { m.machines[i], m.machines[j] = m.machines[j], m.machines[i] }
 func (m sortableMachines) Less(i, j int) bool {
+	// Machines which are deleted first always come before the other Machines, e.g. unhealthy Machines come before
+	// healthy Machines with a high priority set via the DeleteMachinePriorityAnnotation.
+	if deletedFirstI, deletedFirstJ := isMachineDeletedFirst(m.machines[i]), isMachineDeletedFirst(m.machines[j]); deletedFirstI != deletedFirstJ {
+		return deletedFirstI
+	}
 	priorityI, priorityJ := m.priority(m.machines[i]), m.priority(m.machines[j])
 	if priorityI == priorityJ {
 		// In cases where the priority is identical, it should be ensured that the same machine order is returned each time.
@@ -122,19 +158,95 @@ func getMachinesToDeletePrioritized(filteredMachines []*clusterv1.Machine, diff
 	return sortable.machines[:diff]
 }
 
+// getMachinesToDeleteBalanced returns the Machines to delete while keeping the failure domains balanced.
+// Machines that must be deleted are picked first; then, Machines are picked one at a time from the
+// failure domain with the most remaining Machines, according to their priority.
+func getMachinesToDeleteBalanced(filteredMachines []*clusterv1.Machine, diff int, fun deletePriorityFunc) []*clusterv1.Machine {
+	if diff >= len(filteredMachines) {
+		return filteredMachines
+	} else if diff <= 0 {
+		return []*clusterv1.Machine{}
+	}
+
+	sortable := sortableMachines{
+		machines: filteredMachines,
+		priority: fun,
+	}
+	sort.Sort(sortable)
+
+	machinesToDelete := []*clusterv1.Machine{}
+	remainingMachines := []*clusterv1.Machine{}
+	for _, m := range sortable.machines {
+		if len(machinesToDelete) < diff && fun(m) == mustDelete {
+			machinesToDelete = append(machinesToDelete, m)
+			continue
+		}
+		remainingMachines = append(remainingMachines, m)
+	}
+
+	// Note: Machines without a failure domain are counted together, as if they were in the same failure domain.
+	machinesPerFailureDomain := map[string]int{}
+	for _, m := range remainingMachines {
+		machinesPerFailureDomain[failureDomainOf(m)]++
+	}
+
+	for len(machinesToDelete) < diff {
+		maxMachines := 0
+		for _, count := range machinesPerFailureDomain {
+			if count > maxMachines {
+				maxMachines = count
+			}
+		}
+
+		// remainingMachines is sorted by priority, so the first Machine in one of the biggest failure domains
+		// is the one with the highest priority.
+		for i, m := range remainingMachines {
+			if machinesPerFailureDomain[failureDomainOf(m)] != maxMachines {
+				continue
+			}
+			machinesToDelete = append(machinesToDelete, m)
+			machinesPerFailureDomain[failureDomainOf(m)]--
+			remainingMachines = append(remainingMachines[:i], remainingMachines[i+1:]...)
+			break
+		}
+	}
+
+	return machinesToDelete
+}
+
+func failureDomainOf(machine *clusterv1.Machine) string {
+	if machine.Spec.FailureDomain == nil {
+		return ""
+	}
+	return *machine.Spec.FailureDomain
+}
+
+// getMachinesToDelete returns the Machines to delete according to the Spec.DeletePolicy of the MachineSet.
+func getMachinesToDelete(ms *clusterv1.MachineSet, filteredMachines []*clusterv1.Machine, diff int) ([]*clusterv1.Machine, error) {
+	deletePriorityFunc, err := getDeletePriorityFunc(ms)
+	if err != nil {
+		return nil, err
+	}
+
+	if clusterv1.MachineSetDeletePolicy(ms.Spec.DeletePolicy) == clusterv1.BalancedOldestMachineSetDeletePolicy {
+		return getMachinesToDeleteBalanced(filteredMachines, diff, deletePriorityFunc), nil
+	}
+	return getMachinesToDeletePrioritized(filteredMachines, diff, deletePriorityFunc), nil
+}
+
 func getDeletePriorityFunc(ms *clusterv1.MachineSet) (deletePriorityFunc, error) {
 	// Map the Spec.DeletePolicy value to the appropriate delete priority function
 	switch msdp := clusterv1.MachineSetDeletePolicy(ms.Spec.DeletePolicy); msdp {
 	case clusterv1.RandomMachineSetDeletePolicy:
-		return randomDeletePolicy, nil
+		return withDeletePriorityAnnotation(randomDeletePolicy), nil
 	case clusterv1.NewestMachineSetDeletePolicy:
-		return newestDeletePriority, nil
-	case clusterv1.OldestMachineSetDeletePolicy:
-		return oldestDeletePriority, nil
+		return withDeletePriorityAnnotation(newestDeletePriority), nil
+	case clusterv1.OldestMachineSetDeletePolicy, clusterv1.BalancedOldestMachineSetDeletePolicy:
+		return withDeletePriorityAnnotation(oldestDeletePriority), nil
 	case "":
-		return randomDeletePolicy, nil
+		return withDeletePriorityAnnotation(randomDeletePolicy), nil
 	default:
-		return nil, errors.Errorf("Unsupported delete policy %s. Must be one of 'Random', 'Newest', or 'Oldest'", msdp)
+		return nil, errors.Errorf("Unsupported delete policy %s. Must be one of 'Random', 'Newest', 'Oldest', or 'BalancedOldest'", msdp)
 	}
 }
 
@@ -19,11 +19,13 @@ package machineset
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	capierrors "sigs.k8s.io/cluster-api/errors"
@@ -617,6 +619,203 @@ func TestMachineDeleteMultipleSamePriority(t *testing.T) {
 	}
 }
 
+func TestMachineDeletePriorityAnnotation(t *testing.T) {
+	now := metav1.Now()
+	nodeRef := &corev1.ObjectReference{Name: "some-node"}
+	newMachine := func(name string, age time.Duration, annotations map[string]string) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+				Annotations:       annotations,
+			},
+			Status: clusterv1.MachineStatus{NodeRef: nodeRef},
+		}
+	}
+	oldMachine := newMachine("old", 10*time.Hour, nil)
+	youngMachine := newMachine("young", time.Hour, nil)
+	highPriorityMachine := newMachine("high-priority", time.Minute, map[string]string{clusterv1.DeleteMachinePriorityAnnotation: "100"})
+	lowPriorityMachine := newMachine("low-priority", 100*time.Hour, map[string]string{clusterv1.DeleteMachinePriorityAnnotation: "0"})
+	invalidPriorityMachine := newMachine("invalid-priority", 5*time.Hour, map[string]string{clusterv1.DeleteMachinePriorityAnnotation: "not-a-number"})
+	deleteMachine := newMachine("delete", time.Minute, map[string]string{
+		clusterv1.DeleteMachineAnnotation:         "",
+		clusterv1.DeleteMachinePriorityAnnotation: "0",
+	})
+	unhealthyMachine := newMachine("unhealthy", time.Minute, map[string]string{clusterv1.DeleteMachinePriorityAnnotation: "0"})
+	unhealthyMachine.Status.NodeRef = nil
+
+	tests := []struct {
+		desc         string
+		deletePolicy clusterv1.MachineSetDeletePolicy
+		machines     []*clusterv1.Machine
+		diff         int
+		expect       []*clusterv1.Machine
+	}{
+		{
+			desc:         "policy=Oldest, the priority annotation takes precedence over the age",
+			deletePolicy: clusterv1.OldestMachineSetDeletePolicy,
+			machines:     []*clusterv1.Machine{oldMachine, highPriorityMachine, youngMachine},
+			diff:         1,
+			expect:       []*clusterv1.Machine{highPriorityMachine},
+		},
+		{
+			desc:         "policy=Oldest, a Machine with priority 0 is deleted last",
+			deletePolicy: clusterv1.OldestMachineSetDeletePolicy,
+			machines:     []*clusterv1.Machine{lowPriorityMachine, oldMachine, youngMachine},
+			diff:         2,
+			expect:       []*clusterv1.Machine{oldMachine, youngMachine},
+		},
+		{
+			desc:         "policy=Oldest, an invalid priority annotation is ignored",
+			deletePolicy: clusterv1.OldestMachineSetDeletePolicy,
+			machines:     []*clusterv1.Machine{youngMachine, invalidPriorityMachine, oldMachine},
+			diff:         2,
+			expect:       []*clusterv1.Machine{oldMachine, invalidPriorityMachine},
+		},
+		{
+			desc:         "policy=Oldest, the delete-machine annotation is not affected by the priority annotation",
+			deletePolicy: clusterv1.OldestMachineSetDeletePolicy,
+			machines:     []*clusterv1.Machine{oldMachine, deleteMachine},
+			diff:         1,
+			expect:       []*clusterv1.Machine{deleteMachine},
+		},
+		{
+			desc:         "policy=Oldest, unhealthy Machines are deleted before Machines with a high priority",
+			deletePolicy: clusterv1.OldestMachineSetDeletePolicy,
+			machines:     []*clusterv1.Machine{highPriorityMachine, oldMachine, unhealthyMachine},
+			diff:         2,
+			expect:       []*clusterv1.Machine{unhealthyMachine, highPriorityMachine},
+		},
+		{
+			desc:         "policy=Random, unhealthy Machines are deleted before Machines with a high priority",
+			deletePolicy: clusterv1.RandomMachineSetDeletePolicy,
+			machines:     []*clusterv1.Machine{highPriorityMachine, unhealthyMachine},
+			diff:         1,
+			expect:       []*clusterv1.Machine{unhealthyMachine},
+		},
+		{
+			desc:         "policy=BalancedOldest, unhealthy Machines are deleted before Machines with a high priority",
+			deletePolicy: clusterv1.BalancedOldestMachineSetDeletePolicy,
+			machines:     []*clusterv1.Machine{highPriorityMachine, oldMachine, unhealthyMachine},
+			diff:         1,
+			expect:       []*clusterv1.Machine{unhealthyMachine},
+		},
+		{
+			desc:         "policy=Random, the priority annotation takes precedence",
+			deletePolicy: clusterv1.RandomMachineSetDeletePolicy,
+			machines:     []*clusterv1.Machine{oldMachine, youngMachine, highPriorityMachine},
+			diff:         1,
+			expect:       []*clusterv1.Machine{highPriorityMachine},
+		},
+		{
+			desc:         "policy=Newest, a Machine with priority 0 is deleted last",
+			deletePolicy: clusterv1.NewestMachineSetDeletePolicy,
+			machines:     []*clusterv1.Machine{oldMachine, lowPriorityMachine, youngMachine},
+			diff:         2,
+			expect:       []*clusterv1.Machine{youngMachine, oldMachine},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ms := &clusterv1.MachineSet{Spec: clusterv1.MachineSetSpec{DeletePolicy: string(test.deletePolicy)}}
+			result, err := getMachinesToDelete(ms, test.machines, test.diff)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result).To(BeComparableTo(test.expect))
+		})
+	}
+}
+
+func TestMachineBalancedOldestDelete(t *testing.T) {
+	now := metav1.Now()
+	nodeRef := &corev1.ObjectReference{Name: "some-node"}
+	newMachine := func(name, failureDomain string, age time.Duration) *clusterv1.Machine {
+		m := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Status: clusterv1.MachineStatus{NodeRef: nodeRef},
+		}
+		if failureDomain != "" {
+			m.Spec.FailureDomain = ptr.To(failureDomain)
+		}
+		return m
+	}
+	fd1Oldest := newMachine("fd1-oldest", "fd1", 10*time.Hour)
+	fd1Young := newMachine("fd1-young", "fd1", time.Hour)
+	fd1Youngest := newMachine("fd1-youngest", "fd1", time.Minute)
+	fd2Old := newMachine("fd2-old", "fd2", 20*time.Hour)
+	fd2Young := newMachine("fd2-young", "fd2", 2*time.Hour)
+	fd3Old := newMachine("fd3-old", "fd3", 30*time.Hour)
+	noFailureDomain := newMachine("no-failure-domain", "", 40*time.Hour)
+	unhealthyMachine := newMachine("unhealthy", "fd3", time.Minute)
+	unhealthyMachine.Status.NodeRef = nil
+
+	tests := []struct {
+		desc     string
+		machines []*clusterv1.Machine
+		diff     int
+		expect   []*clusterv1.Machine
+	}{
+		{
+			desc:     "diff=0",
+			machines: []*clusterv1.Machine{fd1Oldest, fd2Old},
+			diff:     0,
+			expect:   []*clusterv1.Machine{},
+		},
+		{
+			desc:     "diff=len(machines)",
+			machines: []*clusterv1.Machine{fd1Oldest, fd2Old},
+			diff:     2,
+			expect:   []*clusterv1.Machine{fd1Oldest, fd2Old},
+		},
+		{
+			desc:     "deletes the oldest Machine of the biggest failure domain",
+			machines: []*clusterv1.Machine{fd3Old, fd2Old, fd1Young, fd1Oldest, fd2Young, fd1Youngest},
+			diff:     1,
+			expect:   []*clusterv1.Machine{fd1Oldest},
+		},
+		{
+			desc:     "deletes the oldest Machine across failure domains of the same size",
+			machines: []*clusterv1.Machine{fd3Old, fd2Old, fd1Young, fd1Oldest, fd2Young, fd1Youngest},
+			diff:     2,
+			expect:   []*clusterv1.Machine{fd1Oldest, fd2Old},
+		},
+		{
+			desc:     "keeps failure domains balanced",
+			machines: []*clusterv1.Machine{fd3Old, fd2Old, fd1Young, fd1Oldest, fd2Young, fd1Youngest},
+			diff:     3,
+			expect:   []*clusterv1.Machine{fd1Oldest, fd2Old, fd1Young},
+		},
+		{
+			desc:     "Machines without a failure domain are counted together",
+			machines: []*clusterv1.Machine{noFailureDomain, fd1Oldest, fd1Young},
+			diff:     1,
+			expect:   []*clusterv1.Machine{fd1Oldest},
+		},
+		{
+			desc:     "unhealthy Machines are deleted first",
+			machines: []*clusterv1.Machine{fd1Oldest, fd1Young, fd1Youngest, unhealthyMachine},
+			diff:     2,
+			expect:   []*clusterv1.Machine{unhealthyMachine, fd1Oldest},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := NewWithT(t)
+
+			ms := &clusterv1.MachineSet{Spec: clusterv1.MachineSetSpec{DeletePolicy: string(clusterv1.BalancedOldestMachineSetDeletePolicy)}}
+			result, err := getMachinesToDelete(ms, test.machines, test.diff)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result).To(BeComparableTo(test.expect))
+		})
+	}
+}
+
 func TestIsMachineHealthy(t *testing.T) {
 	nodeRef := &corev1.ObjectReference{Name: "some-node"}
 	statusError := capierrors.MachineStatusError("I'm unhealthy!")
@@ -35,8 +35,10 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
 	"sigs.k8s.io/cluster-api/controllers/external"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
 	"sigs.k8s.io/cluster-api/feature"
-	"sigs.k8s.io/cluster-api/internal/contract"
+	"sigs.k8s.io/cluster-api/util/contract"
 )
 
 type preflightCheckErrorMessage *string
@@ -60,15 +62,49 @@ func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.
 		return ctrl.Result{}, "", nil
 	}
 
+	// Run the preflight checks verifying the MachineSet against the control plane.
+	preflightCheckErrs, err := r.runControlPlanePreflightChecks(ctx, cluster, ms, skipped)
+	if err != nil {
+		return ctrl.Result{}, "", errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks", action)
+	}
+
+	// Run the preflight checks implemented by Runtime Extensions.
+	requeueAfter := preflightFailedRequeueAfter
+	if feature.Gates.Enabled(feature.RuntimeSDK) && !skipped.Has(clusterv1.MachineSetPreflightCheckRuntimeExtensions) {
+		preflightCheckErr, retryAfter, err := r.runtimeExtensionsPreflightCheck(ctx, cluster, ms)
+		if err != nil {
+			return ctrl.Result{}, "", errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks", action)
+		}
+		if preflightCheckErr != nil {
+			preflightCheckErrs = append(preflightCheckErrs, preflightCheckErr)
+			if retryAfter < requeueAfter {
+				requeueAfter = retryAfter
+			}
+		}
+	}
+
+	if len(preflightCheckErrs) > 0 {
+		preflightCheckErrStrings := []string{}
+		for _, v := range preflightCheckErrs {
+			preflightCheckErrStrings = append(preflightCheckErrStrings, *v)
+		}
+		msg := fmt.Sprintf("Performing %q on hold because %s. The operation will continue after the preflight check(s) pass", action, strings.Join(preflightCheckErrStrings, "; "))
+		log.Info(msg)
+		return ctrl.Result{RequeueAfter: requeueAfter}, msg, nil
+	}
+	return ctrl.Result{}, "", nil
+}
+
+func (r *Reconciler) runControlPlanePreflightChecks(ctx context.Context, cluster *clusterv1.Cluster, ms *clusterv1.MachineSet, skipped sets.Set[clusterv1.MachineSetPreflightCheck]) ([]preflightCheckErrorMessage, error) {
 	// If the cluster does not have a control plane reference then there is nothing to do. Return early.
 	if cluster.Spec.ControlPlaneRef == nil {
-		return ctrl.Result{}, "", nil
+		return nil, nil
 	}
 
 	// Get the control plane object.
 	controlPlane, err := external.Get(ctx, r.UnstructuredCachingClient, cluster.Spec.ControlPlaneRef, cluster.Namespace)
 	if err != nil {
-		return ctrl.Result{}, "", errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks: failed to get ControlPlane %s", action, klog.KRef(cluster.Spec.ControlPlaneRef.Namespace, cluster.Spec.ControlPlaneRef.Name))
+		return nil, errors.Wrapf(err, "failed to get ControlPlane %s", klog.KRef(cluster.Spec.ControlPlaneRef.Namespace, cluster.Spec.ControlPlaneRef.Name))
 	}
 	cpKlogRef := klog.KRef(controlPlane.GetNamespace(), controlPlane.GetName())
 
@@ -78,13 +114,13 @@ func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.
 	cpVersion, err := contract.ControlPlane().Version().Get(controlPlane)
 	if err != nil {
 		if errors.Is(err, contract.ErrFieldNotFound) {
-			return ctrl.Result{}, "", nil
+			return nil, nil
 		}
-		return ctrl.Result{}, "", errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks: failed to get the version of ControlPlane %s", action, cpKlogRef)
+		return nil, errors.Wrapf(err, "failed to get the version of ControlPlane %s", cpKlogRef)
 	}
 	cpSemver, err := semver.ParseTolerant(*cpVersion)
 	if err != nil {
-		return ctrl.Result{}, "", errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks: failed to parse version %q of ControlPlane %s", action, *cpVersion, cpKlogRef)
+		return nil, errors.Wrapf(err, "failed to parse version %q of ControlPlane %s", *cpVersion, cpKlogRef)
 	}
 
 	errList := []error{}
@@ -105,7 +141,7 @@ func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.
 		msVersion := *ms.Spec.Template.Spec.Version
 		msSemver, err := semver.ParseTolerant(msVersion)
 		if err != nil {
-			return ctrl.Result{}, "", errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks: failed to parse version %q of MachineSet %s", action, msVersion, klog.KObj(ms))
+			return nil, errors.Wrapf(err, "failed to parse version %q of MachineSet %s", msVersion, klog.KObj(ms))
 		}
 
 		// Run the kubernetes-version skew preflight check.
@@ -129,18 +165,39 @@ func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.
 	}
 
 	if len(errList) > 0 {
-		return ctrl.Result{}, "", errors.Wrapf(kerrors.NewAggregate(errList), "failed to perform %q: failed to perform preflight checks", action)
+		return nil, kerrors.NewAggregate(errList)
 	}
-	if len(preflightCheckErrs) > 0 {
-		preflightCheckErrStrings := []string{}
-		for _, v := range preflightCheckErrs {
-			preflightCheckErrStrings = append(preflightCheckErrStrings, *v)
-		}
-		msg := fmt.Sprintf("Performing %q on hold because %s. The operation will continue after the preflight check(s) pass", action, strings.Join(preflightCheckErrStrings, "; "))
-		log.Info(msg)
-		return ctrl.Result{RequeueAfter: preflightFailedRequeueAfter}, msg, nil
+	return preflightCheckErrs, nil
+}
+
+// runtimeExtensionsPreflightCheck calls the Runtime Extensions implementing the BeforeMachineCreate hook; if any of
+// the Runtime Extensions asks to retry, it returns the message of the failed preflight check and the time
+// after which the preflight check should be performed again.
+// NOTE: If the registry of the Runtime Extensions is not ready yet, the preflight check fails and it is performed again later.
+func (r *Reconciler) runtimeExtensionsPreflightCheck(ctx context.Context, cluster *clusterv1.Cluster, ms *clusterv1.MachineSet) (preflightCheckErrorMessage, time.Duration, error) {
+	if !r.RuntimeClient.IsReady() {
+		return ptr.To(fmt.Sprintf("the Runtime Extensions registry is not ready yet (%q preflight failed)", clusterv1.MachineSetPreflightCheckRuntimeExtensions)),
+			preflightFailedRequeueAfter, nil
 	}
-	return ctrl.Result{}, "", nil
+
+	hookRequest := &runtimehooksv1.BeforeMachineCreateRequest{
+		Cluster:    *cluster,
+		MachineSet: *ms,
+	}
+	hookResponse := &runtimehooksv1.BeforeMachineCreateResponse{}
+	if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.BeforeMachineCreate, cluster, hookRequest, hookResponse); err != nil {
+		return nil, 0, errors.Wrapf(err, "failed to perform %q preflight check", clusterv1.MachineSetPreflightCheckRuntimeExtensions)
+	}
+	if hookResponse.RetryAfterSeconds == 0 {
+		return nil, 0, nil
+	}
+
+	message := fmt.Sprintf("Runtime Extensions implementing the %s hook are blocking the creation of new Machines", runtimecatalog.HookName(runtimehooksv1.BeforeMachineCreate))
+	if hookResponse.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, hookResponse.Message)
+	}
+	return ptr.To(fmt.Sprintf("%s (%q preflight failed)", message, clusterv1.MachineSetPreflightCheckRuntimeExtensions)),
+		time.Duration(hookResponse.RetryAfterSeconds) * time.Second, nil
 }
 
 func (r *Reconciler) controlPlaneStablePreflightCheck(controlPlane *unstructured.Unstructured) (preflightCheckErrorMessage, error) {
@@ -216,6 +273,9 @@ func skippedPreflightChecks(ms *clusterv1.MachineSet) sets.Set[clusterv1.Machine
 	if ms == nil {
 		return skipped
 	}
+	if ms.Spec.PreflightChecks != nil {
+		skipped.Insert(ms.Spec.PreflightChecks.Skip...)
+	}
 	skip := ms.Annotations[clusterv1.MachineSetSkipPreflightChecksAnnotation]
 	if skip == "" {
 		return skipped
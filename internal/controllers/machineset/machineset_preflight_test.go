@@ -18,6 +18,7 @@ package machineset
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -30,9 +31,12 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	bootstrapv1 "sigs.k8s.io/cluster-api/bootstrap/kubeadm/api/v1beta1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
 	"sigs.k8s.io/cluster-api/feature"
-	"sigs.k8s.io/cluster-api/internal/contract"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
 	"sigs.k8s.io/cluster-api/internal/test/builder"
+	"sigs.k8s.io/cluster-api/util/contract"
 )
 
 func TestMachineSetReconciler_runPreflightChecks(t *testing.T) {
@@ -190,6 +194,35 @@ func TestMachineSetReconciler_runPreflightChecks(t *testing.T) {
 				},
 				wantPass: true,
 			},
+			{
+				name: "control plane preflight check: should pass if the control plane is upgrading but the preflight check is skipped in the MachineSet spec",
+				cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: ns,
+					},
+					Spec: clusterv1.ClusterSpec{
+						ControlPlaneRef: contract.ObjToRef(controlPlaneUpgrading),
+					},
+				},
+				controlPlane: controlPlaneUpgrading,
+				machineSet: &clusterv1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: ns,
+					},
+					Spec: clusterv1.MachineSetSpec{
+						PreflightChecks: &clusterv1.MachineSetPreflightChecks{
+							Skip: []clusterv1.MachineSetPreflightCheck{clusterv1.MachineSetPreflightCheckControlPlaneIsStable},
+						},
+						Template: clusterv1.MachineTemplateSpec{
+							Spec: clusterv1.MachineSpec{
+								Version:   ptr.To("v1.26.2"),
+								Bootstrap: clusterv1.Bootstrap{ConfigRef: &corev1.ObjectReference{Kind: "KubeadmConfigTemplate"}},
+							},
+						},
+					},
+				},
+				wantPass: true,
+			},
 			{
 				name: "control plane preflight check: should pass if the control plane is stable",
 				cluster: &clusterv1.Cluster{
@@ -570,6 +603,139 @@ func TestMachineSetReconciler_runPreflightChecks(t *testing.T) {
 		}
 	})
 
+	t.Run("should run the preflight checks implemented by Runtime Extensions if the RuntimeSDK feature gate is enabled", func(t *testing.T) {
+		defer utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachineSetPreflightChecks, true)()
+		defer utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)()
+
+		catalog := runtimecatalog.New()
+		_ = runtimehooksv1.AddToCatalog(catalog)
+		beforeMachineCreateGVH, err := catalog.GroupVersionHook(runtimehooksv1.BeforeMachineCreate)
+		if err != nil {
+			panic(err)
+		}
+
+		blockingResponse := &runtimehooksv1.BeforeMachineCreateResponse{
+			CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+				CommonResponse: runtimehooksv1.CommonResponse{
+					Status:  runtimehooksv1.ResponseStatusSuccess,
+					Message: "image not found in region",
+				},
+				RetryAfterSeconds: 10,
+			},
+		}
+		nonBlockingResponse := &runtimehooksv1.BeforeMachineCreateResponse{
+			CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+				CommonResponse: runtimehooksv1.CommonResponse{
+					Status: runtimehooksv1.ResponseStatusSuccess,
+				},
+				RetryAfterSeconds: 0,
+			},
+		}
+		failureResponse := &runtimehooksv1.BeforeMachineCreateResponse{
+			CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+				CommonResponse: runtimehooksv1.CommonResponse{
+					Status: runtimehooksv1.ResponseStatusFailure,
+				},
+			},
+		}
+
+		tests := []struct {
+			name               string
+			machineSet         *clusterv1.MachineSet
+			hookResponse       *runtimehooksv1.BeforeMachineCreateResponse
+			registryNotReady   bool
+			wantHookToBeCalled bool
+			wantRequeueAfter   time.Duration
+			wantMessage        string
+			wantErr            bool
+		}{
+			{
+				name:               "should pass if the Runtime Extensions do not block machine creation",
+				machineSet:         &clusterv1.MachineSet{},
+				hookResponse:       nonBlockingResponse,
+				wantHookToBeCalled: true,
+			},
+			{
+				name:               "should fail if the Runtime Extensions block machine creation",
+				machineSet:         &clusterv1.MachineSet{},
+				hookResponse:       blockingResponse,
+				wantHookToBeCalled: true,
+				wantRequeueAfter:   10 * time.Second,
+				wantMessage:        "image not found in region",
+			},
+			{
+				name: "should pass if the Runtime Extensions block machine creation but the preflight check is skipped",
+				machineSet: &clusterv1.MachineSet{
+					Spec: clusterv1.MachineSetSpec{
+						PreflightChecks: &clusterv1.MachineSetPreflightChecks{
+							Skip: []clusterv1.MachineSetPreflightCheck{clusterv1.MachineSetPreflightCheckRuntimeExtensions},
+						},
+					},
+				},
+				hookResponse:       blockingResponse,
+				wantHookToBeCalled: false,
+			},
+			{
+				name: "should pass if the Runtime Extensions block machine creation but all the preflight checks are skipped",
+				machineSet: &clusterv1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							clusterv1.MachineSetSkipPreflightChecksAnnotation: string(clusterv1.MachineSetPreflightCheckAll),
+						},
+					},
+				},
+				hookResponse:       blockingResponse,
+				wantHookToBeCalled: false,
+			},
+			{
+				name:               "should fail without calling the Runtime Extensions if the registry is not ready",
+				machineSet:         &clusterv1.MachineSet{},
+				hookResponse:       nonBlockingResponse,
+				registryNotReady:   true,
+				wantHookToBeCalled: false,
+				wantRequeueAfter:   preflightFailedRequeueAfter,
+				wantMessage:        "registry is not ready",
+			},
+			{
+				name:               "should error if the Runtime Extensions fail",
+				machineSet:         &clusterv1.MachineSet{},
+				hookResponse:       failureResponse,
+				wantHookToBeCalled: true,
+				wantErr:            true,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				g := NewWithT(t)
+
+				fakeRuntimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+					WithCallAllExtensionResponses(map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+						beforeMachineCreateGVH: tt.hookResponse,
+					}).
+					WithCatalog(catalog).
+					MarkReady(!tt.registryNotReady).
+					Build()
+				fakeClient := fake.NewClientBuilder().Build()
+				r := &Reconciler{
+					Client:                    fakeClient,
+					UnstructuredCachingClient: fakeClient,
+					RuntimeClient:             fakeRuntimeClient,
+				}
+
+				result, message, err := r.runPreflightChecks(ctx, &clusterv1.Cluster{}, tt.machineSet, "")
+				g.Expect(fakeRuntimeClient.CallAllCount(runtimehooksv1.BeforeMachineCreate) == 1).To(Equal(tt.wantHookToBeCalled))
+				if tt.wantErr {
+					g.Expect(err).To(HaveOccurred())
+					return
+				}
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(result.RequeueAfter).To(Equal(tt.wantRequeueAfter))
+				g.Expect(message).To(ContainSubstring(tt.wantMessage))
+			})
+		}
+	})
+
 	t.Run("should not run the preflight checks if the feature gate is disabled", func(t *testing.T) {
 		defer utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachineSetPreflightChecks, false)()
 
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -48,11 +49,13 @@ import (
 	tlog "sigs.k8s.io/cluster-api/internal/log"
 	runtimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	"sigs.k8s.io/cluster-api/internal/util/tracing"
 	"sigs.k8s.io/cluster-api/internal/webhooks"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io;controlplane.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
@@ -77,6 +80,9 @@ type Reconciler struct {
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
+	// Shard is the shard of Clusters reconciled by this controller.
+	Shard shard.Shard
+
 	// UnstructuredCachingClient provides a client that forces caching of unstructured objects,
 	// thus allowing to optimize reads for templates or provider specific objects in a managed topology.
 	UnstructuredCachingClient client.Client
@@ -88,6 +94,9 @@ type Reconciler struct {
 	desiredStateGenerator desiredstate.Generator
 
 	patchHelperFactory structuredmerge.PatchHelperFactoryFunc
+
+	// patchTracker is used to record which ClusterClass patches changed which fields during a dry run.
+	patchTracker *scope.PatchTracker
 }
 
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
@@ -115,6 +124,7 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 		).
 		WithOptions(options).
 		WithEventFilter(predicates.ResourceNotPausedAndHasFilterLabel(ctrl.LoggerFrom(ctx), r.WatchFilterValue)).
+		WithEventFilter(predicates.ResourceIsInShard(ctrl.LoggerFrom(ctx), r.Shard)).
 		Build(r)
 
 	if err != nil {
@@ -138,6 +148,16 @@ func (r *Reconciler) SetupForDryRun(recorder record.EventRecorder) {
 	r.desiredStateGenerator = desiredstate.NewGenerator(r.Client, r.Tracker, r.RuntimeClient)
 	r.recorder = recorder
 	r.patchHelperFactory = dryRunPatchHelperFactory(r.Client)
+	r.patchTracker = scope.NewPatchTracker()
+}
+
+// PatchContributions returns which ClusterClass patches changed which fields of the templates
+// during the reconciles executed after SetupForDryRun.
+func (r *Reconciler) PatchContributions() []scope.PatchContribution {
+	if r.patchTracker == nil {
+		return nil
+	}
+	return r.patchTracker.Contributions()
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -178,6 +198,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 	// Create a scope initialized with only the cluster; during reconcile
 	// additional information will be added about the Cluster blueprint, current state and desired state.
 	s := scope.New(cluster)
+	s.PatchTracker = r.patchTracker
 
 	defer func() {
 		if err := r.reconcileConditions(s, cluster, reterr); err != nil {
@@ -187,6 +208,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 		options := []patch.Option{
 			patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 				clusterv1.TopologyReconciledCondition,
+				clusterv1.TopologyFieldOwnershipCondition,
 			}},
 			patch.WithForceOverwriteConditions{},
 		}
@@ -216,9 +238,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 }
 
 // reconcile handles cluster reconciliation.
-func (r *Reconciler) reconcile(ctx context.Context, s *scope.Scope) (ctrl.Result, error) {
+func (r *Reconciler) reconcile(ctx context.Context, s *scope.Scope) (_ ctrl.Result, reterr error) {
 	var err error
 
+	ctx, span := tracing.Start(ctx, "ReconcileTopology",
+		attribute.String("cluster.namespace", s.Current.Cluster.Namespace),
+		attribute.String("cluster.name", s.Current.Cluster.Name),
+		attribute.String("cluster.class", s.Current.Cluster.Spec.Topology.Class),
+	)
+	defer func() { tracing.End(span, reterr) }()
+
 	// Get ClusterClass.
 	clusterClass := &clusterv1.ClusterClass{}
 	key := client.ObjectKey{Name: s.Current.Cluster.Spec.Topology.Class, Namespace: s.Current.Cluster.Namespace}
@@ -244,13 +273,17 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope.Scope) (ctrl.Result
 
 	// Gets the blueprint with the ClusterClass and the referenced templates
 	// and store it in the request scope.
-	s.Blueprint, err = r.getBlueprint(ctx, s.Current.Cluster, s.Blueprint.ClusterClass)
+	blueprintCtx, blueprintSpan := tracing.Start(ctx, "GetBlueprint")
+	s.Blueprint, err = r.getBlueprint(blueprintCtx, s.Current.Cluster, s.Blueprint.ClusterClass)
+	tracing.End(blueprintSpan, err)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error reading the ClusterClass")
 	}
 
 	// Gets the current state of the Cluster and store it in the request scope.
-	s.Current, err = r.getCurrentState(ctx, s)
+	currentStateCtx, currentStateSpan := tracing.Start(ctx, "GetCurrentState")
+	s.Current, err = r.getCurrentState(currentStateCtx, s)
+	tracing.End(currentStateSpan, err)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error reading current state of the Cluster topology")
 	}
@@ -272,13 +305,18 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope.Scope) (ctrl.Result
 	}
 
 	// Computes the desired state of the Cluster and store it in the request scope.
-	s.Desired, err = r.desiredStateGenerator.Generate(ctx, s)
+	desiredStateCtx, desiredStateSpan := tracing.Start(ctx, "ComputeDesiredState")
+	s.Desired, err = r.desiredStateGenerator.Generate(desiredStateCtx, s)
+	tracing.End(desiredStateSpan, err)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error computing the desired state of the Cluster topology")
 	}
 
 	// Reconciles current and desired state of the Cluster
-	if err := r.reconcileState(ctx, s); err != nil {
+	reconcileStateCtx, reconcileStateSpan := tracing.Start(ctx, "ReconcileState")
+	err = r.reconcileState(reconcileStateCtx, s)
+	tracing.End(reconcileStateSpan, err)
+	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error reconciling the Cluster topology")
 	}
 
@@ -24,14 +24,49 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
-	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/contract"
 )
 
 func (r *Reconciler) reconcileConditions(s *scope.Scope, cluster *clusterv1.Cluster, reconcileErr error) error {
+	r.reconcileTopologyFieldOwnershipCondition(s, cluster, reconcileErr)
 	return r.reconcileTopologyReconciledCondition(s, cluster, reconcileErr)
 }
 
+// reconcileTopologyFieldOwnershipCondition sets the TopologyFieldOwnership condition on the cluster.
+// The condition is false if server side apply reported conflicts with other field managers while applying
+// the objects of the managed topology; the message lists conflicting fields and the corresponding field managers.
+// NOTE: conflicts are detected only when patching objects, so the condition is left untouched when the cluster
+// is being deleted or when an error prevented the reconcile process to complete without detecting conflicts.
+func (r *Reconciler) reconcileTopologyFieldOwnershipCondition(s *scope.Scope, cluster *clusterv1.Cluster, reconcileErr error) {
+	if !cluster.ObjectMeta.DeletionTimestamp.IsZero() {
+		return
+	}
+
+	if s.FieldConflictTracker.HasConflicts() {
+		conditions.Set(
+			cluster,
+			conditions.FalseCondition(
+				clusterv1.TopologyFieldOwnershipCondition,
+				clusterv1.TopologyFieldConflictReason,
+				clusterv1.ConditionSeverityWarning,
+				// TODO: Add a protection for messages continuously changing leading to Cluster object changes/reconcile.
+				"%s", s.FieldConflictTracker.AggregateMessage(),
+			),
+		)
+		return
+	}
+
+	if reconcileErr != nil {
+		return
+	}
+
+	conditions.Set(
+		cluster,
+		conditions.TrueCondition(clusterv1.TopologyFieldOwnershipCondition),
+	)
+}
+
 // reconcileTopologyReconciledCondition sets the TopologyReconciled condition on the cluster.
 // The TopologyReconciled condition is considered true if spec of all the objects associated with the
 // cluster are in sync with the topology defined in the cluster.
@@ -184,6 +219,11 @@ func (r *Reconciler) reconcileTopologyReconciledCondition(s *scope.Scope, cluste
 			fmt.Fprintf(msgBuilder, " MachineDeployment(s) %s are upgrading",
 				computeNameList(s.UpgradeTracker.MachineDeployments.UpgradingNames()),
 			)
+			if onDeleteNames := s.UpgradeTracker.MachineDeployments.OnDeleteUpgradingNames(); len(onDeleteNames) > 0 {
+				fmt.Fprintf(msgBuilder, ", MachineDeployment(s) %s are using the OnDelete strategy and waiting for Machines to be deleted",
+					computeNameList(onDeleteNames),
+				)
+			}
 
 		case len(s.UpgradeTracker.MachinePools.UpgradingNames()) > 0:
 			fmt.Fprintf(msgBuilder, " MachinePool(s) %s are upgrading",
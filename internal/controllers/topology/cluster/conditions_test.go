@@ -31,6 +31,7 @@ import (
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/structuredmerge"
 	"sigs.k8s.io/cluster-api/internal/test/builder"
 	"sigs.k8s.io/cluster-api/util/conditions"
 )
@@ -243,6 +244,53 @@ func TestReconcileTopologyReconciledCondition(t *testing.T) {
 			wantConditionReason:  clusterv1.TopologyReconciledControlPlaneUpgradePendingReason,
 			wantConditionMessage: "Control plane rollout and upgrade to version v1.22.0 on hold. MachineDeployment(s) md0-abc123 are upgrading",
 		},
+		{
+			name:         "should set the condition to false if new version is not picked up because at least one of the machine deployment using the OnDelete strategy is upgrading",
+			reconcileErr: nil,
+			cluster:      &clusterv1.Cluster{},
+			s: &scope.Scope{
+				Blueprint: &scope.ClusterBlueprint{
+					Topology: &clusterv1.Topology{
+						Version: "v1.22.0",
+					},
+				},
+				Current: &scope.ClusterState{
+					Cluster: &clusterv1.Cluster{},
+					ControlPlane: &scope.ControlPlaneState{
+						Object: builder.ControlPlane("ns1", "controlplane1").
+							WithVersion("v1.21.2").
+							WithReplicas(3).
+							Build(),
+					},
+					MachineDeployments: scope.MachineDeploymentsStateMap{
+						"md0": &scope.MachineDeploymentState{
+							Object: builder.MachineDeployment("ns1", "md0-abc123").
+								WithReplicas(2).
+								WithStatus(clusterv1.MachineDeploymentStatus{
+									Replicas:            int32(1),
+									UpdatedReplicas:     int32(1),
+									ReadyReplicas:       int32(1),
+									AvailableReplicas:   int32(1),
+									UnavailableReplicas: int32(0),
+								}).
+								Build(),
+						},
+					},
+				},
+				UpgradeTracker: func() *scope.UpgradeTracker {
+					ut := scope.NewUpgradeTracker()
+					ut.ControlPlane.IsPendingUpgrade = true
+					ut.MachineDeployments.MarkUpgrading("md0-abc123")
+					ut.MachineDeployments.MarkOnDeleteStrategy("md0-abc123")
+					return ut
+				}(),
+				HookResponseTracker: scope.NewHookResponseTracker(),
+			},
+			wantConditionStatus: corev1.ConditionFalse,
+			wantConditionReason: clusterv1.TopologyReconciledControlPlaneUpgradePendingReason,
+			wantConditionMessage: "Control plane rollout and upgrade to version v1.22.0 on hold. MachineDeployment(s) md0-abc123 are upgrading, " +
+				"MachineDeployment(s) md0-abc123 are using the OnDelete strategy and waiting for Machines to be deleted",
+		},
 		{
 			name:         "should set the condition to false if new version is not picked up because at least one of the machine pool is upgrading",
 			reconcileErr: nil,
@@ -986,6 +1034,89 @@ func TestReconcileTopologyReconciledCondition(t *testing.T) {
 	}
 }
 
+func TestReconcileTopologyFieldOwnershipCondition(t *testing.T) {
+	deletionTime := metav1.Unix(0, 0)
+	conflictTracker := func() *scope.FieldConflictTracker {
+		f := scope.NewFieldConflictTracker()
+		f.Add("MachineDeployment/md-1", structuredmerge.FieldConflict{Path: ".spec.replicas", Manager: "argocd", Forced: true})
+		return f
+	}
+
+	tests := []struct {
+		name                 string
+		cluster              *clusterv1.Cluster
+		fieldConflictTracker *scope.FieldConflictTracker
+		reconcileErr         error
+		wantCondition        bool
+		wantConditionStatus  corev1.ConditionStatus
+		wantConditionReason  string
+		wantConditionMessage string
+	}{
+		{
+			name:                 "should set the condition to true if there are no conflicts",
+			cluster:              &clusterv1.Cluster{},
+			fieldConflictTracker: scope.NewFieldConflictTracker(),
+			wantCondition:        true,
+			wantConditionStatus:  corev1.ConditionTrue,
+		},
+		{
+			name:                 "should set the condition to false if there are conflicts",
+			cluster:              &clusterv1.Cluster{},
+			fieldConflictTracker: conflictTracker(),
+			wantCondition:        true,
+			wantConditionStatus:  corev1.ConditionFalse,
+			wantConditionReason:  clusterv1.TopologyFieldConflictReason,
+			wantConditionMessage: `MachineDeployment/md-1: .spec.replicas owned by "argocd" (forced)`,
+		},
+		{
+			name:                 "should set the condition to false if there are conflicts and a reconcile error",
+			cluster:              &clusterv1.Cluster{},
+			fieldConflictTracker: conflictTracker(),
+			reconcileErr:         errors.New("reconcile error"),
+			wantCondition:        true,
+			wantConditionStatus:  corev1.ConditionFalse,
+			wantConditionReason:  clusterv1.TopologyFieldConflictReason,
+			wantConditionMessage: `MachineDeployment/md-1: .spec.replicas owned by "argocd" (forced)`,
+		},
+		{
+			name:                 "should not set the condition if there are no conflicts but a reconcile error",
+			cluster:              &clusterv1.Cluster{},
+			fieldConflictTracker: scope.NewFieldConflictTracker(),
+			reconcileErr:         errors.New("reconcile error"),
+			wantCondition:        false,
+		},
+		{
+			name: "should not set the condition if the cluster is being deleted",
+			cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					DeletionTimestamp: &deletionTime,
+				},
+			},
+			fieldConflictTracker: conflictTracker(),
+			wantCondition:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := &Reconciler{}
+			r.reconcileTopologyFieldOwnershipCondition(&scope.Scope{FieldConflictTracker: tt.fieldConflictTracker}, tt.cluster, tt.reconcileErr)
+
+			actualCondition := conditions.Get(tt.cluster, clusterv1.TopologyFieldOwnershipCondition)
+			if !tt.wantCondition {
+				g.Expect(actualCondition).To(BeNil())
+				return
+			}
+			g.Expect(actualCondition).ToNot(BeNil())
+			g.Expect(actualCondition.Status).To(Equal(tt.wantConditionStatus))
+			g.Expect(actualCondition.Reason).To(Equal(tt.wantConditionReason))
+			g.Expect(actualCondition.Message).To(Equal(tt.wantConditionMessage))
+		})
+	}
+}
+
 func TestComputeNameList(t *testing.T) {
 	tests := []struct {
 		name     string
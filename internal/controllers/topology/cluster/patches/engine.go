@@ -25,6 +25,8 @@ import (
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
 
@@ -33,18 +35,19 @@ import (
 	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
 	"sigs.k8s.io/cluster-api/feature"
-	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/patches/api"
 	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/patches/external"
 	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/patches/inline"
 	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/patches/variables"
 	tlog "sigs.k8s.io/cluster-api/internal/log"
 	runtimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
+	"sigs.k8s.io/cluster-api/internal/util/tracing"
+	"sigs.k8s.io/cluster-api/util/contract"
 )
 
 // Engine is a patch engine which applies patches defined in a ClusterBlueprint to a ClusterState.
 type Engine interface {
-	Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState) error
+	Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState, tracker *scope.PatchTracker) error
 }
 
 // NewEngine creates a new patch engine.
@@ -65,12 +68,17 @@ type engine struct {
 //   - Then for all ClusterClassPatches of a ClusterClass, JSON or JSON merge patches are generated
 //     and successively applied to the templates in the GeneratePatchesRequest.
 //   - Eventually the patched templates are used to update the specs of the desired objects.
-func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState) error {
+//
+// If a PatchTracker is provided, the fields changed by each ClusterClassPatch are recorded into it.
+func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState, tracker *scope.PatchTracker) (reterr error) {
 	// Return if there are no patches.
 	if len(blueprint.ClusterClass.Spec.Patches) == 0 {
 		return nil
 	}
 
+	ctx, span := tracing.Start(ctx, "ApplyPatches")
+	defer func() { tracing.End(span, reterr) }()
+
 	log := tlog.LoggerFrom(ctx)
 
 	// Create a patch generation request.
@@ -105,15 +113,32 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 		// NOTE: All the partial patches accumulate on top of the request, so the
 		// patch generator in the next iteration of the loop will get the modified
 		// version of the request (including the patched version of the templates).
-		resp, err := generator.Generate(ctx, desired.Cluster, req)
+		generateCtx, generateSpan := tracing.Start(ctx, "GeneratePatches",
+			attribute.String("patch.name", clusterClassPatch.Name),
+			attribute.Bool("patch.external", clusterClassPatch.External != nil),
+		)
+		resp, err := generator.Generate(generateCtx, desired.Cluster, req)
+		tracing.End(generateSpan, err)
 		if err != nil {
 			return errors.Wrapf(err, "failed to generate patches for patch %q", clusterClassPatch.Name)
 		}
 
+		// Take a snapshot of the templates if we have to track the fields changed by the current patch.
+		var snapshot map[types.UID][]byte
+		if tracker != nil {
+			snapshot = snapshotRequestItems(req)
+		}
+
 		// Apply patches to the request.
 		if err := applyPatchesToRequest(ctx, req, resp); err != nil {
 			return errors.Wrapf(err, "failed to apply patches for patch %q", clusterClassPatch.Name)
 		}
+
+		if tracker != nil {
+			if err := trackPatchContributions(tracker, clusterClassPatch.Name, snapshot, req); err != nil {
+				return errors.Wrapf(err, "failed to track changes for patch %q", clusterClassPatch.Name)
+			}
+		}
 	}
 
 	// Convert request to validation request.
@@ -134,7 +159,11 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 
 		validator := external.NewValidator(e.runtimeClient, &clusterClassPatch)
 
-		_, err := validator.Validate(ctx, desired.Cluster, validationRequest)
+		validateCtx, validateSpan := tracing.Start(ctx, "ValidateTopology",
+			attribute.String("patch.name", clusterClassPatch.Name),
+		)
+		_, err := validator.Validate(validateCtx, desired.Cluster, validationRequest)
+		tracing.End(validateSpan, err)
 		if err != nil {
 			return errors.Wrapf(err, "validation of patch %q failed", clusterClassPatch.Name)
 		}
@@ -948,7 +948,7 @@ func TestApply(t *testing.T) {
 			}
 
 			// Apply patches.
-			if err := patchEngine.Apply(context.Background(), blueprint, desired); err != nil {
+			if err := patchEngine.Apply(context.Background(), blueprint, desired, nil); err != nil {
 				if !tt.wantErr {
 					t.Fatal(err)
 				}
@@ -976,6 +976,77 @@ func TestApply(t *testing.T) {
 	}
 }
 
+func TestApplyWithPatchTracker(t *testing.T) {
+	g := NewWithT(t)
+
+	blueprint, desired := setupTestObjects()
+	blueprint.ClusterClass.Spec.Patches = []clusterv1.ClusterClassPatch{
+		{
+			Name: "fake-patch1",
+			Definitions: []clusterv1.PatchDefinition{
+				{
+					Selector: clusterv1.PatchSelector{
+						APIVersion: builder.InfrastructureGroupVersion.String(),
+						Kind:       builder.GenericInfrastructureClusterTemplateKind,
+						MatchResources: clusterv1.PatchSelectorMatch{
+							InfrastructureCluster: true,
+						},
+					},
+					JSONPatches: []clusterv1.JSONPatch{
+						{
+							Op:    "add",
+							Path:  "/spec/template/spec/resource",
+							Value: &apiextensionsv1.JSON{Raw: []byte(`"infraCluster"`)},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "fake-patch2",
+			Definitions: []clusterv1.PatchDefinition{
+				{
+					Selector: clusterv1.PatchSelector{
+						APIVersion: builder.InfrastructureGroupVersion.String(),
+						Kind:       builder.GenericInfrastructureClusterTemplateKind,
+						MatchResources: clusterv1.PatchSelectorMatch{
+							InfrastructureCluster: true,
+						},
+					},
+					JSONPatches: []clusterv1.JSONPatch{
+						{
+							Op:    "add",
+							Path:  "/spec/template/spec/another",
+							Value: &apiextensionsv1.JSON{Raw: []byte(`"value"`)},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tracker := scope.NewPatchTracker()
+	patchEngine := NewEngine(nil)
+	g.Expect(patchEngine.Apply(context.Background(), blueprint, desired, tracker)).To(Succeed())
+
+	g.Expect(tracker.Contributions()).To(Equal([]scope.PatchContribution{
+		{
+			Patch:           "fake-patch1",
+			HolderKind:      "Cluster",
+			HolderName:      desired.Cluster.Name,
+			HolderFieldPath: "spec.infrastructureRef",
+			Paths:           []string{".spec.template.spec.resource"},
+		},
+		{
+			Patch:           "fake-patch2",
+			HolderKind:      "Cluster",
+			HolderName:      desired.Cluster.Name,
+			HolderFieldPath: "spec.infrastructureRef",
+			Paths:           []string{".spec.template.spec.another"},
+		},
+	}))
+}
+
 func setupTestObjects() (*scope.ClusterBlueprint, *scope.ClusterState) {
 	infrastructureClusterTemplate := builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infraClusterTemplate1").
 		Build()
@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patches
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+)
+
+// snapshotRequestItems returns a copy of the templates in a GeneratePatchesRequest, indexed by UID.
+func snapshotRequestItems(req *runtimehooksv1.GeneratePatchesRequest) map[types.UID][]byte {
+	snapshot := make(map[types.UID][]byte, len(req.Items))
+	for _, item := range req.Items {
+		snapshot[item.UID] = append([]byte{}, item.Object.Raw...)
+	}
+	return snapshot
+}
+
+// trackPatchContributions records into the tracker the fields changed by a ClusterClass patch, by comparing
+// the templates in the GeneratePatchesRequest with the snapshot taken before the patch has been applied.
+func trackPatchContributions(tracker *scope.PatchTracker, patchName string, snapshot map[types.UID][]byte, req *runtimehooksv1.GeneratePatchesRequest) error {
+	for _, item := range req.Items {
+		var before, after interface{}
+		if err := json.Unmarshal(snapshot[item.UID], &before); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal template with uid %q", item.UID)
+		}
+		if err := json.Unmarshal(item.Object.Raw, &after); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal template with uid %q", item.UID)
+		}
+
+		tracker.Add(scope.PatchContribution{
+			Patch:           patchName,
+			HolderKind:      item.HolderReference.Kind,
+			HolderName:      item.HolderReference.Name,
+			HolderFieldPath: item.HolderReference.FieldPath,
+			Paths:           changedPaths("", before, after),
+		})
+	}
+	return nil
+}
+
+// changedPaths returns the paths of the fields which are different between before and after.
+// Maps are compared field by field, while all other values (including lists) are compared as a whole.
+func changedPaths(path string, before, after interface{}) []string {
+	beforeMap, beforeIsMap := before.(map[string]interface{})
+	afterMap, afterIsMap := after.(map[string]interface{})
+	if !beforeIsMap || !afterIsMap {
+		if reflect.DeepEqual(before, after) {
+			return nil
+		}
+		return []string{path}
+	}
+
+	keys := map[string]bool{}
+	for k := range beforeMap {
+		keys[k] = true
+	}
+	for k := range afterMap {
+		keys[k] = true
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for k := range keys {
+		sortedKeys = append(sortedKeys, k)
+	}
+	sort.Strings(sortedKeys)
+
+	paths := []string{}
+	for _, k := range sortedKeys {
+		paths = append(paths, changedPaths(fmt.Sprintf("%s.%s", path, k), beforeMap[k], afterMap[k])...)
+	}
+	return paths
+}
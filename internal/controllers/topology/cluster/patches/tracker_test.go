@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package patches
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_changedPaths(t *testing.T) {
+	tests := []struct {
+		name   string
+		before string
+		after  string
+		want   []string
+	}{
+		{
+			name:   "No changes",
+			before: `{"spec":{"a":"1","b":["x"]}}`,
+			after:  `{"spec":{"a":"1","b":["x"]}}`,
+			want:   []string{},
+		},
+		{
+			name:   "Added, changed and removed fields",
+			before: `{"spec":{"a":"1","b":{"c":"2"},"d":"3"}}`,
+			after:  `{"spec":{"a":"2","b":{"c":"2","e":"4"}}}`,
+			want:   []string{".spec.a", ".spec.b.e", ".spec.d"},
+		},
+		{
+			name:   "Lists are compared as a whole",
+			before: `{"spec":{"files":[{"path":"/a"}]}}`,
+			after:  `{"spec":{"files":[{"path":"/a"},{"path":"/b"}]}}`,
+			want:   []string{".spec.files"},
+		},
+		{
+			name:   "Map replaced by a value",
+			before: `{"spec":{"a":{"b":"1"}}}`,
+			after:  `{"spec":{"a":"1"}}`,
+			want:   []string{".spec.a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			var before, after interface{}
+			g.Expect(json.Unmarshal([]byte(tt.before), &before)).To(Succeed())
+			g.Expect(json.Unmarshal([]byte(tt.after), &after)).To(Succeed())
+
+			g.Expect(changedPaths("", before, after)).To(Equal(tt.want))
+		})
+	}
+}
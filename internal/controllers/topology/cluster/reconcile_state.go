@@ -18,6 +18,7 @@ package cluster
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -26,21 +27,24 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/storage/names"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
 	"sigs.k8s.io/cluster-api/feature"
-	"sigs.k8s.io/cluster-api/internal/contract"
+	"sigs.k8s.io/cluster-api/internal/controllers/machinedeployment/mdutil"
 	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/structuredmerge"
 	"sigs.k8s.io/cluster-api/internal/hooks"
 	tlog "sigs.k8s.io/cluster-api/internal/log"
@@ -49,12 +53,15 @@ import (
 	topologynames "sigs.k8s.io/cluster-api/internal/topology/names"
 	"sigs.k8s.io/cluster-api/internal/topology/ownerrefs"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/contract"
 )
 
 const (
-	createEventReason = "TopologyCreate"
-	updateEventReason = "TopologyUpdate"
-	deleteEventReason = "TopologyDelete"
+	createEventReason        = "TopologyCreate"
+	updateEventReason        = "TopologyUpdate"
+	deleteEventReason        = "TopologyDelete"
+	fieldConflictEventReason = "TopologyFieldConflict"
 )
 
 // reconcileState reconciles the current and desired state of the managed Cluster topology.
@@ -238,6 +245,7 @@ func (r *Reconciler) callAfterClusterUpgrade(ctx context.Context, s *scope.Scope
 		// - MachineDeployments/MachinePools are not currently upgrading
 		// - MachineDeployments/MachinePools are not pending an upgrade
 		// - MachineDeployments/MachinePools are not pending create
+		// - The AfterWorkersUpgrade hook is completed
 		if s.UpgradeTracker.ControlPlane.IsControlPlaneStable() && // Control Plane stable checks
 			len(s.UpgradeTracker.MachineDeployments.UpgradingNames()) == 0 && // Machine deployments are not upgrading or not about to upgrade
 			!s.UpgradeTracker.MachineDeployments.IsAnyPendingCreate() && // No MachineDeployments are pending create
@@ -247,6 +255,28 @@ func (r *Reconciler) callAfterClusterUpgrade(ctx context.Context, s *scope.Scope
 			!s.UpgradeTracker.MachinePools.IsAnyPendingCreate() && // No MachinePools are pending create
 			!s.UpgradeTracker.MachinePools.IsAnyPendingUpgrade() && // No MachinePools are pending an upgrade
 			!s.UpgradeTracker.MachinePools.DeferredUpgrade() { // No MachinePools have deferred an upgrade
+			// The workers are upgraded; call the AfterWorkersUpgrade hook if we are tracking the intent to do so.
+			if hooks.IsPending(runtimehooksv1.AfterWorkersUpgrade, s.Current.Cluster) {
+				hookRequest := &runtimehooksv1.AfterWorkersUpgradeRequest{
+					Cluster:           *s.Current.Cluster,
+					KubernetesVersion: s.Current.Cluster.Spec.Topology.Version,
+				}
+				hookResponse := &runtimehooksv1.AfterWorkersUpgradeResponse{}
+				if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.AfterWorkersUpgrade, s.Current.Cluster, hookRequest, hookResponse); err != nil {
+					return err
+				}
+				s.HookResponseTracker.Add(runtimehooksv1.AfterWorkersUpgrade, hookResponse)
+				// If the extension responds to hold off on completing the upgrade, the AfterClusterUpgrade hook
+				// is not called yet, otherwise we can remove this hook from the list of pending-hooks.
+				if hookResponse.RetryAfterSeconds != 0 {
+					tlog.LoggerFrom(ctx).Infof("Cluster upgrade to version %q is blocked by %q hook", s.Current.Cluster.Spec.Topology.Version, runtimecatalog.HookName(runtimehooksv1.AfterWorkersUpgrade))
+					return nil
+				}
+				if err := hooks.MarkAsDone(ctx, r.Client, s.Current.Cluster, runtimehooksv1.AfterWorkersUpgrade); err != nil {
+					return err
+				}
+			}
+
 			// Everything is stable and the cluster can be considered fully upgraded.
 			hookRequest := &runtimehooksv1.AfterClusterUpgradeRequest{
 				Cluster:           *s.Current.Cluster,
@@ -277,10 +307,11 @@ func (r *Reconciler) reconcileInfrastructureCluster(ctx context.Context, s *scop
 	}
 
 	return r.reconcileReferencedObject(ctx, reconcileReferencedObjectInput{
-		cluster:     s.Current.Cluster,
-		current:     s.Current.InfrastructureCluster,
-		desired:     s.Desired.InfrastructureCluster,
-		ignorePaths: ignorePaths,
+		cluster:      s.Current.Cluster,
+		current:      s.Current.InfrastructureCluster,
+		desired:      s.Desired.InfrastructureCluster,
+		ignorePaths:  ignorePaths,
+		patchOptions: []structuredmerge.HelperOption{r.fieldOwnership(s)},
 	})
 }
 
@@ -292,7 +323,7 @@ func (r *Reconciler) reconcileControlPlane(ctx context.Context, s *scope.Scope)
 	// even if the Control Plane is pending an upgrade.
 	if s.Desired.ControlPlane.MachineHealthCheck != nil || s.Current.ControlPlane.MachineHealthCheck != nil {
 		// Reconcile the current and desired state of the MachineHealthCheck.
-		if err := r.reconcileMachineHealthCheck(ctx, s.Current.ControlPlane.MachineHealthCheck, s.Desired.ControlPlane.MachineHealthCheck); err != nil {
+		if err := r.reconcileMachineHealthCheck(ctx, s.Current.ControlPlane.MachineHealthCheck, s.Desired.ControlPlane.MachineHealthCheck, r.fieldOwnership(s)); err != nil {
 			return false, err
 		}
 	}
@@ -321,6 +352,7 @@ func (r *Reconciler) reconcileControlPlane(ctx context.Context, s *scope.Scope)
 			desired:              s.Desired.ControlPlane.InfrastructureMachineTemplate,
 			compatibilityChecker: check.ObjectsAreCompatible,
 			templateNamePrefix:   topologynames.ControlPlaneInfrastructureMachineTemplateNamePrefix(s.Current.Cluster.Name),
+			patchOptions:         []structuredmerge.HelperOption{r.fieldOwnership(s)},
 		})
 		if err != nil {
 			return false, err
@@ -355,6 +387,7 @@ func (r *Reconciler) reconcileControlPlane(ctx context.Context, s *scope.Scope)
 		current:       s.Current.ControlPlane.Object,
 		desired:       s.Desired.ControlPlane.Object,
 		versionGetter: contract.ControlPlane().Version().Get,
+		patchOptions:  []structuredmerge.HelperOption{r.fieldOwnership(s)},
 	})
 	if err != nil {
 		// Best effort cleanup of the InfrastructureMachineTemplate (only on creation).
@@ -381,7 +414,8 @@ func (r *Reconciler) reconcileControlPlane(ctx context.Context, s *scope.Scope)
 
 // reconcileMachineHealthCheck creates, updates, deletes or leaves untouched a MachineHealthCheck depending on the difference between the
 // current state and the desired state.
-func (r *Reconciler) reconcileMachineHealthCheck(ctx context.Context, current, desired *clusterv1.MachineHealthCheck) error {
+// NOTE: opts are used only when patching an existing MachineHealthCheck.
+func (r *Reconciler) reconcileMachineHealthCheck(ctx context.Context, current, desired *clusterv1.MachineHealthCheck, opts ...structuredmerge.HelperOption) error {
 	log := tlog.LoggerFrom(ctx)
 
 	// If a current MachineHealthCheck doesn't exist but there is a desired MachineHealthCheck attempt to create.
@@ -416,7 +450,7 @@ func (r *Reconciler) reconcileMachineHealthCheck(ctx context.Context, current, d
 	// Check differences between current and desired MachineHealthChecks, and patch if required.
 	// NOTE: we want to be authoritative on the entire spec because the users are
 	// expected to change MHC fields from the ClusterClass only.
-	patchHelper, err := r.patchHelperFactory(ctx, current, desired)
+	patchHelper, err := r.patchHelperFactory(ctx, current, desired, opts...)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create patch helper for %s", tlog.KObj{Obj: current})
 	}
@@ -441,7 +475,7 @@ func (r *Reconciler) reconcileCluster(ctx context.Context, s *scope.Scope) error
 	ctx, log := tlog.LoggerFrom(ctx).WithObject(s.Desired.Cluster).Into(ctx)
 
 	// Check differences between current and desired state, and eventually patch the current object.
-	patchHelper, err := r.patchHelperFactory(ctx, s.Current.Cluster, s.Desired.Cluster)
+	patchHelper, err := r.patchHelperFactory(ctx, s.Current.Cluster, s.Desired.Cluster, r.fieldOwnership(s))
 	if err != nil {
 		return errors.Wrapf(err, "failed to create patch helper for %s", tlog.KObj{Obj: s.Current.Cluster})
 	}
@@ -668,7 +702,7 @@ func (r *Reconciler) updateMachineDeployment(ctx context.Context, s *scope.Scope
 	// MHC changes are not Kubernetes version dependent, therefore proceed with MHC reconciliation
 	// even if the MachineDeployment is pending an upgrade.
 	if desiredMD.MachineHealthCheck != nil || currentMD.MachineHealthCheck != nil {
-		if err := r.reconcileMachineHealthCheck(ctx, currentMD.MachineHealthCheck, desiredMD.MachineHealthCheck); err != nil {
+		if err := r.reconcileMachineHealthCheck(ctx, currentMD.MachineHealthCheck, desiredMD.MachineHealthCheck, r.fieldOwnership(s)); err != nil {
 			return err
 		}
 	}
@@ -690,6 +724,7 @@ func (r *Reconciler) updateMachineDeployment(ctx context.Context, s *scope.Scope
 		desired:              desiredMD.InfrastructureMachineTemplate,
 		templateNamePrefix:   topologynames.InfrastructureMachineTemplateNamePrefix(cluster.Name, mdTopologyName),
 		compatibilityChecker: check.ObjectsAreCompatible,
+		patchOptions:         []structuredmerge.HelperOption{r.fieldOwnership(s)},
 	})
 	if err != nil {
 		return errors.Wrapf(err, "failed to reconcile %s", tlog.KObj{Obj: currentMD.Object})
@@ -717,6 +752,7 @@ func (r *Reconciler) updateMachineDeployment(ctx context.Context, s *scope.Scope
 		desired:              desiredMD.BootstrapTemplate,
 		templateNamePrefix:   topologynames.BootstrapTemplateNamePrefix(cluster.Name, mdTopologyName),
 		compatibilityChecker: check.ObjectsAreInTheSameNamespace,
+		patchOptions:         []structuredmerge.HelperOption{r.fieldOwnership(s)},
 	})
 	if err != nil {
 		// Best effort cleanup of the InfrastructureMachineTemplate (only on template rotation).
@@ -737,9 +773,18 @@ func (r *Reconciler) updateMachineDeployment(ctx context.Context, s *scope.Scope
 		}
 	}
 
+	// Check if Runtime Extensions accept to update the Machines in-place after a template rotation.
+	rotated := (createdInfra && currentMD.InfrastructureMachineTemplate != nil) || (createdBootstrap && currentMD.BootstrapTemplate != nil)
+	if err := r.reconcileInPlaceUpdate(ctx, s, currentMD, desiredMD, rotated); err != nil {
+		// Best effort cleanup of the InfrastructureMachineTemplate & BootstrapTemplate (only on template rotation).
+		infrastructureMachineCleanupFunc()
+		bootstrapCleanupFunc()
+		return err
+	}
+
 	// Check differences between current and desired MachineDeployment, and eventually patch the current object.
 	log = log.WithObject(desiredMD.Object)
-	patchHelper, err := r.patchHelperFactory(ctx, currentMD.Object, desiredMD.Object)
+	patchHelper, err := r.patchHelperFactory(ctx, currentMD.Object, desiredMD.Object, r.fieldOwnership(s))
 	if err != nil {
 		// Best effort cleanup of the InfrastructureMachineTemplate & BootstrapTemplate (only on template rotation).
 		infrastructureMachineCleanupFunc()
@@ -793,6 +838,140 @@ func logMachineDeploymentVersionChange(current, desired *clusterv1.MachineDeploy
 	return ""
 }
 
+// reconcileInPlaceUpdate tracks on the MachineDeployment if Runtime Extensions accepted to update its Machines in-place.
+// When the bootstrap or infrastructure machine templates are rotated, the UpdateMachine hook is called and, if all the
+// Runtime Extensions accept the request, the hash of the current machine template is tracked in an annotation on the
+// MachineDeployment; the MachineDeployment controller then propagates the references to the new templates to the existing
+// MachineSet instead of rolling out the Machines.
+// NOTE: The hook is only called when the templates are rotated, so Machines created afterwards, e.g. on scale up or
+// remediation, always use the new templates.
+func (r *Reconciler) reconcileInPlaceUpdate(ctx context.Context, s *scope.Scope, currentMD, desiredMD *scope.MachineDeploymentState, rotated bool) error {
+	// If the templates are not rotated, preserve the in-place update tracked on the MachineDeployment until the next
+	// template rotation, so the MachineDeployment controller can complete it.
+	if !rotated {
+		if templateHash, ok := currentMD.Object.GetAnnotations()[clusterv1.ClusterTopologyInPlaceUpdateHashAnnotation]; ok {
+			setInPlaceUpdateHashAnnotation(desiredMD.Object, templateHash)
+		}
+		return nil
+	}
+
+	if !feature.Gates.Enabled(feature.RuntimeSDK) {
+		return nil
+	}
+
+	inPlaceUpdate, err := r.callUpdateMachine(ctx, s, currentMD, desiredMD)
+	if err != nil || !inPlaceUpdate {
+		return err
+	}
+
+	templateHash, err := mdutil.ComputeMachineTemplateHash(&currentMD.Object.Spec.Template)
+	if err != nil {
+		return errors.Wrapf(err, "failed to compute hash of the machine template of %s", tlog.KObj{Obj: currentMD.Object})
+	}
+	setInPlaceUpdateHashAnnotation(desiredMD.Object, templateHash)
+	return nil
+}
+
+func setInPlaceUpdateHashAnnotation(md *clusterv1.MachineDeployment, templateHash string) {
+	annotations := md.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[clusterv1.ClusterTopologyInPlaceUpdateHashAnnotation] = templateHash
+	md.SetAnnotations(annotations)
+}
+
+// callUpdateMachine calls the UpdateMachine hook when the changes to a MachineDeployment can be applied to its Machines
+// in-place, and returns true if all the Runtime Extensions accepted to update the Machines instead of rolling them out.
+func (r *Reconciler) callUpdateMachine(ctx context.Context, s *scope.Scope, currentMD, desiredMD *scope.MachineDeploymentState) (bool, error) {
+	log := tlog.LoggerFrom(ctx).WithMachineDeployment(desiredMD.Object)
+
+	if !canUpdateMachineDeploymentInPlace(currentMD, desiredMD) {
+		return false, nil
+	}
+
+	hookRequest, err := newUpdateMachineRequest(s.Current.Cluster, currentMD, desiredMD)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create %s request for %s", runtimecatalog.HookName(runtimehooksv1.UpdateMachine), tlog.KObj{Obj: currentMD.Object})
+	}
+	hookResponse := &runtimehooksv1.UpdateMachineResponse{}
+	if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.UpdateMachine, s.Current.Cluster, hookRequest, hookResponse); err != nil {
+		return false, err
+	}
+	if !hookResponse.Accepted {
+		log.Infof("Runtime Extensions did not accept to update the Machines of %s in-place, falling back to rollout", tlog.KObj{Obj: currentMD.Object})
+		return false, nil
+	}
+	log.Infof("Runtime Extensions accepted to update the Machines of %s in-place", tlog.KObj{Obj: currentMD.Object})
+	r.recorder.Eventf(s.Current.Cluster, corev1.EventTypeNormal, updateEventReason, "Updating Machines of %q in-place", tlog.KObj{Obj: currentMD.Object})
+	return true, nil
+}
+
+// canUpdateMachineDeploymentInPlace returns true if the changes to the MachineDeployment, other than the changes to the spec
+// of its bootstrap and infrastructure machine templates, can be applied to its Machines in-place.
+// NOTE: Changes to the Kubernetes version, to the failure domain or to the kind of the templates require the Machines
+// to be reprovisioned, and thus they always trigger a rollout.
+func canUpdateMachineDeploymentInPlace(currentMD, desiredMD *scope.MachineDeploymentState) bool {
+	currentSpec := currentMD.Object.Spec.Template.Spec
+	desiredSpec := desiredMD.Object.Spec.Template.Spec
+	if !ptr.Equal(currentSpec.Version, desiredSpec.Version) ||
+		!ptr.Equal(currentSpec.FailureDomain, desiredSpec.FailureDomain) ||
+		currentSpec.InfrastructureRef.GroupVersionKind().GroupKind() != desiredSpec.InfrastructureRef.GroupVersionKind().GroupKind() {
+		return false
+	}
+	if (currentMD.BootstrapTemplate == nil) != (desiredMD.BootstrapTemplate == nil) {
+		return false
+	}
+	if currentMD.BootstrapTemplate != nil &&
+		currentMD.BootstrapTemplate.GroupVersionKind().GroupKind() != desiredMD.BootstrapTemplate.GroupVersionKind().GroupKind() {
+		return false
+	}
+	return true
+}
+
+// newUpdateMachineRequest returns the request of the UpdateMachine hook for a MachineDeployment.
+func newUpdateMachineRequest(cluster *clusterv1.Cluster, currentMD, desiredMD *scope.MachineDeploymentState) (*runtimehooksv1.UpdateMachineRequest, error) {
+	current, err := newMachineTemplates(currentMD)
+	if err != nil {
+		return nil, err
+	}
+	desired, err := newMachineTemplates(desiredMD)
+	if err != nil {
+		return nil, err
+	}
+	return &runtimehooksv1.UpdateMachineRequest{
+		Cluster:           *cluster,
+		MachineDeployment: *currentMD.Object,
+		Current:           *current,
+		Desired:           *desired,
+	}, nil
+}
+
+// newMachineTemplates returns the bootstrap and infrastructure machine templates of a MachineDeployment.
+func newMachineTemplates(md *scope.MachineDeploymentState) (*runtimehooksv1.MachineTemplates, error) {
+	infrastructureMachineTemplate, err := json.Marshal(md.InfrastructureMachineTemplate)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal %s", tlog.KObj{Obj: md.InfrastructureMachineTemplate})
+	}
+	templates := &runtimehooksv1.MachineTemplates{
+		InfrastructureMachineTemplate: runtime.RawExtension{
+			Raw:    infrastructureMachineTemplate,
+			Object: md.InfrastructureMachineTemplate,
+		},
+	}
+	if md.BootstrapTemplate != nil {
+		bootstrapTemplate, err := json.Marshal(md.BootstrapTemplate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal %s", tlog.KObj{Obj: md.BootstrapTemplate})
+		}
+		templates.BootstrapTemplate = &runtime.RawExtension{
+			Raw:    bootstrapTemplate,
+			Object: md.BootstrapTemplate,
+		}
+	}
+	return templates, nil
+}
+
 // deleteMachineDeployment deletes a MachineDeployment.
 func (r *Reconciler) deleteMachineDeployment(ctx context.Context, cluster *clusterv1.Cluster, md *scope.MachineDeploymentState) error {
 	log := tlog.LoggerFrom(ctx).WithMachineDeployment(md.Object).WithObject(md.Object)
@@ -1002,25 +1181,27 @@ func (r *Reconciler) updateMachinePool(ctx context.Context, s *scope.Scope, curr
 	cluster := s.Current.Cluster
 	infraCtx, _ := log.WithObject(desiredMP.InfrastructureMachinePoolObject).Into(ctx)
 	if _, err := r.reconcileReferencedObject(infraCtx, reconcileReferencedObjectInput{
-		cluster: cluster,
-		current: currentMP.InfrastructureMachinePoolObject,
-		desired: desiredMP.InfrastructureMachinePoolObject,
+		cluster:      cluster,
+		current:      currentMP.InfrastructureMachinePoolObject,
+		desired:      desiredMP.InfrastructureMachinePoolObject,
+		patchOptions: []structuredmerge.HelperOption{r.fieldOwnership(s)},
 	}); err != nil {
 		return errors.Wrapf(err, "failed to reconcile %s", tlog.KObj{Obj: currentMP.Object})
 	}
 
 	bootstrapCtx, _ := log.WithObject(desiredMP.BootstrapObject).Into(ctx)
 	if _, err := r.reconcileReferencedObject(bootstrapCtx, reconcileReferencedObjectInput{
-		cluster: cluster,
-		current: currentMP.BootstrapObject,
-		desired: desiredMP.BootstrapObject,
+		cluster:      cluster,
+		current:      currentMP.BootstrapObject,
+		desired:      desiredMP.BootstrapObject,
+		patchOptions: []structuredmerge.HelperOption{r.fieldOwnership(s)},
 	}); err != nil {
 		return errors.Wrapf(err, "failed to reconcile %s", tlog.KObj{Obj: currentMP.Object})
 	}
 
 	// Check differences between current and desired MachinePool, and eventually patch the current object.
 	log = log.WithObject(desiredMP.Object)
-	patchHelper, err := r.patchHelperFactory(ctx, currentMP.Object, desiredMP.Object)
+	patchHelper, err := r.patchHelperFactory(ctx, currentMP.Object, desiredMP.Object, r.fieldOwnership(s))
 	if err != nil {
 		return errors.Wrapf(err, "failed to create patch helper for %s", tlog.KObj{Obj: currentMP.Object})
 	}
@@ -1135,6 +1316,8 @@ type reconcileReferencedObjectInput struct {
 	desired       *unstructured.Unstructured
 	versionGetter unstructuredVersionGetter
 	ignorePaths   []contract.Path
+	// patchOptions are additional options used when patching an existing object.
+	patchOptions []structuredmerge.HelperOption
 }
 
 // reconcileReferencedObject reconciles the desired state of the referenced object.
@@ -1164,7 +1347,7 @@ func (r *Reconciler) reconcileReferencedObject(ctx context.Context, in reconcile
 	}
 
 	// Check differences between current and desired state, and eventually patch the current object.
-	patchHelper, err := r.patchHelperFactory(ctx, in.current, in.desired, structuredmerge.IgnorePaths(in.ignorePaths))
+	patchHelper, err := r.patchHelperFactory(ctx, in.current, in.desired, append(in.patchOptions, structuredmerge.IgnorePaths(in.ignorePaths))...)
 	if err != nil {
 		return false, errors.Wrapf(err, "failed to create patch helper for %s", tlog.KObj{Obj: in.current})
 	}
@@ -1208,6 +1391,8 @@ type reconcileReferencedTemplateInput struct {
 	desired              *unstructured.Unstructured
 	templateNamePrefix   string
 	compatibilityChecker func(current, desired client.Object) field.ErrorList
+	// patchOptions are additional options used when patching an existing template (metadata changes only).
+	patchOptions []structuredmerge.HelperOption
 }
 
 // reconcileReferencedTemplate reconciles the desired state of a referenced Template.
@@ -1246,7 +1431,7 @@ func (r *Reconciler) reconcileReferencedTemplate(ctx context.Context, in reconci
 	}
 
 	// Check differences between current and desired objects, and if there are changes eventually start the template rotation.
-	patchHelper, err := r.patchHelperFactory(ctx, in.current, in.desired)
+	patchHelper, err := r.patchHelperFactory(ctx, in.current, in.desired, in.patchOptions...)
 	if err != nil {
 		return false, errors.Wrapf(err, "failed to create patch helper for %s", tlog.KObj{Obj: in.current})
 	}
@@ -1337,3 +1522,31 @@ func createErrorWithoutObjectName(ctx context.Context, err error, obj client.Obj
 	}
 	return errors.New("failed to create object")
 }
+
+// fieldOwnership returns a HelperOption resolving conflicts with other field managers according to the
+// field ownership policy defined in the ClusterClass; detected conflicts are recorded in the scope and
+// surfaced as events on the Cluster when they first appear.
+// NOTE: Ongoing conflicts are reported by the TopologyFieldOwnership condition only.
+func (r *Reconciler) fieldOwnership(s *scope.Scope) structuredmerge.HelperOption {
+	var policy *clusterv1.FieldOwnershipPolicy
+	if s.Blueprint != nil && s.Blueprint.ClusterClass != nil {
+		policy = s.Blueprint.ClusterClass.Spec.FieldOwnership
+	}
+
+	return structuredmerge.FieldOwnership{
+		Policy: policy,
+		OnConflicts: func(obj client.Object, conflicts []structuredmerge.FieldConflict) {
+			object := tlog.KObj{Obj: obj}.String()
+			s.FieldConflictTracker.Add(object, conflicts...)
+
+			// Conflicts already reported by the condition set in a previous reconcile are not new.
+			if c := conditions.Get(s.Current.Cluster, clusterv1.TopologyFieldOwnershipCondition); c != nil &&
+				c.Status == corev1.ConditionFalse && c.Reason == clusterv1.TopologyFieldConflictReason &&
+				s.FieldConflictTracker.IsReportedIn(c.Message, object) {
+				return
+			}
+			r.recorder.Eventf(s.Current.Cluster, corev1.EventTypeWarning, fieldConflictEventReason,
+				"Fields of %q are owned by other field managers: %s", object, s.FieldConflictTracker.ObjectMessage(object))
+		},
+	}
+}
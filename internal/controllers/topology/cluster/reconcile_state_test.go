@@ -33,6 +33,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -46,7 +48,8 @@ import (
 	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
 	"sigs.k8s.io/cluster-api/exp/topology/desiredstate"
 	"sigs.k8s.io/cluster-api/exp/topology/scope"
-	"sigs.k8s.io/cluster-api/internal/contract"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/controllers/machinedeployment/mdutil"
 	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/structuredmerge"
 	"sigs.k8s.io/cluster-api/internal/hooks"
 	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
@@ -57,6 +60,7 @@ import (
 	"sigs.k8s.io/cluster-api/internal/topology/selectors"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/internal/webhooks"
+	"sigs.k8s.io/cluster-api/util/contract"
 )
 
 var (
@@ -1094,6 +1098,309 @@ func TestReconcile_callAfterClusterUpgrade(t *testing.T) {
 	}
 }
 
+func TestReconcile_callAfterWorkersUpgrade(t *testing.T) {
+	catalog := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(catalog)
+
+	afterWorkersUpgradeGVH, err := catalog.GroupVersionHook(runtimehooksv1.AfterWorkersUpgrade)
+	if err != nil {
+		panic(err)
+	}
+	afterClusterUpgradeGVH, err := catalog.GroupVersionHook(runtimehooksv1.AfterClusterUpgrade)
+	if err != nil {
+		panic(err)
+	}
+
+	nonBlockingResponse := &runtimehooksv1.AfterWorkersUpgradeResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusSuccess,
+			},
+		},
+	}
+	blockingResponse := &runtimehooksv1.AfterWorkersUpgradeResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusSuccess,
+			},
+			RetryAfterSeconds: 10,
+		},
+	}
+
+	topologyVersion := "v1.2.3"
+	controlPlaneObj := builder.ControlPlane("test1", "cp1").
+		Build()
+
+	newScope := func(upgradeTracker *scope.UpgradeTracker) *scope.Scope {
+		return &scope.Scope{
+			Blueprint: &scope.ClusterBlueprint{
+				Topology: &clusterv1.Topology{
+					ControlPlane: clusterv1.ControlPlaneTopology{
+						Replicas: ptr.To[int32](2),
+					},
+				},
+			},
+			Current: &scope.ClusterState{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-cluster",
+						Namespace: "test-ns",
+						Annotations: map[string]string{
+							runtimev1.PendingHooksAnnotation: "AfterClusterUpgrade,AfterWorkersUpgrade",
+						},
+					},
+					Spec: clusterv1.ClusterSpec{
+						Topology: &clusterv1.Topology{
+							Version: topologyVersion,
+						},
+					},
+				},
+				ControlPlane: &scope.ControlPlaneState{
+					Object: controlPlaneObj,
+				},
+			},
+			HookResponseTracker: scope.NewHookResponseTracker(),
+			UpgradeTracker:      upgradeTracker,
+		}
+	}
+
+	tests := []struct {
+		name                          string
+		s                             *scope.Scope
+		hookResponse                  *runtimehooksv1.AfterWorkersUpgradeResponse
+		wantHookToBeCalled            bool
+		wantMarked                    bool
+		wantAfterClusterUpgradeCalled bool
+	}{
+		{
+			name: "hook should not be called if MDs are pending an upgrade - hook is marked",
+			s: newScope(func() *scope.UpgradeTracker {
+				ut := scope.NewUpgradeTracker()
+				ut.MachineDeployments.MarkPendingUpgrade("md1")
+				return ut
+			}()),
+			hookResponse:                  nonBlockingResponse,
+			wantHookToBeCalled:            false,
+			wantMarked:                    true,
+			wantAfterClusterUpgradeCalled: false,
+		},
+		{
+			name:                          "hook should be called if the control plane, MDs, and MPs are stable at the topology version - non blocking response should unmark the hook and call AfterClusterUpgrade",
+			s:                             newScope(scope.NewUpgradeTracker()),
+			hookResponse:                  nonBlockingResponse,
+			wantHookToBeCalled:            true,
+			wantMarked:                    false,
+			wantAfterClusterUpgradeCalled: true,
+		},
+		{
+			name:                          "hook should be called if the control plane, MDs, and MPs are stable at the topology version - blocking response should leave the hook marked and not call AfterClusterUpgrade",
+			s:                             newScope(scope.NewUpgradeTracker()),
+			hookResponse:                  blockingResponse,
+			wantHookToBeCalled:            true,
+			wantMarked:                    true,
+			wantAfterClusterUpgradeCalled: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fakeRuntimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithCallAllExtensionResponses(map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+					afterWorkersUpgradeGVH: tt.hookResponse,
+					afterClusterUpgradeGVH: &runtimehooksv1.AfterClusterUpgradeResponse{
+						CommonResponse: runtimehooksv1.CommonResponse{
+							Status: runtimehooksv1.ResponseStatusSuccess,
+						},
+					},
+				}).
+				WithCatalog(catalog).
+				Build()
+
+			fakeClient := fake.NewClientBuilder().WithObjects(tt.s.Current.Cluster).Build()
+
+			r := &Reconciler{
+				Client:                fakeClient,
+				APIReader:             fakeClient,
+				RuntimeClient:         fakeRuntimeClient,
+				desiredStateGenerator: desiredstate.NewGenerator(fakeClient, nil, fakeRuntimeClient),
+			}
+
+			err := r.callAfterClusterUpgrade(ctx, tt.s)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(fakeRuntimeClient.CallAllCount(runtimehooksv1.AfterWorkersUpgrade) == 1).To(Equal(tt.wantHookToBeCalled))
+			g.Expect(hooks.IsPending(runtimehooksv1.AfterWorkersUpgrade, tt.s.Current.Cluster)).To(Equal(tt.wantMarked))
+			g.Expect(fakeRuntimeClient.CallAllCount(runtimehooksv1.AfterClusterUpgrade) == 1).To(Equal(tt.wantAfterClusterUpgradeCalled))
+			g.Expect(hooks.IsPending(runtimehooksv1.AfterClusterUpgrade, tt.s.Current.Cluster)).To(Equal(!tt.wantAfterClusterUpgradeCalled))
+		})
+	}
+}
+
+func TestReconcile_reconcileInPlaceUpdate(t *testing.T) {
+	defer utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)()
+
+	catalog := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(catalog)
+
+	updateMachineGVH, err := catalog.GroupVersionHook(runtimehooksv1.UpdateMachine)
+	if err != nil {
+		panic(err)
+	}
+
+	acceptedResponse := &runtimehooksv1.UpdateMachineResponse{
+		CommonAcceptResponse: runtimehooksv1.CommonAcceptResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusSuccess,
+			},
+			Accepted: true,
+		},
+	}
+	declinedResponse := &runtimehooksv1.UpdateMachineResponse{
+		CommonAcceptResponse: runtimehooksv1.CommonAcceptResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusSuccess,
+			},
+			Accepted: false,
+		},
+	}
+	failureResponse := &runtimehooksv1.UpdateMachineResponse{
+		CommonAcceptResponse: runtimehooksv1.CommonAcceptResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status: runtimehooksv1.ResponseStatusFailure,
+			},
+		},
+	}
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-1",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	infrastructureMachineTemplate := builder.TestInfrastructureMachineTemplate(metav1.NamespaceDefault, "infrastructure-machine-1").
+		WithSpecFields(map[string]interface{}{"spec.template.spec.foo": "foo"}).
+		Build()
+	infrastructureMachineTemplateWithChanges := builder.TestInfrastructureMachineTemplate(metav1.NamespaceDefault, "infrastructure-machine-2").
+		WithSpecFields(map[string]interface{}{"spec.template.spec.foo": "bar"}).
+		Build()
+	bootstrapTemplate := builder.TestBootstrapTemplate(metav1.NamespaceDefault, "bootstrap-config-1").Build()
+
+	newMD := func(infrastructureMachineTemplate *unstructured.Unstructured, version *string, annotations map[string]string) *scope.MachineDeploymentState {
+		md := newFakeMachineDeploymentTopologyState("md-1", infrastructureMachineTemplate, bootstrapTemplate, nil)
+		md.Object.Spec.Template.Spec.Version = version
+		md.Object.SetAnnotations(annotations)
+		return md
+	}
+	templateHash, err := mdutil.ComputeMachineTemplateHash(&newMD(infrastructureMachineTemplate, nil, nil).Object.Spec.Template)
+	if err != nil {
+		panic(err)
+	}
+
+	tests := []struct {
+		name               string
+		current            *scope.MachineDeploymentState
+		desired            *scope.MachineDeploymentState
+		rotated            bool
+		hookResponse       *runtimehooksv1.UpdateMachineResponse
+		wantHookToBeCalled bool
+		wantInPlaceUpdate  bool
+		wantError          bool
+	}{
+		{
+			name:               "hook should not be called if templates are not rotated",
+			current:            newMD(infrastructureMachineTemplate, nil, nil),
+			desired:            newMD(infrastructureMachineTemplate, nil, nil),
+			rotated:            false,
+			hookResponse:       acceptedResponse,
+			wantHookToBeCalled: false,
+			wantInPlaceUpdate:  false,
+		},
+		{
+			name:               "hook should not be called and the in-place update should be preserved if templates are not rotated",
+			current:            newMD(infrastructureMachineTemplateWithChanges, nil, map[string]string{clusterv1.ClusterTopologyInPlaceUpdateHashAnnotation: templateHash}),
+			desired:            newMD(infrastructureMachineTemplateWithChanges, nil, nil),
+			rotated:            false,
+			hookResponse:       declinedResponse,
+			wantHookToBeCalled: false,
+			wantInPlaceUpdate:  true,
+		},
+		{
+			name:               "hook should not be called if changes require the Machines to be reprovisioned",
+			current:            newMD(infrastructureMachineTemplate, nil, nil),
+			desired:            newMD(infrastructureMachineTemplateWithChanges, ptr.To("v1.2.3"), nil),
+			rotated:            true,
+			hookResponse:       acceptedResponse,
+			wantHookToBeCalled: false,
+			wantInPlaceUpdate:  false,
+		},
+		{
+			name:               "hook should be called and Machines updated in-place if the Runtime Extensions accept the request",
+			current:            newMD(infrastructureMachineTemplate, nil, nil),
+			desired:            newMD(infrastructureMachineTemplateWithChanges, nil, nil),
+			rotated:            true,
+			hookResponse:       acceptedResponse,
+			wantHookToBeCalled: true,
+			wantInPlaceUpdate:  true,
+		},
+		{
+			name:               "hook should be called and Machines rolled out if the Runtime Extensions decline the request",
+			current:            newMD(infrastructureMachineTemplate, nil, nil),
+			desired:            newMD(infrastructureMachineTemplateWithChanges, nil, nil),
+			rotated:            true,
+			hookResponse:       declinedResponse,
+			wantHookToBeCalled: true,
+			wantInPlaceUpdate:  false,
+		},
+		{
+			name:               "hook should be called and a previous in-place update dropped if the Runtime Extensions decline the request",
+			current:            newMD(infrastructureMachineTemplate, nil, map[string]string{clusterv1.ClusterTopologyInPlaceUpdateHashAnnotation: "123"}),
+			desired:            newMD(infrastructureMachineTemplateWithChanges, nil, nil),
+			rotated:            true,
+			hookResponse:       declinedResponse,
+			wantHookToBeCalled: true,
+			wantInPlaceUpdate:  false,
+		},
+		{
+			name:               "fails if the hook fails",
+			current:            newMD(infrastructureMachineTemplate, nil, nil),
+			desired:            newMD(infrastructureMachineTemplateWithChanges, nil, nil),
+			rotated:            true,
+			hookResponse:       failureResponse,
+			wantHookToBeCalled: true,
+			wantError:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fakeRuntimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithCallAllExtensionResponses(map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+					updateMachineGVH: tt.hookResponse,
+				}).
+				WithCatalog(catalog).
+				Build()
+
+			r := &Reconciler{
+				RuntimeClient: fakeRuntimeClient,
+				recorder:      record.NewFakeRecorder(32),
+			}
+
+			s := scope.New(cluster.DeepCopy())
+			err := r.reconcileInPlaceUpdate(ctx, s, tt.current, tt.desired, tt.rotated)
+			g.Expect(err != nil).To(Equal(tt.wantError))
+			g.Expect(fakeRuntimeClient.CallAllCount(runtimehooksv1.UpdateMachine) == 1).To(Equal(tt.wantHookToBeCalled))
+			if tt.wantInPlaceUpdate {
+				g.Expect(tt.desired.Object.GetAnnotations()).To(HaveKeyWithValue(clusterv1.ClusterTopologyInPlaceUpdateHashAnnotation, templateHash))
+			} else {
+				g.Expect(tt.desired.Object.GetAnnotations()).ToNot(HaveKey(clusterv1.ClusterTopologyInPlaceUpdateHashAnnotation))
+			}
+		})
+	}
+}
+
 func TestReconcileCluster(t *testing.T) {
 	cluster1 := builder.Cluster(metav1.NamespaceDefault, "cluster1").
 		Build()
@@ -4109,3 +4416,37 @@ func Test_createErrorWithoutObjectName(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldOwnershipConflictEvents(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := builder.Cluster(metav1.NamespaceDefault, "cluster1").Build()
+	md := builder.MachineDeployment(metav1.NamespaceDefault, "md1").Build()
+	recorder := record.NewFakeRecorder(32)
+	r := &Reconciler{recorder: recorder}
+
+	reconcileConflicts := func(conflicts ...structuredmerge.FieldConflict) {
+		s := scope.New(cluster)
+		fieldOwnership, ok := r.fieldOwnership(s).(structuredmerge.FieldOwnership)
+		g.Expect(ok).To(BeTrue())
+		fieldOwnership.OnConflicts(md, conflicts)
+		r.reconcileTopologyFieldOwnershipCondition(s, cluster, nil)
+	}
+
+	// The event is emitted when a conflict first appears.
+	reconcileConflicts(structuredmerge.FieldConflict{Path: ".spec.replicas", Manager: "argocd"})
+	g.Expect(recorder.Events).To(HaveLen(1))
+	g.Expect(<-recorder.Events).To(ContainSubstring(fieldConflictEventReason))
+
+	// The event is not emitted again while the conflict is ongoing.
+	reconcileConflicts(structuredmerge.FieldConflict{Path: ".spec.replicas", Manager: "argocd"})
+	g.Expect(recorder.Events).To(BeEmpty())
+
+	// The event is emitted when a new conflict appears.
+	reconcileConflicts(
+		structuredmerge.FieldConflict{Path: ".spec.replicas", Manager: "argocd"},
+		structuredmerge.FieldConflict{Path: ".metadata.labels.foo", Manager: "kubectl", Forced: true},
+	)
+	g.Expect(recorder.Events).To(HaveLen(1))
+	g.Expect(<-recorder.Events).To(ContainSubstring(".metadata.labels.foo"))
+}
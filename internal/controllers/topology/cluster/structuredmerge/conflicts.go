@@ -0,0 +1,169 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package structuredmerge
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// FieldConflict describes a conflict between the topology controller and another field manager
+// detected by server side apply.
+type FieldConflict struct {
+	// Path is the path of the conflicting field, e.g. ".spec.replicas".
+	Path string
+
+	// Manager is the name of the field manager owning the field.
+	Manager string
+
+	// Forced is true if the topology controller took ownership of the field, false if the field
+	// has been left to the other field manager.
+	Forced bool
+}
+
+// fieldConflictsFromError returns the list of field conflicts reported by a server side apply error.
+// It returns false if the error is not a server side apply conflict.
+func fieldConflictsFromError(err error) ([]FieldConflict, bool) {
+	if !apierrors.IsConflict(err) {
+		return nil, false
+	}
+
+	var apiStatus apierrors.APIStatus
+	if !errors.As(err, &apiStatus) || apiStatus.Status().Details == nil {
+		return nil, false
+	}
+
+	var conflicts []FieldConflict
+	for _, cause := range apiStatus.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		conflicts = append(conflicts, FieldConflict{
+			Path:    cause.Field,
+			Manager: managerFromConflictMessage(cause.Message),
+		})
+	}
+	return conflicts, len(conflicts) > 0
+}
+
+// managerFromConflictMessage extracts the name of the field manager from the message of a server side apply
+// conflict, e.g. `conflict with "kubectl" using v1`.
+func managerFromConflictMessage(msg string) string {
+	rest := strings.TrimPrefix(msg, "conflict with ")
+	quoted, err := strconv.QuotedPrefix(rest)
+	if err != nil {
+		return msg
+	}
+	manager, err := strconv.Unquote(quoted)
+	if err != nil {
+		return msg
+	}
+	return manager
+}
+
+// shouldForceOwnership returns true if the topology controller should take ownership of the field
+// at the given path according to the given policy.
+func shouldForceOwnership(policy *clusterv1.FieldOwnershipPolicy, path string) bool {
+	if policy == nil || policy.ConflictResolution != clusterv1.YieldFieldConflictResolution {
+		return true
+	}
+	for _, forcePath := range policy.ForceOwnershipPaths {
+		if path == forcePath || strings.HasPrefix(path, forcePath+".") || strings.HasPrefix(path, forcePath+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+// yieldsFieldConflicts returns true if the topology controller should leave conflicting fields to other field managers.
+func yieldsFieldConflicts(fieldOwnership *FieldOwnership) bool {
+	return fieldOwnership != nil && fieldOwnership.Policy != nil &&
+		fieldOwnership.Policy.ConflictResolution == clusterv1.YieldFieldConflictResolution
+}
+
+// dropYieldedFields detects the conflicts with other field managers using a server side apply dry-run without forcing
+// ownership, and drops from the intent the conflicting fields the topology controller should not take ownership of.
+// It returns the conflicts for the dropped fields.
+func dropYieldedFields(ctx context.Context, c client.Client, intent *unstructured.Unstructured, policy *clusterv1.FieldOwnershipPolicy) ([]FieldConflict, error) {
+	dryRunIntent := intent.DeepCopy()
+
+	// Add TopologyDryRunAnnotation to notify validation webhooks to skip immutability checks.
+	if err := unstructured.SetNestedField(dryRunIntent.Object, "", "metadata", "annotations", clusterv1.TopologyDryRunAnnotation); err != nil {
+		return nil, errors.Wrap(err, "failed to add topology dry-run annotation to modified object")
+	}
+
+	err := c.Patch(ctx, dryRunIntent, client.Apply, client.DryRunAll, client.FieldOwner(TopologyManagerName))
+	conflicts, isConflict := fieldConflictsFromError(err)
+	if !isConflict {
+		if err != nil {
+			return nil, errors.Wrap(err, "server side apply dry-run failed for detecting conflicts with other field managers")
+		}
+		return nil, nil
+	}
+
+	var yieldedConflicts []FieldConflict
+	for _, conflict := range conflicts {
+		if shouldForceOwnership(policy, conflict.Path) || !removeFieldPath(intent.Object, conflict.Path) {
+			continue
+		}
+		yieldedConflicts = append(yieldedConflicts, conflict)
+	}
+	return yieldedConflicts, nil
+}
+
+// removeFieldPath removes the field at the given path from obj, returning true if the field has been removed.
+// NOTE: Paths are in the format reported by server side apply conflicts, e.g. ".metadata.labels.foo"; given that
+// map keys can contain dots, each path segment is matched against the longest corresponding key in obj.
+// NOTE: Paths to fields nested in list items are not supported.
+func removeFieldPath(obj map[string]interface{}, path string) bool {
+	if !strings.HasPrefix(path, ".") {
+		return false
+	}
+	rest := path[1:]
+	current := obj
+	for {
+		key := ""
+		for k := range current {
+			if len(k) > len(key) && (rest == k || strings.HasPrefix(rest, k+".")) {
+				key = k
+			}
+		}
+		if key == "" {
+			return false
+		}
+		if rest == key {
+			delete(current, key)
+			return true
+		}
+
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		current = next
+		rest = rest[len(key)+1:]
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package structuredmerge
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestFieldConflictsFromError(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		wantConflicts []FieldConflict
+		wantOK        bool
+	}{
+		{
+			name:   "no error",
+			err:    nil,
+			wantOK: false,
+		},
+		{
+			name:   "not a conflict error",
+			err:    errors.New("something went wrong"),
+			wantOK: false,
+		},
+		{
+			name:   "conflict error without field manager conflicts",
+			err:    apierrors.NewConflict(schema.GroupResource{Resource: "machinedeployments"}, "md", errors.New("the object has been modified")),
+			wantOK: false,
+		},
+		{
+			name: "server side apply conflicts",
+			err: &apierrors.StatusError{ErrStatus: metav1.Status{
+				Status: metav1.StatusFailure,
+				Code:   409,
+				Reason: metav1.StatusReasonConflict,
+				Details: &metav1.StatusDetails{
+					Causes: []metav1.StatusCause{
+						{
+							Type:    metav1.CauseTypeFieldManagerConflict,
+							Message: `conflict with "argocd-controller" using cluster.x-k8s.io/v1beta1`,
+							Field:   ".spec.replicas",
+						},
+						{
+							Type:    metav1.CauseTypeFieldManagerConflict,
+							Message: `conflict with "kubectl-client-side-apply" using cluster.x-k8s.io/v1beta1`,
+							Field:   ".metadata.labels.cluster.x-k8s.io/environment",
+						},
+					},
+				},
+			}},
+			wantConflicts: []FieldConflict{
+				{Path: ".spec.replicas", Manager: "argocd-controller"},
+				{Path: ".metadata.labels.cluster.x-k8s.io/environment", Manager: "kubectl-client-side-apply"},
+			},
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			conflicts, ok := fieldConflictsFromError(tt.err)
+			g.Expect(ok).To(Equal(tt.wantOK))
+			g.Expect(conflicts).To(Equal(tt.wantConflicts))
+		})
+	}
+}
+
+func TestManagerFromConflictMessage(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(managerFromConflictMessage(`conflict with "kubectl" using v1`)).To(Equal("kubectl"))
+	g.Expect(managerFromConflictMessage(`conflict with "manager" with subresource "status" using v1`)).To(Equal("manager"))
+	g.Expect(managerFromConflictMessage("unexpected message")).To(Equal("unexpected message"))
+}
+
+func TestShouldForceOwnership(t *testing.T) {
+	yieldPolicy := &clusterv1.FieldOwnershipPolicy{
+		ConflictResolution:  clusterv1.YieldFieldConflictResolution,
+		ForceOwnershipPaths: []string{".spec.replicas", ".spec.template.spec"},
+	}
+
+	tests := []struct {
+		name   string
+		policy *clusterv1.FieldOwnershipPolicy
+		path   string
+		want   bool
+	}{
+		{
+			name:   "force if there is no policy",
+			policy: nil,
+			path:   ".spec.foo",
+			want:   true,
+		},
+		{
+			name:   "force if conflict resolution is not set",
+			policy: &clusterv1.FieldOwnershipPolicy{},
+			path:   ".spec.foo",
+			want:   true,
+		},
+		{
+			name:   "force if conflict resolution is Force",
+			policy: &clusterv1.FieldOwnershipPolicy{ConflictResolution: clusterv1.ForceFieldConflictResolution},
+			path:   ".spec.foo",
+			want:   true,
+		},
+		{
+			name:   "force if the path is in forceOwnershipPaths",
+			policy: yieldPolicy,
+			path:   ".spec.replicas",
+			want:   true,
+		},
+		{
+			name:   "force if the path is nested in a path in forceOwnershipPaths",
+			policy: yieldPolicy,
+			path:   ".spec.template.spec.version",
+			want:   true,
+		},
+		{
+			name:   "force if the path is a list item nested in a path in forceOwnershipPaths",
+			policy: yieldPolicy,
+			path:   `.spec.template.spec[name="foo"]`,
+			want:   true,
+		},
+		{
+			name:   "yield if the path is not in forceOwnershipPaths",
+			policy: yieldPolicy,
+			path:   ".spec.replicasFoo",
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(shouldForceOwnership(tt.policy, tt.path)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestRemoveFieldPath(t *testing.T) {
+	newObj := func() map[string]interface{} {
+		return map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"cluster.x-k8s.io/environment": "prod",
+					"cluster.x-k8s.io":             "foo",
+				},
+			},
+			"spec": map[string]interface{}{
+				"replicas": int64(3),
+				"items": []interface{}{
+					map[string]interface{}{"name": "foo"},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		want    bool
+		wantObj func() map[string]interface{}
+	}{
+		{
+			name: "remove a field",
+			path: ".spec.replicas",
+			want: true,
+			wantObj: func() map[string]interface{} {
+				obj := newObj()
+				delete(obj["spec"].(map[string]interface{}), "replicas")
+				return obj
+			},
+		},
+		{
+			name: "remove a map key containing dots",
+			path: ".metadata.labels.cluster.x-k8s.io/environment",
+			want: true,
+			wantObj: func() map[string]interface{} {
+				obj := newObj()
+				delete(obj["metadata"].(map[string]interface{})["labels"].(map[string]interface{}), "cluster.x-k8s.io/environment")
+				return obj
+			},
+		},
+		{
+			name:    "do not remove fields nested in list items",
+			path:    `.spec.items[name="foo"].name`,
+			want:    false,
+			wantObj: newObj,
+		},
+		{
+			name:    "do not remove fields not existing in the object",
+			path:    ".spec.foo",
+			want:    false,
+			wantObj: newObj,
+		},
+		{
+			name:    "do not remove fields with invalid paths",
+			path:    "spec.replicas",
+			want:    false,
+			wantObj: newObj,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			obj := newObj()
+			g.Expect(removeFieldPath(obj, tt.path)).To(Equal(tt.want))
+			g.Expect(obj).To(Equal(tt.wantObj()))
+		})
+	}
+}
@@ -21,8 +21,8 @@ import (
 
 	. "github.com/onsi/gomega"
 
-	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	"sigs.k8s.io/cluster-api/util/contract"
 )
 
 func Test_dropDiffForNotAllowedPaths(t *testing.T) {
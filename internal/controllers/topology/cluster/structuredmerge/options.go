@@ -20,8 +20,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
-	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	"sigs.k8s.io/cluster-api/util/contract"
 )
 
 var (
@@ -72,6 +72,9 @@ type HelperOption interface {
 // HelperOptions contains options for Helper.
 type HelperOptions struct {
 	ssa.FilterObjectInput
+
+	// FieldOwnership defines how to handle conflicts with other field managers.
+	FieldOwnership *FieldOwnership
 }
 
 // newHelperOptions returns initialized HelperOptions.
@@ -109,3 +112,21 @@ type IgnorePaths []contract.Path
 func (i IgnorePaths) ApplyToHelper(opts *HelperOptions) {
 	opts.IgnorePaths = i
 }
+
+// FieldOwnership instructs the Helper about how to resolve conflicts with other field managers,
+// and how to report them.
+// NOTE: If FieldOwnership is not set, the Helper takes ownership of all the conflicting fields without
+// reporting them.
+type FieldOwnership struct {
+	// Policy defines how the conflicts should be resolved; if nil, the Helper takes ownership of all
+	// the conflicting fields.
+	Policy *clusterv1.FieldOwnershipPolicy
+
+	// OnConflicts is called with the list of conflicts detected when patching an object.
+	OnConflicts func(obj client.Object, conflicts []FieldConflict)
+}
+
+// ApplyToHelper applies this configuration to the given helper options.
+func (f FieldOwnership) ApplyToHelper(opts *HelperOptions) {
+	opts.FieldOwnership = &f
+}
@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	"sigs.k8s.io/cluster-api/internal/util/tracing"
 	"sigs.k8s.io/cluster-api/util"
 )
 
@@ -36,6 +37,7 @@ type serverSidePatchHelper struct {
 	modified       *unstructured.Unstructured
 	hasChanges     bool
 	hasSpecChanges bool
+	fieldOwnership *FieldOwnership
 }
 
 // NewServerSidePatchHelper returns a new PatchHelper using server side apply.
@@ -86,6 +88,19 @@ func NewServerSidePatchHelper(ctx context.Context, original, modified client.Obj
 		modifiedUnstructured.SetUID(originalUnstructured.GetUID())
 	}
 
+	// If conflicting fields should be left to other field managers, drop them from the intent before checking
+	// for changes; this prevents applying the intent at every reconcile when the only difference is on those fields.
+	// NOTE: Conflicts on those fields are reported at every reconcile, so they are surfaced as long as they exist.
+	if originalUnstructured != nil && yieldsFieldConflicts(helperOptions.FieldOwnership) {
+		yieldedConflicts, err := dropYieldedFields(ctx, c, modifiedUnstructured, helperOptions.FieldOwnership.Policy)
+		if err != nil {
+			return nil, err
+		}
+		if len(yieldedConflicts) > 0 && helperOptions.FieldOwnership.OnConflicts != nil {
+			helperOptions.FieldOwnership.OnConflicts(modifiedUnstructured, yieldedConflicts)
+		}
+	}
+
 	// Determine if the intent defined in the modified object is going to trigger
 	// an actual change when running server side apply, and if this change might impact the object spec or not.
 	var hasChanges, hasSpecChanges bool
@@ -111,6 +126,7 @@ func NewServerSidePatchHelper(ctx context.Context, original, modified client.Obj
 		modified:       modifiedUnstructured,
 		hasChanges:     hasChanges,
 		hasSpecChanges: hasSpecChanges,
+		fieldOwnership: helperOptions.FieldOwnership,
 	}, nil
 }
 
@@ -125,19 +141,55 @@ func (h *serverSidePatchHelper) HasChanges() bool {
 }
 
 // Patch will server side apply the current intent (the modified object.
-func (h *serverSidePatchHelper) Patch(ctx context.Context) error {
+func (h *serverSidePatchHelper) Patch(ctx context.Context) (reterr error) {
 	if !h.HasChanges() {
 		return nil
 	}
 
+	ctx, span := tracing.Start(ctx, "ServerSideApply", tracing.ObjectAttributes(h.modified)...)
+	defer func() { tracing.End(span, reterr) }()
+
 	log := ctrl.LoggerFrom(ctx)
 	log.V(5).Info("Patching object", "Intent", h.modified)
 
+	// If there is no need to report conflicts, take ownership of all the fields.
+	if h.fieldOwnership == nil {
+		return h.forceApply(ctx, h.modified)
+	}
+
+	// Apply without forcing ownership first, so server side apply reports the conflicts with other field managers.
+	// NOTE: This does not require an additional call when there are no conflicts.
+	err := h.client.Patch(ctx, h.modified.DeepCopy(), client.Apply, client.FieldOwner(TopologyManagerName))
+	conflicts, isConflict := fieldConflictsFromError(err)
+	if !isConflict {
+		return err
+	}
+
+	// Resolve conflicts according to the field ownership policy; conflicting fields which the topology controller
+	// should not take ownership of are dropped from the intent, so they are left to the other field managers.
+	intent := h.modified.DeepCopy()
+	for i := range conflicts {
+		conflicts[i].Forced = shouldForceOwnership(h.fieldOwnership.Policy, conflicts[i].Path) ||
+			!removeFieldPath(intent.Object, conflicts[i].Path)
+	}
+	log.V(5).Info("Detected conflicts with other field managers", "conflicts", conflicts)
+
+	if err := h.forceApply(ctx, intent); err != nil {
+		return err
+	}
+	if h.fieldOwnership.OnConflicts != nil {
+		h.fieldOwnership.OnConflicts(h.modified, conflicts)
+	}
+	return nil
+}
+
+// forceApply server side applies the given intent taking ownership of all the fields.
+func (h *serverSidePatchHelper) forceApply(ctx context.Context, intent *unstructured.Unstructured) error {
 	options := []client.PatchOption{
 		client.FieldOwner(TopologyManagerName),
 		// NOTE: we are using force ownership so in case of conflicts the topology controller
 		// overwrite values and become sole manager.
 		client.ForceOwnership,
 	}
-	return h.client.Patch(ctx, h.modified, client.Apply, options...)
+	return h.client.Patch(ctx, intent, client.Apply, options...)
 }
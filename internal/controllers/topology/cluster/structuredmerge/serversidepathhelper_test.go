@@ -439,6 +439,55 @@ func TestServerSideApply(t *testing.T) {
 		g.Expect(specFieldV1).ToNot(HaveKey("f:foo"))               // topology controller should not express opinions on ignore paths.
 		g.Expect(specFieldV1).To(HaveKey("f:bar"))                  // topology controller now has an opinion on a field previously managed by other controllers (force ownership).
 	})
+	t.Run("Topology controller does not apply changes only on fields yielded to another controller", func(t *testing.T) {
+		g := NewWithT(t)
+
+		obj3 := builder.TestInfrastructureCluster(ns.Name, "obj3").WithSpecFields(map[string]interface{}{
+			"spec.controlPlaneEndpoint.host": "1.2.3.4",
+			"spec.controlPlaneEndpoint.port": int64(1234),
+			"spec.bar":                       "topology-value",
+		}).Build()
+
+		// Create the object using server side apply.
+		p0, err := NewServerSidePatchHelper(ctx, nil, obj3, env.GetClient(), ssa.NewCache())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(p0.Patch(ctx)).To(Succeed())
+
+		// Another controller takes ownership of spec.bar.
+		other := &unstructured.Unstructured{}
+		other.SetGroupVersionKind(obj3.GroupVersionKind())
+		other.SetNamespace(obj3.GetNamespace())
+		other.SetName(obj3.GetName())
+		g.Expect(unstructured.SetNestedField(other.Object, "other-value", "spec", "bar")).To(Succeed())
+		g.Expect(env.Patch(ctx, other, client.Apply, client.FieldOwner("other-controller"), client.ForceOwnership)).To(Succeed())
+
+		original := obj3.DeepCopy()
+		g.Expect(env.GetAPIReader().Get(ctx, client.ObjectKeyFromObject(original), original)).To(Succeed())
+
+		// Create a patch helper yielding conflicting fields for a modified object with changes only on spec.bar.
+		var gotConflicts []FieldConflict
+		fieldOwnership := FieldOwnership{
+			Policy: &clusterv1.FieldOwnershipPolicy{ConflictResolution: clusterv1.YieldFieldConflictResolution},
+			OnConflicts: func(_ client.Object, conflicts []FieldConflict) {
+				gotConflicts = append(gotConflicts, conflicts...)
+			},
+		}
+		p1, err := NewServerSidePatchHelper(ctx, original, obj3.DeepCopy(), env.GetClient(), ssa.NewCache(), fieldOwnership)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(p1.HasChanges()).To(BeFalse())
+		g.Expect(p1.HasSpecChanges()).To(BeFalse())
+		g.Expect(gotConflicts).To(ConsistOf(FieldConflict{Path: ".spec.bar", Manager: "other-controller", Forced: false}))
+
+		g.Expect(p1.Patch(ctx)).To(Succeed())
+
+		// Check the object and verify the field is still owned by the other controller.
+		got := obj3.DeepCopy()
+		g.Expect(env.GetAPIReader().Get(ctx, client.ObjectKeyFromObject(got), got)).To(Succeed())
+		g.Expect(got.GetResourceVersion()).To(Equal(original.GetResourceVersion()))
+
+		v, _, _ := unstructured.NestedString(got.Object, "spec", "bar")
+		g.Expect(v).To(Equal("other-value"))
+	})
 	t.Run("No-op on unstructured object having empty map[string]interface in spec", func(t *testing.T) {
 		g := NewWithT(t)
 
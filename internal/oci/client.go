@@ -0,0 +1,456 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oci implements a minimal client for pulling artifacts from OCI registries.
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// HelmChartContentLayerMediaType is the media type of the layer containing a Helm chart archive.
+	HelmChartContentLayerMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	ociManifestMediaType    = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// maxBlobSize is the maximum size of a manifest or layer pulled from a registry.
+	maxBlobSize = 32 << 20
+
+	// maxCachedBlobsSize is the maximum total size of the layers kept in the blob cache.
+	maxCachedBlobsSize = 256 << 20
+
+	// defaultTimeout is the timeout of the requests to OCI registries when no http.Client is provided.
+	defaultTimeout = 2 * time.Minute
+
+	// TitleAnnotation is the annotation used by tools like ORAS to store the file name of a layer.
+	TitleAnnotation = "org.opencontainers.image.title"
+)
+
+// Credentials are used to authenticate to an OCI registry.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Artifact is an artifact pulled from an OCI registry.
+type Artifact struct {
+	// Digest is the digest of the manifest of the artifact, computed from the pulled manifest.
+	Digest string
+
+	// Layers are the layers of the artifact, in the order defined in the manifest.
+	Layers []Layer
+}
+
+// Layer is a layer of an artifact pulled from an OCI registry.
+type Layer struct {
+	MediaType   string
+	Digest      string
+	Annotations map[string]string
+	Data        []byte
+}
+
+// Client pulls artifacts from OCI registries.
+type Client interface {
+	// Pull pulls the artifact with the given oci:// URL, using the given credentials if not nil.
+	Pull(ctx context.Context, url string, credentials *Credentials) (*Artifact, error)
+
+	// Tags returns the tags of the repository with the given oci:// URL, using the given credentials if not nil.
+	// The tag or digest in the URL, if any, is ignored.
+	Tags(ctx context.Context, url string, credentials *Credentials) ([]string, error)
+}
+
+// NewClient returns a Client using the given http.Client; if nil, a dedicated http.Client with a timeout is used.
+// Layers are cached by digest, so artifacts are downloaded only when they change; the least recently added
+// layers are evicted when the total size of the cache exceeds 256MiB.
+func NewClient(httpClient *http.Client) Client {
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Transport: http.DefaultTransport.(*http.Transport).Clone(),
+			Timeout:   defaultTimeout,
+		}
+	}
+	return &client{
+		httpClient: httpClient,
+		scheme:     "https",
+		blobs:      map[string][]byte{},
+	}
+}
+
+type client struct {
+	httpClient *http.Client
+	scheme     string
+
+	lock sync.Mutex
+	// blobs are the cached layers by digest; blobsOrder tracks the order layers have been added to the cache
+	// and blobsSize their total size.
+	blobs      map[string][]byte
+	blobsOrder []string
+	blobsSize  int
+}
+
+// Reference is a parsed oci:// URL.
+type Reference struct {
+	Registry   string
+	Repository string
+	// Reference is either a tag or a digest.
+	Reference string
+	// Tag is the tag specified in the URL, if any. When the URL specifies both a tag and a digest,
+	// the artifact is pulled by digest.
+	Tag string
+}
+
+// IsDigest returns true if the reference pins the artifact by digest.
+func (r *Reference) IsDigest() bool {
+	return strings.Contains(r.Reference, ":")
+}
+
+// ParseReference parses an URL in the form oci://<registry>/<repository>:<tag>, oci://<registry>/<repository>@<digest>
+// or oci://<registry>/<repository>:<tag>@<digest>.
+// If neither a tag nor a digest is specified, the latest tag is used.
+func ParseReference(u string) (*Reference, error) {
+	if !strings.HasPrefix(u, "oci://") {
+		return nil, errors.Errorf("invalid OCI URL %q: must start with oci://", u)
+	}
+	registry, repository, ok := strings.Cut(strings.TrimPrefix(u, "oci://"), "/")
+	if !ok || registry == "" || repository == "" {
+		return nil, errors.Errorf("invalid OCI URL %q: must be in the form oci://<registry>/<repository>[:<tag>|@<digest>]", u)
+	}
+
+	var digest, tag string
+	repository, digest, _ = strings.Cut(repository, "@")
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, tag = repository[:i], repository[i+1:]
+		if tag == "" {
+			return nil, errors.Errorf("invalid OCI URL %q: tag can't be empty", u)
+		}
+	}
+	if repository == "" || strings.HasSuffix(u, "@") {
+		return nil, errors.Errorf("invalid OCI URL %q: must be in the form oci://<registry>/<repository>[:<tag>][@<digest>]", u)
+	}
+
+	ref := &Reference{Registry: registry, Repository: repository, Reference: tag, Tag: tag}
+	switch {
+	case digest != "":
+		if !strings.HasPrefix(digest, "sha256:") {
+			return nil, errors.Errorf("invalid OCI URL %q: only sha256 digests are supported", u)
+		}
+		ref.Reference = digest
+	case tag == "":
+		ref.Reference = "latest"
+	}
+	return ref, nil
+}
+
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Layers    []descriptor `json:"layers"`
+	Manifests []descriptor `json:"manifests"`
+}
+
+func (c *client) Pull(ctx context.Context, u string, credentials *Credentials) (*Artifact, error) {
+	ref, err := ParseReference(u)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &authorizer{client: c, credentials: credentials}
+
+	data, headerDigest, err := c.get(ctx, a, ref, fmt.Sprintf("/v2/%s/manifests/%s", ref.Repository, ref.Reference), strings.Join([]string{ociManifestMediaType, dockerManifestMediaType}, ", "))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get manifest of %s", u)
+	}
+	// The digest of the artifact is always computed from the manifest, so it can be trusted e.g. to verify signatures,
+	// no matter what the registry says; the manifest must match the digest the artifact is pulled by, if any, and the
+	// digest reported by the registry, if any.
+	digest := fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+	if ref.IsDigest() && digest != ref.Reference {
+		return nil, errors.Errorf("failed to pull %s: digest mismatch, got %s", u, digest)
+	}
+	if headerDigest != "" && headerDigest != digest {
+		return nil, errors.Errorf("failed to pull %s: digest mismatch, the registry reported %s but got %s", u, headerDigest, digest)
+	}
+
+	m := &manifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse manifest of %s", u)
+	}
+	if len(m.Manifests) > 0 {
+		return nil, errors.Errorf("failed to pull %s: image indexes are not supported", u)
+	}
+
+	artifact := &Artifact{Digest: digest}
+	for _, l := range m.Layers {
+		blob, err := c.getBlob(ctx, a, ref, l)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get layer %s of %s", l.Digest, u)
+		}
+		artifact.Layers = append(artifact.Layers, Layer{MediaType: l.MediaType, Digest: l.Digest, Annotations: l.Annotations, Data: blob})
+	}
+	return artifact, nil
+}
+
+func (c *client) Tags(ctx context.Context, u string, credentials *Credentials) ([]string, error) {
+	ref, err := ParseReference(u)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &authorizer{client: c, credentials: credentials}
+
+	// Tags are paginated using the Link header, see https://github.com/opencontainers/distribution-spec/blob/main/spec.md#listing-tags.
+	tags := []string{}
+	path := fmt.Sprintf("/v2/%s/tags/list", ref.Repository)
+	for path != "" {
+		data, header, err := c.do(ctx, a, ref, path, "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list tags of %s", u)
+		}
+		list := struct {
+			Tags []string `json:"tags"`
+		}{}
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse tags of %s", u)
+		}
+		tags = append(tags, list.Tags...)
+		path = parseNextLink(header.Get("Link"))
+	}
+	return tags, nil
+}
+
+func (c *client) getBlob(ctx context.Context, a *authorizer, ref *Reference, l descriptor) ([]byte, error) {
+	c.lock.Lock()
+	blob, ok := c.blobs[l.Digest]
+	c.lock.Unlock()
+	if ok {
+		return blob, nil
+	}
+
+	blob, _, err := c.get(ctx, a, ref, fmt.Sprintf("/v2/%s/blobs/%s", ref.Repository, l.Digest), "")
+	if err != nil {
+		return nil, err
+	}
+	algorithm, expected, _ := strings.Cut(l.Digest, ":")
+	if algorithm != "sha256" {
+		return nil, errors.Errorf("unsupported digest algorithm %q", algorithm)
+	}
+	if actual := fmt.Sprintf("%x", sha256.Sum256(blob)); actual != expected {
+		return nil, errors.Errorf("digest mismatch, got sha256:%s", actual)
+	}
+
+	c.cacheBlob(l.Digest, blob)
+	return blob, nil
+}
+
+// cacheBlob adds a layer to the blob cache, evicting the least recently added layers if the total size
+// of the cache would exceed maxCachedBlobsSize.
+func (c *client) cacheBlob(digest string, blob []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.blobs[digest]; ok || len(blob) > maxCachedBlobsSize {
+		return
+	}
+	for len(c.blobsOrder) > 0 && c.blobsSize+len(blob) > maxCachedBlobsSize {
+		evicted := c.blobsOrder[0]
+		c.blobsOrder = c.blobsOrder[1:]
+		c.blobsSize -= len(c.blobs[evicted])
+		delete(c.blobs, evicted)
+	}
+	c.blobs[digest] = blob
+	c.blobsOrder = append(c.blobsOrder, digest)
+	c.blobsSize += len(blob)
+}
+
+// get executes a GET request against the registry, authenticating if required, and returns the
+// response body and the value of the Docker-Content-Digest header.
+func (c *client) get(ctx context.Context, a *authorizer, ref *Reference, path, accept string) ([]byte, string, error) {
+	data, header, err := c.do(ctx, a, ref, path, accept)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, header.Get("Docker-Content-Digest"), nil
+}
+
+// do executes a GET request against the registry, authenticating if required, and returns the
+// response body and headers.
+func (c *client) do(ctx context.Context, a *authorizer, ref *Reference, path, accept string) ([]byte, http.Header, error) {
+	u := fmt.Sprintf("%s://%s%s", c.scheme, ref.Registry, path)
+	send := func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+		if err != nil {
+			return nil, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		a.authorize(req)
+		return c.httpClient.Do(req)
+	}
+
+	resp, err := send()
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := a.login(ctx, challenge); err != nil {
+			return nil, nil, err
+		}
+		if resp, err = send(); err != nil {
+			return nil, nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBlobSize+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) > maxBlobSize {
+		return nil, nil, errors.Errorf("content exceeds the maximum size of %d bytes", maxBlobSize)
+	}
+	return data, resp.Header, nil
+}
+
+// authorizer implements the Basic and Bearer token authentication flows used by OCI registries.
+type authorizer struct {
+	client      *client
+	credentials *Credentials
+
+	basic bool
+	token string
+}
+
+func (a *authorizer) authorize(req *http.Request) {
+	switch {
+	case a.token != "":
+		req.Header.Set("Authorization", "Bearer "+a.token)
+	case a.basic && a.credentials != nil:
+		req.SetBasicAuth(a.credentials.Username, a.credentials.Password)
+	}
+}
+
+func (a *authorizer) login(ctx context.Context, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	switch scheme {
+	case "basic":
+		if a.credentials == nil {
+			return errors.New("registry requires authentication but no credentials are configured")
+		}
+		a.basic = true
+		return nil
+	case "bearer":
+		realm := params["realm"]
+		if realm == "" {
+			return errors.Errorf("invalid authentication challenge %q: realm is missing", challenge)
+		}
+		query := url.Values{}
+		if service := params["service"]; service != "" {
+			query.Set("service", service)
+		}
+		if scope := params["scope"]; scope != "" {
+			query.Set("scope", scope)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm+"?"+query.Encode(), http.NoBody)
+		if err != nil {
+			return err
+		}
+		if a.credentials != nil {
+			req.SetBasicAuth(a.credentials.Username, a.credentials.Password)
+		}
+		resp, err := a.client.httpClient.Do(req)
+		if err != nil {
+			return errors.Wrap(err, "failed to get registry token")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return errors.Errorf("failed to get registry token: unexpected status code %d", resp.StatusCode)
+		}
+		token := struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}{}
+		if err := json.NewDecoder(io.LimitReader(resp.Body, maxBlobSize)).Decode(&token); err != nil {
+			return errors.Wrap(err, "failed to parse registry token")
+		}
+		a.token = token.Token
+		if a.token == "" {
+			a.token = token.AccessToken
+		}
+		if a.token == "" {
+			return errors.New("failed to get registry token: token is empty")
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported authentication challenge %q", challenge)
+	}
+}
+
+// parseNextLink returns the path of the next page from a Link header, e.g. `</v2/repo/tags/list?n=100&last=v1.0.0>; rel="next"`.
+func parseNextLink(link string) string {
+	target, params, ok := strings.Cut(link, ";")
+	if !ok || !strings.Contains(params, `rel="next"`) {
+		return ""
+	}
+	target = strings.Trim(strings.TrimSpace(target), "<>")
+	if u, err := url.Parse(target); err == nil && u.IsAbs() {
+		return u.RequestURI()
+	}
+	return target
+}
+
+// parseChallenge parses a WWW-Authenticate header, e.g. `Bearer realm="https://auth.example.com/token",service="example.com"`.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key != "" {
+			params[strings.ToLower(strings.TrimSpace(key))] = value
+		}
+	}
+	return strings.ToLower(scheme), params
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		want    *Reference
+		wantErr bool
+	}{
+		{
+			name: "tag",
+			url:  "oci://registry.example.com/addons/cni:v1.0.0",
+			want: &Reference{Registry: "registry.example.com", Repository: "addons/cni", Reference: "v1.0.0", Tag: "v1.0.0"},
+		},
+		{
+			name: "digest",
+			url:  "oci://registry.example.com/addons/cni@sha256:abc",
+			want: &Reference{Registry: "registry.example.com", Repository: "addons/cni", Reference: "sha256:abc"},
+		},
+		{
+			name: "tag and digest",
+			url:  "oci://registry.example.com/addons/cni:v1.0.0@sha256:abc",
+			want: &Reference{Registry: "registry.example.com", Repository: "addons/cni", Reference: "sha256:abc", Tag: "v1.0.0"},
+		},
+		{
+			name: "registry with port and no tag",
+			url:  "oci://localhost:5000/addons/cni",
+			want: &Reference{Registry: "localhost:5000", Repository: "addons/cni", Reference: "latest"},
+		},
+		{
+			name:    "no oci scheme",
+			url:     "https://registry.example.com/addons/cni:v1.0.0",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported digest algorithm",
+			url:     "oci://registry.example.com/addons/cni@sha512:abc",
+			wantErr: true,
+		},
+		{
+			name:    "empty tag",
+			url:     "oci://registry.example.com/addons/cni:",
+			wantErr: true,
+		},
+		{
+			name:    "no repository",
+			url:     "oci://registry.example.com",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := ParseReference(tt.url)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestPull(t *testing.T) {
+	layer := []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n")
+	layerDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(layer))
+	manifest, _ := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     ociManifestMediaType,
+		"layers": []map[string]interface{}{
+			{"mediaType": "application/yaml", "digest": layerDigest, "size": len(layer)},
+		},
+	})
+
+	manifestDigest := fmt.Sprintf("sha256:%x", sha256.Sum256(manifest))
+
+	blobRequests := 0
+	mux := http.NewServeMux()
+	var srv *httptest.Server
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "pass" || r.URL.Query().Get("scope") != "repository:addons/cni:pull" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"secret-token"}`))
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret-token" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:addons/cni:pull"`, srv.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/addons/cni/manifests/v1.0.0", "/v2/addons/cni/manifests/" + manifestDigest:
+			_, _ = w.Write(manifest)
+		case "/v2/addons/cni/manifests/sha256:0000000000000000000000000000000000000000000000000000000000000000":
+			// A registry returning the wrong manifest for a digest.
+			_, _ = w.Write(manifest)
+		case "/v2/addons/cni/manifests/v1.0.1":
+			// A registry reporting a digest which does not match the manifest.
+			w.Header().Set("Docker-Content-Digest", "sha256:0000000000000000000000000000000000000000000000000000000000000000")
+			_, _ = w.Write(manifest)
+		case "/v2/addons/cni/tags/list":
+			if r.URL.Query().Get("last") == "" {
+				w.Header().Set("Link", `</v2/addons/cni/tags/list?n=2&last=v1.1.0>; rel="next"`)
+				_, _ = w.Write([]byte(`{"name":"addons/cni","tags":["v1.0.0","v1.1.0"]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"name":"addons/cni","tags":["v2.0.0"]}`))
+		case "/v2/addons/cni/blobs/" + layerDigest:
+			blobRequests++
+			_, _ = w.Write(layer)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	srv = httptest.NewTLSServer(mux)
+	defer srv.Close()
+
+	url := fmt.Sprintf("oci://%s/addons/cni:v1.0.0", srv.Listener.Addr().String())
+
+	t.Run("pulls an artifact with credentials", func(t *testing.T) {
+		g := NewWithT(t)
+
+		c := NewClient(srv.Client())
+		artifact, err := c.Pull(context.Background(), url, &Credentials{Username: "user", Password: "pass"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(artifact.Digest).To(Equal(manifestDigest))
+		g.Expect(artifact.Layers).To(HaveLen(1))
+		g.Expect(artifact.Layers[0].MediaType).To(Equal("application/yaml"))
+		g.Expect(artifact.Layers[0].Data).To(Equal(layer))
+
+		// Layers are cached by digest.
+		_, err = c.Pull(context.Background(), url, &Credentials{Username: "user", Password: "pass"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(blobRequests).To(Equal(1))
+	})
+
+	t.Run("pulls an artifact by digest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		artifact, err := NewClient(srv.Client()).Pull(context.Background(), fmt.Sprintf("oci://%s/addons/cni:v1.0.0@%s", srv.Listener.Addr().String(), manifestDigest), &Credentials{Username: "user", Password: "pass"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(artifact.Digest).To(Equal(manifestDigest))
+	})
+
+	t.Run("fails if the manifest does not match the digest", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := NewClient(srv.Client()).Pull(context.Background(), fmt.Sprintf("oci://%s/addons/cni@sha256:0000000000000000000000000000000000000000000000000000000000000000", srv.Listener.Addr().String()), &Credentials{Username: "user", Password: "pass"})
+		g.Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+	})
+
+	t.Run("fails if the manifest does not match the digest reported by the registry", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := NewClient(srv.Client()).Pull(context.Background(), fmt.Sprintf("oci://%s/addons/cni:v1.0.1", srv.Listener.Addr().String()), &Credentials{Username: "user", Password: "pass"})
+		g.Expect(err).To(MatchError(ContainSubstring("digest mismatch")))
+	})
+
+	t.Run("lists tags across pages", func(t *testing.T) {
+		g := NewWithT(t)
+
+		tags, err := NewClient(srv.Client()).Tags(context.Background(), fmt.Sprintf("oci://%s/addons/cni", srv.Listener.Addr().String()), &Credentials{Username: "user", Password: "pass"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(tags).To(Equal([]string{"v1.0.0", "v1.1.0", "v2.0.0"}))
+	})
+
+	t.Run("fails without credentials", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := NewClient(srv.Client()).Pull(context.Background(), url, nil)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("fails for a missing artifact", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := NewClient(srv.Client()).Pull(context.Background(), fmt.Sprintf("oci://%s/addons/cni:v2.0.0", srv.Listener.Addr().String()), &Credentials{Username: "user", Password: "pass"})
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestCacheBlob(t *testing.T) {
+	g := NewWithT(t)
+
+	c := NewClient(nil).(*client)
+	g.Expect(c.httpClient.Timeout).To(Equal(defaultTimeout))
+
+	blob := make([]byte, maxCachedBlobsSize/4)
+	for i := 0; i < 4; i++ {
+		c.cacheBlob(fmt.Sprintf("sha256:%d", i), blob)
+	}
+	g.Expect(c.blobs).To(HaveLen(4))
+	g.Expect(c.blobsSize).To(Equal(maxCachedBlobsSize))
+
+	// Adding a layer evicts the least recently added layers until the cache fits maxCachedBlobsSize.
+	c.cacheBlob("sha256:4", make([]byte, maxCachedBlobsSize/4+1))
+	g.Expect(c.blobs).To(HaveLen(3))
+	g.Expect(c.blobs).ToNot(HaveKey("sha256:0"))
+	g.Expect(c.blobs).ToNot(HaveKey("sha256:1"))
+	g.Expect(c.blobs).To(HaveKey("sha256:4"))
+	g.Expect(c.blobsSize).To(Equal(maxCachedBlobsSize/2 + maxCachedBlobsSize/4 + 1))
+
+	// Layers larger than the cache are not cached.
+	c.cacheBlob("sha256:5", make([]byte, maxCachedBlobsSize+1))
+	g.Expect(c.blobs).ToNot(HaveKey("sha256:5"))
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// cosignSignatureAnnotation is the annotation of a cosign signature layer hosting the base64 encoded signature of the layer.
+const cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+
+// ParsePublicKey parses a PEM encoded public key, e.g. the cosign.pub file generated by `cosign generate-key-pair`.
+// ECDSA, RSA and Ed25519 keys are supported.
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to parse public key: no PEM data found")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse public key")
+	}
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return publicKey, nil
+	default:
+		return nil, errors.Errorf("failed to parse public key: unsupported key type %T", publicKey)
+	}
+}
+
+// cosignPayload is the payload signed by cosign, see https://github.com/containers/image/blob/main/docs/containers-signature.5.md.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// VerifySignature verifies that the artifact with the given digest in the repository of ref has been signed
+// with the given public key using cosign. Signatures are expected in the same repository, with the
+// sha256-<digest>.sig tag used by cosign.
+func VerifySignature(ctx context.Context, c Client, ref *Reference, digest string, credentials *Credentials, publicKey crypto.PublicKey) error {
+	signatureURL := fmt.Sprintf("oci://%s/%s:%s.sig", ref.Registry, ref.Repository, strings.Replace(digest, ":", "-", 1))
+	signatures, err := c.Pull(ctx, signatureURL, credentials)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get signatures of %s/%s@%s", ref.Registry, ref.Repository, digest)
+	}
+
+	for _, l := range signatures.Layers {
+		encoded, ok := l.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			continue
+		}
+		if !verify(publicKey, l.Data, signature) {
+			continue
+		}
+
+		// The signature is valid, check it is for this artifact and not for another one signed with the same key.
+		payload := &cosignPayload{}
+		if err := json.Unmarshal(l.Data, payload); err != nil {
+			continue
+		}
+		if payload.Critical.Image.DockerManifestDigest == digest {
+			return nil
+		}
+	}
+	return errors.Errorf("no valid signature found for %s/%s@%s", ref.Registry, ref.Repository, digest)
+}
+
+func verify(publicKey crypto.PublicKey, payload, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+	switch k := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, hash[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, signature)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+type fakeClient struct {
+	artifacts map[string]*Artifact
+}
+
+func (f *fakeClient) Pull(_ context.Context, url string, _ *Credentials) (*Artifact, error) {
+	if a, ok := f.artifacts[url]; ok {
+		return a, nil
+	}
+	return nil, errors.Errorf("%s not found", url)
+}
+
+func (f *fakeClient) Tags(_ context.Context, _ string, _ *Credentials) ([]string, error) {
+	return nil, nil
+}
+
+func TestVerifySignature(t *testing.T) {
+	g := NewWithT(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	g.Expect(err).ToNot(HaveOccurred())
+	publicKey, err := ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	ref := &Reference{Registry: "registry.example.com", Repository: "providers/core"}
+	digest := "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	signatureURL := "oci://registry.example.com/providers/core:sha256-1111111111111111111111111111111111111111111111111111111111111111.sig"
+
+	signatureLayer := func(k *ecdsa.PrivateKey, digest string) Layer {
+		payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"registry.example.com/providers/core"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, digest))
+		hash := sha256.Sum256(payload)
+		signature, err := ecdsa.SignASN1(rand.Reader, k, hash[:])
+		g.Expect(err).ToNot(HaveOccurred())
+		return Layer{
+			MediaType:   "application/vnd.dev.cosign.simplesigning.v1+json",
+			Annotations: map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature)},
+			Data:        payload,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		artifacts map[string]*Artifact
+		wantErr   bool
+	}{
+		{
+			name: "valid signature",
+			artifacts: map[string]*Artifact{
+				signatureURL: {Layers: []Layer{signatureLayer(otherKey, digest), signatureLayer(key, digest)}},
+			},
+		},
+		{
+			name:      "no signatures",
+			artifacts: map[string]*Artifact{},
+			wantErr:   true,
+		},
+		{
+			name: "signed with another key",
+			artifacts: map[string]*Artifact{
+				signatureURL: {Layers: []Layer{signatureLayer(otherKey, digest)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "signature of another artifact",
+			artifacts: map[string]*Artifact{
+				signatureURL: {Layers: []Layer{signatureLayer(key, "sha256:2222222222222222222222222222222222222222222222222222222222222222")}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := VerifySignature(context.Background(), &fakeClient{artifacts: tt.artifacts}, ref, digest, nil, publicKey)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := ParsePublicKey([]byte("not a key"))
+	g.Expect(err).To(HaveOccurred())
+}
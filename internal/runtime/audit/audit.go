@@ -0,0 +1,199 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit implements the audit log of the calls to Runtime Extensions.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+)
+
+// Level defines the amount of information recorded for each call to a Runtime Extension.
+type Level string
+
+const (
+	// LevelNone disables the audit log.
+	LevelNone Level = "None"
+
+	// LevelMetadata records the metadata of each call, e.g. the hook, the extension handler,
+	// the duration and the status of the response, but not the request and response payloads.
+	LevelMetadata Level = "Metadata"
+
+	// LevelRequestResponse records the metadata of each call as well as the request and response payloads.
+	LevelRequestResponse Level = "RequestResponse"
+)
+
+// Levels is the list of the supported audit levels.
+var Levels = []Level{LevelNone, LevelMetadata, LevelRequestResponse}
+
+// ParseLevel parses an audit level.
+func ParseLevel(s string) (Level, error) {
+	for _, l := range Levels {
+		if string(l) == s {
+			return l, nil
+		}
+	}
+	return "", errors.Errorf("invalid audit level %q, must be one of %v", s, Levels)
+}
+
+// Event is the record of a call to a Runtime Extension.
+type Event struct {
+	// Timestamp is the time the call completed.
+	Timestamp metav1.Time `json:"timestamp"`
+
+	// Hook is the GroupVersionHook of the hook called, e.g. hooks.runtime.cluster.x-k8s.io/v1alpha1, Hook=BeforeClusterUpgrade.
+	Hook string `json:"hook"`
+
+	// ExtensionHandler is the name of the extension handler called.
+	ExtensionHandler string `json:"extensionHandler"`
+
+	// ExtensionConfig is the name of the ExtensionConfig the extension handler belongs to.
+	ExtensionConfig string `json:"extensionConfig"`
+
+	// Object is the object the call is made for, in the namespace/name form.
+	Object string `json:"object,omitempty"`
+
+	// FailurePolicy is the failure policy of the extension handler.
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+
+	// Duration is the duration of the call.
+	Duration metav1.Duration `json:"duration"`
+
+	// Status is the status of the response.
+	Status runtimehooksv1.ResponseStatus `json:"status,omitempty"`
+
+	// Message is the message of the response.
+	Message string `json:"message,omitempty"`
+
+	// RetryAfterSeconds is the retry after seconds of the response of blocking hooks.
+	RetryAfterSeconds int32 `json:"retryAfterSeconds,omitempty"`
+
+	// Accepted is set for the responses of hooks that can accept or reject a request.
+	Accepted *bool `json:"accepted,omitempty"`
+
+	// Error is the error that occurred when calling the extension handler, if any.
+	Error string `json:"error,omitempty"`
+
+	// Request is the request payload; it is recorded only at LevelRequestResponse.
+	Request json.RawMessage `json:"request,omitempty"`
+
+	// Response is the response payload; it is recorded only at LevelRequestResponse and if the call succeeded.
+	Response json.RawMessage `json:"response,omitempty"`
+}
+
+// Sink is the destination of audit events.
+type Sink interface {
+	// Record records an audit event.
+	Record(ctx context.Context, event *Event)
+}
+
+// Options are creation options for an Auditor.
+type Options struct {
+	// Level defines the amount of information recorded for each call.
+	Level Level
+
+	// Sink is the destination of the audit events.
+	Sink Sink
+
+	// RedactPayloads redacts the values of settings and variables in the request and response payloads,
+	// which may contain sensitive information.
+	RedactPayloads bool
+}
+
+// Auditor records the calls to Runtime Extensions.
+// NOTE: a nil Auditor is valid and records nothing.
+type Auditor struct {
+	level          Level
+	sink           Sink
+	redactPayloads bool
+}
+
+// New returns a new Auditor, or nil if the audit log is disabled.
+func New(options Options) *Auditor {
+	if options.Level == "" || options.Level == LevelNone || options.Sink == nil {
+		return nil
+	}
+	return &Auditor{
+		level:          options.Level,
+		sink:           options.Sink,
+		redactPayloads: options.RedactPayloads,
+	}
+}
+
+// Record completes the given event with the outcome of the call and the payloads, if required by the level,
+// and records it to the sink.
+func (a *Auditor) Record(ctx context.Context, event Event, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject, callErr error) {
+	if a == nil {
+		return
+	}
+
+	event.Timestamp = metav1.NewTime(time.Now())
+	if callErr != nil {
+		event.Error = callErr.Error()
+	} else if response != nil {
+		event.Status = response.GetStatus()
+		event.Message = response.GetMessage()
+		if retryResponse, ok := response.(runtimehooksv1.RetryResponseObject); ok {
+			event.RetryAfterSeconds = retryResponse.GetRetryAfterSeconds()
+		}
+		if acceptResponse, ok := response.(runtimehooksv1.AcceptResponseObject); ok {
+			accepted := acceptResponse.GetAccepted()
+			event.Accepted = &accepted
+		}
+	}
+
+	if a.level == LevelRequestResponse {
+		event.Request = a.payload(request)
+		if callErr == nil {
+			event.Response = a.payload(response)
+		}
+	}
+
+	a.sink.Record(ctx, &event)
+}
+
+// payload returns the JSON representation of an object, redacted if required.
+// NOTE: payloads which can't be serialized are omitted, given that audit must never block calls to Runtime Extensions.
+func (a *Auditor) payload(obj runtime.Object) json.RawMessage {
+	if obj == nil {
+		return nil
+	}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil
+	}
+	if !a.redactPayloads {
+		return raw
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil
+	}
+	redacted, err := json.Marshal(redact(value))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+)
+
+type fakeSink struct {
+	events []*Event
+}
+
+func (s *fakeSink) Record(_ context.Context, event *Event) {
+	s.events = append(s.events, event)
+}
+
+func TestNew(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(New(Options{Level: LevelNone, Sink: &fakeSink{}})).To(BeNil())
+	g.Expect(New(Options{Level: LevelMetadata})).To(BeNil())
+	g.Expect(New(Options{Level: LevelMetadata, Sink: &fakeSink{}})).ToNot(BeNil())
+
+	// A nil Auditor records nothing.
+	var auditor *Auditor
+	auditor.Record(context.Background(), Event{}, nil, nil, nil)
+}
+
+func TestParseLevel(t *testing.T) {
+	g := NewWithT(t)
+
+	level, err := ParseLevel("RequestResponse")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(level).To(Equal(LevelRequestResponse))
+
+	_, err = ParseLevel("Everything")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestAuditor_Record(t *testing.T) {
+	request := &runtimehooksv1.BeforeClusterUpgradeRequest{
+		CommonRequest: runtimehooksv1.CommonRequest{
+			Settings: map[string]string{"token": "secret"},
+		},
+		FromKubernetesVersion: "v1.29.0",
+		ToKubernetesVersion:   "v1.30.0",
+	}
+	response := &runtimehooksv1.BeforeClusterUpgradeResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Status:  runtimehooksv1.ResponseStatusSuccess,
+				Message: "waiting for backup",
+			},
+			RetryAfterSeconds: 30,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		level          Level
+		redactPayloads bool
+		callErr        error
+		want           func(g *WithT, event *Event)
+	}{
+		{
+			name:  "records metadata",
+			level: LevelMetadata,
+			want: func(g *WithT, event *Event) {
+				g.Expect(event.Hook).To(Equal("BeforeClusterUpgrade"))
+				g.Expect(event.Status).To(Equal(runtimehooksv1.ResponseStatusSuccess))
+				g.Expect(event.Message).To(Equal("waiting for backup"))
+				g.Expect(event.RetryAfterSeconds).To(Equal(int32(30)))
+				g.Expect(event.Timestamp.IsZero()).To(BeFalse())
+				g.Expect(event.Request).To(BeNil())
+				g.Expect(event.Response).To(BeNil())
+			},
+		},
+		{
+			name:    "records errors",
+			level:   LevelRequestResponse,
+			callErr: errors.New("connection refused"),
+			want: func(g *WithT, event *Event) {
+				g.Expect(event.Error).To(Equal("connection refused"))
+				g.Expect(event.Status).To(BeEmpty())
+				g.Expect(event.Request).ToNot(BeNil())
+				g.Expect(event.Response).To(BeNil())
+			},
+		},
+		{
+			name:  "records payloads",
+			level: LevelRequestResponse,
+			want: func(g *WithT, event *Event) {
+				g.Expect(string(event.Request)).To(ContainSubstring(`"token":"secret"`))
+				g.Expect(string(event.Response)).To(ContainSubstring(`"retryAfterSeconds":30`))
+			},
+		},
+		{
+			name:           "records redacted payloads",
+			level:          LevelRequestResponse,
+			redactPayloads: true,
+			want: func(g *WithT, event *Event) {
+				g.Expect(string(event.Request)).To(ContainSubstring(`"token":"REDACTED"`))
+				g.Expect(string(event.Request)).To(ContainSubstring(`"toKubernetesVersion":"v1.30.0"`))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			sink := &fakeSink{}
+			auditor := New(Options{Level: tt.level, Sink: sink, RedactPayloads: tt.redactPayloads})
+			auditor.Record(context.Background(), Event{Hook: "BeforeClusterUpgrade"}, request, response, tt.callErr)
+
+			g.Expect(sink.events).To(HaveLen(1))
+			tt.want(g, sink.events[0])
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	g := NewWithT(t)
+
+	request := &runtimehooksv1.GeneratePatchesRequest{
+		CommonRequest: runtimehooksv1.CommonRequest{
+			Settings: map[string]string{"token": "secret"},
+		},
+		Variables: []runtimehooksv1.Variable{
+			{Name: "password", Value: apiextensionsv1.JSON{Raw: []byte(`"secret"`)}},
+		},
+		Items: []runtimehooksv1.GeneratePatchesRequestItem{
+			{
+				UID: "1",
+				Variables: []runtimehooksv1.Variable{
+					{Name: "apiKey", Value: apiextensionsv1.JSON{Raw: []byte(`{"key":"secret"}`)}},
+				},
+			},
+		},
+	}
+
+	auditor := &Auditor{level: LevelRequestResponse, redactPayloads: true}
+	payload := auditor.payload(request)
+	g.Expect(string(payload)).ToNot(ContainSubstring("secret"))
+
+	var redacted runtimehooksv1.GeneratePatchesRequest
+	g.Expect(json.Unmarshal(payload, &redacted)).To(Succeed())
+	g.Expect(redacted.Variables[0].Name).To(Equal("password"))
+	g.Expect(redacted.Items[0].UID).To(BeEquivalentTo("1"))
+	g.Expect(redacted.Items[0].Variables[0].Name).To(Equal("apiKey"))
+}
+
+func TestWriterSink(t *testing.T) {
+	g := NewWithT(t)
+
+	var buf bytes.Buffer
+	sink := NewWriterSink(&buf, logr.Discard())
+	sink.Record(context.Background(), &Event{Hook: "BeforeClusterCreate", ExtensionHandler: "handler.extension"})
+	sink.Record(context.Background(), &Event{Hook: "BeforeClusterDelete", ExtensionHandler: "handler.extension"})
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	g.Expect(lines).To(HaveLen(2))
+
+	event := &Event{}
+	g.Expect(json.Unmarshal(lines[1], event)).To(Succeed())
+	g.Expect(event.Hook).To(Equal("BeforeClusterDelete"))
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+// redactedValue is the value replacing redacted values in payloads.
+const redactedValue = "REDACTED"
+
+// redact replaces the values which may contain sensitive information in a payload:
+//   - the values of settings, e.g. the settings of the request or of an ExtensionConfig.
+//   - the values of variables, e.g. the variables of a GeneratePatches request or of a Cluster topology.
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, fieldValue := range v {
+			switch key {
+			case "settings":
+				if settings, ok := fieldValue.(map[string]interface{}); ok {
+					for name := range settings {
+						settings[name] = redactedValue
+					}
+					continue
+				}
+			case "variables":
+				if variables, ok := fieldValue.([]interface{}); ok {
+					for _, variable := range variables {
+						if variable, ok := variable.(map[string]interface{}); ok {
+							if _, ok := variable["value"]; ok {
+								variable["value"] = redactedValue
+							}
+						}
+					}
+				}
+			}
+			v[key] = redact(fieldValue)
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/go-logr/logr"
+)
+
+// NewLogSink returns a Sink writing audit events as structured logs.
+func NewLogSink(log logr.Logger) Sink {
+	return &logSink{log: log}
+}
+
+type logSink struct {
+	log logr.Logger
+}
+
+func (s *logSink) Record(_ context.Context, event *Event) {
+	keysAndValues := []interface{}{
+		"hook", event.Hook,
+		"extensionHandler", event.ExtensionHandler,
+		"extensionConfig", event.ExtensionConfig,
+		"object", event.Object,
+		"failurePolicy", event.FailurePolicy,
+		"duration", event.Duration.Duration.String(),
+		"status", event.Status,
+	}
+	if event.Message != "" {
+		keysAndValues = append(keysAndValues, "message", event.Message)
+	}
+	if event.RetryAfterSeconds != 0 {
+		keysAndValues = append(keysAndValues, "retryAfterSeconds", event.RetryAfterSeconds)
+	}
+	if event.Accepted != nil {
+		keysAndValues = append(keysAndValues, "accepted", *event.Accepted)
+	}
+	if event.Error != "" {
+		keysAndValues = append(keysAndValues, "error", event.Error)
+	}
+	if event.Request != nil {
+		keysAndValues = append(keysAndValues, "request", string(event.Request))
+	}
+	if event.Response != nil {
+		keysAndValues = append(keysAndValues, "response", string(event.Response))
+	}
+	s.log.Info("Runtime Extension called", keysAndValues...)
+}
+
+// NewWriterSink returns a Sink writing audit events to a writer, e.g. a file, one JSON object per line.
+func NewWriterSink(w io.Writer, errorLog logr.Logger) Sink {
+	return &writerSink{encoder: json.NewEncoder(w), errorLog: errorLog}
+}
+
+type writerSink struct {
+	lock     sync.Mutex
+	encoder  *json.Encoder
+	errorLog logr.Logger
+}
+
+func (s *writerSink) Record(_ context.Context, event *Event) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// NOTE: errors are logged but not returned, given that audit must never block calls to Runtime Extensions.
+	if err := s.encoder.Encode(event); err != nil {
+		s.errorLog.Error(err, "Failed to write Runtime Extension audit event", "hook", event.Hook, "extensionHandler", event.ExtensionHandler)
+	}
+}
@@ -20,6 +20,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -29,17 +30,24 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/transport"
+	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,8 +55,10 @@ import (
 	runtimev1 "sigs.k8s.io/cluster-api/exp/runtime/api/v1alpha1"
 	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+	runtimeaudit "sigs.k8s.io/cluster-api/internal/runtime/audit"
 	runtimemetrics "sigs.k8s.io/cluster-api/internal/runtime/metrics"
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
+	"sigs.k8s.io/cluster-api/internal/util/tracing"
 	"sigs.k8s.io/cluster-api/util"
 )
 
@@ -61,14 +71,19 @@ type Options struct {
 	Catalog  *runtimecatalog.Catalog
 	Registry runtimeregistry.ExtensionRegistry
 	Client   ctrlclient.Client
+
+	// Auditor records the calls to extension handlers; if nil, calls are not audited.
+	Auditor *runtimeaudit.Auditor
 }
 
 // New returns a new Client.
 func New(options Options) Client {
 	return &client{
-		catalog:  options.Catalog,
-		registry: options.Registry,
-		client:   options.Client,
+		catalog:            options.Catalog,
+		registry:           options.Registry,
+		client:             options.Client,
+		auditor:            options.Auditor,
+		clientCertificates: map[types.NamespacedName]*tls.Certificate{},
 	}
 }
 
@@ -105,6 +120,15 @@ type client struct {
 	catalog  *runtimecatalog.Catalog
 	registry runtimeregistry.ExtensionRegistry
 	client   ctrlclient.Client
+	auditor  *runtimeaudit.Auditor
+
+	// clientCertificates caches the client certificates used to call Extension servers, indexed by the
+	// name of the Secret they are read from.
+	// NOTE: client certificates are reloaded at every discovery, which happens every time an ExtensionConfig
+	// or the Secret it refers to changes; this allows to rotate client certificates without reading the
+	// Secret at every call.
+	clientCertificatesLock sync.RWMutex
+	clientCertificates     map[types.NamespacedName]*tls.Certificate
 }
 
 func (c *client) WarmUp(extensionConfigList *runtimev1.ExtensionConfigList) error {
@@ -115,7 +139,10 @@ func (c *client) IsReady() bool {
 	return c.registry.IsReady()
 }
 
-func (c *client) Discover(ctx context.Context, extensionConfig *runtimev1.ExtensionConfig) (*runtimev1.ExtensionConfig, error) {
+func (c *client) Discover(ctx context.Context, extensionConfig *runtimev1.ExtensionConfig) (_ *runtimev1.ExtensionConfig, reterr error) {
+	ctx, span := tracing.Start(ctx, "Discover", attribute.String("extensionConfig", extensionConfig.Name))
+	defer func() { tracing.End(span, reterr) }()
+
 	log := ctrl.LoggerFrom(ctx)
 	log.Info("Performing discovery for ExtensionConfig")
 
@@ -124,14 +151,23 @@ func (c *client) Discover(ctx context.Context, extensionConfig *runtimev1.Extens
 		return nil, errors.Wrapf(err, "failed to discover extension %q: failed to compute GVH of hook", extensionConfig.Name)
 	}
 
+	var clientCertificate *tls.Certificate
+	if extensionConfig.Spec.ClientConfig.ClientCertificate != nil {
+		clientCertificate, err = c.loadClientCertificate(ctx, extensionConfig.Spec.ClientConfig.ClientCertificate.SecretRef)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to discover extension %q", extensionConfig.Name)
+		}
+	}
+
 	request := &runtimehooksv1.DiscoveryRequest{}
 	response := &runtimehooksv1.DiscoveryResponse{}
 	opts := &httpCallOptions{
-		catalog:         c.catalog,
-		config:          extensionConfig.Spec.ClientConfig,
-		registrationGVH: hookGVH,
-		hookGVH:         hookGVH,
-		timeout:         defaultDiscoveryTimeout,
+		catalog:           c.catalog,
+		config:            extensionConfig.Spec.ClientConfig,
+		clientCertificate: clientCertificate,
+		registrationGVH:   hookGVH,
+		hookGVH:           hookGVH,
+		timeout:           defaultDiscoveryTimeout,
 	}
 	if err := httpCall(ctx, request, response, opts); err != nil {
 		return nil, errors.Wrapf(err, "failed to discover extension %q", extensionConfig.Name)
@@ -194,8 +230,11 @@ func (c *client) Unregister(extensionConfig *runtimev1.ExtensionConfig) error {
 // This ensures we don't end up waiting for timeout from multiple unreachable Extensions.
 // See CallExtension for more details on when an ExtensionHandler returns an error.
 // The aggregated result of the ExtensionHandlers is updated into the response object passed to the function.
-func (c *client) CallAllExtensions(ctx context.Context, hook runtimecatalog.Hook, forObject metav1.Object, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject) error {
+func (c *client) CallAllExtensions(ctx context.Context, hook runtimecatalog.Hook, forObject metav1.Object, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject) (reterr error) {
 	hookName := runtimecatalog.HookName(hook)
+	ctx, span := tracing.Start(ctx, "CallAllExtensions", attribute.String("hook", hookName))
+	defer func() { tracing.End(span, reterr) }()
+
 	log := ctrl.LoggerFrom(ctx).WithValues("hook", hookName)
 	ctx = ctrl.LoggerInto(ctx, log)
 	gvh, err := c.catalog.GroupVersionHook(hook)
@@ -258,8 +297,14 @@ func aggregateSuccessfulResponses(aggregatedResponse runtimehooksv1.ResponseObje
 	// At this point the Status should always be ResponseStatusSuccess.
 	aggregatedResponse.SetStatus(runtimehooksv1.ResponseStatusSuccess)
 
+	// Note: A request is accepted only if at least one extension handler has been called
+	// and all the extension handlers accepted the request.
+	if aggregatedAcceptResponse, ok := aggregatedResponse.(runtimehooksv1.AcceptResponseObject); ok {
+		aggregatedAcceptResponse.SetAccepted(len(responses) > 0)
+	}
+
 	// Note: As all responses have the same type we can assume now that
-	// they all implement the RetryResponseObject interface.
+	// they all implement the RetryResponseObject or AcceptResponseObject interface.
 	messages := []string{}
 	for _, resp := range responses {
 		aggregatedRetryResponse, ok := aggregatedResponse.(runtimehooksv1.RetryResponseObject)
@@ -269,6 +314,10 @@ func aggregateSuccessfulResponses(aggregatedResponse runtimehooksv1.ResponseObje
 				resp.(runtimehooksv1.RetryResponseObject).GetRetryAfterSeconds(),
 			))
 		}
+		aggregatedAcceptResponse, ok := aggregatedResponse.(runtimehooksv1.AcceptResponseObject)
+		if ok && !resp.(runtimehooksv1.AcceptResponseObject).GetAccepted() {
+			aggregatedAcceptResponse.SetAccepted(false)
+		}
 		if resp.GetMessage() != "" {
 			messages = append(messages, resp.GetMessage())
 		}
@@ -288,7 +337,13 @@ func aggregateSuccessfulResponses(aggregatedResponse runtimehooksv1.ResponseObje
 // Nb. FailurePolicy does not affect the following kinds of errors:
 // - Internal errors. Examples: hooks is incompatible with ExtensionHandler, ExtensionHandler information is missing.
 // - Error when ExtensionHandler returns a response with `Status` set to `Failure`.
-func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, forObject metav1.Object, name string, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject) error {
+func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, forObject metav1.Object, name string, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject) (reterr error) {
+	ctx, span := tracing.Start(ctx, "CallExtension",
+		attribute.String("hook", runtimecatalog.HookName(hook)),
+		attribute.String("extensionHandler", name),
+	)
+	defer func() { tracing.End(span, reterr) }()
+
 	log := ctrl.LoggerFrom(ctx).WithValues("extensionHandler", name, "hook", runtimecatalog.HookName(hook))
 	ctx = ctrl.LoggerInto(ctx, log)
 	hookGVH, err := c.catalog.GroupVersionHook(hook)
@@ -328,18 +383,36 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 		timeoutDuration = time.Duration(*registration.TimeoutSeconds) * time.Second
 	}
 
+	var clientCertificate *tls.Certificate
+	if registration.ClientConfig.ClientCertificate != nil {
+		clientCertificate, err = c.getClientCertificate(ctx, registration.ClientConfig.ClientCertificate.SecretRef)
+		if err != nil {
+			return errors.Wrapf(err, "failed to call extension handler %q", name)
+		}
+	}
+
 	// Prepare the request by merging the settings in the registration with the settings in the request.
 	request = cloneAndAddSettings(request, registration.Settings)
 
 	opts := &httpCallOptions{
-		catalog:         c.catalog,
-		config:          registration.ClientConfig,
-		registrationGVH: registration.GroupVersionHook,
-		hookGVH:         hookGVH,
-		name:            strings.TrimSuffix(registration.Name, "."+registration.ExtensionConfigName),
-		timeout:         timeoutDuration,
+		catalog:           c.catalog,
+		config:            registration.ClientConfig,
+		clientCertificate: clientCertificate,
+		registrationGVH:   registration.GroupVersionHook,
+		hookGVH:           hookGVH,
+		name:              strings.TrimSuffix(registration.Name, "."+registration.ExtensionConfigName),
+		timeout:           timeoutDuration,
 	}
+	start := time.Now()
 	err = httpCall(ctx, request, response, opts)
+	c.auditor.Record(ctx, runtimeaudit.Event{
+		Hook:             hookGVH.String(),
+		ExtensionHandler: name,
+		ExtensionConfig:  registration.ExtensionConfigName,
+		Object:           klog.KObj(forObject).String(),
+		FailurePolicy:    string(*registration.FailurePolicy),
+		Duration:         metav1.Duration{Duration: time.Since(start)},
+	}, request, response, err)
 	if err != nil {
 		// If the error is errCallingExtensionHandler then apply failure policy to calculate
 		// the effective result of the operation.
@@ -373,6 +446,38 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 	return nil
 }
 
+// getClientCertificate returns the client certificate stored in the given Secret, loading it if it is not cached yet.
+func (c *client) getClientCertificate(ctx context.Context, secretRef runtimev1.SecretReference) (*tls.Certificate, error) {
+	c.clientCertificatesLock.RLock()
+	certificate, ok := c.clientCertificates[types.NamespacedName{Namespace: secretRef.Namespace, Name: secretRef.Name}]
+	c.clientCertificatesLock.RUnlock()
+	if ok {
+		return certificate, nil
+	}
+	return c.loadClientCertificate(ctx, secretRef)
+}
+
+// loadClientCertificate reads the client certificate from the given Secret and caches it.
+func (c *client) loadClientCertificate(ctx context.Context, secretRef runtimev1.SecretReference) (*tls.Certificate, error) {
+	secretName := types.NamespacedName{Namespace: secretRef.Namespace, Name: secretRef.Name}
+
+	secret := &corev1.Secret{}
+	// Note: this is an expensive API call because secrets are explicitly not cached.
+	if err := c.client.Get(ctx, secretName, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to load client certificate: failed to get secret %s", secretName)
+	}
+
+	certificate, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load client certificate: failed to parse %q and %q entries of secret %s", corev1.TLSCertKey, corev1.TLSPrivateKeyKey, secretName)
+	}
+
+	c.clientCertificatesLock.Lock()
+	defer c.clientCertificatesLock.Unlock()
+	c.clientCertificates[secretName] = &certificate
+	return &certificate, nil
+}
+
 // cloneAndAddSettings creates a new request object and adds settings to it.
 func cloneAndAddSettings(request runtimehooksv1.RequestObject, registrationSettings map[string]string) runtimehooksv1.RequestObject {
 	// Merge the settings from registration with the settings in the request.
@@ -391,12 +496,13 @@ func cloneAndAddSettings(request runtimehooksv1.RequestObject, registrationSetti
 }
 
 type httpCallOptions struct {
-	catalog         *runtimecatalog.Catalog
-	config          runtimev1.ClientConfig
-	registrationGVH runtimecatalog.GroupVersionHook
-	hookGVH         runtimecatalog.GroupVersionHook
-	name            string
-	timeout         time.Duration
+	catalog           *runtimecatalog.Catalog
+	config            runtimev1.ClientConfig
+	clientCertificate *tls.Certificate
+	registrationGVH   runtimecatalog.GroupVersionHook
+	hookGVH           runtimecatalog.GroupVersionHook
+	name              string
+	timeout           time.Duration
 }
 
 func httpCall(ctx context.Context, request, response runtime.Object, opts *httpCallOptions) error {
@@ -475,8 +581,10 @@ func httpCall(ctx context.Context, request, response runtime.Object, opts *httpC
 		return errors.Wrap(err, "http call failed: failed to create http request")
 	}
 
+	// Propagate the trace context to the Runtime Extension.
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(httpRequest.Header))
+
 	// Use client-go's transport.TLSConfigureFor to ensure good defaults for tls
-	client := http.DefaultClient
 	tlsConfig, err := transport.TLSConfigFor(&transport.Config{
 		TLS: transport.TLSConfig{
 			CAData:     opts.config.CABundle,
@@ -486,10 +594,18 @@ func httpCall(ctx context.Context, request, response runtime.Object, opts *httpC
 	if err != nil {
 		return errors.Wrap(err, "http call failed: failed to create tls config")
 	}
+	// Present the client certificate, if any, so the Extension server can authenticate the caller.
+	if opts.clientCertificate != nil {
+		tlsConfig.Certificates = []tls.Certificate{*opts.clientCertificate}
+	}
+	// Use a dedicated client and transport for each call, so the TLS configuration of an Extension, e.g. its
+	// client certificate, is never shared with calls to other Extensions or with other users of http.DefaultClient.
 	// This also adds http2
-	client.Transport = utilnet.SetTransportDefaults(&http.Transport{
+	httpTransport := utilnet.SetTransportDefaults(&http.Transport{
 		TLSClientConfig: tlsConfig,
 	})
+	defer httpTransport.CloseIdleConnections()
+	client := &http.Client{Transport: httpTransport}
 
 	resp, err := client.Do(httpRequest)
 
@@ -19,6 +19,7 @@ package client
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -41,6 +42,7 @@ import (
 	runtimev1 "sigs.k8s.io/cluster-api/exp/runtime/api/v1alpha1"
 	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/exp/runtime/hooks/api/v1alpha1"
+	runtimeaudit "sigs.k8s.io/cluster-api/internal/runtime/audit"
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
 	fakev1alpha1 "sigs.k8s.io/cluster-api/internal/runtime/test/v1alpha1"
 	fakev1alpha2 "sigs.k8s.io/cluster-api/internal/runtime/test/v1alpha2"
@@ -789,6 +791,256 @@ func TestClient_CallExtension(t *testing.T) {
 	}
 }
 
+func TestClient_CallExtensionWithClientCertificate(t *testing.T) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+		},
+	}
+	clientCertificateSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "client-cert",
+			Namespace: "foo",
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       testcerts.ClientCert,
+			corev1.TLSPrivateKeyKey: testcerts.ClientKey,
+		},
+	}
+	fpFail := runtimev1.FailurePolicyFail
+
+	extensionConfig := func(clientCertificate *runtimev1.ClientCertificate) runtimev1.ExtensionConfig {
+		return runtimev1.ExtensionConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "extension",
+			},
+			Spec: runtimev1.ExtensionConfigSpec{
+				ClientConfig: runtimev1.ClientConfig{
+					// Set a fake URL, the URL will be overridden with the URL of the test server.
+					URL:               ptr.To("https://127.0.0.1/"),
+					CABundle:          testcerts.CACert,
+					ClientCertificate: clientCertificate,
+				},
+				NamespaceSelector: &metav1.LabelSelector{},
+			},
+			Status: runtimev1.ExtensionConfigStatus{
+				Handlers: []runtimev1.ExtensionHandler{
+					{
+						Name: "valid-extension.extension",
+						RequestHook: runtimev1.GroupVersionHook{
+							APIVersion: fakev1alpha1.GroupVersion.String(),
+							Hook:       "FakeHook",
+						},
+						TimeoutSeconds: ptr.To[int32](1),
+						FailurePolicy:  &fpFail,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name              string
+		clientCertificate *runtimev1.ClientCertificate
+		wantErr           bool
+	}{
+		{
+			name:    "fails if the client certificate is not presented",
+			wantErr: true,
+		},
+		{
+			name: "fails if the client certificate Secret does not exist",
+			clientCertificate: &runtimev1.ClientCertificate{
+				SecretRef: runtimev1.SecretReference{Namespace: "foo", Name: "does-not-exist"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "succeeds if the client certificate is presented",
+			clientCertificate: &runtimev1.ClientCertificate{
+				SecretRef: runtimev1.SecretReference{Namespace: "foo", Name: "client-cert"},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			srv := createSecureTestServer(testServerConfig{
+				responses: map[string]testServerResponse{
+					"/*": response(runtimehooksv1.ResponseStatusSuccess),
+				},
+			})
+			// Require the client to present a certificate signed by the test CA.
+			clientCAs := x509.NewCertPool()
+			g.Expect(clientCAs.AppendCertsFromPEM(testcerts.CACert)).To(BeTrue())
+			srv.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+			srv.TLS.ClientCAs = clientCAs
+			srv.StartTLS()
+			defer srv.Close()
+
+			config := extensionConfig(tt.clientCertificate)
+			config.Spec.ClientConfig.URL = ptr.To(fmt.Sprintf("https://%s/", srv.Listener.Addr().String()))
+
+			cat := runtimecatalog.New()
+			_ = fakev1alpha1.AddToCatalog(cat)
+			fakeClient := fake.NewClientBuilder().
+				WithObjects(ns, clientCertificateSecret).
+				Build()
+
+			c := New(Options{
+				Catalog:  cat,
+				Registry: registry([]runtimev1.ExtensionConfig{config}),
+				Client:   fakeClient,
+			})
+
+			obj := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster",
+					Namespace: "foo",
+				},
+			}
+			err := c.CallExtension(context.Background(), fakev1alpha1.FakeHook, obj, "valid-extension.extension", &fakev1alpha1.FakeRequest{}, &fakev1alpha1.FakeResponse{})
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			// The TLS configuration of the Extension must not leak into http.DefaultClient.
+			g.Expect(http.DefaultClient.Transport).To(BeNil())
+		})
+	}
+}
+
+func TestClient_CallExtensionAudit(t *testing.T) {
+	g := NewWithT(t)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+		},
+	}
+	fpIgnore := runtimev1.FailurePolicyIgnore
+	config := runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "extension",
+		},
+		Spec: runtimev1.ExtensionConfigSpec{
+			ClientConfig: runtimev1.ClientConfig{
+				// Set a fake URL, the URL will be overridden with the URL of the test server.
+				URL:      ptr.To("https://127.0.0.1/"),
+				CABundle: testcerts.CACert,
+			},
+			NamespaceSelector: &metav1.LabelSelector{},
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name: "valid-extension.extension",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: fakev1alpha1.GroupVersion.String(),
+						Hook:       "FakeHook",
+					},
+					TimeoutSeconds: ptr.To[int32](1),
+					FailurePolicy:  &fpIgnore,
+				},
+			},
+		},
+	}
+
+	srv := createSecureTestServer(testServerConfig{
+		responses: map[string]testServerResponse{
+			"/*": response(runtimehooksv1.ResponseStatusSuccess),
+		},
+	})
+	srv.StartTLS()
+	defer srv.Close()
+	config.Spec.ClientConfig.URL = ptr.To(fmt.Sprintf("https://%s/", srv.Listener.Addr().String()))
+
+	cat := runtimecatalog.New()
+	_ = fakev1alpha1.AddToCatalog(cat)
+	sink := &fakeAuditSink{}
+	c := New(Options{
+		Catalog:  cat,
+		Registry: registry([]runtimev1.ExtensionConfig{config}),
+		Client:   fake.NewClientBuilder().WithObjects(ns).Build(),
+		Auditor:  runtimeaudit.New(runtimeaudit.Options{Level: runtimeaudit.LevelRequestResponse, Sink: sink}),
+	})
+
+	obj := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "foo",
+		},
+	}
+	err := c.CallExtension(context.Background(), fakev1alpha1.FakeHook, obj, "valid-extension.extension", &fakev1alpha1.FakeRequest{}, &fakev1alpha1.FakeResponse{})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(sink.events).To(HaveLen(1))
+	event := sink.events[0]
+	g.Expect(event.Hook).To(ContainSubstring("FakeHook"))
+	g.Expect(event.ExtensionHandler).To(Equal("valid-extension.extension"))
+	g.Expect(event.ExtensionConfig).To(Equal("extension"))
+	g.Expect(event.Object).To(Equal("foo/cluster"))
+	g.Expect(event.FailurePolicy).To(Equal(string(runtimev1.FailurePolicyIgnore)))
+	g.Expect(event.Status).To(Equal(runtimehooksv1.ResponseStatusSuccess))
+	g.Expect(event.Error).To(BeEmpty())
+	g.Expect(event.Request).ToNot(BeNil())
+	g.Expect(event.Response).ToNot(BeNil())
+}
+
+type fakeAuditSink struct {
+	events []*runtimeaudit.Event
+}
+
+func (s *fakeAuditSink) Record(_ context.Context, event *runtimeaudit.Event) {
+	s.events = append(s.events, event)
+}
+
+func TestClient_loadClientCertificate(t *testing.T) {
+	g := NewWithT(t)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "client-cert",
+			Namespace: "foo",
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       testcerts.ClientCert,
+			corev1.TLSPrivateKeyKey: testcerts.ClientKey,
+		},
+	}
+	secretRef := runtimev1.SecretReference{Namespace: "foo", Name: "client-cert"}
+	fakeClient := fake.NewClientBuilder().WithObjects(secret).Build()
+	c := New(Options{Client: fakeClient}).(*client)
+
+	// The client certificate is loaded and cached.
+	certificate, err := c.getClientCertificate(context.Background(), secretRef)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(certificate.Certificate).ToNot(BeEmpty())
+
+	// The cached client certificate is used until it is reloaded, e.g. on discovery.
+	secret.Data[corev1.TLSCertKey] = testcerts.ServerCert
+	secret.Data[corev1.TLSPrivateKeyKey] = testcerts.ServerKey
+	g.Expect(fakeClient.Update(context.Background(), secret)).To(Succeed())
+
+	cachedCertificate, err := c.getClientCertificate(context.Background(), secretRef)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cachedCertificate).To(Equal(certificate))
+
+	rotatedCertificate, err := c.loadClientCertificate(context.Background(), secretRef)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(rotatedCertificate.Certificate).ToNot(Equal(certificate.Certificate))
+
+	// Loading fails if the Secret doesn't contain a valid key pair.
+	secret.Data[corev1.TLSPrivateKeyKey] = testcerts.ClientKey
+	g.Expect(fakeClient.Update(context.Background(), secret)).To(Succeed())
+	_, err = c.loadClientCertificate(context.Background(), secretRef)
+	g.Expect(err).To(HaveOccurred())
+}
+
 func TestPrepareRequest(t *testing.T) {
 	t.Run("request should have the correct settings", func(t *testing.T) {
 		tests := []struct {
@@ -1222,6 +1474,30 @@ func Test_aggregateResponses(t *testing.T) {
 			},
 			want: fakeRetryableSuccessResponse(1, "test1, test2"),
 		},
+		{
+			name:              "Aggregate accept responses to accepted if all the responses are accepted",
+			aggregateResponse: acceptSuccessResponse(false, ""),
+			responses: []runtimehooksv1.ResponseObject{
+				acceptSuccessResponse(true, "test1"),
+				acceptSuccessResponse(true, "test2"),
+			},
+			want: acceptSuccessResponse(true, "test1, test2"),
+		},
+		{
+			name:              "Aggregate accept responses to not accepted if one of the responses is not accepted",
+			aggregateResponse: acceptSuccessResponse(false, ""),
+			responses: []runtimehooksv1.ResponseObject{
+				acceptSuccessResponse(true, ""),
+				acceptSuccessResponse(false, "test"),
+			},
+			want: acceptSuccessResponse(false, "test"),
+		},
+		{
+			name:              "Aggregate accept responses to not accepted if there are no responses",
+			aggregateResponse: acceptSuccessResponse(true, ""),
+			responses:         []runtimehooksv1.ResponseObject{},
+			want:              acceptSuccessResponse(false, ""),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1322,6 +1598,18 @@ func fakeRetryableSuccessResponse(retryAfterSeconds int32, message string) *fake
 	}
 }
 
+func acceptSuccessResponse(accepted bool, message string) *runtimehooksv1.UpdateMachineResponse {
+	return &runtimehooksv1.UpdateMachineResponse{
+		CommonAcceptResponse: runtimehooksv1.CommonAcceptResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{
+				Message: message,
+				Status:  runtimehooksv1.ResponseStatusSuccess,
+			},
+			Accepted: accepted,
+		},
+	}
+}
+
 func newUnstartedTLSServer(handler http.Handler) *httptest.Server {
 	cert, err := tls.X509KeyPair(testcerts.ServerCert, testcerts.ServerKey)
 	if err != nil {
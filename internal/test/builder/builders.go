@@ -27,6 +27,7 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/contract"
 )
 
 // ClusterBuilder holds the variables and objects required to build a clusterv1.Cluster.
@@ -319,6 +320,7 @@ type ClusterClassBuilder struct {
 	variables                                 []clusterv1.ClusterClassVariable
 	statusVariables                           []clusterv1.ClusterClassStatusVariable
 	patches                                   []clusterv1.ClusterClassPatch
+	fieldOwnership                            *clusterv1.FieldOwnershipPolicy
 }
 
 // ClusterClass returns a ClusterClassBuilder with the given name and namespace.
@@ -404,6 +406,12 @@ func (c *ClusterClassBuilder) WithPatches(patches []clusterv1.ClusterClassPatch)
 	return c
 }
 
+// WithFieldOwnership adds the field ownership policy to the ClusterClassBuilder.
+func (c *ClusterClassBuilder) WithFieldOwnership(f *clusterv1.FieldOwnershipPolicy) *ClusterClassBuilder {
+	c.fieldOwnership = f
+	return c
+}
+
 // WithWorkerMachineDeploymentClasses adds the variables and objects needed to create MachineDeploymentTemplates for a ClusterClassBuilder.
 func (c *ClusterClassBuilder) WithWorkerMachineDeploymentClasses(mdcs ...clusterv1.MachineDeploymentClass) *ClusterClassBuilder {
 	if c.machineDeploymentClasses == nil {
@@ -434,8 +442,9 @@ func (c *ClusterClassBuilder) Build() *clusterv1.ClusterClass {
 			Namespace: c.namespace,
 		},
 		Spec: clusterv1.ClusterClassSpec{
-			Variables: c.variables,
-			Patches:   c.patches,
+			Variables:      c.variables,
+			Patches:        c.patches,
+			FieldOwnership: c.fieldOwnership,
 		},
 		Status: clusterv1.ClusterClassStatus{
 			Variables: c.statusVariables,
@@ -492,9 +501,11 @@ type MachineDeploymentClassBuilder struct {
 	nodeDrainTimeout              *metav1.Duration
 	nodeVolumeDetachTimeout       *metav1.Duration
 	nodeDeletionTimeout           *metav1.Duration
+	nodeDrainRules                *clusterv1.NodeDrainRules
 	minReadySeconds               *int32
 	strategy                      *clusterv1.MachineDeploymentStrategy
 	namingStrategy                *clusterv1.MachineDeploymentClassNamingStrategy
+	preflightChecks               *clusterv1.MachineSetPreflightChecks
 }
 
 // MachineDeploymentClass returns a MachineDeploymentClassBuilder with the given name and namespace.
@@ -558,6 +569,12 @@ func (m *MachineDeploymentClassBuilder) WithNodeDeletionTimeout(t *metav1.Durati
 	return m
 }
 
+// WithNodeDrainRules sets the NodeDrainRules for the MachineDeploymentClassBuilder.
+func (m *MachineDeploymentClassBuilder) WithNodeDrainRules(r *clusterv1.NodeDrainRules) *MachineDeploymentClassBuilder {
+	m.nodeDrainRules = r
+	return m
+}
+
 // WithMinReadySeconds sets the MinReadySeconds for the MachineDeploymentClassBuilder.
 func (m *MachineDeploymentClassBuilder) WithMinReadySeconds(t *int32) *MachineDeploymentClassBuilder {
 	m.minReadySeconds = t
@@ -576,6 +593,12 @@ func (m *MachineDeploymentClassBuilder) WithNamingStrategy(n *clusterv1.MachineD
 	return m
 }
 
+// WithPreflightChecks sets the PreflightChecks for the MachineDeploymentClassBuilder.
+func (m *MachineDeploymentClassBuilder) WithPreflightChecks(p *clusterv1.MachineSetPreflightChecks) *MachineDeploymentClassBuilder {
+	m.preflightChecks = p
+	return m
+}
+
 // Build creates a full MachineDeploymentClass object with the variables passed to the MachineDeploymentClassBuilder.
 func (m *MachineDeploymentClassBuilder) Build() *clusterv1.MachineDeploymentClass {
 	obj := &clusterv1.MachineDeploymentClass{
@@ -608,6 +631,9 @@ func (m *MachineDeploymentClassBuilder) Build() *clusterv1.MachineDeploymentClas
 	if m.nodeDeletionTimeout != nil {
 		obj.NodeDeletionTimeout = m.nodeDeletionTimeout
 	}
+	if m.nodeDrainRules != nil {
+		obj.NodeDrainRules = m.nodeDrainRules
+	}
 	if m.minReadySeconds != nil {
 		obj.MinReadySeconds = m.minReadySeconds
 	}
@@ -617,6 +643,9 @@ func (m *MachineDeploymentClassBuilder) Build() *clusterv1.MachineDeploymentClas
 	if m.namingStrategy != nil {
 		obj.NamingStrategy = m.namingStrategy
 	}
+	if m.preflightChecks != nil {
+		obj.PreflightChecks = m.preflightChecks
+	}
 	return obj
 }
 
@@ -1340,8 +1369,7 @@ func ControlPlane(namespace, name string) *ControlPlaneBuilder {
 
 // WithInfrastructureMachineTemplate adds the given unstructured object to the ControlPlaneBuilder as its InfrastructureMachineTemplate.
 func (c *ControlPlaneBuilder) WithInfrastructureMachineTemplate(t *unstructured.Unstructured) *ControlPlaneBuilder {
-	// TODO(killianmuldoon): Update to use the internal/contract package, when it is importable from here
-	if err := setNestedRef(c.obj, t, "spec", "machineTemplate", "infrastructureRef"); err != nil {
+	if err := contract.ControlPlane().MachineTemplate().InfrastructureRef().Set(c.obj, t); err != nil {
 		panic(err)
 	}
 	return c
@@ -1413,8 +1441,7 @@ func TestControlPlane(namespace, name string) *ControlPlaneBuilder {
 
 // WithInfrastructureMachineTemplate adds the given unstructured object to the TestControlPlaneBuilder as its InfrastructureMachineTemplate.
 func (c *TestControlPlaneBuilder) WithInfrastructureMachineTemplate(t *unstructured.Unstructured) *TestControlPlaneBuilder {
-	// TODO(killianmuldoon): Update to use the internal/contract package, when it is importable from here
-	if err := setNestedRef(c.obj, t, "spec", "machineTemplate", "infrastructureRef"); err != nil {
+	if err := contract.ControlPlane().MachineTemplate().InfrastructureRef().Set(c.obj, t); err != nil {
 		panic(err)
 	}
 	return c
@@ -192,6 +192,11 @@ func (in *ClusterClassBuilder) DeepCopyInto(out *ClusterClassBuilder) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.fieldOwnership != nil {
+		in, out := &in.fieldOwnership, &out.fieldOwnership
+		*out = new(v1beta1.FieldOwnershipPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterClassBuilder.
@@ -510,6 +515,11 @@ func (in *MachineDeploymentClassBuilder) DeepCopyInto(out *MachineDeploymentClas
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.nodeDrainRules != nil {
+		in, out := &in.nodeDrainRules, &out.nodeDrainRules
+		*out = new(v1beta1.NodeDrainRules)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.minReadySeconds != nil {
 		in, out := &in.minReadySeconds, &out.minReadySeconds
 		*out = new(int32)
@@ -525,6 +535,11 @@ func (in *MachineDeploymentClassBuilder) DeepCopyInto(out *MachineDeploymentClas
 		*out = new(v1beta1.MachineDeploymentClassNamingStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.preflightChecks != nil {
+		in, out := &in.preflightChecks, &out.preflightChecks
+		*out = new(v1beta1.MachineSetPreflightChecks)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentClassBuilder.
@@ -0,0 +1,145 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package templatefuncs implements the functions available in the value templates of ClusterClass inline patches.
+package templatefuncs
+
+import (
+	"sort"
+	"sync"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Profile is a predefined set of template functions.
+type Profile string
+
+const (
+	// HardenedProfile includes all the hermetic sprig functions except the ones generating random values or
+	// cryptographic material, e.g. genPrivateKey or bcrypt; those functions are expensive, and they produce a
+	// different value at every reconcile, thus triggering continuous rollouts.
+	HardenedProfile Profile = "hardened"
+
+	// HermeticProfile includes all the hermetic sprig functions, i.e. all the sprig functions except the ones
+	// depending on the time, on the environment or on the network.
+	HermeticProfile Profile = "hermetic"
+)
+
+// Profiles are the supported profiles.
+var Profiles = []Profile{HardenedProfile, HermeticProfile}
+
+// nonHardenedFunctions are the hermetic sprig functions not included in the hardened profile.
+var nonHardenedFunctions = sets.New[string](
+	"randInt",
+	"bcrypt",
+	"htpasswd",
+	"derivePassword",
+	"genPrivateKey",
+	"buildCustomCert",
+	"genCA",
+	"genCAWithKey",
+	"genSelfSignedCert",
+	"genSelfSignedCertWithKey",
+	"genSignedCert",
+	"genSignedCertWithKey",
+	"encryptAES",
+	"decryptAES",
+)
+
+// Options defines the template functions available in the value templates of ClusterClass inline patches.
+type Options struct {
+	// Profile is the predefined set of template functions to use; defaults to HardenedProfile.
+	Profile Profile
+
+	// AllowedFunctions is an allow-list of template functions; if set, only the listed functions are
+	// available and Profile is ignored. Each function must be a hermetic sprig function.
+	AllowedFunctions []string
+}
+
+var (
+	funcMapLock sync.RWMutex
+	funcMap     = hardenedFuncMap()
+)
+
+// New returns the template functions defined by the given options.
+func New(options Options) (template.FuncMap, error) {
+	hermetic := sprig.HermeticTxtFuncMap()
+
+	if len(options.AllowedFunctions) > 0 {
+		f := template.FuncMap{}
+		unknown := []string{}
+		for _, name := range options.AllowedFunctions {
+			fn, ok := hermetic[name]
+			if !ok {
+				unknown = append(unknown, name)
+				continue
+			}
+			f[name] = fn
+		}
+		if len(unknown) > 0 {
+			sort.Strings(unknown)
+			return nil, errors.Errorf("invalid template functions %v: only hermetic sprig functions are supported", unknown)
+		}
+		return f, nil
+	}
+
+	switch options.Profile {
+	case "", HardenedProfile:
+		return hardenedFuncMap(), nil
+	case HermeticProfile:
+		return hermetic, nil
+	default:
+		return nil, errors.Errorf("invalid template function profile %q, must be one of %v", options.Profile, Profiles)
+	}
+}
+
+// Set sets the template functions available in the value templates of ClusterClass inline patches.
+// NOTE: This func is meant to be called once at startup, before the controllers and the webhooks are started.
+func Set(options Options) error {
+	f, err := New(options)
+	if err != nil {
+		return err
+	}
+
+	funcMapLock.Lock()
+	defer funcMapLock.Unlock()
+	funcMap = f
+	return nil
+}
+
+// FuncMap returns the template functions available in the value templates of ClusterClass inline patches.
+func FuncMap() template.FuncMap {
+	funcMapLock.RLock()
+	defer funcMapLock.RUnlock()
+
+	// Return a copy, so callers cannot change the template functions.
+	f := make(template.FuncMap, len(funcMap))
+	for name, fn := range funcMap {
+		f[name] = fn
+	}
+	return f
+}
+
+func hardenedFuncMap() template.FuncMap {
+	f := sprig.HermeticTxtFuncMap()
+	for name := range nonHardenedFunctions {
+		delete(f, name)
+	}
+	return f
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package templatefuncs
+
+import (
+	"testing"
+
+	"github.com/Masterminds/sprig/v3"
+	. "github.com/onsi/gomega"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     Options
+		wantErr     bool
+		wantFuncs   []string
+		wantNoFuncs []string
+		wantLen     int
+	}{
+		{
+			name:        "defaults to the hardened profile",
+			options:     Options{},
+			wantFuncs:   []string{"toJson", "b64enc", "upper"},
+			wantNoFuncs: []string{"genPrivateKey", "bcrypt", "randInt", "env", "now"},
+		},
+		{
+			name:        "hermetic profile",
+			options:     Options{Profile: HermeticProfile},
+			wantFuncs:   []string{"toJson", "genPrivateKey", "bcrypt"},
+			wantNoFuncs: []string{"env", "now", "randAlphaNum"},
+			wantLen:     len(sprig.HermeticTxtFuncMap()),
+		},
+		{
+			name:        "allowed functions take precedence over the profile",
+			options:     Options{Profile: HermeticProfile, AllowedFunctions: []string{"upper", "genPrivateKey"}},
+			wantFuncs:   []string{"upper", "genPrivateKey"},
+			wantNoFuncs: []string{"toJson"},
+			wantLen:     2,
+		},
+		{
+			name:    "fails with an invalid profile",
+			options: Options{Profile: "foo"},
+			wantErr: true,
+		},
+		{
+			name:    "fails with functions which are not hermetic sprig functions",
+			options: Options{AllowedFunctions: []string{"upper", "env", "foo"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := New(tt.options)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			for _, name := range tt.wantFuncs {
+				g.Expect(got).To(HaveKey(name))
+			}
+			for _, name := range tt.wantNoFuncs {
+				g.Expect(got).ToNot(HaveKey(name))
+			}
+			if tt.wantLen > 0 {
+				g.Expect(got).To(HaveLen(tt.wantLen))
+			}
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	g := NewWithT(t)
+	defer func() {
+		g.Expect(Set(Options{})).To(Succeed())
+	}()
+
+	g.Expect(FuncMap()).ToNot(HaveKey("genPrivateKey"))
+
+	g.Expect(Set(Options{AllowedFunctions: []string{"upper"}})).To(Succeed())
+	g.Expect(FuncMap()).To(HaveLen(1))
+	g.Expect(FuncMap()).To(HaveKey("upper"))
+
+	// An invalid configuration does not change the template functions.
+	g.Expect(Set(Options{Profile: "foo"})).ToNot(Succeed())
+	g.Expect(FuncMap()).To(HaveLen(1))
+}
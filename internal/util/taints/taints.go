@@ -47,6 +47,22 @@ func HasTaint(taints []corev1.Taint, targetTaint corev1.Taint) bool {
 	return false
 }
 
+// SetNodeTaint makes sure the node has the Taint with the same value.
+// It returns true if the taints are modified, false otherwise.
+func SetNodeTaint(node *corev1.Node, taint corev1.Taint) bool {
+	for i := range node.Spec.Taints {
+		if node.Spec.Taints[i].MatchTaint(&taint) {
+			if node.Spec.Taints[i].Value == taint.Value {
+				return false
+			}
+			node.Spec.Taints[i].Value = taint.Value
+			return true
+		}
+	}
+	node.Spec.Taints = append(node.Spec.Taints, taint)
+	return true
+}
+
 // EnsureNodeTaint makes sure the node has the Taint.
 // It returns true if the taints are modified, false otherwise.
 func EnsureNodeTaint(node *corev1.Node, taint corev1.Taint) bool {
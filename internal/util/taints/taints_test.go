@@ -23,6 +23,51 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+func TestSetNodeTaint(t *testing.T) {
+	taint1 := corev1.Taint{Key: "taint1", Effect: corev1.TaintEffectNoSchedule}
+	taint1WithValue := corev1.Taint{Key: "taint1", Value: "value", Effect: corev1.TaintEffectNoSchedule}
+	taint2 := corev1.Taint{Key: "taint2", Effect: corev1.TaintEffectNoSchedule}
+
+	tests := []struct {
+		name         string
+		node         *corev1.Node
+		setTaint     corev1.Taint
+		wantTaints   []corev1.Taint
+		wantModified bool
+	}{
+		{
+			name:         "setting a new taint should return true",
+			node:         &corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{taint2}}},
+			setTaint:     taint1,
+			wantTaints:   []corev1.Taint{taint2, taint1},
+			wantModified: true,
+		},
+		{
+			name:         "setting an existing taint should return false",
+			node:         &corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{taint1, taint2}}},
+			setTaint:     taint1,
+			wantTaints:   []corev1.Taint{taint1, taint2},
+			wantModified: false,
+		},
+		{
+			name:         "setting an existing taint with a different value should return true",
+			node:         &corev1.Node{Spec: corev1.NodeSpec{Taints: []corev1.Taint{taint1, taint2}}},
+			setTaint:     taint1WithValue,
+			wantTaints:   []corev1.Taint{taint1WithValue, taint2},
+			wantModified: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got := SetNodeTaint(tt.node, tt.setTaint)
+			g.Expect(got).To(Equal(tt.wantModified))
+			g.Expect(tt.node.Spec.Taints).To(BeComparableTo(tt.wantTaints))
+		})
+	}
+}
+
 func TestRemoveNodeTaint(t *testing.T) {
 	taint1 := corev1.Taint{Key: "taint1", Effect: corev1.TaintEffectNoSchedule}
 	taint2 := corev1.Taint{Key: "taint2", Effect: corev1.TaintEffectNoSchedule}
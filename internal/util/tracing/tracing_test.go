@@ -0,0 +1,63 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestStartAndEnd(t *testing.T) {
+	g := NewWithT(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+	otel.SetTracerProvider(tracerProvider)
+
+	cluster := &clusterv1.Cluster{
+		TypeMeta:   metav1.TypeMeta{Kind: "Cluster"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "cluster"},
+	}
+
+	ctx, parent := Start(context.Background(), "Reconcile", ObjectAttributes(cluster)...)
+	_, child := Start(ctx, "ComputeDesiredState")
+	End(child, errors.New("failed to compute desired state"))
+	End(parent, nil)
+
+	spans := recorder.Ended()
+	g.Expect(spans).To(HaveLen(2))
+
+	g.Expect(spans[0].Name()).To(Equal("ComputeDesiredState"))
+	g.Expect(spans[0].Parent().SpanID()).To(Equal(spans[1].SpanContext().SpanID()))
+	g.Expect(spans[0].Status().Code).To(Equal(codes.Error))
+	g.Expect(spans[0].Events()).To(HaveLen(1))
+
+	g.Expect(spans[1].Name()).To(Equal("Reconcile"))
+	g.Expect(spans[1].Status().Code).To(Equal(codes.Unset))
+	g.Expect(spans[1].Attributes()).To(ConsistOf(ObjectAttributes(cluster)))
+}
@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -42,10 +43,10 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/external"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/feature"
-	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/topology/check"
 	"sigs.k8s.io/cluster-api/internal/topology/variables"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/contract"
 	"sigs.k8s.io/cluster-api/util/version"
 )
 
@@ -269,6 +270,12 @@ func (webhook *Cluster) validateTopology(ctx context.Context, oldCluster, newClu
 	// metadata in topology should be valid
 	allErrs = append(allErrs, validateTopologyMetadata(newCluster.Spec.Topology, fldPath)...)
 
+	// failure domain spreading in topology should be valid
+	allErrs = append(allErrs, validateTopologyFailureDomainSpread(newCluster.Spec.Topology, fldPath)...)
+
+	// node drain rules in topology should be valid
+	allErrs = append(allErrs, validateTopologyNodeDrainRules(newCluster.Spec.Topology, fldPath)...)
+
 	// upgrade concurrency should be a numeric value.
 	if concurrency, ok := newCluster.Annotations[clusterv1.ClusterTopologyUpgradeConcurrencyAnnotation]; ok {
 		concurrencyAnnotationField := field.NewPath("metadata", "annotations", clusterv1.ClusterTopologyUpgradeConcurrencyAnnotation)
@@ -865,6 +872,35 @@ func clusterClassIsReconciled(clusterClass *clusterv1.ClusterClass) error {
 	return nil
 }
 
+func validateTopologyFailureDomainSpread(topology *clusterv1.Topology, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if topology.Workers == nil {
+		return allErrs
+	}
+	for idx, md := range topology.Workers.MachineDeployments {
+		if clusterv1.FailureDomainSpreadPolicy(ptr.Deref(md.FailureDomainSpread, "")) == clusterv1.EvenFailureDomainSpreadPolicy && md.FailureDomain != nil {
+			allErrs = append(allErrs, field.Forbidden(
+				fldPath.Child("workers", "machineDeployments").Index(idx).Child("failureDomain"),
+				fmt.Sprintf("cannot be set if failureDomainSpread is %q", clusterv1.EvenFailureDomainSpreadPolicy),
+			))
+		}
+	}
+	return allErrs
+}
+
+func validateTopologyNodeDrainRules(topology *clusterv1.Topology, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if topology.Workers == nil {
+		return allErrs
+	}
+	for idx, md := range topology.Workers.MachineDeployments {
+		allErrs = append(allErrs, md.NodeDrainRules.Validate(
+			fldPath.Child("workers", "machineDeployments").Index(idx).Child("nodeDrainRules"),
+		)...)
+	}
+	return allErrs
+}
+
 func validateTopologyMetadata(topology *clusterv1.Topology, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, topology.ControlPlane.Metadata.Validate(fldPath.Child("controlPlane", "metadata"))...)
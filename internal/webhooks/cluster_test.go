@@ -1287,6 +1287,37 @@ func TestClusterTopologyValidation(t *testing.T) {
 					Build()).
 				Build(),
 		},
+		{
+			name:      "should return error when a MachineDeployment spread across failure domains defines a failure domain",
+			expectErr: true,
+			in: builder.Cluster("fooboo", "cluster1").
+				WithTopology(builder.ClusterTopology().
+					WithClass("foo").
+					WithVersion("v1.19.1").
+					WithMachineDeployment(clusterv1.MachineDeploymentTopology{
+						Class:               "aa",
+						Name:                "workers1",
+						FailureDomain:       ptr.To("fd-1"),
+						FailureDomainSpread: ptr.To(string(clusterv1.EvenFailureDomainSpreadPolicy)),
+					}).
+					Build()).
+				Build(),
+		},
+		{
+			name:      "should pass when a MachineDeployment is spread across failure domains",
+			expectErr: false,
+			in: builder.Cluster("fooboo", "cluster1").
+				WithTopology(builder.ClusterTopology().
+					WithClass("foo").
+					WithVersion("v1.19.1").
+					WithMachineDeployment(clusterv1.MachineDeploymentTopology{
+						Class:               "aa",
+						Name:                "workers1",
+						FailureDomainSpread: ptr.To(string(clusterv1.EvenFailureDomainSpreadPolicy)),
+					}).
+					Build()).
+				Build(),
+		},
 		{
 			name:      "should pass when MachinePools names in a Topology are unique",
 			expectErr: false,
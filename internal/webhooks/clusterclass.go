@@ -173,6 +173,12 @@ func (webhook *ClusterClass) validate(ctx context.Context, oldClusterClass, newC
 	// Validate metadata
 	allErrs = append(allErrs, validateClusterClassMetadata(newClusterClass)...)
 
+	// Validate field ownership policy.
+	allErrs = append(allErrs, validateFieldOwnership(newClusterClass)...)
+
+	// Validate node drain rules.
+	allErrs = append(allErrs, validateNodeDrainRules(newClusterClass)...)
+
 	// If this is an update run additional validation.
 	if oldClusterClass != nil {
 		// Ensure spec changes are compatible.
@@ -506,3 +512,25 @@ func validateClusterClassMetadata(clusterClass *clusterv1.ClusterClass) field.Er
 	}
 	return allErrs
 }
+
+func validateNodeDrainRules(clusterClass *clusterv1.ClusterClass) field.ErrorList {
+	var allErrs field.ErrorList
+	for idx, md := range clusterClass.Spec.Workers.MachineDeployments {
+		allErrs = append(allErrs, md.NodeDrainRules.Validate(field.NewPath("spec", "workers", "machineDeployments").Index(idx).Child("nodeDrainRules"))...)
+	}
+	return allErrs
+}
+
+func validateFieldOwnership(clusterClass *clusterv1.ClusterClass) field.ErrorList {
+	var allErrs field.ErrorList
+	if clusterClass.Spec.FieldOwnership == nil {
+		return allErrs
+	}
+	fldPath := field.NewPath("spec", "fieldOwnership", "forceOwnershipPaths")
+	for i, path := range clusterClass.Spec.FieldOwnership.ForceOwnershipPaths {
+		if !strings.HasPrefix(path, ".") || len(path) == 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), path, "path must start with \".\" and reference a field, e.g. \".spec.replicas\""))
+		}
+	}
+	return allErrs
+}
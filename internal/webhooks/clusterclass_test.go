@@ -1769,6 +1769,36 @@ func TestClusterClassValidation(t *testing.T) {
 				Build(),
 			expectErr: true,
 		},
+		{
+			name: "should pass for a valid fieldOwnership policy",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithFieldOwnership(&clusterv1.FieldOwnershipPolicy{
+					ConflictResolution:  clusterv1.YieldFieldConflictResolution,
+					ForceOwnershipPaths: []string{".spec.replicas", ".metadata.labels.environment"},
+				}).
+				Build(),
+			expectErr: false,
+		},
+		{
+			name: "should return error for invalid fieldOwnership.forceOwnershipPaths",
+			in: builder.ClusterClass(metav1.NamespaceDefault, "class1").
+				WithInfrastructureClusterTemplate(
+					builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infra1").Build()).
+				WithControlPlaneTemplate(
+					builder.ControlPlaneTemplate(metav1.NamespaceDefault, "cp1").
+						Build()).
+				WithFieldOwnership(&clusterv1.FieldOwnershipPolicy{
+					ConflictResolution:  clusterv1.YieldFieldConflictResolution,
+					ForceOwnershipPaths: []string{"spec.replicas"},
+				}).
+				Build(),
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
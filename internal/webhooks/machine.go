@@ -167,6 +167,9 @@ func (webhook *Machine) validate(oldM, newM *clusterv1.Machine) error {
 		}
 	}
 
+	allErrs = append(allErrs, newM.Spec.NodeDrainRules.Validate(specPath.Child("nodeDrainRules"))...)
+	allErrs = append(allErrs, newM.Spec.NodeMetadata.Validate(specPath.Child("nodeMetadata"))...)
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -284,3 +284,182 @@ func TestMachineVersionValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestMachineNodeDrainRulesValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		drainRules *clusterv1.NodeDrainRules
+		expectErr  bool
+	}{
+		{
+			name:       "should succeed when drain rules are not set",
+			drainRules: nil,
+			expectErr:  false,
+		},
+		{
+			name: "should succeed when ordering by pod priority",
+			drainRules: &clusterv1.NodeDrainRules{
+				Order: clusterv1.PodPriorityNodeDrainOrder,
+			},
+			expectErr: false,
+		},
+		{
+			name: "should succeed when ordering by a valid label",
+			drainRules: &clusterv1.NodeDrainRules{
+				Order:      clusterv1.LabelNodeDrainOrder,
+				OrderLabel: "example.com/drain-order",
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error when ordering by label without orderLabel",
+			drainRules: &clusterv1.NodeDrainRules{
+				Order: clusterv1.LabelNodeDrainOrder,
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when orderLabel is set without ordering by label",
+			drainRules: &clusterv1.NodeDrainRules{
+				Order:      clusterv1.PodPriorityNodeDrainOrder,
+				OrderLabel: "example.com/drain-order",
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when orderLabel is not a valid label key",
+			drainRules: &clusterv1.NodeDrainRules{
+				Order:      clusterv1.LabelNodeDrainOrder,
+				OrderLabel: "not a label",
+			},
+			expectErr: true,
+		},
+		{
+			name: "should succeed with valid exclusions",
+			drainRules: &clusterv1.NodeDrainRules{
+				Exclusions: []clusterv1.NodeDrainExclusion{
+					{Namespace: "kube-system"},
+					{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "logging"}}},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error when an exclusion matches all pods",
+			drainRules: &clusterv1.NodeDrainRules{
+				Exclusions: []clusterv1.NodeDrainExclusion{{}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when an exclusion has an invalid pod selector",
+			drainRules: &clusterv1.NodeDrainRules{
+				Exclusions: []clusterv1.NodeDrainExclusion{
+					{PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Invalid"}}}},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := &clusterv1.Machine{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap:      clusterv1.Bootstrap{ConfigRef: nil, DataSecretName: ptr.To("test")},
+					NodeDrainRules: tt.drainRules,
+				},
+			}
+			webhook := &Machine{}
+
+			warnings, err := webhook.ValidateCreate(ctx, m)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
+func TestMachineNodeMetadataValidation(t *testing.T) {
+	tests := []struct {
+		name         string
+		nodeMetadata *clusterv1.NodeMetadata
+		expectErr    bool
+	}{
+		{
+			name:         "should succeed when node metadata is not set",
+			nodeMetadata: nil,
+			expectErr:    false,
+		},
+		{
+			name: "should succeed with valid labels, annotations and taints",
+			nodeMetadata: &clusterv1.NodeMetadata{
+				Labels:      map[string]string{"example.com/role": "gpu"},
+				Annotations: map[string]string{"example.com/owner": "team-a"},
+				Taints: []corev1.Taint{
+					{Key: "example.com/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "example.com/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error when a label is not valid",
+			nodeMetadata: &clusterv1.NodeMetadata{
+				Labels: map[string]string{"not a label": "gpu"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when an annotation is not valid",
+			nodeMetadata: &clusterv1.NodeMetadata{
+				Annotations: map[string]string{"not an annotation": "team-a"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when a taint effect is not valid",
+			nodeMetadata: &clusterv1.NodeMetadata{
+				Taints: []corev1.Taint{{Key: "example.com/dedicated", Effect: "Invalid"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when a taint is duplicated",
+			nodeMetadata: &clusterv1.NodeMetadata{
+				Taints: []corev1.Taint{
+					{Key: "example.com/dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "example.com/dedicated", Value: "cpu", Effect: corev1.TaintEffectNoSchedule},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := &clusterv1.Machine{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap:    clusterv1.Bootstrap{ConfigRef: nil, DataSecretName: ptr.To("test")},
+					NodeMetadata: tt.nodeMetadata,
+				},
+			}
+			webhook := &Machine{}
+
+			warnings, err := webhook.ValidateCreate(ctx, m)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
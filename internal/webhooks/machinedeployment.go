@@ -277,6 +277,9 @@ func (webhook *MachineDeployment) validate(oldMD, newMD *clusterv1.MachineDeploy
 	// Validate the metadata of the template.
 	allErrs = append(allErrs, newMD.Spec.Template.ObjectMeta.Validate(specPath.Child("template", "metadata"))...)
 
+	allErrs = append(allErrs, newMD.Spec.Template.Spec.NodeDrainRules.Validate(specPath.Child("template", "spec", "nodeDrainRules"))...)
+	allErrs = append(allErrs, newMD.Spec.Template.Spec.NodeMetadata.Validate(specPath.Child("template", "spec", "nodeMetadata"))...)
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -91,6 +92,12 @@ func (webhook *MachineHealthCheck) Default(_ context.Context, obj runtime.Object
 		m.Spec.RemediationTemplate.Namespace = m.Namespace
 	}
 
+	for i := range m.Spec.RemediationStrategies {
+		if t := m.Spec.RemediationStrategies[i].RemediationTemplate; t != nil && t.Namespace == "" {
+			t.Namespace = m.Namespace
+		}
+	}
+
 	return nil
 }
 
@@ -158,6 +165,8 @@ func (webhook *MachineHealthCheck) validate(oldMHC, newMHC *clusterv1.MachineHea
 	}
 
 	allErrs = append(allErrs, webhook.validateCommonFields(newMHC, specPath)...)
+	allErrs = append(allErrs, validateRemediationBackoff(newMHC.Spec.RemediationBackoff, specPath.Child("remediationBackoff"))...)
+	allErrs = append(allErrs, validateRemediationStrategies(newMHC, specPath.Child("remediationStrategies"))...)
 
 	if len(allErrs) == 0 {
 		return nil
@@ -199,3 +208,60 @@ func (webhook *MachineHealthCheck) validateCommonFields(m *clusterv1.MachineHeal
 
 	return allErrs
 }
+
+// validateRemediationBackoff validates the remediation backoff of the MHC.
+func validateRemediationBackoff(backoff *clusterv1.MachineHealthCheckRemediationBackoff, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if backoff == nil {
+		return allErrs
+	}
+
+	for name, duration := range map[string]*metav1.Duration{
+		"initialBackoff": backoff.InitialBackoff,
+		"maxBackoff":     backoff.MaxBackoff,
+		"cooldownPeriod": backoff.CooldownPeriod,
+	} {
+		if duration != nil && duration.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), duration.String(), "must be greater than or equal to 0"))
+		}
+	}
+
+	if backoff.InitialBackoff != nil && backoff.MaxBackoff != nil && backoff.MaxBackoff.Duration < backoff.InitialBackoff.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackoff"), backoff.MaxBackoff.String(), "must be greater than or equal to initialBackoff"))
+	}
+
+	return allErrs
+}
+
+// validateRemediationStrategies validates the remediation strategies of the MHC.
+func validateRemediationStrategies(m *clusterv1.MachineHealthCheck, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	strategies := m.Spec.RemediationStrategies
+	if len(strategies) == 0 {
+		return allErrs
+	}
+
+	if m.Spec.RemediationTemplate != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "cannot be set together with remediationTemplate"))
+	}
+
+	names := sets.Set[string]{}
+	for i, s := range strategies {
+		if names.Has(s.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("name"), s.Name))
+		}
+		names.Insert(s.Name)
+
+		if s.RemediationTemplate == nil && i != len(strategies)-1 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("remediationTemplate"), "must be set for all the remediation strategies except the last one"))
+		}
+		if s.RemediationTemplate != nil && s.RemediationTemplate.Namespace != m.Namespace {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("remediationTemplate", "namespace"), s.RemediationTemplate.Namespace, "must match metadata.namespace"))
+		}
+		if s.Timeout != nil && s.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("timeout"), s.Timeout.String(), "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
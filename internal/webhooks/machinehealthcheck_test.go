@@ -24,6 +24,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/internal/webhooks/util"
@@ -496,3 +497,186 @@ func TestMachineHealthCheckRemediationTemplateNamespaceValidation(t *testing.T)
 		})
 	}
 }
+
+func TestMachineHealthCheckRemediationBackoffValidation(t *testing.T) {
+	oneMinute := &metav1.Duration{Duration: time.Minute}
+	oneHour := &metav1.Duration{Duration: time.Hour}
+	minusOneMinute := &metav1.Duration{Duration: -time.Minute}
+
+	tests := []struct {
+		name      string
+		backoff   *clusterv1.MachineHealthCheckRemediationBackoff
+		expectErr bool
+	}{
+		{
+			name:      "should succeed when remediationBackoff is not set",
+			backoff:   nil,
+			expectErr: false,
+		},
+		{
+			name: "should succeed with a valid remediationBackoff",
+			backoff: &clusterv1.MachineHealthCheckRemediationBackoff{
+				InitialBackoff:         oneMinute,
+				MaxBackoff:             oneHour,
+				MaxRemediationsPerHour: ptr.To[int32](5),
+				CooldownPeriod:         oneMinute,
+			},
+			expectErr: false,
+		},
+		{
+			name: "should return error when initialBackoff is negative",
+			backoff: &clusterv1.MachineHealthCheckRemediationBackoff{
+				InitialBackoff: minusOneMinute,
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when cooldownPeriod is negative",
+			backoff: &clusterv1.MachineHealthCheckRemediationBackoff{
+				CooldownPeriod: minusOneMinute,
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when maxBackoff is lower than initialBackoff",
+			backoff: &clusterv1.MachineHealthCheckRemediationBackoff{
+				InitialBackoff: oneHour,
+				MaxBackoff:     oneMinute,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mhc := &clusterv1.MachineHealthCheck{
+				Spec: clusterv1.MachineHealthCheckSpec{
+					Selector:           metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					RemediationBackoff: tt.backoff,
+					UnhealthyConditions: []clusterv1.UnhealthyCondition{
+						{
+							Type:   corev1.NodeReady,
+							Status: corev1.ConditionFalse,
+						},
+					},
+				},
+			}
+			webhook := &MachineHealthCheck{}
+
+			if tt.expectErr {
+				g.Expect(webhook.validate(nil, mhc)).NotTo(Succeed())
+			} else {
+				g.Expect(webhook.validate(nil, mhc)).To(Succeed())
+			}
+		})
+	}
+}
+
+func TestMachineHealthCheckRemediationStrategiesValidation(t *testing.T) {
+	template := func(namespace string) *corev1.ObjectReference {
+		return &corev1.ObjectReference{
+			APIVersion: "infrastructure.cluster.x-k8s.io/v1beta1",
+			Kind:       "RebootRemediationTemplate",
+			Name:       "reboot",
+			Namespace:  namespace,
+		}
+	}
+
+	tests := []struct {
+		name                string
+		remediationTemplate *corev1.ObjectReference
+		strategies          []clusterv1.MachineHealthCheckRemediationStrategy
+		expectErr           bool
+	}{
+		{
+			name:       "should succeed when remediationStrategies is not set",
+			strategies: nil,
+			expectErr:  false,
+		},
+		{
+			name: "should succeed with an external strategy followed by the owner remediation",
+			strategies: []clusterv1.MachineHealthCheckRemediationStrategy{
+				{Name: "reboot", RemediationTemplate: template("default"), MaxAttempts: ptr.To[int32](2), Timeout: &metav1.Duration{Duration: time.Minute}},
+				{Name: "delete"},
+			},
+			expectErr: false,
+		},
+		{
+			name:                "should return error when remediationTemplate is set",
+			remediationTemplate: template("default"),
+			strategies: []clusterv1.MachineHealthCheckRemediationStrategy{
+				{Name: "delete"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when names are not unique",
+			strategies: []clusterv1.MachineHealthCheckRemediationStrategy{
+				{Name: "reboot", RemediationTemplate: template("default")},
+				{Name: "reboot"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when the owner remediation is not the last strategy",
+			strategies: []clusterv1.MachineHealthCheckRemediationStrategy{
+				{Name: "delete"},
+				{Name: "reboot", RemediationTemplate: template("default")},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when the template namespace does not match",
+			strategies: []clusterv1.MachineHealthCheckRemediationStrategy{
+				{Name: "reboot", RemediationTemplate: template("foo")},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when timeout is negative",
+			strategies: []clusterv1.MachineHealthCheckRemediationStrategy{
+				{Name: "reboot", RemediationTemplate: template("default"), Timeout: &metav1.Duration{Duration: -time.Minute}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "should return error when timeout is 0",
+			strategies: []clusterv1.MachineHealthCheckRemediationStrategy{
+				{Name: "reboot", RemediationTemplate: template("default"), Timeout: &metav1.Duration{}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mhc := &clusterv1.MachineHealthCheck{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: clusterv1.MachineHealthCheckSpec{
+					Selector:              metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					RemediationTemplate:   tt.remediationTemplate,
+					RemediationStrategies: tt.strategies,
+					UnhealthyConditions: []clusterv1.UnhealthyCondition{
+						{
+							Type:   corev1.NodeReady,
+							Status: corev1.ConditionFalse,
+						},
+					},
+				},
+			}
+			webhook := &MachineHealthCheck{}
+
+			if tt.expectErr {
+				g.Expect(webhook.validate(nil, mhc)).NotTo(Succeed())
+			} else {
+				g.Expect(webhook.validate(nil, mhc)).To(Succeed())
+			}
+		})
+	}
+}
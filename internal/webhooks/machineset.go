@@ -213,6 +213,9 @@ func (webhook *MachineSet) validate(oldMS, newMS *clusterv1.MachineSet) error {
 	// Validate the metadata of the template.
 	allErrs = append(allErrs, newMS.Spec.Template.ObjectMeta.Validate(specPath.Child("template", "metadata"))...)
 
+	allErrs = append(allErrs, newMS.Spec.Template.Spec.NodeDrainRules.Validate(specPath.Child("template", "spec", "nodeDrainRules"))...)
+	allErrs = append(allErrs, newMS.Spec.Template.Spec.NodeMetadata.Validate(specPath.Child("template", "spec", "nodeMetadata"))...)
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -23,7 +23,6 @@ import (
 	"strings"
 	"text/template"
 
-	"github.com/Masterminds/sprig/v3"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -32,6 +31,7 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/topology/templatefuncs"
 )
 
 // validatePatches returns errors if the Patches in the ClusterClass violate any validation rules.
@@ -146,7 +146,7 @@ func validateEnabledIf(enabledIf *string, path *field.Path) field.ErrorList {
 
 	if enabledIf != nil {
 		// Error if template can not be parsed.
-		_, err := template.New("enabledIf").Funcs(sprig.HermeticTxtFuncMap()).Parse(*enabledIf)
+		_, err := template.New("enabledIf").Funcs(templatefuncs.FuncMap()).Parse(*enabledIf)
 		if err != nil {
 			allErrs = append(allErrs,
 				field.Invalid(
@@ -414,7 +414,7 @@ func validateJSONPatchValues(jsonPatch clusterv1.JSONPatch, variableSet map[stri
 
 	if jsonPatch.ValueFrom != nil && jsonPatch.ValueFrom.Template != nil {
 		// Error if template can not be parsed.
-		_, err := template.New("valueFrom.template").Funcs(sprig.HermeticTxtFuncMap()).Parse(*jsonPatch.ValueFrom.Template)
+		_, err := template.New("valueFrom.template").Funcs(templatefuncs.FuncMap()).Parse(*jsonPatch.ValueFrom.Template)
 		if err != nil {
 			allErrs = append(allErrs,
 				field.Invalid(
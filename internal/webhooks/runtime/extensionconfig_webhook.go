@@ -221,6 +221,24 @@ func validateExtensionConfigSpec(e *runtimev1.ExtensionConfig) field.ErrorList {
 			}
 		}
 	}
+
+	// Validate ClientCertificate if defined
+	if e.Spec.ClientConfig.ClientCertificate != nil {
+		secretRef := e.Spec.ClientConfig.ClientCertificate.SecretRef
+		if secretRef.Name == "" {
+			allErrs = append(allErrs, field.Required(
+				specPath.Child("clientConfig", "clientCertificate", "secretRef", "name"),
+				"must not be empty",
+			))
+		}
+		if secretRef.Namespace == "" {
+			allErrs = append(allErrs, field.Required(
+				specPath.Child("clientConfig", "clientCertificate", "secretRef", "namespace"),
+				"must not be empty",
+			))
+		}
+	}
+
 	if e.Spec.NamespaceSelector == nil {
 		allErrs = append(allErrs, field.Required(
 			specPath.Child("namespaceSelector"),
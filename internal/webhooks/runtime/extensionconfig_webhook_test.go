@@ -197,6 +197,20 @@ func TestExtensionConfigValidate(t *testing.T) {
 	extensionWithInvalidServicePort := extensionWithService.DeepCopy()
 	extensionWithInvalidServicePort.Spec.ClientConfig.Service.Port = ptr.To[int32](90000)
 
+	extensionWithClientCertificate := extensionWithService.DeepCopy()
+	extensionWithClientCertificate.Spec.ClientConfig.ClientCertificate = &runtimev1.ClientCertificate{
+		SecretRef: runtimev1.SecretReference{
+			Namespace: "bar",
+			Name:      "foo-client-cert",
+		},
+	}
+
+	extensionWithNoClientCertificateSecretName := extensionWithClientCertificate.DeepCopy()
+	extensionWithNoClientCertificateSecretName.Spec.ClientConfig.ClientCertificate.SecretRef.Name = ""
+
+	extensionWithNoClientCertificateSecretNamespace := extensionWithClientCertificate.DeepCopy()
+	extensionWithNoClientCertificateSecretNamespace.Spec.ClientConfig.ClientCertificate.SecretRef.Namespace = ""
+
 	extensionWithInvalidNamespaceSelector := extensionWithService.DeepCopy()
 	extensionWithInvalidNamespaceSelector.Spec.NamespaceSelector = &metav1.LabelSelector{
 		MatchExpressions: []metav1.LabelSelectorRequirement{
@@ -322,6 +336,24 @@ func TestExtensionConfigValidate(t *testing.T) {
 			featureGate: true,
 			expectErr:   true,
 		},
+		{
+			name:        "creation should succeed if ClientCertificate is correctly defined",
+			in:          extensionWithClientCertificate,
+			featureGate: true,
+			expectErr:   false,
+		},
+		{
+			name:        "creation should fail if no ClientCertificate Secret Name is defined",
+			in:          extensionWithNoClientCertificateSecretName,
+			featureGate: true,
+			expectErr:   true,
+		},
+		{
+			name:        "creation should fail if no ClientCertificate Secret Namespace is defined",
+			in:          extensionWithNoClientCertificateSecretNamespace,
+			featureGate: true,
+			expectErr:   true,
+		},
 		{
 			name:        "update should pass if updated Extension is valid",
 			old:         extensionWithService,
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -38,6 +39,7 @@ import (
 	"k8s.io/component-base/logs"
 	logsv1 "k8s.io/component-base/logs/api/v1"
 	_ "k8s.io/component-base/logs/json/register"
+	"k8s.io/component-base/tracing"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -68,10 +70,13 @@ import (
 	expv1alpha4 "sigs.k8s.io/cluster-api/internal/apis/core/exp/v1alpha4"
 	clusterv1alpha3 "sigs.k8s.io/cluster-api/internal/apis/core/v1alpha3"
 	clusterv1alpha4 "sigs.k8s.io/cluster-api/internal/apis/core/v1alpha4"
+	runtimeaudit "sigs.k8s.io/cluster-api/internal/runtime/audit"
 	runtimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
+	"sigs.k8s.io/cluster-api/internal/topology/templatefuncs"
 	runtimewebhooks "sigs.k8s.io/cluster-api/internal/webhooks/runtime"
 	"sigs.k8s.io/cluster-api/util/flags"
+	"sigs.k8s.io/cluster-api/util/shard"
 	"sigs.k8s.io/cluster-api/version"
 	"sigs.k8s.io/cluster-api/webhooks"
 )
@@ -98,21 +103,33 @@ var (
 	webhookCertDir              string
 	healthAddr                  string
 	tlsOptions                  = flags.TLSOptions{}
+	shardOptions                = flags.ShardOptions{}
+	tracingOptions              = flags.TracingOptions{}
 	diagnosticsOptions          = flags.DiagnosticsOptions{}
 	logOptions                  = logs.NewOptions()
 	// core Cluster API specific flags.
-	clusterTopologyConcurrency     int
-	clusterCacheTrackerConcurrency int
-	clusterClassConcurrency        int
-	clusterConcurrency             int
-	extensionConfigConcurrency     int
-	machineConcurrency             int
-	machineSetConcurrency          int
-	machineDeploymentConcurrency   int
-	machinePoolConcurrency         int
-	clusterResourceSetConcurrency  int
-	machineHealthCheckConcurrency  int
-	nodeDrainClientTimeout         time.Duration
+	clusterTopologyConcurrency                  int
+	clusterCacheTrackerConcurrency              int
+	clusterCacheTrackerClientQPS                float32
+	clusterCacheTrackerClientBurst              int
+	clusterCacheTrackerHealthCheckInterval      time.Duration
+	clusterCacheTrackerHealthCheckTimeout       time.Duration
+	clusterCacheTrackerMaxConcurrentConnections int
+	clusterClassConcurrency                     int
+	clusterConcurrency                          int
+	extensionConfigConcurrency                  int
+	machineConcurrency                          int
+	machineSetConcurrency                       int
+	machineDeploymentConcurrency                int
+	machinePoolConcurrency                      int
+	clusterResourceSetConcurrency               int
+	machineHealthCheckConcurrency               int
+	nodeDrainClientTimeout                      time.Duration
+	runtimeSDKAuditLevel                        string
+	runtimeSDKAuditLogPath                      string
+	runtimeSDKAuditRedactPayloads               bool
+	clusterClassTemplateFunctionProfile         string
+	clusterClassTemplateFunctions               []string
 )
 
 func init() {
@@ -179,6 +196,21 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&clusterCacheTrackerConcurrency, "clustercachetracker-concurrency", 10,
 		"Number of clusters to process simultaneously")
 
+	fs.Float32Var(&clusterCacheTrackerClientQPS, "clustercachetracker-client-qps", 0,
+		"Maximum queries per second from the controller client to the Kubernetes API server of each workload cluster. If not set, the client-go default is used.")
+
+	fs.IntVar(&clusterCacheTrackerClientBurst, "clustercachetracker-client-burst", 0,
+		"Maximum number of queries that should be allowed in one burst from the controller client to the Kubernetes API server of each workload cluster. If not set, the client-go default is used.")
+
+	fs.DurationVar(&clusterCacheTrackerHealthCheckInterval, "clustercachetracker-health-check-interval", 10*time.Second,
+		"Interval between the health checks of the connection to each workload cluster (e.g. 10s)")
+
+	fs.DurationVar(&clusterCacheTrackerHealthCheckTimeout, "clustercachetracker-health-check-timeout", 5*time.Second,
+		"Timeout of each health check request to a workload cluster (e.g. 5s)")
+
+	fs.IntVar(&clusterCacheTrackerMaxConcurrentConnections, "clustercachetracker-max-concurrent-connections", 0,
+		"Maximum number of connections to workload clusters created simultaneously. If 0, the number of connections created simultaneously is not limited.")
+
 	fs.IntVar(&extensionConfigConcurrency, "extensionconfig-concurrency", 10,
 		"Number of extension configs to process simultaneously")
 
@@ -212,6 +244,21 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&nodeDrainClientTimeout, "node-drain-client-timeout-duration", time.Second*10,
 		"The timeout of the client used for draining nodes. Defaults to 10s")
 
+	fs.StringVar(&runtimeSDKAuditLevel, "runtime-sdk-audit-level", string(runtimeaudit.LevelNone),
+		fmt.Sprintf("The level of the audit log of the calls to Runtime Extensions, one of %v. Only used when the RuntimeSDK feature flag is enabled.", runtimeaudit.Levels))
+
+	fs.StringVar(&runtimeSDKAuditLogPath, "runtime-sdk-audit-log-path", "",
+		"The path of the file where the audit log of the calls to Runtime Extensions is written, one JSON object per line. If not set, the audit log is written to the controller logs.")
+
+	fs.BoolVar(&runtimeSDKAuditRedactPayloads, "runtime-sdk-audit-redact-payloads", true,
+		"Redact the values of settings and variables in the request and response payloads recorded in the audit log of the calls to Runtime Extensions.")
+
+	fs.StringVar(&clusterClassTemplateFunctionProfile, "clusterclass-template-function-profile", string(templatefuncs.HardenedProfile),
+		fmt.Sprintf("The set of template functions available in the templates of ClusterClass inline patches, one of %v.", templatefuncs.Profiles))
+
+	fs.StringSliceVar(&clusterClassTemplateFunctions, "clusterclass-template-functions", nil,
+		"Comma-separated list of the template functions available in the templates of ClusterClass inline patches. If set, only the listed functions are available and --clusterclass-template-function-profile is ignored.")
+
 	fs.IntVar(&webhookPort, "webhook-port", 9443,
 		"Webhook Server port")
 
@@ -223,6 +270,8 @@ func InitFlags(fs *pflag.FlagSet) {
 
 	flags.AddDiagnosticsOptions(fs, &diagnosticsOptions)
 	flags.AddTLSOptions(fs, &tlsOptions)
+	flags.AddShardOptions(fs, &shardOptions)
+	flags.AddTracingOptions(fs, &tracingOptions)
 
 	feature.MutableGates.AddFlag(fs)
 }
@@ -260,6 +309,14 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := templatefuncs.Set(templatefuncs.Options{
+		Profile:          templatefuncs.Profile(clusterClassTemplateFunctionProfile),
+		AllowedFunctions: clusterClassTemplateFunctions,
+	}); err != nil {
+		setupLog.Error(err, "unable to configure the template functions of ClusterClass inline patches")
+		os.Exit(1)
+	}
+
 	minVer := version.MinimumKubernetesVersion
 	if feature.Gates.Enabled(feature.ClusterTopology) {
 		minVer = version.MinimumKubernetesVersionClusterTopology
@@ -276,6 +333,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	managerShard, err := flags.GetShard(shardOptions)
+	if err != nil {
+		setupLog.Error(err, "unable to configure sharding")
+		os.Exit(1)
+	}
+
 	diagnosticsOpts := flags.GetDiagnosticsOptions(diagnosticsOptions)
 
 	var watchNamespaces map[string]cache.Config
@@ -295,7 +358,7 @@ func main() {
 	ctrlOptions := ctrl.Options{
 		Scheme:                     scheme,
 		LeaderElection:             enableLeaderElection,
-		LeaderElectionID:           "controller-leader-election-capi",
+		LeaderElectionID:           managerShard.LeaderElectionID("controller-leader-election-capi"),
 		LeaseDuration:              &leaderElectionLeaseDuration,
 		RenewDeadline:              &leaderElectionRenewDeadline,
 		RetryPeriod:                &leaderElectionRetryPeriod,
@@ -341,13 +404,30 @@ func main() {
 	// Setup the context that's going to be used in controllers and for the manager.
 	ctx := ctrl.SetupSignalHandler()
 
+	tracerProvider, err := flags.GetTracerProvider(ctx, tracingOptions, "capi-controller-manager")
+	if err != nil {
+		setupLog.Error(err, "Unable to create tracer provider")
+		os.Exit(1)
+	}
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(tracing.Propagators())
+
 	setupChecks(mgr)
 	setupIndexes(ctx, mgr)
-	tracker := setupReconcilers(ctx, mgr)
+	tracker := setupReconcilers(ctx, mgr, managerShard)
 	setupWebhooks(mgr, tracker)
 
 	setupLog.Info("starting manager", "version", version.Get().String())
-	if err := mgr.Start(ctx); err != nil {
+	err = mgr.Start(ctx)
+
+	// Export the remaining traces before exiting.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if shutdownErr := tracerProvider.Shutdown(shutdownCtx); shutdownErr != nil {
+		setupLog.Error(shutdownErr, "Unable to shutdown tracer provider")
+	}
+
+	if err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
@@ -372,7 +452,7 @@ func setupIndexes(ctx context.Context, mgr ctrl.Manager) {
 	}
 }
 
-func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCacheTrackerReader {
+func setupReconcilers(ctx context.Context, mgr ctrl.Manager, managerShard shard.Shard) webhooks.ClusterCacheTrackerReader {
 	secretCachingClient, err := client.New(mgr.GetConfig(), client.Options{
 		HTTPClient: mgr.GetHTTPClient(),
 		Cache: &client.CacheOptions{
@@ -389,10 +469,15 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 	tracker, err := remote.NewClusterCacheTracker(
 		mgr,
 		remote.ClusterCacheTrackerOptions{
-			SecretCachingClient: secretCachingClient,
-			ControllerName:      controllerName,
-			Log:                 &ctrl.Log,
-			Indexes:             []remote.Index{remote.NodeProviderIDIndex},
+			SecretCachingClient:            secretCachingClient,
+			ControllerName:                 controllerName,
+			Log:                            &ctrl.Log,
+			ClientQPS:                      clusterCacheTrackerClientQPS,
+			ClientBurst:                    clusterCacheTrackerClientBurst,
+			HealthCheckInterval:            clusterCacheTrackerHealthCheckInterval,
+			HealthCheckTimeout:             clusterCacheTrackerHealthCheckTimeout,
+			MaxConcurrentAccessorCreations: clusterCacheTrackerMaxConcurrentConnections,
+			Indexes:                        []remote.Index{remote.NodeProviderIDIndex},
 		},
 	)
 	if err != nil {
@@ -404,6 +489,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 		Client:           mgr.GetClient(),
 		Tracker:          tracker,
 		WatchFilterValue: watchFilterValue,
+		Shard:            managerShard,
 	}).SetupWithManager(ctx, mgr, concurrency(clusterCacheTrackerConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ClusterCacheReconciler")
 		os.Exit(1)
@@ -412,10 +498,16 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 	var runtimeClient runtimeclient.Client
 	if feature.Gates.Enabled(feature.RuntimeSDK) {
 		// This is the creation of the runtimeClient for the controllers, embedding a shared catalog and registry instance.
+		auditor, err := newRuntimeSDKAuditor()
+		if err != nil {
+			setupLog.Error(err, "unable to create Runtime SDK audit log")
+			os.Exit(1)
+		}
 		runtimeClient = runtimeclient.New(runtimeclient.Options{
 			Catalog:  catalog,
 			Registry: runtimeregistry.New(),
 			Client:   mgr.GetClient(),
+			Auditor:  auditor,
 		})
 	}
 
@@ -432,14 +524,17 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 	}
 
 	if feature.Gates.Enabled(feature.ClusterTopology) {
-		if err := (&controllers.ClusterClassReconciler{
-			Client:                    mgr.GetClient(),
-			RuntimeClient:             runtimeClient,
-			UnstructuredCachingClient: unstructuredCachingClient,
-			WatchFilterValue:          watchFilterValue,
-		}).SetupWithManager(ctx, mgr, concurrency(clusterClassConcurrency)); err != nil {
-			setupLog.Error(err, "unable to create controller", "controller", "ClusterClass")
-			os.Exit(1)
+		// ClusterClasses do not belong to a Cluster, so they are reconciled only by the primary shard.
+		if managerShard.IsPrimary() {
+			if err := (&controllers.ClusterClassReconciler{
+				Client:                    mgr.GetClient(),
+				RuntimeClient:             runtimeClient,
+				UnstructuredCachingClient: unstructuredCachingClient,
+				WatchFilterValue:          watchFilterValue,
+			}).SetupWithManager(ctx, mgr, concurrency(clusterClassConcurrency)); err != nil {
+				setupLog.Error(err, "unable to create controller", "controller", "ClusterClass")
+				os.Exit(1)
+			}
 		}
 
 		if err := (&controllers.ClusterTopologyReconciler{
@@ -449,6 +544,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 			Tracker:                   tracker,
 			UnstructuredCachingClient: unstructuredCachingClient,
 			WatchFilterValue:          watchFilterValue,
+			Shard:                     managerShard,
 		}).SetupWithManager(ctx, mgr, concurrency(clusterTopologyConcurrency)); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "ClusterTopology")
 			os.Exit(1)
@@ -458,6 +554,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 			Client:           mgr.GetClient(),
 			APIReader:        mgr.GetAPIReader(),
 			WatchFilterValue: watchFilterValue,
+			Shard:            managerShard,
 		}).SetupWithManager(ctx, mgr, controller.Options{}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "MachineDeploymentTopology")
 			os.Exit(1)
@@ -467,6 +564,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 			Client:           mgr.GetClient(),
 			APIReader:        mgr.GetAPIReader(),
 			WatchFilterValue: watchFilterValue,
+			Shard:            managerShard,
 		}).SetupWithManager(ctx, mgr, controller.Options{}); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "MachineSetTopology")
 			os.Exit(1)
@@ -479,6 +577,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 			APIReader:        mgr.GetAPIReader(),
 			RuntimeClient:    runtimeClient,
 			WatchFilterValue: watchFilterValue,
+			Shard:            managerShard,
 		}).SetupWithManager(ctx, mgr, concurrency(extensionConfigConcurrency)); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "ExtensionConfig")
 			os.Exit(1)
@@ -490,6 +589,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 		UnstructuredCachingClient: unstructuredCachingClient,
 		APIReader:                 mgr.GetAPIReader(),
 		WatchFilterValue:          watchFilterValue,
+		Shard:                     managerShard,
 	}).SetupWithManager(ctx, mgr, concurrency(clusterConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Cluster")
 		os.Exit(1)
@@ -500,6 +600,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 		APIReader:                 mgr.GetAPIReader(),
 		Tracker:                   tracker,
 		WatchFilterValue:          watchFilterValue,
+		Shard:                     managerShard,
 		NodeDrainClientTimeout:    nodeDrainClientTimeout,
 	}).SetupWithManager(ctx, mgr, concurrency(machineConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Machine")
@@ -510,7 +611,9 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 		UnstructuredCachingClient: unstructuredCachingClient,
 		APIReader:                 mgr.GetAPIReader(),
 		Tracker:                   tracker,
+		RuntimeClient:             runtimeClient,
 		WatchFilterValue:          watchFilterValue,
+		Shard:                     managerShard,
 	}).SetupWithManager(ctx, mgr, concurrency(machineSetConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MachineSet")
 		os.Exit(1)
@@ -520,6 +623,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 		UnstructuredCachingClient: unstructuredCachingClient,
 		APIReader:                 mgr.GetAPIReader(),
 		WatchFilterValue:          watchFilterValue,
+		Shard:                     managerShard,
 	}).SetupWithManager(ctx, mgr, concurrency(machineDeploymentConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MachineDeployment")
 		os.Exit(1)
@@ -531,6 +635,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 			APIReader:        mgr.GetAPIReader(),
 			Tracker:          tracker,
 			WatchFilterValue: watchFilterValue,
+			Shard:            managerShard,
 		}).SetupWithManager(ctx, mgr, concurrency(machinePoolConcurrency)); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "MachinePool")
 			os.Exit(1)
@@ -542,6 +647,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 			Client:           mgr.GetClient(),
 			Tracker:          tracker,
 			WatchFilterValue: watchFilterValue,
+			Shard:            managerShard,
 		}).SetupWithManager(ctx, mgr, concurrency(clusterResourceSetConcurrency)); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "ClusterResourceSet")
 			os.Exit(1)
@@ -549,6 +655,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 		if err := (&addonscontrollers.ClusterResourceSetBindingReconciler{
 			Client:           mgr.GetClient(),
 			WatchFilterValue: watchFilterValue,
+			Shard:            managerShard,
 		}).SetupWithManager(ctx, mgr, concurrency(clusterResourceSetConcurrency)); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "ClusterResourceSetBinding")
 			os.Exit(1)
@@ -559,6 +666,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) webhooks.ClusterCac
 		Client:           mgr.GetClient(),
 		Tracker:          tracker,
 		WatchFilterValue: watchFilterValue,
+		Shard:            managerShard,
 	}).SetupWithManager(ctx, mgr, concurrency(machineHealthCheckConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MachineHealthCheck")
 		os.Exit(1)
@@ -642,6 +750,34 @@ func setupWebhooks(mgr ctrl.Manager, tracker webhooks.ClusterCacheTrackerReader)
 	}
 }
 
+// newRuntimeSDKAuditor returns the auditor recording the calls to Runtime Extensions, or nil if the audit log is disabled.
+func newRuntimeSDKAuditor() (*runtimeaudit.Auditor, error) {
+	level, err := runtimeaudit.ParseLevel(runtimeSDKAuditLevel)
+	if err != nil {
+		return nil, err
+	}
+	if level == runtimeaudit.LevelNone {
+		return nil, nil
+	}
+
+	auditLog := ctrl.Log.WithName("runtime-sdk-audit")
+	sink := runtimeaudit.NewLogSink(auditLog)
+	if runtimeSDKAuditLogPath != "" {
+		// NOTE: the file is kept open for the lifetime of the process.
+		f, err := os.OpenFile(runtimeSDKAuditLogPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open audit log file %s: %w", runtimeSDKAuditLogPath, err)
+		}
+		sink = runtimeaudit.NewWriterSink(f, auditLog)
+	}
+
+	return runtimeaudit.New(runtimeaudit.Options{
+		Level:          level,
+		Sink:           sink,
+		RedactPayloads: runtimeSDKAuditRedactPayloads,
+	}), nil
+}
+
 func concurrency(c int) controller.Options {
 	return controller.Options{MaxConcurrentReconciles: c}
 }
@@ -272,6 +272,8 @@ func clusterUpgradeWithRuntimeSDKSpec(ctx context.Context, inputGetter func() cl
 			"BeforeClusterUpgrade":         "Status: Success, RetryAfterSeconds: 0",
 			"BeforeClusterDelete":          "Status: Success, RetryAfterSeconds: 0",
 			"AfterControlPlaneUpgrade":     "Status: Success, RetryAfterSeconds: 0",
+			"BeforeWorkersUpgrade":         "Status: Success, RetryAfterSeconds: 0",
+			"AfterWorkersUpgrade":          "Status: Success, RetryAfterSeconds: 0",
 			"AfterControlPlaneInitialized": "Success",
 			"AfterClusterUpgrade":          "Success",
 		})).To(Succeed(), "Lifecycle hook calls were not as expected")
@@ -135,6 +135,46 @@ func (m *ExtensionHandlers) DoAfterControlPlaneUpgrade(ctx context.Context, requ
 	}
 }
 
+// DoBeforeWorkersUpgrade implements the HandlerFunc for the BeforeWorkersUpgrade hook.
+// The hook answers with the response stored in a well know config map, thus allowing E2E tests to
+// control the hook behaviour during a test.
+// NOTE: custom RuntimeExtension, must implement the body of this func according to the specific use case.
+func (m *ExtensionHandlers) DoBeforeWorkersUpgrade(ctx context.Context, request *runtimehooksv1.BeforeWorkersUpgradeRequest, response *runtimehooksv1.BeforeWorkersUpgradeResponse) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("BeforeWorkersUpgrade is called")
+
+	if err := m.readResponseFromConfigMap(ctx, &request.Cluster, runtimehooksv1.BeforeWorkersUpgrade, request.GetSettings(), response); err != nil {
+		response.Status = runtimehooksv1.ResponseStatusFailure
+		response.Message = err.Error()
+		return
+	}
+
+	if err := m.recordCallInConfigMap(ctx, &request.Cluster, runtimehooksv1.BeforeWorkersUpgrade, response); err != nil {
+		response.Status = runtimehooksv1.ResponseStatusFailure
+		response.Message = err.Error()
+	}
+}
+
+// DoAfterWorkersUpgrade implements the HandlerFunc for the AfterWorkersUpgrade hook.
+// The hook answers with the response stored in a well know config map, thus allowing E2E tests to
+// control the hook behaviour during a test.
+// NOTE: custom RuntimeExtension, must implement the body of this func according to the specific use case.
+func (m *ExtensionHandlers) DoAfterWorkersUpgrade(ctx context.Context, request *runtimehooksv1.AfterWorkersUpgradeRequest, response *runtimehooksv1.AfterWorkersUpgradeResponse) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("AfterWorkersUpgrade is called")
+
+	if err := m.readResponseFromConfigMap(ctx, &request.Cluster, runtimehooksv1.AfterWorkersUpgrade, request.GetSettings(), response); err != nil {
+		response.Status = runtimehooksv1.ResponseStatusFailure
+		response.Message = err.Error()
+		return
+	}
+
+	if err := m.recordCallInConfigMap(ctx, &request.Cluster, runtimehooksv1.AfterWorkersUpgrade, response); err != nil {
+		response.Status = runtimehooksv1.ResponseStatusFailure
+		response.Message = err.Error()
+	}
+}
+
 // DoAfterClusterUpgrade implements the HandlerFunc for the AfterClusterUpgrade hook.
 // The hook answers with the response stored in a well know config map, thus allowing E2E tests to
 // control the hook behaviour during a test.
@@ -230,6 +270,10 @@ func responsesConfigMap(cluster *clusterv1.Cluster, defaultAllHandlersToBlocking
 			// Non-blocking hooks are set to Status:Success.
 			"AfterControlPlaneInitialized-preloadedResponse": `{"Status": "Success"}`,
 			"AfterClusterUpgrade-preloadedResponse":          `{"Status": "Success"}`,
+
+			// Blocking hooks not gating the test are set to Status:Success.
+			"BeforeWorkersUpgrade-preloadedResponse": `{"Status": "Success"}`,
+			"AfterWorkersUpgrade-preloadedResponse":  `{"Status": "Success"}`,
 		},
 	}
 }
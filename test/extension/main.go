@@ -343,6 +343,24 @@ func setupLifecycleHookHandlers(mgr ctrl.Manager, runtimeExtensionWebhookServer
 		os.Exit(1)
 	}
 
+	if err := runtimeExtensionWebhookServer.AddExtensionHandler(server.ExtensionHandler{
+		Hook:        runtimehooksv1.BeforeWorkersUpgrade,
+		Name:        "before-workers-upgrade",
+		HandlerFunc: lifecycleExtensionHandlers.DoBeforeWorkersUpgrade,
+	}); err != nil {
+		setupLog.Error(err, "error adding handler")
+		os.Exit(1)
+	}
+
+	if err := runtimeExtensionWebhookServer.AddExtensionHandler(server.ExtensionHandler{
+		Hook:        runtimehooksv1.AfterWorkersUpgrade,
+		Name:        "after-workers-upgrade",
+		HandlerFunc: lifecycleExtensionHandlers.DoAfterWorkersUpgrade,
+	}); err != nil {
+		setupLog.Error(err, "error adding handler")
+		os.Exit(1)
+	}
+
 	if err := runtimeExtensionWebhookServer.AddExtensionHandler(server.ExtensionHandler{
 		Hook:        runtimehooksv1.AfterClusterUpgrade,
 		Name:        "after-cluster-upgrade",
@@ -0,0 +1,302 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	. "sigs.k8s.io/cluster-api/test/framework/ginkgoextensions"
+	"sigs.k8s.io/cluster-api/test/infrastructure/container"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+// DeleteControlPlaneMachineAndWaitForRecoveryInput is the input for DeleteControlPlaneMachineAndWaitForRecovery.
+type DeleteControlPlaneMachineAndWaitForRecoveryInput struct {
+	ClusterProxy ClusterProxy
+	Cluster      *clusterv1.Cluster
+}
+
+// DeleteControlPlaneMachineAndWaitForRecovery deletes one of the control plane Machines of a Cluster, and then waits
+// until the control plane provider replaces it with a new Machine with a Node.
+func DeleteControlPlaneMachineAndWaitForRecovery(ctx context.Context, input DeleteControlPlaneMachineAndWaitForRecoveryInput, intervals ...interface{}) {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for DeleteControlPlaneMachineAndWaitForRecovery")
+	Expect(input.ClusterProxy).ToNot(BeNil(), "Invalid argument. input.ClusterProxy can't be nil when calling DeleteControlPlaneMachineAndWaitForRecovery")
+	Expect(input.Cluster).ToNot(BeNil(), "Invalid argument. input.Cluster can't be nil when calling DeleteControlPlaneMachineAndWaitForRecovery")
+
+	mgmtClient := input.ClusterProxy.GetClient()
+	machines := GetControlPlaneMachinesByCluster(ctx, GetControlPlaneMachinesByClusterInput{
+		Lister:      mgmtClient,
+		ClusterName: input.Cluster.Name,
+		Namespace:   input.Cluster.Namespace,
+	})
+	Expect(machines).ToNot(BeEmpty(), "Cluster %s does not have control plane Machines", klog.KObj(input.Cluster))
+
+	deleted := machines[0]
+	Byf("Deleting control plane Machine %s", klog.KObj(&deleted))
+	Eventually(func() error {
+		return client.IgnoreNotFound(mgmtClient.Delete(ctx, &deleted))
+	}, retryableOperationTimeout, retryableOperationInterval).Should(Succeed(), "Failed to delete Machine %s", klog.KObj(&deleted))
+
+	Byf("Waiting for control plane Machine %s to be replaced", klog.KObj(&deleted))
+	Eventually(func() error {
+		current := GetControlPlaneMachinesByCluster(ctx, GetControlPlaneMachinesByClusterInput{
+			Lister:      mgmtClient,
+			ClusterName: input.Cluster.Name,
+			Namespace:   input.Cluster.Namespace,
+		})
+		if len(current) != len(machines) {
+			return errors.Errorf("expected %d control plane Machines, got %d", len(machines), len(current))
+		}
+		for _, m := range current {
+			if m.UID == deleted.UID {
+				return errors.Errorf("control plane Machine %s still exists", klog.KObj(&m))
+			}
+			if m.Status.NodeRef == nil {
+				return errors.Errorf("control plane Machine %s does not have a Node yet", klog.KObj(&m))
+			}
+		}
+		return nil
+	}, intervals...).Should(Succeed())
+}
+
+// SetNodeNotReadyInput is the input for SetNodeNotReady.
+type SetNodeNotReadyInput struct {
+	ClusterProxy ClusterProxy
+	Cluster      *clusterv1.Cluster
+	Machine      clusterv1.Machine
+}
+
+// SetNodeNotReady stops the kubelet on the Machine, and then waits until the Kubernetes node lifecycle controller
+// sets the Ready condition of the Node to Unknown because the kubelet stopped posting the Node status.
+// The kubelet can be started again with SetNodeReady.
+// NOTE: This is only supported for Machines created by the Docker infrastructure provider (CAPD).
+func SetNodeNotReady(ctx context.Context, input SetNodeNotReadyInput, intervals ...interface{}) {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for SetNodeNotReady")
+	Expect(input.ClusterProxy).ToNot(BeNil(), "Invalid argument. input.ClusterProxy can't be nil when calling SetNodeNotReady")
+	Expect(input.Cluster).ToNot(BeNil(), "Invalid argument. input.Cluster can't be nil when calling SetNodeNotReady")
+	Expect(input.Machine.Status.NodeRef).ToNot(BeNil(), "Invalid argument. input.Machine must have a Node when calling SetNodeNotReady")
+
+	Byf("Stopping the kubelet of Machine %s", klog.KObj(&input.Machine))
+	execOnMachine(ctx, input.Cluster, &input.Machine, "systemctl", "stop", "kubelet")
+
+	Byf("Waiting for Node %s of Machine %s to be NotReady", input.Machine.Status.NodeRef.Name, klog.KObj(&input.Machine))
+	workloadClient := input.ClusterProxy.GetWorkloadCluster(ctx, input.Cluster.Namespace, input.Cluster.Name).GetClient()
+	Eventually(func() (bool, error) {
+		node := &corev1.Node{}
+		if err := workloadClient.Get(ctx, types.NamespacedName{Name: input.Machine.Status.NodeRef.Name}, node); err != nil {
+			return false, err
+		}
+		return isNodeReady(node), nil
+	}, intervals...).Should(BeFalse(), "Node %s is still Ready", input.Machine.Status.NodeRef.Name)
+}
+
+// SetNodeReadyInput is the input for SetNodeReady.
+type SetNodeReadyInput struct {
+	Cluster *clusterv1.Cluster
+	Machine clusterv1.Machine
+}
+
+// SetNodeReady starts again the kubelet on a Machine stopped by SetNodeNotReady; WaitForMachineNodeHealthy can be
+// used to wait until the Machine reports a healthy Node again.
+// NOTE: This is only supported for Machines created by the Docker infrastructure provider (CAPD).
+func SetNodeReady(ctx context.Context, input SetNodeReadyInput) {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for SetNodeReady")
+	Expect(input.Cluster).ToNot(BeNil(), "Invalid argument. input.Cluster can't be nil when calling SetNodeReady")
+
+	Byf("Starting the kubelet of Machine %s", klog.KObj(&input.Machine))
+	execOnMachine(ctx, input.Cluster, &input.Machine, "systemctl", "start", "kubelet")
+}
+
+// execOnMachine runs a command in the container of a Machine created by the Docker infrastructure provider.
+func execOnMachine(ctx context.Context, cluster *clusterv1.Cluster, machine *clusterv1.Machine, command string, args ...string) {
+	containerRuntime, err := container.NewDockerClient()
+	Expect(err).ToNot(HaveOccurred(), "Failed to get the container runtime")
+
+	containerName := machineContainerName(cluster.Name, machine.Name)
+	Eventually(func() error {
+		return containerRuntime.ExecContainer(ctx, containerName, &container.ExecContainerInput{}, command, args...)
+	}, retryableOperationTimeout, retryableOperationInterval).Should(Succeed(), "Failed to run %q in the container of Machine %s", command, klog.KObj(machine))
+}
+
+// isNodeReady returns true if a Node has the Ready condition set to true.
+func isNodeReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// WaitForMachineNodeHealthyInput is the input for WaitForMachineNodeHealthy.
+type WaitForMachineNodeHealthyInput struct {
+	Getter  Getter
+	Machine *clusterv1.Machine
+}
+
+// WaitForMachineNodeHealthy waits until the NodeHealthy condition of a Machine is true, e.g. to check
+// the Machine recovered after SetNodeReady.
+func WaitForMachineNodeHealthy(ctx context.Context, input WaitForMachineNodeHealthyInput, intervals ...interface{}) {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for WaitForMachineNodeHealthy")
+	Expect(input.Getter).ToNot(BeNil(), "Invalid argument. input.Getter can't be nil when calling WaitForMachineNodeHealthy")
+	Expect(input.Machine).ToNot(BeNil(), "Invalid argument. input.Machine can't be nil when calling WaitForMachineNodeHealthy")
+
+	Byf("Waiting for Machine %s to have a healthy Node", klog.KObj(input.Machine))
+	Eventually(func() (bool, error) {
+		machine := &clusterv1.Machine{}
+		if err := input.Getter.Get(ctx, client.ObjectKeyFromObject(input.Machine), machine); err != nil {
+			return false, err
+		}
+		return conditions.IsTrue(machine, clusterv1.MachineNodeHealthyCondition), nil
+	}, intervals...).Should(BeTrue(), "Machine %s does not have a healthy Node", klog.KObj(input.Machine))
+}
+
+// BlockWorkloadClusterAPIServerInput is the input for BlockWorkloadClusterAPIServer.
+type BlockWorkloadClusterAPIServerInput struct {
+	Cluster *clusterv1.Cluster
+}
+
+// BlockWorkloadClusterAPIServer blocks the traffic to the API server of a workload cluster, by pausing the container
+// of the load balancer in front of the control plane; both new and existing connections stop getting responses.
+// NOTE: This is only supported for Clusters created by the Docker infrastructure provider (CAPD).
+// NOTE: The kubelets of the workload cluster connect to the API server through the load balancer too, so the Nodes
+// become NotReady if the traffic is blocked for longer than the node monitor grace period of the workload cluster.
+// NOTE: The workload cluster cannot be accessed via ClusterProxy.GetWorkloadCluster until UnblockWorkloadClusterAPIServer is called.
+func BlockWorkloadClusterAPIServer(ctx context.Context, input BlockWorkloadClusterAPIServerInput) {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for BlockWorkloadClusterAPIServer")
+	Expect(input.Cluster).ToNot(BeNil(), "Invalid argument. input.Cluster can't be nil when calling BlockWorkloadClusterAPIServer")
+
+	containerRuntime, err := container.NewDockerClient()
+	Expect(err).ToNot(HaveOccurred(), "Failed to get the container runtime")
+
+	Byf("Blocking the traffic to the API server of Cluster %s", klog.KObj(input.Cluster))
+	Eventually(func() error {
+		return containerRuntime.PauseContainer(ctx, loadBalancerContainerName(input.Cluster.Name))
+	}, retryableOperationTimeout, retryableOperationInterval).Should(Succeed(), "Failed to block the traffic to the API server of Cluster %s", klog.KObj(input.Cluster))
+}
+
+// UnblockWorkloadClusterAPIServerInput is the input for UnblockWorkloadClusterAPIServer.
+type UnblockWorkloadClusterAPIServerInput struct {
+	ClusterProxy ClusterProxy
+	Cluster      *clusterv1.Cluster
+}
+
+// UnblockWorkloadClusterAPIServer restores the traffic to the API server of a workload cluster blocked by BlockWorkloadClusterAPIServer,
+// and then waits until the API server of the workload cluster can be reached again.
+func UnblockWorkloadClusterAPIServer(ctx context.Context, input UnblockWorkloadClusterAPIServerInput, intervals ...interface{}) {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for UnblockWorkloadClusterAPIServer")
+	Expect(input.ClusterProxy).ToNot(BeNil(), "Invalid argument. input.ClusterProxy can't be nil when calling UnblockWorkloadClusterAPIServer")
+	Expect(input.Cluster).ToNot(BeNil(), "Invalid argument. input.Cluster can't be nil when calling UnblockWorkloadClusterAPIServer")
+
+	containerRuntime, err := container.NewDockerClient()
+	Expect(err).ToNot(HaveOccurred(), "Failed to get the container runtime")
+
+	Byf("Restoring the traffic to the API server of Cluster %s", klog.KObj(input.Cluster))
+	Eventually(func() error {
+		return containerRuntime.UnpauseContainer(ctx, loadBalancerContainerName(input.Cluster.Name))
+	}, retryableOperationTimeout, retryableOperationInterval).Should(Succeed(), "Failed to restore the traffic to the API server of Cluster %s", klog.KObj(input.Cluster))
+
+	Byf("Waiting for the API server of Cluster %s to be reachable", klog.KObj(input.Cluster))
+	workloadClient := input.ClusterProxy.GetWorkloadCluster(ctx, input.Cluster.Namespace, input.Cluster.Name).GetClient()
+	Eventually(func() error {
+		return workloadClient.List(ctx, &corev1.NodeList{})
+	}, intervals...).Should(Succeed(), "The API server of Cluster %s is not reachable", klog.KObj(input.Cluster))
+}
+
+// DeleteProviderPodsAndWaitForRecoveryInput is the input for DeleteProviderPodsAndWaitForRecovery.
+type DeleteProviderPodsAndWaitForRecoveryInput struct {
+	ClusterProxy ClusterProxy
+	Deployment   *appsv1.Deployment
+}
+
+// DeleteProviderPodsAndWaitForRecovery simulates a crash of a provider by deleting all its Pods without grace period,
+// and then waits until the Deployment of the provider is available again with new Pods.
+func DeleteProviderPodsAndWaitForRecovery(ctx context.Context, input DeleteProviderPodsAndWaitForRecoveryInput, intervals ...interface{}) {
+	Expect(ctx).NotTo(BeNil(), "ctx is required for DeleteProviderPodsAndWaitForRecovery")
+	Expect(input.ClusterProxy).ToNot(BeNil(), "Invalid argument. input.ClusterProxy can't be nil when calling DeleteProviderPodsAndWaitForRecovery")
+	Expect(input.Deployment).ToNot(BeNil(), "Invalid argument. input.Deployment can't be nil when calling DeleteProviderPodsAndWaitForRecovery")
+
+	mgmtClient := input.ClusterProxy.GetClient()
+	selector, err := metav1.LabelSelectorAsMap(input.Deployment.Spec.Selector)
+	Expect(err).ToNot(HaveOccurred(), "Failed to get the selector of Deployment %s", klog.KObj(input.Deployment))
+	listOptions := []client.ListOption{
+		client.InNamespace(input.Deployment.Namespace),
+		client.MatchingLabels(selector),
+	}
+
+	pods := &corev1.PodList{}
+	Eventually(func() error {
+		return mgmtClient.List(ctx, pods, listOptions...)
+	}, retryableOperationTimeout, retryableOperationInterval).Should(Succeed(), "Failed to list Pods of Deployment %s", klog.KObj(input.Deployment))
+
+	deleted := sets.Set[types.UID]{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		Byf("Deleting Pod %s of Deployment %s", klog.KObj(pod), klog.KObj(input.Deployment))
+		Eventually(func() error {
+			return client.IgnoreNotFound(mgmtClient.Delete(ctx, pod, client.GracePeriodSeconds(0)))
+		}, retryableOperationTimeout, retryableOperationInterval).Should(Succeed(), "Failed to delete Pod %s", klog.KObj(pod))
+		deleted.Insert(pod.UID)
+	}
+
+	Byf("Waiting for Deployment %s to be available with new Pods", klog.KObj(input.Deployment))
+	Eventually(func() error {
+		pods := &corev1.PodList{}
+		if err := mgmtClient.List(ctx, pods, listOptions...); err != nil {
+			return err
+		}
+		ready := 0
+		for _, pod := range pods.Items {
+			if deleted.Has(pod.UID) {
+				return errors.Errorf("Pod %s still exists", klog.KObj(&pod))
+			}
+			if isPodReady(&pod) {
+				ready++
+			}
+		}
+		if ready == 0 {
+			return errors.Errorf("Deployment %s does not have ready Pods", klog.KObj(input.Deployment))
+		}
+		return nil
+	}, intervals...).Should(Succeed())
+
+	WaitForDeploymentsAvailable(ctx, WaitForDeploymentsAvailableInput{
+		Getter:     mgmtClient,
+		Deployment: input.Deployment,
+	}, intervals...)
+}
+
+// isPodReady returns true if a Pod has the Ready condition set to true.
+func isPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_isNodeReady(t *testing.T) {
+	g := NewWithT(t)
+
+	node := &corev1.Node{}
+	g.Expect(isNodeReady(node)).To(BeFalse())
+
+	node.Status.Conditions = []corev1.NodeCondition{
+		{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse},
+		{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+	}
+	g.Expect(isNodeReady(node)).To(BeTrue())
+
+	node.Status.Conditions[1].Status = corev1.ConditionUnknown
+	g.Expect(isNodeReady(node)).To(BeFalse())
+}
+
+func Test_loadBalancerContainerName(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(loadBalancerContainerName("my-cluster")).To(Equal("my-cluster-lb"))
+}
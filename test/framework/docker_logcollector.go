@@ -47,6 +47,11 @@ func machineContainerName(cluster, machine string) string {
 	return fmt.Sprintf("%s-%s", cluster, machine)
 }
 
+// loadBalancerContainerName returns the name of the container of the load balancer of a cluster, using the same rule used in CAPD.
+func loadBalancerContainerName(cluster string) string {
+	return fmt.Sprintf("%s-lb", cluster)
+}
+
 func (k DockerLogCollector) CollectMachineLog(ctx context.Context, _ client.Client, m *clusterv1.Machine, outputPath string) error {
 	containerName := machineContainerName(m.Spec.ClusterName, m.Name)
 	containerRuntime, err := container.NewDockerClient()
@@ -83,7 +88,7 @@ func (k DockerLogCollector) CollectInfrastructureLogs(ctx context.Context, _ cli
 	}
 	ctx = container.RuntimeInto(ctx, containerRuntime)
 
-	lbContainerName := fmt.Sprintf("%s-lb", c.GetName())
+	lbContainerName := loadBalancerContainerName(c.GetName())
 
 	f, err := fileOnHost(filepath.Join(outputPath, fmt.Sprintf("%s.log", lbContainerName)))
 	if err != nil {
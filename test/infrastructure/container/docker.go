@@ -312,6 +312,16 @@ func (d *dockerRuntime) KillContainer(ctx context.Context, containerName, signal
 	return d.dockerClient.ContainerKill(ctx, containerName, signal)
 }
 
+// PauseContainer suspends all the processes of a running container.
+func (d *dockerRuntime) PauseContainer(ctx context.Context, containerName string) error {
+	return d.dockerClient.ContainerPause(ctx, containerName)
+}
+
+// UnpauseContainer resumes all the processes of a container suspended by PauseContainer.
+func (d *dockerRuntime) UnpauseContainer(ctx context.Context, containerName string) error {
+	return d.dockerClient.ContainerUnpause(ctx, containerName)
+}
+
 // GetContainerIPs inspects a container to get its IPv4 and IPv6 IP addresses.
 // Will not error if there is no IP address assigned. Calling code will need to
 // determine whether that is an issue or not.
@@ -143,6 +143,16 @@ func (f *FakeRuntime) ResetKillContainerCallLogs() {
 	killContainerCallLog = []KillContainerArgs{}
 }
 
+// PauseContainer suspends all the processes of a running container.
+func (f *FakeRuntime) PauseContainer(_ context.Context, _ string) error {
+	return nil
+}
+
+// UnpauseContainer resumes all the processes of a container suspended by PauseContainer.
+func (f *FakeRuntime) UnpauseContainer(_ context.Context, _ string) error {
+	return nil
+}
+
 // GetContainerIPs inspects a container to get its IPv4 and IPv6 IP addresses.
 // Will not error if there is no IP address assigned. Calling code will need to
 // determine whether that is an issue or not.
@@ -44,6 +44,8 @@ type Runtime interface {
 	ContainerDebugInfo(ctx context.Context, containerName string, w io.Writer) error
 	DeleteContainer(ctx context.Context, containerName string) error
 	KillContainer(ctx context.Context, containerName, signal string) error
+	PauseContainer(ctx context.Context, containerName string) error
+	UnpauseContainer(ctx context.Context, containerName string) error
 }
 
 // Mount contains mount details.
@@ -87,8 +87,13 @@ var (
 	diagnosticsOptions          = flags.DiagnosticsOptions{}
 	logOptions                  = logs.NewOptions()
 	// CAPD specific flags.
-	concurrency                    int
-	clusterCacheTrackerConcurrency int
+	concurrency                                 int
+	clusterCacheTrackerConcurrency              int
+	clusterCacheTrackerClientQPS                float32
+	clusterCacheTrackerClientBurst              int
+	clusterCacheTrackerHealthCheckInterval      time.Duration
+	clusterCacheTrackerHealthCheckTimeout       time.Duration
+	clusterCacheTrackerMaxConcurrentConnections int
 )
 
 func init() {
@@ -137,6 +142,21 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&clusterCacheTrackerConcurrency, "clustercachetracker-concurrency", 10,
 		"Number of clusters to process simultaneously")
 
+	fs.Float32Var(&clusterCacheTrackerClientQPS, "clustercachetracker-client-qps", 0,
+		"Maximum queries per second from the controller client to the Kubernetes API server of each workload cluster. If not set, the client-go default is used.")
+
+	fs.IntVar(&clusterCacheTrackerClientBurst, "clustercachetracker-client-burst", 0,
+		"Maximum number of queries that should be allowed in one burst from the controller client to the Kubernetes API server of each workload cluster. If not set, the client-go default is used.")
+
+	fs.DurationVar(&clusterCacheTrackerHealthCheckInterval, "clustercachetracker-health-check-interval", 10*time.Second,
+		"Interval between the health checks of the connection to each workload cluster (e.g. 10s)")
+
+	fs.DurationVar(&clusterCacheTrackerHealthCheckTimeout, "clustercachetracker-health-check-timeout", 5*time.Second,
+		"Timeout of each health check request to a workload cluster (e.g. 5s)")
+
+	fs.IntVar(&clusterCacheTrackerMaxConcurrentConnections, "clustercachetracker-max-concurrent-connections", 0,
+		"Maximum number of connections to workload clusters created simultaneously. If 0, the number of connections created simultaneously is not limited.")
+
 	fs.DurationVar(&syncPeriod, "sync-period", 10*time.Minute,
 		"The minimum interval at which watched resources are reconciled (e.g. 15m)")
 
@@ -310,9 +330,14 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 	tracker, err := remote.NewClusterCacheTracker(
 		mgr,
 		remote.ClusterCacheTrackerOptions{
-			SecretCachingClient: secretCachingClient,
-			ControllerName:      controllerName,
-			Log:                 &ctrl.Log,
+			SecretCachingClient:            secretCachingClient,
+			ControllerName:                 controllerName,
+			Log:                            &ctrl.Log,
+			ClientQPS:                      clusterCacheTrackerClientQPS,
+			ClientBurst:                    clusterCacheTrackerClientBurst,
+			HealthCheckInterval:            clusterCacheTrackerHealthCheckInterval,
+			HealthCheckTimeout:             clusterCacheTrackerHealthCheckTimeout,
+			MaxConcurrentAccessorCreations: clusterCacheTrackerMaxConcurrentConnections,
 		},
 	)
 	if err != nil {
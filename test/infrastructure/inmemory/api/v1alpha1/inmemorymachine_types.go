@@ -103,6 +103,10 @@ type InMemoryVMBehaviour struct {
 	// Provisioning defines variables influencing how the VM implementing the InMemoryMachine is going to be provisioned.
 	// NOTE: VM provisioning includes all the steps from creation to power-on.
 	Provisioning CommonProvisioningSettings `json:"provisioning,omitempty"`
+
+	// Deletion defines variables influencing how the VM implementing the InMemoryMachine is going to be deleted.
+	// NOTE: VM deletion includes all the steps from power-off to the VM being removed.
+	Deletion CommonDeletionSettings `json:"deletion,omitempty"`
 }
 
 // InMemoryNodeBehaviour defines the behaviour of the Node (the kubelet) hosted on the InMemoryMachine.
@@ -135,6 +139,28 @@ type CommonProvisioningSettings struct {
 	// amount chosen uniformly at random from the interval between zero and `StartupJitter*StartupDuration`.
 	// NOTE: this is modeled as string because the usage of float is highly discouraged, as support for them varies across languages.
 	StartupJitter string `json:"startupJitter,omitempty"`
+
+	// FailureProbability is the probability of the object provisioning to never complete, e.g. to simulate a VM never
+	// becoming ready; it must be a value between 0 and 1, and the failure is decided once for each InMemoryMachine.
+	// NOTE: this is modeled as string because the usage of float is highly discouraged, as support for them varies across languages.
+	FailureProbability string `json:"failureProbability,omitempty"`
+}
+
+// CommonDeletionSettings holds parameters that applies to deletion of most of the objects.
+type CommonDeletionSettings struct {
+	// Duration defines the duration of the object deletion phase.
+	Duration metav1.Duration `json:"duration,omitempty"`
+
+	// Jitter adds some randomness on Duration; the actual duration will be Duration plus an additional
+	// amount chosen uniformly at random from the interval between zero and `Jitter*Duration`; the additional
+	// amount is decided once for each InMemoryMachine.
+	// NOTE: this is modeled as string because the usage of float is highly discouraged, as support for them varies across languages.
+	Jitter string `json:"jitter,omitempty"`
+
+	// HangProbability is the probability of the object deletion to never complete, e.g. to simulate a VM stuck
+	// while being deleted; it must be a value between 0 and 1, and the hang is decided once for each InMemoryMachine.
+	// NOTE: this is modeled as string because the usage of float is highly discouraged, as support for them varies across languages.
+	HangProbability string `json:"hangProbability,omitempty"`
 }
 
 // InMemoryMachineStatus defines the observed state of InMemoryMachine.
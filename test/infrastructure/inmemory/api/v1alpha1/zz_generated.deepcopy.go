@@ -40,6 +40,22 @@ func (in *APIEndpoint) DeepCopy() *APIEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommonDeletionSettings) DeepCopyInto(out *CommonDeletionSettings) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonDeletionSettings.
+func (in *CommonDeletionSettings) DeepCopy() *CommonDeletionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(CommonDeletionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonProvisioningSettings) DeepCopyInto(out *CommonProvisioningSettings) {
 	*out = *in
@@ -528,6 +544,7 @@ func (in *InMemoryNodeBehaviour) DeepCopy() *InMemoryNodeBehaviour {
 func (in *InMemoryVMBehaviour) DeepCopyInto(out *InMemoryVMBehaviour) {
 	*out = *in
 	out.Provisioning = in.Provisioning
+	out.Deletion = in.Deletion
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InMemoryVMBehaviour.
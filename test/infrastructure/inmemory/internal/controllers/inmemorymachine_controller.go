@@ -21,6 +21,7 @@ import (
 	"context"
 	"crypto/rsa"
 	"fmt"
+	"hash/fnv"
 	"math/rand"
 	"strconv"
 	"time"
@@ -259,6 +260,7 @@ func (r *InMemoryMachineReconciler) reconcileNormalCloudMachine(ctx context.Cont
 
 	// Wait for the VM to be provisioned; provisioned happens a configurable time after the cloud machine creation.
 	provisioningDuration := time.Duration(0)
+	provisioningFailure := false
 	if inMemoryMachine.Spec.Behaviour != nil && inMemoryMachine.Spec.Behaviour.VM != nil {
 		x := inMemoryMachine.Spec.Behaviour.VM.Provisioning
 
@@ -272,6 +274,13 @@ func (r *InMemoryMachineReconciler) reconcileNormalCloudMachine(ctx context.Cont
 				provisioningDuration += time.Duration(rand.Float64() * jitter * float64(provisioningDuration)) //nolint:gosec // Intentionally using a weak random number generator here.
 			}
 		}
+		if x.FailureProbability != "" {
+			var err error
+			provisioningFailure, err = simulateFailure(inMemoryMachine, "vm", x.FailureProbability)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "failed to parse VM's FailureProbability")
+			}
+		}
 	}
 
 	start := cloudMachine.CreationTimestamp
@@ -281,6 +290,12 @@ func (r *InMemoryMachineReconciler) reconcileNormalCloudMachine(ctx context.Cont
 		return ctrl.Result{RequeueAfter: start.Add(provisioningDuration).Sub(now)}, nil
 	}
 
+	// If a provisioning failure is simulated, the VM never starts up; already provisioned objects are not affected.
+	if provisioningFailure && !conditions.IsTrue(inMemoryMachine, infrav1.VMProvisionedCondition) {
+		conditions.MarkFalse(inMemoryMachine, infrav1.VMProvisionedCondition, infrav1.VMWaitingForStartupTimeoutReason, clusterv1.ConditionSeverityInfo, "Simulated VM provisioning failure")
+		return ctrl.Result{}, nil
+	}
+
 	// TODO: consider if to surface VM provisioned also on the cloud machine (currently it surfaces only on the inMemoryMachine)
 
 	inMemoryMachine.Spec.ProviderID = ptr.To(calculateProviderID(inMemoryMachine))
@@ -297,6 +312,7 @@ func (r *InMemoryMachineReconciler) reconcileNormalNode(ctx context.Context, clu
 
 	// Wait for the node/kubelet to start up; node/kubelet start happens a configurable time after the VM is provisioned.
 	provisioningDuration := time.Duration(0)
+	provisioningFailure := false
 	if inMemoryMachine.Spec.Behaviour != nil && inMemoryMachine.Spec.Behaviour.Node != nil {
 		x := inMemoryMachine.Spec.Behaviour.Node.Provisioning
 
@@ -310,6 +326,13 @@ func (r *InMemoryMachineReconciler) reconcileNormalNode(ctx context.Context, clu
 				provisioningDuration += time.Duration(rand.Float64() * jitter * float64(provisioningDuration)) //nolint:gosec // Intentionally using a weak random number generator here.
 			}
 		}
+		if x.FailureProbability != "" {
+			var err error
+			provisioningFailure, err = simulateFailure(inMemoryMachine, "node", x.FailureProbability)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "failed to parse node's FailureProbability")
+			}
+		}
 	}
 
 	start := conditions.Get(inMemoryMachine, infrav1.VMProvisionedCondition).LastTransitionTime
@@ -319,6 +342,12 @@ func (r *InMemoryMachineReconciler) reconcileNormalNode(ctx context.Context, clu
 		return ctrl.Result{RequeueAfter: start.Add(provisioningDuration).Sub(now)}, nil
 	}
 
+	// If a provisioning failure is simulated, the Node never starts up; already provisioned objects are not affected.
+	if provisioningFailure && !conditions.IsTrue(inMemoryMachine, infrav1.NodeProvisionedCondition) {
+		conditions.MarkFalse(inMemoryMachine, infrav1.NodeProvisionedCondition, infrav1.NodeWaitingForStartupTimeoutReason, clusterv1.ConditionSeverityInfo, "Simulated Node provisioning failure")
+		return ctrl.Result{}, nil
+	}
+
 	// Compute the name for resource group.
 	resourceGroup := klog.KObj(cluster).String()
 	inmemoryClient := r.InMemoryManager.GetResourceGroup(resourceGroup).GetClient()
@@ -364,6 +393,28 @@ func (r *InMemoryMachineReconciler) reconcileNormalNode(ctx context.Context, clu
 	return ctrl.Result{}, nil
 }
 
+// simulateFailure returns true if a failure must be simulated for a component of the InMemoryMachine, given the failure probability.
+// NOTE: the decision is computed from the InMemoryMachine UID, so it does not change across reconciles.
+func simulateFailure(inMemoryMachine *infrav1.InMemoryMachine, component, probability string) (bool, error) {
+	p, err := strconv.ParseFloat(probability, 64)
+	if err != nil {
+		return false, err
+	}
+	if p < 0 || p > 1 {
+		return false, errors.Errorf("probability must be a value between 0 and 1, got %s", probability)
+	}
+	return randomFraction(inMemoryMachine, component) < p, nil
+}
+
+// randomFraction returns a value uniformly distributed in [0, 1) for a component of the InMemoryMachine.
+// NOTE: the value is computed from the InMemoryMachine UID, so it does not change across reconciles.
+func randomFraction(inMemoryMachine *infrav1.InMemoryMachine, component string) float64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(fmt.Sprintf("%s/%s", inMemoryMachine.UID, component)))
+	// Use the 53 most significant bits of the hash to get a value uniformly distributed in [0, 1).
+	return float64(h.Sum64()>>11) / (1 << 53)
+}
+
 func calculateProviderID(inMemoryMachine *infrav1.InMemoryMachine) string {
 	return fmt.Sprintf("in-memory://%s", inMemoryMachine.Name)
 }
@@ -381,6 +432,7 @@ func (r *InMemoryMachineReconciler) reconcileNormalETCD(ctx context.Context, clu
 
 	// Wait for the etcd pod to start up; etcd pod start happens a configurable time after the Node is provisioned.
 	provisioningDuration := time.Duration(0)
+	provisioningFailure := false
 	if inMemoryMachine.Spec.Behaviour != nil && inMemoryMachine.Spec.Behaviour.Etcd != nil {
 		x := inMemoryMachine.Spec.Behaviour.Etcd.Provisioning
 
@@ -394,6 +446,13 @@ func (r *InMemoryMachineReconciler) reconcileNormalETCD(ctx context.Context, clu
 				provisioningDuration += time.Duration(rand.Float64() * jitter * float64(provisioningDuration)) //nolint:gosec // Intentionally using a weak random number generator here.
 			}
 		}
+		if x.FailureProbability != "" {
+			var err error
+			provisioningFailure, err = simulateFailure(inMemoryMachine, "etcd", x.FailureProbability)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "failed to parse etcd's FailureProbability")
+			}
+		}
 	}
 
 	start := conditions.Get(inMemoryMachine, infrav1.NodeProvisionedCondition).LastTransitionTime
@@ -403,6 +462,12 @@ func (r *InMemoryMachineReconciler) reconcileNormalETCD(ctx context.Context, clu
 		return ctrl.Result{RequeueAfter: start.Add(provisioningDuration).Sub(now)}, nil
 	}
 
+	// If a provisioning failure is simulated, the etcd never starts up; already provisioned objects are not affected.
+	if provisioningFailure && !conditions.IsTrue(inMemoryMachine, infrav1.EtcdProvisionedCondition) {
+		conditions.MarkFalse(inMemoryMachine, infrav1.EtcdProvisionedCondition, infrav1.EtcdWaitingForStartupTimeoutReason, clusterv1.ConditionSeverityInfo, "Simulated etcd provisioning failure")
+		return ctrl.Result{}, nil
+	}
+
 	// Compute the resource group and listener unique name.
 	// NOTE: we are using the same name for convenience, but it is not required.
 	resourceGroup := klog.KObj(cluster).String()
@@ -586,6 +651,7 @@ func (r *InMemoryMachineReconciler) reconcileNormalAPIServer(ctx context.Context
 
 	// Wait for the API server pod to start up; API server pod start happens a configurable time after the Node is provisioned.
 	provisioningDuration := time.Duration(0)
+	provisioningFailure := false
 	if inMemoryMachine.Spec.Behaviour != nil && inMemoryMachine.Spec.Behaviour.APIServer != nil {
 		x := inMemoryMachine.Spec.Behaviour.APIServer.Provisioning
 
@@ -599,6 +665,13 @@ func (r *InMemoryMachineReconciler) reconcileNormalAPIServer(ctx context.Context
 				provisioningDuration += time.Duration(rand.Float64() * jitter * float64(provisioningDuration)) //nolint:gosec // Intentionally using a weak random number generator here.
 			}
 		}
+		if x.FailureProbability != "" {
+			var err error
+			provisioningFailure, err = simulateFailure(inMemoryMachine, "apiServer", x.FailureProbability)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "failed to parse API server's FailureProbability")
+			}
+		}
 	}
 
 	start := conditions.Get(inMemoryMachine, infrav1.NodeProvisionedCondition).LastTransitionTime
@@ -608,6 +681,12 @@ func (r *InMemoryMachineReconciler) reconcileNormalAPIServer(ctx context.Context
 		return ctrl.Result{RequeueAfter: start.Add(provisioningDuration).Sub(now)}, nil
 	}
 
+	// If a provisioning failure is simulated, the API server never starts up; already provisioned objects are not affected.
+	if provisioningFailure && !conditions.IsTrue(inMemoryMachine, infrav1.APIServerProvisionedCondition) {
+		conditions.MarkFalse(inMemoryMachine, infrav1.APIServerProvisionedCondition, infrav1.APIServerWaitingForStartupTimeoutReason, clusterv1.ConditionSeverityInfo, "Simulated API server provisioning failure")
+		return ctrl.Result{}, nil
+	}
+
 	// Compute the resource group and listener unique name.
 	// NOTE: we are using the same name for convenience, but it is not required.
 	resourceGroup := klog.KObj(cluster).String()
@@ -994,6 +1073,42 @@ func (r *InMemoryMachineReconciler) reconcileDeleteCloudMachine(ctx context.Cont
 	resourceGroup := klog.KObj(cluster).String()
 	inmemoryClient := r.InMemoryManager.GetResourceGroup(resourceGroup).GetClient()
 
+	// Wait for the VM to be deleted; deletion happens a configurable time after the InMemoryMachine deletion.
+	if inMemoryMachine.Spec.Behaviour != nil && inMemoryMachine.Spec.Behaviour.VM != nil {
+		x := inMemoryMachine.Spec.Behaviour.VM.Deletion
+
+		if x.HangProbability != "" {
+			hang, err := simulateFailure(inMemoryMachine, "vmDeletion", x.HangProbability)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "failed to parse VM's HangProbability")
+			}
+			// If a deletion hang is simulated, the VM is never deleted.
+			// NOTE: we are requeuing so the InMemoryMachine finalizer is not removed.
+			if hang {
+				return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+			}
+		}
+
+		deletionDuration := x.Duration.Duration
+		if x.Jitter != "" {
+			jitter, err := strconv.ParseFloat(x.Jitter, 64)
+			if err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "failed to parse VM's deletion Jitter")
+			}
+			if jitter > 0.0 {
+				// NOTE: the additional amount is computed from the InMemoryMachine UID, so the deletion does not complete
+				// at a different time on every reconcile.
+				deletionDuration += time.Duration(randomFraction(inMemoryMachine, "vmDeletionJitter") * jitter * float64(deletionDuration))
+			}
+		}
+
+		start := inMemoryMachine.DeletionTimestamp
+		now := time.Now()
+		if now.Before(start.Add(deletionDuration)) {
+			return ctrl.Result{RequeueAfter: start.Add(deletionDuration).Sub(now)}, nil
+		}
+	}
+
 	// Delete VM
 	cloudMachine := &cloudv1.CloudMachine{
 		ObjectMeta: metav1.ObjectMeta{
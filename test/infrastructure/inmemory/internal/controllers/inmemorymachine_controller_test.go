@@ -33,6 +33,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -146,6 +147,60 @@ func TestReconcileNormalCloudMachine(t *testing.T) {
 	})
 }
 
+func TestSimulateFailure(t *testing.T) {
+	t.Run("fails if the probability is not valid", func(t *testing.T) {
+		g := NewWithT(t)
+
+		inMemoryMachine := &infrav1.InMemoryMachine{ObjectMeta: metav1.ObjectMeta{UID: "foo"}}
+		_, err := simulateFailure(inMemoryMachine, "vm", "foo")
+		g.Expect(err).To(HaveOccurred())
+		_, err = simulateFailure(inMemoryMachine, "vm", "1.5")
+		g.Expect(err).To(HaveOccurred())
+	})
+	t.Run("never and always fails with probability 0 and 1", func(t *testing.T) {
+		g := NewWithT(t)
+
+		for i := 0; i < 100; i++ {
+			inMemoryMachine := &infrav1.InMemoryMachine{ObjectMeta: metav1.ObjectMeta{UID: types.UID(fmt.Sprintf("machine-%d", i))}}
+			failure, err := simulateFailure(inMemoryMachine, "vm", "0")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(failure).To(BeFalse())
+			failure, err = simulateFailure(inMemoryMachine, "vm", "1")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(failure).To(BeTrue())
+		}
+	})
+	t.Run("is stable for the same InMemoryMachine and roughly follows the probability", func(t *testing.T) {
+		g := NewWithT(t)
+
+		failures := 0
+		for i := 0; i < 1000; i++ {
+			inMemoryMachine := &infrav1.InMemoryMachine{ObjectMeta: metav1.ObjectMeta{UID: types.UID(fmt.Sprintf("machine-%d", i))}}
+			failure, err := simulateFailure(inMemoryMachine, "vm", "0.3")
+			g.Expect(err).ToNot(HaveOccurred())
+			again, err := simulateFailure(inMemoryMachine, "vm", "0.3")
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(again).To(Equal(failure))
+			if failure {
+				failures++
+			}
+		}
+		g.Expect(failures).To(BeNumerically("~", 300, 60))
+	})
+}
+
+func TestRandomFraction(t *testing.T) {
+	g := NewWithT(t)
+
+	for i := 0; i < 100; i++ {
+		inMemoryMachine := &infrav1.InMemoryMachine{ObjectMeta: metav1.ObjectMeta{UID: types.UID(fmt.Sprintf("machine-%d", i))}}
+		f := randomFraction(inMemoryMachine, "vmDeletionJitter")
+		g.Expect(f).To(BeNumerically(">=", 0))
+		g.Expect(f).To(BeNumerically("<", 1))
+		g.Expect(randomFraction(inMemoryMachine, "vmDeletionJitter")).To(Equal(f))
+	}
+}
+
 func TestReconcileNormalNode(t *testing.T) {
 	inMemoryMachineWithVMNotYetProvisioned := &infrav1.InMemoryMachine{
 		ObjectMeta: metav1.ObjectMeta{
@@ -15,4 +15,12 @@ limitations under the License.
 */
 
 // Package contract contains utils related to the Cluster API contract.
+//
+// It provides accessors for the fields defined by the Cluster API contract for
+// bootstrap, control plane and infrastructure providers objects (e.g. ControlPlane().Replicas()
+// or InfrastructureCluster().ControlPlaneEndpoint()), so providers and other consumers of
+// the Cluster API contract can read and write those fields without copying the field paths.
+// Accessors for fields which are part of the contract are kept compatible across minor releases;
+// whenever a field is removed from the contract, the corresponding accessor is deprecated first
+// and then removed following the Cluster API deprecation policy.
 package contract
@@ -0,0 +1,71 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/cluster-api/util/shard"
+)
+
+// ShardOptions has the options to configure sharding.
+type ShardOptions struct {
+	ShardCount int
+	ShardIndex int
+}
+
+// AddShardOptions adds the sharding flags to the flag set.
+func AddShardOptions(fs *pflag.FlagSet, options *ShardOptions) {
+	fs.IntVar(&options.ShardCount, "shard-count", 0,
+		"Number of shards the Clusters are partitioned into. Each replica of the controller only watches and reconciles "+
+			"the Clusters of its shard, and the objects belonging to them. If lower than 2, sharding is disabled.")
+
+	fs.IntVar(&options.ShardIndex, "shard-index", -1,
+		"Index of the shard reconciled by this replica of the controller, from 0 to --shard-count minus 1. "+
+			"If not set, the index is the ordinal of the StatefulSet Pod running the controller, read from the POD_NAME environment variable.")
+}
+
+// GetShard returns the shard reconciled by the controller.
+func GetShard(options ShardOptions) (shard.Shard, error) {
+	s := shard.Shard{
+		Index: options.ShardIndex,
+		Count: options.ShardCount,
+	}
+	if !s.Enabled() {
+		return shard.Shard{}, nil
+	}
+
+	if s.Index < 0 {
+		podName := os.Getenv("POD_NAME")
+		if podName == "" {
+			return shard.Shard{}, errors.New("failed to get shard index: --shard-index is not set and the POD_NAME environment variable is empty")
+		}
+		index, err := shard.IndexFromPodName(podName)
+		if err != nil {
+			return shard.Shard{}, err
+		}
+		s.Index = index
+	}
+
+	if err := s.Validate(); err != nil {
+		return shard.Shard{}, err
+	}
+	return s, nil
+}
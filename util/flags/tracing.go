@@ -0,0 +1,60 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flags
+
+import (
+	"context"
+
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"k8s.io/component-base/tracing"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
+)
+
+// TracingOptions has the options to configure OpenTelemetry tracing.
+type TracingOptions struct {
+	TracingEndpoint               string
+	TracingSamplingRatePerMillion int32
+}
+
+// AddTracingOptions adds the tracing flags to the flag set.
+func AddTracingOptions(fs *pflag.FlagSet, options *TracingOptions) {
+	fs.StringVar(&options.TracingEndpoint, "tracing-endpoint", "",
+		"The endpoint of the OpenTelemetry collector the traces are exported to using the OTLP gRPC protocol, e.g. localhost:4317. "+
+			"If not set, tracing is disabled.")
+
+	fs.Int32Var(&options.TracingSamplingRatePerMillion, "tracing-sampling-rate-per-million", 1000000,
+		"Number of reconciles out of a million which are traced when tracing is enabled.")
+}
+
+// GetTracerProvider returns a TracerProvider exporting the traces as configured by the options.
+// The TracerProvider must be shut down to export the remaining traces before the controller exits.
+func GetTracerProvider(ctx context.Context, options TracingOptions, serviceName string) (tracing.TracerProvider, error) {
+	if options.TracingEndpoint == "" {
+		return tracing.NewNoopTracerProvider(), nil
+	}
+
+	return tracing.NewProvider(ctx,
+		&tracingapi.TracingConfiguration{
+			Endpoint:               &options.TracingEndpoint,
+			SamplingRatePerMillion: &options.TracingSamplingRatePerMillion,
+		},
+		nil,
+		[]resource.Option{resource.WithAttributes(semconv.ServiceName(serviceName))},
+	)
+}
@@ -26,6 +26,7 @@ import (
 
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/labels"
+	"sigs.k8s.io/cluster-api/util/shard"
 )
 
 // All returns a predicate that returns true only if all given predicates return true.
@@ -184,6 +185,33 @@ func ResourceNotPausedAndHasFilterLabel(logger logr.Logger, labelValue string) p
 	return All(logger, ResourceNotPaused(logger), ResourceHasFilterLabel(logger, labelValue))
 }
 
+// ResourceIsInShard returns a predicate that returns true only if the provided resource belongs to the given shard,
+// i.e. if the resource is a Cluster or belongs to a Cluster assigned to the shard.
+// If sharding is disabled, the predicate returns true for all the resources.
+// Example use:
+//
+//	err := controller.Watch(
+//		source.Kind(cache, &v1.MyType{}),
+//		&handler.EnqueueRequestForObject{},
+//		predicates.ResourceIsInShard(logger, shard),
+//	)
+func ResourceIsInShard(logger logr.Logger, s shard.Shard) predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return processIfInShard(logger.WithValues("predicate", "ResourceIsInShard", "eventType", "update"), e.ObjectNew, s)
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return processIfInShard(logger.WithValues("predicate", "ResourceIsInShard", "eventType", "create"), e.Object, s)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return processIfInShard(logger.WithValues("predicate", "ResourceIsInShard", "eventType", "delete"), e.Object, s)
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return processIfInShard(logger.WithValues("predicate", "ResourceIsInShard", "eventType", "generic"), e.Object, s)
+		},
+	}
+}
+
 func processIfNotPaused(logger logr.Logger, obj client.Object) bool {
 	kind := strings.ToLower(obj.GetObjectKind().GroupVersionKind().Kind)
 	log := logger.WithValues("namespace", obj.GetNamespace(), kind, obj.GetName())
@@ -274,3 +302,19 @@ func processIfTopologyOwned(logger logr.Logger, obj client.Object) bool {
 	log.V(6).Info("Resource is not topology owned, will not attempt to map resource")
 	return false
 }
+
+func processIfInShard(logger logr.Logger, obj client.Object, s shard.Shard) bool {
+	// Return early if sharding is disabled.
+	if !s.Enabled() {
+		return true
+	}
+
+	kind := strings.ToLower(obj.GetObjectKind().GroupVersionKind().Kind)
+	log := logger.WithValues("namespace", obj.GetNamespace(), kind, obj.GetName())
+	if s.Contains(obj) {
+		log.V(6).Info("Resource belongs to the shard, will attempt to map resource")
+		return true
+	}
+	log.V(6).Info("Resource does not belong to the shard, will not attempt to map resource")
+	return false
+}
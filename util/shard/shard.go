@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shard implements utilities to partition Clusters and the objects belonging to them
+// across multiple replicas of a controller.
+package shard
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// Shard identifies the subset of Clusters reconciled by a replica of a controller.
+// Clusters are assigned to shards using an FNV hash of their namespace and name modulo the number of shards;
+// all the objects belonging to a Cluster, i.e. having the cluster name label, are assigned to the same shard as the Cluster.
+// Note: this is not a consistent hash, changing the number of shards moves most of the Clusters to a different shard.
+// The zero value of Shard disables sharding.
+type Shard struct {
+	// Index is the index of the shard, from 0 to Count-1.
+	Index int
+
+	// Count is the total number of shards.
+	// Sharding is disabled if Count is lower than 2.
+	Count int
+}
+
+// Enabled returns true if sharding is enabled.
+func (s Shard) Enabled() bool {
+	return s.Count > 1
+}
+
+// IsPrimary returns true if the shard is responsible for objects which do not belong to a Cluster,
+// e.g. ClusterClasses, which must be reconciled by a single replica; this is the shard with index 0.
+// If sharding is disabled, the shard is always the primary one.
+func (s Shard) IsPrimary() bool {
+	return !s.Enabled() || s.Index == 0
+}
+
+// Validate returns an error if the shard is not valid.
+func (s Shard) Validate() error {
+	if s.Count < 0 {
+		return errors.Errorf("shard count must be greater than or equal to 0, got %d", s.Count)
+	}
+	if s.Enabled() && (s.Index < 0 || s.Index >= s.Count) {
+		return errors.Errorf("shard index must be between 0 and %d, got %d", s.Count-1, s.Index)
+	}
+	return nil
+}
+
+// Contains returns true if the object belongs to the shard.
+// Objects which do not belong to a Cluster, e.g. a ClusterClass, belong to all the shards; controllers reconciling
+// them should either run only on the primary shard or scope their work to the Clusters of the shard.
+// If sharding is disabled, all the objects belong to the shard.
+func (s Shard) Contains(obj client.Object) bool {
+	if !s.Enabled() {
+		return true
+	}
+	name, ok := clusterName(obj)
+	if !ok {
+		return true
+	}
+	return For(obj.GetNamespace(), name, s.Count) == s.Index
+}
+
+// LeaderElectionID returns the leader election ID for the shard, so that one replica per shard
+// can be elected as a leader.
+func (s Shard) LeaderElectionID(id string) string {
+	if !s.Enabled() {
+		return id
+	}
+	return fmt.Sprintf("%s-shard-%d", id, s.Index)
+}
+
+// For returns the index of the shard the Cluster with the given namespace and name belongs to.
+func For(namespace, name string, count int) int {
+	if count < 2 {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace + "/" + name))
+	return int(h.Sum32() % uint32(count))
+}
+
+// IndexFromPodName returns the shard index from the ordinal of a StatefulSet Pod, e.g. 2 for "capi-controller-manager-2".
+func IndexFromPodName(podName string) (int, error) {
+	i := strings.LastIndex(podName, "-")
+	if i < 0 {
+		return 0, errors.Errorf("failed to get shard index from Pod name %q: Pod name must end with an ordinal", podName)
+	}
+	index, err := strconv.Atoi(podName[i+1:])
+	if err != nil || index < 0 {
+		return 0, errors.Errorf("failed to get shard index from Pod name %q: Pod name must end with an ordinal", podName)
+	}
+	return index, nil
+}
+
+// clusterName returns the name of the Cluster the object belongs to, if any.
+func clusterName(obj client.Object) (string, bool) {
+	if _, ok := obj.(*clusterv1.Cluster); ok {
+		return obj.GetName(), true
+	}
+	if gvk := obj.GetObjectKind().GroupVersionKind(); gvk.Group == clusterv1.GroupVersion.Group && gvk.Kind == "Cluster" {
+		return obj.GetName(), true
+	}
+	if name, ok := obj.GetLabels()[clusterv1.ClusterNameLabel]; ok && name != "" {
+		return name, true
+	}
+	return "", false
+}
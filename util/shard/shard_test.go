@@ -0,0 +1,102 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestShard_Contains(t *testing.T) {
+	g := NewWithT(t)
+
+	shards := []Shard{{Index: 0, Count: 3}, {Index: 1, Count: 3}, {Index: 2, Count: 3}}
+
+	for i := 0; i < 20; i++ {
+		cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: fmt.Sprintf("cluster-%d", i)}}
+		machine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "machine",
+			Labels:    map[string]string{clusterv1.ClusterNameLabel: cluster.Name},
+		}}
+
+		// Each Cluster belongs to exactly one shard, together with its Machines.
+		count := 0
+		for _, s := range shards {
+			if s.Contains(cluster) {
+				count++
+				g.Expect(s.Contains(machine)).To(BeTrue())
+			} else {
+				g.Expect(s.Contains(machine)).To(BeFalse())
+			}
+		}
+		g.Expect(count).To(Equal(1))
+
+		// If sharding is disabled, all the objects belong to the shard.
+		g.Expect(Shard{}.Contains(cluster)).To(BeTrue())
+	}
+
+	// Objects which do not belong to a Cluster belong to all the shards.
+	clusterClass := &clusterv1.ClusterClass{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "class"}}
+	for _, s := range shards {
+		g.Expect(s.Contains(clusterClass)).To(BeTrue())
+	}
+}
+
+func TestShard_Validate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(Shard{}.Validate()).To(Succeed())
+	g.Expect(Shard{Index: 2, Count: 3}.Validate()).To(Succeed())
+	g.Expect(Shard{Index: 3, Count: 3}.Validate()).ToNot(Succeed())
+	g.Expect(Shard{Index: -1, Count: 3}.Validate()).ToNot(Succeed())
+	g.Expect(Shard{Count: -1}.Validate()).ToNot(Succeed())
+}
+
+func TestShard_IsPrimary(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(Shard{}.IsPrimary()).To(BeTrue())
+	g.Expect(Shard{Index: 0, Count: 3}.IsPrimary()).To(BeTrue())
+	g.Expect(Shard{Index: 1, Count: 3}.IsPrimary()).To(BeFalse())
+}
+
+func TestShard_LeaderElectionID(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(Shard{}.LeaderElectionID("controller-leader-election-capi")).To(Equal("controller-leader-election-capi"))
+	g.Expect(Shard{Index: 1, Count: 3}.LeaderElectionID("controller-leader-election-capi")).To(Equal("controller-leader-election-capi-shard-1"))
+}
+
+func TestIndexFromPodName(t *testing.T) {
+	g := NewWithT(t)
+
+	index, err := IndexFromPodName("capi-controller-manager-2")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(index).To(Equal(2))
+
+	_, err = IndexFromPodName("capi-controller-manager-7c9d8f6b5-x2x4z")
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = IndexFromPodName("capi")
+	g.Expect(err).To(HaveOccurred())
+}